@@ -0,0 +1,155 @@
+// Package api serve the GraphQL API, the files of the repositories and the web
+// UI of git-bug as a http.Handler, to mount in another Go program.
+//
+// The web UI request the API at /graphql and the files at /gitfile, /upload
+// and /avatar: the handler has to be mounted at the root of the server. The
+// deadlines of the bugs are served as an iCalendar at /calendar.ics.
+// Without the web UI, the API can be mounted under a prefix with
+// http.StripPrefix, though the urls of the uploaded files don't have it.
+package api
+
+import (
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/99designs/gqlgen/handler"
+	"github.com/gorilla/mux"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/graphql"
+	"github.com/MichaelMure/git-bug/graphql/auth"
+	"github.com/MichaelMure/git-bug/webui"
+)
+
+// Options configure what the handler serve, and to whom
+type Options struct {
+	// Repos are additional repositories, served under /repos/<name>
+	Repos map[string]*cache.RepoCache
+
+	// ReadOnly reject all the changes
+	ReadOnly bool
+
+	// Authenticator identify the users, who act as their identity. Without,
+	// the changes are made as the user identity of the repository. The API
+	// tokens of the repository are accepted either way.
+	Authenticator auth.Authenticator
+
+	// NoUI don't serve the web UI, only the API
+	NoUI bool
+
+	// NoPlayground don't serve the GraphQL playground at /playground
+	NoPlayground bool
+
+	// Limits protect the API from the costly queries, graphql.DefaultLimits
+	// if nil. With an allow-list, the queries of the web UI have to be in it.
+	Limits *graphql.Limits
+}
+
+// Handler serve the API and the web UI of git-bug
+type Handler struct {
+	http.Handler
+	graphql graphql.Handler
+	tokens  *auth.Tokens
+}
+
+// NewHandler serve a repository as the default one, with the given options.
+// The repositories are closed with the handler.
+func NewHandler(repoCache *cache.RepoCache, options Options) (*Handler, error) {
+	limits := graphql.DefaultLimits()
+	if options.Limits != nil {
+		limits = *options.Limits
+	}
+
+	graphqlHandler, err := graphql.NewCacheHandler(repoCache, limits)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, r := range options.Repos {
+		err := graphqlHandler.RegisterRepoCache(name, r)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tokens, err := auth.NewTokens(repoCache)
+	if err != nil {
+		return nil, err
+	}
+
+	mrc := &graphqlHandler.MultiRepoCache
+
+	// Routes
+	router := mux.NewRouter()
+	if !options.NoPlayground {
+		router.Path("/playground").Handler(handler.Playground("git-bug", "/graphql"))
+	}
+	router.Path("/graphql").Handler(graphqlHandler)
+	repoRoutes(router, mrc, options.ReadOnly, graphqlHandler.LockCaches)
+	repoRoutes(router.PathPrefix("/repos/{repo}").Subrouter(), mrc, options.ReadOnly, graphqlHandler.LockCaches)
+	if !options.NoUI {
+		assetsHandler := &fileSystemWithDefault{
+			FileSystem:  webui.WebUIAssets,
+			defaultFile: "index.html",
+		}
+		router.PathPrefix("/").Handler(http.FileServer(assetsHandler))
+	}
+
+	var rootHandler http.Handler = router
+	if options.ReadOnly {
+		rootHandler = auth.ReadOnly(rootHandler)
+	}
+	authHandler := rootHandler
+	if options.Authenticator != nil {
+		authHandler = auth.Middleware(options.Authenticator, rootHandler)
+	}
+
+	rootHandler = tokens.Middleware(authHandler, rootHandler)
+	if oidc, ok := options.Authenticator.(*auth.OIDC); ok {
+		// the login happens before the authentication
+		authRouter := mux.NewRouter()
+		authRouter.PathPrefix(auth.OIDCPathPrefix).Handler(oidc)
+		authRouter.PathPrefix("/").Handler(rootHandler)
+		rootHandler = authRouter
+	}
+
+	return &Handler{
+		Handler: rootHandler,
+		graphql: graphqlHandler,
+		tokens:  tokens,
+	}, nil
+}
+
+// CacheLock return the lock of the repository caches, held for reading by the
+// requests. It has to be held for writing to change the caches from outside,
+// like to refresh them.
+func (h *Handler) CacheLock() *sync.RWMutex {
+	return h.graphql.CacheLock()
+}
+
+// Tokens return the API tokens accepted by the handler
+func (h *Handler) Tokens() *auth.Tokens {
+	return h.tokens
+}
+
+// Close stop the running syncs of the bridges, and close the repositories
+func (h *Handler) Close() error {
+	return h.graphql.Close()
+}
+
+// implement a http.FileSystem that will serve a default file when the looked up
+// file doesn't exist. Useful for Single-Page App that implement routing client
+// side, where the server has to return the root index.html file for every route.
+type fileSystemWithDefault struct {
+	http.FileSystem
+	defaultFile string
+}
+
+func (fswd *fileSystemWithDefault) Open(name string) (http.File, error) {
+	f, err := fswd.FileSystem.Open(name)
+	if os.IsNotExist(err) {
+		return fswd.FileSystem.Open(fswd.defaultFile)
+	}
+	return f, err
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestNewHandler(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	other := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo, other)
+
+	repoCache, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	otherCache, err := cache.NewRepoCache(other)
+	require.NoError(t, err)
+
+	h, err := NewHandler(repoCache, Options{
+		Repos:        map[string]*cache.RepoCache{"other": otherCache},
+		ReadOnly:     true,
+		NoUI:         true,
+		NoPlayground: true,
+	})
+	require.NoError(t, err)
+	defer h.Close()
+
+	rec := httptest.NewRecorder()
+	query := `{"query": "{ repository(ref: \"other\") { allBugs { totalCount } } }"}`
+	req := httptest.NewRequest("POST", "/graphql", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.JSONEq(t, `{"data": {"repository": {"allBugs": {"totalCount": 0}}}}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/playground", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	// the uploads are not even routed in read-only
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("uploadfile", "screen.gif")
+	require.NoError(t, err)
+	_, err = part.Write(tinyGif)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	req = httptest.NewRequest("POST", "/upload", &body)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"time"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/interchange"
+)
+
+// implement a http.Handler that will serve the deadlines of the bugs and the
+// milestones as an iCalendar, to subscribe to from a calendar application. The
+// bugs can be restricted with a query given by ?q=<query>.
+type calendarHandler struct {
+	cache *cache.MultiRepoCache
+}
+
+func newCalendarHandler(cache *cache.MultiRepoCache) http.Handler {
+	return &calendarHandler{
+		cache: cache,
+	}
+}
+
+func (ch *calendarHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	query := cache.NewQuery()
+	if q := r.URL.Query().Get("q"); q != "" {
+		var err error
+		query, err = cache.ParseQuery(q)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	repo, err := requestRepo(ch.cache, r)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	milestones, err := repo.MilestoneDates()
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var bugs []interchange.Bug
+	for _, id := range repo.QueryBugs(query) {
+		b, err := repo.ResolveBug(id)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		bugs = append(bugs, interchange.FromSnapshot(b.Snapshot(), repo.LabelStore()))
+	}
+
+	var buf bytes.Buffer
+	err = interchange.WriteICS(&buf, bugs, milestones)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	http.ServeContent(rw, r, "", time.Now(), bytes.NewReader(buf.Bytes()))
+}
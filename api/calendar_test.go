@@ -0,0 +1,64 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestCalendar(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	mrc := cache.NewMultiRepoCache()
+	require.NoError(t, mrc.RegisterDefaultRepository(repo))
+	defer mrc.Close()
+
+	repoCache, err := mrc.DefaultRepo()
+	require.NoError(t, err)
+
+	rene, err := repoCache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, repoCache.SetUserIdentity(rene))
+
+	crash, _, err := repoCache.NewBug("Crash on startup", "message")
+	require.NoError(t, err)
+	_, err = crash.SetDueDate(time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	_, _, err = crash.ChangeLabels([]string{"milestone:v1.0"}, nil)
+	require.NoError(t, err)
+	require.NoError(t, crash.Commit())
+
+	_, _, err = repoCache.NewBug("Slow search", "message")
+	require.NoError(t, err)
+
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.milestone.v1.0.due", "2020-03-01"))
+
+	router := mux.NewRouter()
+	repoRoutes(router, &mrc, false, noLock)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("GET", "/calendar.ics", nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, "text/calendar; charset=utf-8", rec.Header().Get("Content-Type"))
+	require.Contains(t, rec.Body.String(), "UID:"+crash.Id().String()+"@git-bug")
+	require.Contains(t, rec.Body.String(), "SUMMARY:Milestone v1.0")
+	require.NotContains(t, rec.Body.String(), "Slow search")
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("GET", "/calendar.ics?q=status:closed", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotContains(t, rec.Body.String(), "VTODO")
+	require.NotContains(t, rec.Body.String(), "VEVENT")
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("GET", "/calendar.ics?q=status:unknown", nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,279 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/graphql/auth"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+// repoRoutes register the routes serving the files and the calendar of a
+// repository: the default one, or the one named in the {repo} variable of the
+// router
+func repoRoutes(router *mux.Router, mrc *cache.MultiRepoCache, readOnly bool, lock func(http.Handler) http.Handler) {
+	router.Path("/gitfile/{hash}").Handler(lock(newGitFileHandler(mrc)))
+	if !readOnly {
+		router.Path("/upload").Methods("POST").Handler(lock(newGitUploadFileHandler(mrc)))
+	}
+	router.Path("/avatar/{id}").Handler(lock(newAvatarHandler(mrc)))
+	router.Path("/calendar.ics").Handler(lock(newCalendarHandler(mrc)))
+}
+
+// requestRepo return the repository named in the url of a request, or the
+// default one
+func requestRepo(mrc *cache.MultiRepoCache, r *http.Request) (*cache.RepoCache, error) {
+	if ref := mux.Vars(r)["repo"]; ref != "" {
+		return mrc.ResolveRepo(ref)
+	}
+	return mrc.DefaultRepo()
+}
+
+// implement a http.Handler that will read and server git blob.
+type gitFileHandler struct {
+	cache *cache.MultiRepoCache
+}
+
+func newGitFileHandler(cache *cache.MultiRepoCache) http.Handler {
+	return &gitFileHandler{
+		cache: cache,
+	}
+}
+
+func (gfh *gitFileHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	hash := git.Hash(mux.Vars(r)["hash"])
+
+	if !hash.IsValid() {
+		http.Error(rw, "invalid git hash", http.StatusBadRequest)
+		return
+	}
+
+	// TODO: this mean that the whole file will he buffered in memory
+	// This can be a problem for big files. There might be a way around
+	// that by implementing a io.ReadSeeker that would read and discard
+	// data when a seek is called.
+	repo, err := requestRepo(gfh.cache, r)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	data, err := repo.ReadData(git.Hash(hash))
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// the other files could run scripts in the web UI
+	if !inlineImage(http.DetectContentType(data)) {
+		rw.Header().Set("Content-Type", "application/octet-stream")
+		rw.Header().Set("Content-Disposition", "attachment")
+	}
+	rw.Header().Set("X-Content-Type-Options", "nosniff")
+
+	http.ServeContent(rw, r, "", time.Now(), bytes.NewReader(data))
+}
+
+// implement a http.Handler that will serve the locally cached avatar of an
+// identity, at the size given by ?size=<pixels>. The web UI doesn't request
+// the avatar providers directly, which would give them the address of every
+// visitor.
+type avatarHandler struct {
+	cache *cache.MultiRepoCache
+}
+
+func newAvatarHandler(cache *cache.MultiRepoCache) http.Handler {
+	return &avatarHandler{
+		cache: cache,
+	}
+}
+
+func (ah *avatarHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	id := entity.Id(mux.Vars(r)["id"])
+
+	if err := id.Validate(); err != nil {
+		http.Error(rw, "invalid identity id", http.StatusBadRequest)
+		return
+	}
+
+	size := 0
+	if value := r.URL.Query().Get("size"); value != "" {
+		var err error
+		size, err = strconv.Atoi(value)
+		if err != nil || size <= 0 {
+			http.Error(rw, "invalid size", http.StatusBadRequest)
+			return
+		}
+	}
+	size = cache.NormalizeAvatarSize(size)
+
+	repo, err := requestRepo(ah.cache, r)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	i, err := repo.ResolveIdentity(id)
+	if err == identity.ErrIdentityNotExist {
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, contentType, err := repo.AvatarSized(id, size)
+	switch {
+	case err == nil:
+		rw.Header().Set("Cache-Control", "max-age=86400")
+
+	case err == cache.ErrNoAvatar:
+		data, contentType = initialsAvatar(id, identity.Initials(i.Identity), size), "image/svg+xml"
+		rw.Header().Set("Cache-Control", "max-age=86400")
+
+	default:
+		// offline, or the provider is unavailable: try again later
+		data, contentType = initialsAvatar(id, identity.Initials(i.Identity), size), "image/svg+xml"
+		rw.Header().Set("Cache-Control", "no-store")
+	}
+
+	rw.Header().Set("Content-Type", contentType)
+	rw.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(rw, r, "", time.Time{}, bytes.NewReader(data))
+}
+
+// initialsAvatar draw the initials of an identity in a square, colored after
+// its id to tell the identities apart
+func initialsAvatar(id entity.Id, initials string, size int) []byte {
+	hue := 0
+	for _, c := range id.String() {
+		hue = (hue*31 + int(c)) % 360
+	}
+
+	return []byte(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%[1]d" height="%[1]d" viewBox="0 0 100 100">`+
+		`<rect width="100" height="100" fill="hsl(%[2]d, 45%%, 55%%)"/>`+
+		`<text x="50" y="50" dy="0.35em" fill="#fff" font-family="sans-serif" font-size="42" text-anchor="middle">%[3]s</text>`+
+		`</svg>`, size, hue, html.EscapeString(initials)))
+}
+
+// the maximum size of an uploaded file, 100MB like github
+const maxUploadSize int64 = 100 * 1000 * 1000
+
+// implement a http.Handler that will accept and store content into git blob,
+// to be attached to a comment.
+type gitUploadFileHandler struct {
+	cache *cache.MultiRepoCache
+}
+
+func newGitUploadFileHandler(cache *cache.MultiRepoCache) http.Handler {
+	return &gitUploadFileHandler{
+		cache: cache,
+	}
+}
+
+func (gufh *gitUploadFileHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if auth.IsReadOnly(r.Context()) {
+		http.Error(rw, auth.ErrReadOnly.Error(), http.StatusForbidden)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(rw, r.Body, maxUploadSize)
+	if err := r.ParseMultipartForm(maxUploadSize); err != nil {
+		http.Error(rw, "file too big (100MB max)", http.StatusBadRequest)
+		return
+	}
+
+	file, header, err := r.FormFile("uploadfile")
+	if err != nil {
+		http.Error(rw, "invalid file", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+	fileBytes, err := ioutil.ReadAll(file)
+	if err != nil {
+		http.Error(rw, "invalid file", http.StatusBadRequest)
+		return
+	}
+
+	repo, err := requestRepo(gufh.cache, r)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	hash, err := repo.StoreData(fileBytes)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type response struct {
+		Hash        string `json:"hash"`
+		ContentType string `json:"contentType"`
+		Url         string `json:"url"`
+		Markdown    string `json:"markdown"`
+	}
+
+	contentType := http.DetectContentType(fileBytes)
+	url := fmt.Sprintf("/gitfile/%s", hash)
+	if ref := mux.Vars(r)["repo"]; ref != "" {
+		url = fmt.Sprintf("/repos/%s%s", ref, url)
+	}
+
+	resp := response{
+		Hash:        string(hash),
+		ContentType: contentType,
+		Url:         url,
+		Markdown:    attachmentMarkdown(header.Filename, url, inlineImage(contentType)),
+	}
+
+	js, err := json.Marshal(resp)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	_, err = rw.Write(js)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// inlineImage tell if a file can be displayed in the browser as an image,
+// others are downloaded as they could run scripts in the web UI
+func inlineImage(contentType string) bool {
+	switch contentType {
+	case "image/jpeg", "image/gif", "image/png", "image/webp", "image/bmp":
+		return true
+	}
+	return false
+}
+
+// attachmentMarkdown return the markdown referencing an uploaded file in a
+// comment: an image, or a link to download the file
+func attachmentMarkdown(filename string, url string, image bool) string {
+	name := strings.NewReplacer(`\`, `\\`, "[", `\[`, "]", `\]`, "\n", " ", "\r", " ").Replace(filename)
+	if name == "" {
+		name = "attachment"
+	}
+
+	if image {
+		return fmt.Sprintf("![%s](%s)", name, url)
+	}
+	return fmt.Sprintf("[%s](%s)", name, url)
+}
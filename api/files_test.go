@@ -0,0 +1,183 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"image"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+// a 1x1 transparent gif
+var tinyGif = []byte("GIF89a\x01\x00\x01\x00\x00\x00\x00!\xf9\x04\x01\x00\x00\x00\x00,\x00\x00\x00\x00\x01\x00\x01\x00\x00\x02\x00;")
+
+// the routes are used without background changes of the caches
+func noLock(handler http.Handler) http.Handler {
+	return handler
+}
+
+func upload(t *testing.T, handler http.Handler, path string, filename string, content []byte) map[string]string {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("uploadfile", filename)
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	req := httptest.NewRequest("POST", path, &body)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp map[string]string
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp
+}
+
+func TestUploadFile(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	mrc := cache.NewMultiRepoCache()
+	require.NoError(t, mrc.RegisterDefaultRepository(repo))
+	defer mrc.Close()
+
+	router := mux.NewRouter()
+	router.Path("/upload").Methods("POST").Handler(newGitUploadFileHandler(&mrc))
+	router.Path("/gitfile/{hash}").Handler(newGitFileHandler(&mrc))
+
+	image := upload(t, router, "/upload", "screen [1].gif", tinyGif)
+	require.Equal(t, "image/gif", image["contentType"])
+	require.Equal(t, "/gitfile/"+image["hash"], image["url"])
+	require.Equal(t, `![screen \[1\].gif](`+image["url"]+`)`, image["markdown"])
+
+	data, err := repo.ReadData(git.Hash(image["hash"]))
+	require.NoError(t, err)
+	require.Equal(t, tinyGif, data)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("GET", image["url"], nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "image/gif", rec.Header().Get("Content-Type"))
+	require.Empty(t, rec.Header().Get("Content-Disposition"))
+
+	// any other file is a link, downloaded instead of displayed
+	page := upload(t, router, "/upload", "page.html", []byte("<html><script>alert(1)</script></html>"))
+	require.Equal(t, "[page.html]("+page["url"]+")", page["markdown"])
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("GET", page["url"], nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/octet-stream", rec.Header().Get("Content-Type"))
+	require.Equal(t, "attachment", rec.Header().Get("Content-Disposition"))
+	require.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+}
+
+func TestRepoRoutes(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	other := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo, other)
+
+	mrc := cache.NewMultiRepoCache()
+	require.NoError(t, mrc.RegisterDefaultRepository(repo))
+	require.NoError(t, mrc.RegisterRepository("other", other))
+	defer mrc.Close()
+
+	router := mux.NewRouter()
+	repoRoutes(router, &mrc, false, noLock)
+	repoRoutes(router.PathPrefix("/repos/{repo}").Subrouter(), &mrc, false, noLock)
+
+	resp := upload(t, router, "/repos/other/upload", "screen.gif", tinyGif)
+	require.Equal(t, "/repos/other/gitfile/"+resp["hash"], resp["url"])
+
+	_, err := other.ReadData(git.Hash(resp["hash"]))
+	require.NoError(t, err)
+	_, err = repo.ReadData(git.Hash(resp["hash"]))
+	require.Error(t, err)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("GET", resp["url"], nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("GET", "/repos/unknown/gitfile/"+resp["hash"], nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestAvatar(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	// the provider of the test is on the loopback
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.avatar.private-hosts", "true"))
+
+	mrc := cache.NewMultiRepoCache()
+	require.NoError(t, mrc.RegisterDefaultRepository(repo))
+	defer mrc.Close()
+
+	repoCache, err := mrc.DefaultRepo()
+	require.NoError(t, err)
+
+	var avatar bytes.Buffer
+	require.NoError(t, png.Encode(&avatar, image.NewGray(image.Rect(0, 0, 200, 200))))
+
+	provider := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write(avatar.Bytes())
+	}))
+	defer provider.Close()
+
+	rene, err := repoCache.NewIdentityFull("René Descartes", "rene@descartes.fr", "", provider.URL+"/rene.gif")
+	require.NoError(t, err)
+	isaac, err := repoCache.NewIdentityFull("Isaac Newton", "isaac@newton.uk", "", "http://127.0.0.1:1/isaac.gif")
+	require.NoError(t, err)
+	nobody, err := repoCache.NewIdentity("Nobody", "")
+	require.NoError(t, err)
+
+	router := mux.NewRouter()
+	router.Path("/avatar/{id}").Handler(newAvatarHandler(&mrc))
+
+	get := func(url string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest("GET", url, nil))
+		return rec
+	}
+
+	rec := get("/avatar/" + rene.Id().String() + "?size=32")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	require.Equal(t, "max-age=86400", rec.Header().Get("Cache-Control"))
+	resized, err := png.Decode(rec.Body)
+	require.NoError(t, err)
+	require.Equal(t, image.Rect(0, 0, 32, 32), resized.Bounds())
+
+	rec = get("/avatar/" + rene.Id().String() + "?size=abc")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// the initials, until the provider can be reached
+	rec = get("/avatar/" + isaac.Id().String())
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "image/svg+xml", rec.Header().Get("Content-Type"))
+	require.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+	require.Contains(t, rec.Body.String(), ">IN</text>")
+
+	rec = get("/avatar/" + nobody.Id().String())
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "image/svg+xml", rec.Header().Get("Content-Type"))
+	require.Equal(t, "max-age=86400", rec.Header().Get("Cache-Control"))
+
+	rec = get("/avatar/" + strings.Repeat("0", 64))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -27,6 +27,8 @@ const (
 	MetaKeyOrigin    = "origin"
 
 	bridgeConfigKeyPrefix = "git-bug.bridge"
+	// the bridge used when none is given and several are configured
+	defaultBridgeConfigKey = "git-bug.default-bridge"
 )
 
 var bridgeImpl map[string]reflect.Type
@@ -40,6 +42,9 @@ type BridgeParams struct {
 	Token      string
 	TokenId    string
 	TokenStdin bool
+	// keep the token in the git credential helpers instead of the git-bug
+	// token store
+	CredentialHelper bool
 }
 
 // Bridge is a wrapper around a BridgeImpl that will bind low-level
@@ -122,9 +127,18 @@ func LoadBridge(repo *cache.RepoCache, name string) (*Bridge, error) {
 	return bridge, nil
 }
 
-// Attempt to retrieve a default bridge for the given repo. If zero or multiple
-// bridge exist, it fails.
+// Attempt to retrieve a default bridge for the given repo: the one configured
+// with git-bug.default-bridge, or else the only one configured. If zero or
+// multiple bridge exist, it fails.
 func DefaultBridge(repo *cache.RepoCache) (*Bridge, error) {
+	name, err := repo.LocalConfig().ReadString(defaultBridgeConfigKey)
+	if err == nil {
+		return LoadBridge(repo, name)
+	}
+	if err != repository.ErrNoConfigEntry {
+		return nil, err
+	}
+
 	bridges, err := ConfiguredBridges(repo)
 	if err != nil {
 		return nil, err
@@ -135,7 +149,7 @@ func DefaultBridge(repo *cache.RepoCache) (*Bridge, error) {
 	}
 
 	if len(bridges) > 1 {
-		return nil, fmt.Errorf("multiple bridge are configured, you need to select one explicitely")
+		return nil, fmt.Errorf("multiple bridge are configured, you need to select one explicitely or configure git-bug.default-bridge")
 	}
 
 	return LoadBridge(repo, bridges[0])
@@ -177,6 +191,38 @@ func ConfiguredBridges(repo repository.RepoCommon) ([]string, error) {
 	return result, nil
 }
 
+// LastImport return the name of the bridge that imported the most recently
+// without error, and when. An empty name is returned if no bridge did.
+func LastImport(repo repository.RepoCommon) (string, time.Time, error) {
+	bridges, err := ConfiguredBridges(repo)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	var name string
+	var last time.Time
+
+	for _, bridge := range bridges {
+		t, err := repo.LocalConfig().ReadTimestamp(lastImportTimeKey(bridge))
+		if err == repository.ErrNoConfigEntry {
+			continue
+		}
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		if t.After(last) {
+			name = bridge
+			last = t
+		}
+	}
+
+	return name, last, nil
+}
+
+func lastImportTimeKey(name string) string {
+	return fmt.Sprintf("git-bug.bridge.%s.lastImportTime", name)
+}
+
 // Check if a bridge exist
 func BridgeExist(repo repository.RepoCommon, name string) bool {
 	keyPrefix := fmt.Sprintf("git-bug.bridge.%s.", name)
@@ -201,6 +247,21 @@ func RemoveBridge(repo repository.RepoCommon, name string) error {
 	return repo.LocalConfig().RemoveAll(keyPrefix)
 }
 
+// Target return the kind of bug tracker of the bridge
+func (b *Bridge) Target() string {
+	return b.impl.Target()
+}
+
+// LastImportTime return when the bridge last imported without error, or the
+// zero time if it never did
+func (b *Bridge) LastImportTime() (time.Time, error) {
+	t, err := b.repo.LocalConfig().ReadTimestamp(lastImportTimeKey(b.Name))
+	if err == repository.ErrNoConfigEntry {
+		return time.Time{}, nil
+	}
+	return t, err
+}
+
 // Configure run the target specific configuration process
 func (b *Bridge) Configure(params BridgeParams) error {
 	conf, err := b.impl.Configure(b.repo, params)
@@ -280,12 +341,21 @@ func (b *Bridge) ensureInit() error {
 		return nil
 	}
 
-	token, err := LoadToken(b.repo, entity.Id(b.conf[ConfigKeyTokenId]))
-	if err != nil {
-		return err
-	}
+	if url, ok := b.conf[ConfigKeyCredentialURL]; ok {
+		cred, err := FillCredential(url)
+		if err != nil {
+			return err
+		}
+
+		b.conf[ConfigKeyToken] = cred.Password
+	} else {
+		token, err := LoadToken(b.repo, entity.Id(b.conf[ConfigKeyTokenId]))
+		if err != nil {
+			return err
+		}
 
-	b.conf[ConfigKeyToken] = token.Value
+		b.conf[ConfigKeyToken] = token.Value
+	}
 
 	importer := b.getImporter()
 	if importer != nil {
@@ -327,8 +397,13 @@ func (b *Bridge) ImportAllSince(ctx context.Context, since time.Time) (<-chan Im
 		return nil, err
 	}
 
+	// an import can write thousands of bugs, their refs are written at once
+	// and their objects packed at the end instead of staying loose
+	b.repo.BeginBatch()
+
 	events, err := importer.ImportAll(ctx, b.repo, since)
 	if err != nil {
+		_ = b.repo.EndBatch()
 		return nil, err
 	}
 
@@ -345,10 +420,14 @@ func (b *Bridge) ImportAllSince(ctx context.Context, since time.Time) (<-chan Im
 			out <- event
 		}
 
+		if err := b.repo.EndBatch(); err != nil {
+			noError = false
+			out <- NewImportError(err, "")
+		}
+
 		// store the last import time ONLY if no error happened
 		if noError {
-			key := fmt.Sprintf("git-bug.bridge.%s.lastImportTime", b.Name)
-			err = b.repo.LocalConfig().StoreTimestamp(key, importStartTime)
+			err = b.repo.LocalConfig().StoreTimestamp(lastImportTimeKey(b.Name), importStartTime)
 		}
 	}()
 
@@ -357,7 +436,7 @@ func (b *Bridge) ImportAllSince(ctx context.Context, since time.Time) (<-chan Im
 
 func (b *Bridge) ImportAll(ctx context.Context) (<-chan ImportResult, error) {
 	// If possible, restart from the last import time
-	lastImport, err := b.repo.LocalConfig().ReadTimestamp(fmt.Sprintf("git-bug.bridge.%s.lastImportTime", b.Name))
+	lastImport, err := b.repo.LocalConfig().ReadTimestamp(lastImportTimeKey(b.Name))
 	if err == nil {
 		return b.ImportAllSince(ctx, lastImport)
 	}
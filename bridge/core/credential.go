@@ -0,0 +1,109 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"strings"
+)
+
+// ConfigKeyCredentialURL is the url the token of a bridge is asked for to the
+// git credential helpers, instead of the git-bug token store
+const ConfigKeyCredentialURL = "credential-url"
+
+// Credential is a username and a password, usually a token, kept by the git
+// credential helpers
+type Credential struct {
+	Username string
+	Password string
+}
+
+// CredentialURL return the url of the host of a project, like
+// https://gitlab.com for https://gitlab.com/owner/project, as the credential
+// helpers only consider the host by default
+func CredentialURL(projectURL string) (string, error) {
+	if !strings.Contains(projectURL, "://") {
+		projectURL = "https://" + projectURL
+	}
+
+	u, err := url.Parse(projectURL)
+	if err != nil {
+		return "", err
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("no host in %s", projectURL)
+	}
+
+	return fmt.Sprintf("%s://%s", u.Scheme, u.Host), nil
+}
+
+// FillCredential ask the git credential helpers for the credential of a url.
+// Like for a git push, the user is prompted without helper knowing it.
+func FillCredential(url string) (Credential, error) {
+	out, err := runGitCredential("fill", url, Credential{})
+	if err != nil {
+		return Credential{}, err
+	}
+
+	var cred Credential
+
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		split := strings.SplitN(scanner.Text(), "=", 2)
+		if len(split) != 2 {
+			continue
+		}
+		switch split[0] {
+		case "username":
+			cred.Username = split[1]
+		case "password":
+			cred.Password = split[1]
+		}
+	}
+
+	if cred.Password == "" {
+		return Credential{}, fmt.Errorf("no credential for %s", url)
+	}
+
+	return cred, nil
+}
+
+// ApproveCredential store a credential in the git credential helpers
+func ApproveCredential(url string, cred Credential) error {
+	_, err := runGitCredential("approve", url, cred)
+	return err
+}
+
+// runGitCredential run git credential in the current directory, to use the
+// helpers configured for the repository as well
+func runGitCredential(action string, url string, cred Credential) (string, error) {
+	var stdin bytes.Buffer
+	_, _ = fmt.Fprintf(&stdin, "url=%s\n", url)
+	if cred.Username != "" {
+		_, _ = fmt.Fprintf(&stdin, "username=%s\n", cred.Username)
+	}
+	if cred.Password != "" {
+		_, _ = fmt.Fprintf(&stdin, "password=%s\n", cred.Password)
+	}
+	stdin.WriteString("\n")
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command("git", "credential", action)
+	cmd.Stdin = &stdin
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return "", fmt.Errorf("git credential %s: %s", action, msg)
+	}
+
+	return stdout.String(), nil
+}
@@ -0,0 +1,56 @@
+package core
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredentialURL(t *testing.T) {
+	url, err := CredentialURL("https://gitlab.com/owner/project")
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitlab.com", url)
+
+	url, err = CredentialURL("gitlab.example.com/owner/project")
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitlab.example.com", url)
+
+	_, err = CredentialURL("https:///project")
+	assert.Error(t, err)
+}
+
+func TestCredentialHelper(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git-bug-credential")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// only a store helper in a temporary file, without prompt
+	env := map[string]string{
+		"GIT_CONFIG_COUNT":    "2",
+		"GIT_CONFIG_KEY_0":    "credential.helper",
+		"GIT_CONFIG_VALUE_0":  "",
+		"GIT_CONFIG_KEY_1":    "credential.helper",
+		"GIT_CONFIG_VALUE_1":  "store --file=" + filepath.Join(dir, "credentials"),
+		"GIT_TERMINAL_PROMPT": "0",
+		"GIT_ASKPASS":         "",
+		"SSH_ASKPASS":         "",
+	}
+	for key, value := range env {
+		require.NoError(t, os.Setenv(key, value))
+		defer os.Unsetenv(key)
+	}
+
+	_, err = FillCredential("https://example.com")
+	assert.Error(t, err)
+
+	cred := Credential{Username: "user", Password: "token"}
+	require.NoError(t, ApproveCredential("https://example.com", cred))
+
+	filled, err := FillCredential("https://example.com")
+	require.NoError(t, err)
+	assert.Equal(t, cred, filled)
+}
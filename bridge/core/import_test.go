@@ -0,0 +1,139 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const (
+	mockTarget    = "mock"
+	metaKeyMockId = "mock-id"
+)
+
+// mockImporter import generated issues the way the real bridges do, without
+// a remote API: an author resolved by its metadata, then each issue and its
+// events unless they were already imported
+type mockImporter struct {
+	issues   int
+	comments int
+}
+
+func (mi *mockImporter) Init(conf Configuration) error {
+	return nil
+}
+
+func (mi *mockImporter) ImportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan ImportResult, error) {
+	out := make(chan ImportResult)
+
+	go func() {
+		defer close(out)
+
+		author, err := repo.ResolveIdentityImmutableMetadata(metaKeyMockId, "rene")
+		if err == identity.ErrIdentityNotExist {
+			author, err = repo.NewIdentityRaw("René Descartes", "rene@descartes.fr", "rene", "",
+				map[string]string{MetaKeyOrigin: mockTarget, metaKeyMockId: "rene"})
+		}
+		if err != nil {
+			out <- NewImportError(err, "")
+			return
+		}
+
+		for i := 0; i < mi.issues; i++ {
+			if ctx.Err() != nil {
+				return
+			}
+			if err := mi.importIssue(repo, author, i, out); err != nil {
+				out <- NewImportError(err, "")
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (mi *mockImporter) importIssue(repo *cache.RepoCache, author *cache.IdentityCache, i int, out chan<- ImportResult) error {
+	unix := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Unix() + int64(i)*3600
+	issueId := fmt.Sprintf("issue-%d", i)
+
+	b, err := repo.ResolveBugCreateMetadata(metaKeyMockId, issueId)
+	if err == bug.ErrBugNotExist {
+		b, _, err = repo.NewBugRaw(author, unix, fmt.Sprintf("issue %d", i), "message", nil,
+			map[string]string{MetaKeyOrigin: mockTarget, metaKeyMockId: issueId})
+		if err != nil {
+			return err
+		}
+		out <- NewImportBug(b.Id())
+	}
+	if err != nil {
+		return err
+	}
+
+	for j := 0; j < mi.comments; j++ {
+		commentId := fmt.Sprintf("%s-comment-%d", issueId, j)
+		if _, err := b.ResolveOperationWithMetadata(metaKeyMockId, commentId); err == nil {
+			continue
+		}
+
+		op, err := b.AddCommentRaw(author, unix+int64(j), fmt.Sprintf("comment %d", j), nil,
+			map[string]string{metaKeyMockId: commentId})
+		if err != nil {
+			return err
+		}
+		out <- NewImportComment(op.Id())
+	}
+
+	labelId := issueId + "-label"
+	if _, err := b.ResolveOperationWithMetadata(metaKeyMockId, labelId); err != nil {
+		_, op, err := b.ChangeLabelsRaw(author, unix, []string{"bug"}, nil,
+			map[string]string{metaKeyMockId: labelId})
+		if err != nil {
+			return err
+		}
+		out <- NewImportLabelChange(op.Id())
+	}
+
+	return b.CommitAsNeeded()
+}
+
+func benchmarkImport(issues int, b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		repo := repository.CreateTestRepo(false)
+		backend, err := cache.NewRepoCache(repo)
+		require.NoError(b, err)
+		importer := &mockImporter{issues: issues, comments: 10}
+		b.StartTimer()
+
+		events, err := importer.ImportAll(context.Background(), backend, time.Time{})
+		require.NoError(b, err)
+		for event := range events {
+			require.NoError(b, event.Err)
+		}
+
+		// nothing new the second time
+		events, err = importer.ImportAll(context.Background(), backend, time.Time{})
+		require.NoError(b, err)
+		for event := range events {
+			require.NoError(b, event.Err)
+			b.Fatalf("unexpected event %v", event)
+		}
+
+		b.StopTimer()
+		require.NoError(b, backend.Close())
+		repository.CleanupTestRepos(b, repo)
+		b.StartTimer()
+	}
+}
+
+func BenchmarkImport10(b *testing.B) { benchmarkImport(10, b) }
+func BenchmarkImport50(b *testing.B) { benchmarkImport(50, b) }
@@ -14,26 +14,27 @@ import (
 	"sort"
 	"strconv"
 	"strings"
-	"syscall"
 	"time"
 
 	text "github.com/MichaelMure/go-term-text"
 	"github.com/pkg/errors"
-	"golang.org/x/crypto/ssh/terminal"
 
 	"github.com/MichaelMure/git-bug/bridge/core"
 	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/input"
 	"github.com/MichaelMure/git-bug/repository"
 	"github.com/MichaelMure/git-bug/util/colors"
-	"github.com/MichaelMure/git-bug/util/interrupt"
+	"github.com/MichaelMure/git-bug/util/term"
 )
 
 const (
 	target      = "github"
 	githubV3Url = "https://api.github.com"
-	keyOwner    = "owner"
-	keyProject  = "project"
-	keyToken    = "token"
+	// the host the git credential helpers know the token for
+	githubCredentialUrl = "https://github.com"
+	keyOwner            = "owner"
+	keyProject          = "project"
+	keyToken            = "token"
 
 	defaultTimeout = 60 * time.Second
 )
@@ -46,6 +47,10 @@ func (g *Github) Configure(repo repository.RepoCommon, params core.BridgeParams)
 	conf := make(core.Configuration)
 	var err error
 
+	if params.CredentialHelper && params.TokenId != "" {
+		return nil, fmt.Errorf("a token id can't be used with the git credential helpers")
+	}
+
 	if (params.Token != "" || params.TokenId != "" || params.TokenStdin) &&
 		(params.URL == "" && (params.Project == "" || params.Owner == "")) {
 		return nil, fmt.Errorf("you must provide a project URL or Owner/Name to configure this bridge with a token")
@@ -108,13 +113,17 @@ func (g *Github) Configure(repo repository.RepoCommon, params core.BridgeParams)
 		token = strings.TrimSpace(token)
 	} else if params.TokenId != "" {
 		tokenId = entity.Id(params.TokenId)
-	} else {
+	} else if !params.CredentialHelper {
 		tokenObj, err = promptTokenOptions(repo, owner, project)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	if params.CredentialHelper {
+		return g.configureCredential(owner, project, token)
+	}
+
 	// at this point, we check if the token already exist or we create a new one
 	if token != "" {
 		tokenObj, err = core.LoadOrCreateToken(repo, target, token)
@@ -153,6 +162,48 @@ func (g *Github) Configure(repo repository.RepoCommon, params core.BridgeParams)
 	return conf, nil
 }
 
+// configureCredential configure the bridge with a token kept by the git
+// credential helpers. A token given by the user is stored in the helpers once
+// validated, otherwise it's asked to the helpers.
+func (g *Github) configureCredential(owner, project, token string) (core.Configuration, error) {
+	cred := core.Credential{Username: owner, Password: token}
+
+	if token == "" {
+		var err error
+		cred, err = core.FillCredential(githubCredentialUrl)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// verify access to the repository with token
+	ok, err := validateProject(owner, project, cred.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("project doesn't exist or authentication token has an incorrect scope")
+	}
+
+	err = core.ApproveCredential(githubCredentialUrl, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := make(core.Configuration)
+	conf[core.ConfigKeyTarget] = target
+	conf[core.ConfigKeyCredentialURL] = githubCredentialUrl
+	conf[keyOwner] = owner
+	conf[keyProject] = project
+
+	err = g.ValidateConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
 func (*Github) ValidateConfig(conf core.Configuration) error {
 	if v, ok := conf[core.ConfigKeyTarget]; !ok {
 		return fmt.Errorf("missing %s key", core.ConfigKeyTarget)
@@ -160,8 +211,10 @@ func (*Github) ValidateConfig(conf core.Configuration) error {
 		return fmt.Errorf("unexpected target name: %v", v)
 	}
 
-	if _, ok := conf[core.ConfigKeyTokenId]; !ok {
-		return fmt.Errorf("missing %s key", core.ConfigKeyTokenId)
+	_, hasTokenId := conf[core.ConfigKeyTokenId]
+	_, hasCredential := conf[core.ConfigKeyCredentialURL]
+	if !hasTokenId && !hasCredential {
+		return fmt.Errorf("missing %s or %s key", core.ConfigKeyTokenId, core.ConfigKeyCredentialURL)
 	}
 
 	if _, ok := conf[keyOwner]; !ok {
@@ -246,6 +299,11 @@ func randomFingerprint() string {
 }
 
 func promptTokenOptions(repo repository.RepoCommon, owner, project string) (*core.Token, error) {
+	err := input.RequireInteractive("prompt for the authentication token", "use --token, --token-id or --token-stdin")
+	if err != nil {
+		return nil, err
+	}
+
 	for {
 		tokens, err := core.LoadTokensWithTarget(repo, target)
 		if err != nil {
@@ -263,7 +321,7 @@ func promptTokenOptions(repo repository.RepoCommon, owner, project string) (*cor
 				if token.Target == target {
 					fmt.Printf("[%d]: %s => %s (%s)\n",
 						i+3,
-						colors.Cyan(token.ID().Human()),
+						colors.Id(token.ID().Human()),
 						text.TruncateMax(token.Value, 10),
 						token.CreateTime.Format(time.RFC822),
 					)
@@ -327,7 +385,9 @@ func promptToken() (string, error) {
 	for {
 		fmt.Print("Enter token: ")
 
-		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		line, err := term.ReadPassword()
+		// ReadPassword clip the new line entered by the user
+		fmt.Println()
 		if err != nil {
 			return "", err
 		}
@@ -436,6 +496,11 @@ func promptUsername() (string, error) {
 }
 
 func promptURL(remotes map[string]string) (string, string, error) {
+	err := input.RequireInteractive("prompt for the project URL", "use --url or --owner and --project")
+	if err != nil {
+		return "", "", err
+	}
+
 	validRemotes := getValidGithubRemoteURLs(remotes)
 	if len(validRemotes) > 0 {
 		for {
@@ -585,20 +650,10 @@ func validateProject(owner, project, token string) (bool, error) {
 }
 
 func promptPassword() (string, error) {
-	termState, err := terminal.GetState(int(syscall.Stdin))
-	if err != nil {
-		return "", err
-	}
-
-	cancel := interrupt.RegisterCleaner(func() error {
-		return terminal.Restore(int(syscall.Stdin), termState)
-	})
-	defer cancel()
-
 	for {
 		fmt.Print("password: ")
 
-		bytePassword, err := terminal.ReadPassword(int(syscall.Stdin))
+		password, err := term.ReadPassword()
 		// new line for coherent formatting, ReadPassword clip the normal new line
 		// entered by the user
 		fmt.Println()
@@ -607,8 +662,8 @@ func promptPassword() (string, error) {
 			return "", err
 		}
 
-		if len(bytePassword) > 0 {
-			return string(bytePassword), nil
+		if len(password) > 0 {
+			return password, nil
 		}
 
 		fmt.Println("password is empty")
@@ -616,27 +671,17 @@ func promptPassword() (string, error) {
 }
 
 func prompt2FA() (string, error) {
-	termState, err := terminal.GetState(int(syscall.Stdin))
-	if err != nil {
-		return "", err
-	}
-
-	cancel := interrupt.RegisterCleaner(func() error {
-		return terminal.Restore(int(syscall.Stdin), termState)
-	})
-	defer cancel()
-
 	for {
 		fmt.Print("two-factor authentication code: ")
 
-		byte2fa, err := terminal.ReadPassword(int(syscall.Stdin))
+		code, err := term.ReadPassword()
 		fmt.Println()
 		if err != nil {
 			return "", err
 		}
 
-		if len(byte2fa) > 0 {
-			return string(byte2fa), nil
+		if len(code) > 0 {
+			return code, nil
 		}
 
 		fmt.Println("code is empty")
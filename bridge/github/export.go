@@ -254,6 +254,18 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 			continue
 		}
 
+		// the operations replaced by a snapshot are not exported anymore, but
+		// the comments can still be edited
+		if snap, ok := op.(*bug.SnapshotOperation); ok {
+			for _, archived := range snap.Archived {
+				metadata, _ := snap.ArchivedMetadata(archived.Id)
+				if id, ok := metadata[metaKeyGithubId]; ok {
+					ge.cachedOperationIDs[archived.Id] = id
+				}
+			}
+			continue
+		}
+
 		// ignore operations already existing in github (due to import or export)
 		// cache the ID of already exported or imported issues and events from Github
 		if id, ok := op.GetMetadata(metaKeyGithubId); ok {
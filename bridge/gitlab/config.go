@@ -16,8 +16,10 @@ import (
 
 	"github.com/MichaelMure/git-bug/bridge/core"
 	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/input"
 	"github.com/MichaelMure/git-bug/repository"
 	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/term"
 )
 
 var (
@@ -39,6 +41,10 @@ func (g *Gitlab) Configure(repo repository.RepoCommon, params core.BridgeParams)
 	var tokenId entity.Id
 	var tokenObj *core.Token
 
+	if params.CredentialHelper && params.TokenId != "" {
+		return nil, fmt.Errorf("a token id can't be used with the git credential helpers")
+	}
+
 	if (params.Token != "" || params.TokenStdin) && params.URL == "" {
 		return nil, fmt.Errorf("you must provide a project URL to configure this bridge with a token")
 	}
@@ -73,13 +79,17 @@ func (g *Gitlab) Configure(repo repository.RepoCommon, params core.BridgeParams)
 		token = strings.TrimSpace(token)
 	} else if params.TokenId != "" {
 		tokenId = entity.Id(params.TokenId)
-	} else {
+	} else if !params.CredentialHelper {
 		tokenObj, err = promptTokenOptions(repo)
 		if err != nil {
 			return nil, errors.Wrap(err, "token prompt")
 		}
 	}
 
+	if params.CredentialHelper {
+		return g.configureCredential(url, token)
+	}
+
 	if token != "" {
 		tokenObj, err = core.LoadOrCreateToken(repo, target, token)
 		if err != nil {
@@ -113,6 +123,49 @@ func (g *Gitlab) Configure(repo repository.RepoCommon, params core.BridgeParams)
 	return conf, nil
 }
 
+// configureCredential configure the bridge with a token kept by the git
+// credential helpers. A token given by the user is stored in the helpers once
+// validated, otherwise it's asked to the helpers.
+func (g *Gitlab) configureCredential(projectURL, token string) (core.Configuration, error) {
+	credentialURL, err := core.CredentialURL(projectURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "project validation")
+	}
+
+	// the username gitlab expects with a token
+	cred := core.Credential{Username: "oauth2", Password: token}
+
+	if token == "" {
+		cred, err = core.FillCredential(credentialURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// validate project url and get its ID
+	id, err := validateProjectURL(projectURL, cred.Password)
+	if err != nil {
+		return nil, errors.Wrap(err, "project validation")
+	}
+
+	err = core.ApproveCredential(credentialURL, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := make(core.Configuration)
+	conf[keyProjectID] = strconv.Itoa(id)
+	conf[core.ConfigKeyCredentialURL] = credentialURL
+	conf[core.ConfigKeyTarget] = target
+
+	err = g.ValidateConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
 func (g *Gitlab) ValidateConfig(conf core.Configuration) error {
 	if v, ok := conf[core.ConfigKeyTarget]; !ok {
 		return fmt.Errorf("missing %s key", core.ConfigKeyTarget)
@@ -120,8 +173,10 @@ func (g *Gitlab) ValidateConfig(conf core.Configuration) error {
 		return fmt.Errorf("unexpected target name: %v", v)
 	}
 
-	if _, ok := conf[keyToken]; !ok {
-		return fmt.Errorf("missing %s key", keyToken)
+	_, hasTokenId := conf[core.ConfigKeyTokenId]
+	_, hasCredential := conf[core.ConfigKeyCredentialURL]
+	if !hasTokenId && !hasCredential {
+		return fmt.Errorf("missing %s or %s key", core.ConfigKeyTokenId, core.ConfigKeyCredentialURL)
 	}
 
 	if _, ok := conf[keyProjectID]; !ok {
@@ -132,6 +187,11 @@ func (g *Gitlab) ValidateConfig(conf core.Configuration) error {
 }
 
 func promptTokenOptions(repo repository.RepoCommon) (*core.Token, error) {
+	err := input.RequireInteractive("prompt for the authentication token", "use --token, --token-id or --token-stdin")
+	if err != nil {
+		return nil, err
+	}
+
 	for {
 		tokens, err := core.LoadTokensWithTarget(repo, target)
 		if err != nil {
@@ -155,7 +215,7 @@ func promptTokenOptions(repo repository.RepoCommon) (*core.Token, error) {
 			if token.Target == target {
 				fmt.Printf("[%d]: %s => %s (%s)\n",
 					i+2,
-					colors.Cyan(token.ID().Human()),
+					colors.Id(token.ID().Human()),
 					text.TruncateMax(token.Value, 10),
 					token.CreateTime.Format(time.RFC822),
 				)
@@ -208,7 +268,9 @@ func promptToken() (string, error) {
 	for {
 		fmt.Print("Enter token: ")
 
-		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		line, err := term.ReadPassword()
+		// ReadPassword clip the new line entered by the user
+		fmt.Println()
 		if err != nil {
 			return "", err
 		}
@@ -223,6 +285,11 @@ func promptToken() (string, error) {
 }
 
 func promptURL(remotes map[string]string) (string, error) {
+	err := input.RequireInteractive("prompt for the project URL", "use --url")
+	if err != nil {
+		return "", err
+	}
+
 	validRemotes := getValidGitlabRemoteURLs(remotes)
 	if len(validRemotes) > 0 {
 		for {
@@ -230,6 +230,18 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 			continue
 		}
 
+		// the operations replaced by a snapshot are not exported anymore, but
+		// the comments can still be edited
+		if snap, ok := op.(*bug.SnapshotOperation); ok {
+			for _, archived := range snap.Archived {
+				metadata, _ := snap.ArchivedMetadata(archived.Id)
+				if id, ok := metadata[metaKeyGitlabId]; ok {
+					ge.cachedOperationIDs[archived.Id.String()] = id
+				}
+			}
+			continue
+		}
+
 		// ignore operations already existing in gitlab (due to import or export)
 		// cache the ID of already exported or imported issues and events from Gitlab
 		if id, ok := op.GetMetadata(metaKeyGitlabId); ok {
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/input"
 	"github.com/MichaelMure/git-bug/repository"
 )
 
@@ -85,6 +86,11 @@ func (*Launchpad) ValidateConfig(conf core.Configuration) error {
 }
 
 func promptProjectName() (string, error) {
+	err := input.RequireInteractive("prompt for the project name", "use --project or --url")
+	if err != nil {
+		return "", err
+	}
+
 	for {
 		fmt.Print("Launchpad project name: ")
 
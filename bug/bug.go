@@ -4,7 +4,9 @@ package bug
 import (
 	"encoding/json"
 	"fmt"
+	"runtime"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 
@@ -114,6 +116,23 @@ func ReadRemoteBug(repo repository.ClockedRepo, remote string, id string) (*Bug,
 
 // readBug will read and parse a Bug from git
 func readBug(repo repository.ClockedRepo, ref string) (*Bug, error) {
+	bug, err := readBugData(repo, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure that the identities are properly loaded
+	resolver := identity.NewSimpleResolver(repo)
+	err = bug.EnsureIdentities(resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	return bug, nil
+}
+
+// readBugData read and parse a Bug from git, without loading the identities
+func readBugData(repo repository.ClockedRepo, ref string) (*Bug, error) {
 	refSplit := strings.Split(ref, "/")
 	id := entity.Id(refSplit[len(refSplit)-1])
 
@@ -209,6 +228,11 @@ func readBug(repo repository.ClockedRepo, ref string) (*Bug, error) {
 			return nil, errors.Wrap(err, "failed to read git blob data")
 		}
 
+		data, err = decodePack(data)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode OperationPack cbor")
+		}
+
 		opp := &OperationPack{}
 		err = json.Unmarshal(data, &opp)
 
@@ -216,19 +240,13 @@ func readBug(repo repository.ClockedRepo, ref string) (*Bug, error) {
 			return nil, errors.Wrap(err, "failed to decode OperationPack json")
 		}
 
-		// tag the pack with the commit hash
+		// tag the pack with the commit hash and its edit time
 		opp.commitHash = hash
+		opp.editTime = lamport.Time(editTime)
 
 		bug.packs = append(bug.packs, *opp)
 	}
 
-	// Make sure that the identities are properly loaded
-	resolver := identity.NewSimpleResolver(repo)
-	err = bug.EnsureIdentities(resolver)
-	if err != nil {
-		return nil, err
-	}
-
 	return &bug, nil
 }
 
@@ -250,26 +268,80 @@ func ReadAllRemoteBugs(repo repository.ClockedRepo, remote string) <-chan Stream
 
 // Read and parse all available bug with a given ref prefix
 func readAllBugs(repo repository.ClockedRepo, refPrefix string) <-chan StreamedBug {
+	refs, err := repo.ListRefs(refPrefix)
+	if err != nil {
+		out := make(chan StreamedBug, 1)
+		out <- StreamedBug{Err: err}
+		close(out)
+		return out
+	}
+
+	return readBugs(repo, refs)
+}
+
+// ReadLocalBugs read and parse the given local bugs
+func ReadLocalBugs(repo repository.ClockedRepo, ids []entity.Id) <-chan StreamedBug {
+	refs := make([]string, len(ids))
+	for i, id := range ids {
+		refs[i] = bugsRefPattern + id.String()
+	}
+
+	return readBugs(repo, refs)
+}
+
+// readBugsConcurrency is the number of bugs read at the same time, reading
+// a bug being mostly waiting for git
+var readBugsConcurrency = runtime.NumCPU()
+
+// readBugs read and parse the bugs of the given refs concurrently, but send
+// them in the order of the refs. The reading stop at the first error.
+func readBugs(repo repository.ClockedRepo, refs []string) <-chan StreamedBug {
 	out := make(chan StreamedBug)
 
 	go func() {
 		defer close(out)
 
-		refs, err := repo.ListRefs(refPrefix)
-		if err != nil {
-			out <- StreamedBug{Err: err}
-			return
-		}
+		// the reads still running when stopping early write the clocks, so
+		// they are waited for before telling that the reading is done
+		var running sync.WaitGroup
+		defer running.Wait()
 
-		for _, ref := range refs {
-			b, err := readBug(repo, ref)
+		done := make(chan struct{})
+		defer close(done)
 
-			if err != nil {
-				out <- StreamedBug{Err: err}
-				return
+		// the bugs being read, in order
+		pending := make(chan chan StreamedBug, readBugsConcurrency)
+
+		running.Add(1)
+		go func() {
+			defer running.Done()
+			defer close(pending)
+
+			for _, ref := range refs {
+				result := make(chan StreamedBug, 1)
+
+				select {
+				case pending <- result:
+				case <-done:
+					return
+				}
+
+				running.Add(1)
+				go func(ref string) {
+					defer running.Done()
+					b, err := readBug(repo, ref)
+					result <- StreamedBug{Bug: b, Err: err}
+				}(ref)
 			}
+		}()
 
-			out <- StreamedBug{Bug: b}
+		for result := range pending {
+			streamed := <-result
+			out <- streamed
+
+			if streamed.Err != nil {
+				return
+			}
 		}
 	}()
 
@@ -286,6 +358,23 @@ func ListLocalIds(repo repository.Repo) ([]entity.Id, error) {
 	return refsToIds(refs), nil
 }
 
+// ListLocalHeads list all the available local bug ids, with the hash of the
+// last commit of each bug
+func ListLocalHeads(repo repository.Repo) (map[entity.Id]git.Hash, error) {
+	refs, err := repo.ResolveRefs(bugsRefPattern)
+	if err != nil {
+		return nil, err
+	}
+
+	heads := make(map[entity.Id]git.Hash, len(refs))
+	for ref, hash := range refs {
+		split := strings.Split(ref, "/")
+		heads[entity.Id(split[len(split)-1])] = hash
+	}
+
+	return heads, nil
+}
+
 func refsToIds(refs []string) []entity.Id {
 	ids := make([]entity.Id, len(refs))
 
@@ -470,6 +559,7 @@ func (bug *Bug) Commit(repo repository.ClockedRepo) error {
 	}
 
 	bug.staging.commitHash = hash
+	bug.staging.editTime = bug.editTime
 	bug.packs = append(bug.packs, bug.staging)
 	bug.staging = OperationPack{}
 
@@ -604,6 +694,12 @@ func (bug *Bug) Merge(repo repository.Repo, other Interface) (bool, error) {
 	return true, nil
 }
 
+// LastCommit return the hash of the last commit of the bug, empty if the bug
+// has never been stored
+func (bug *Bug) LastCommit() git.Hash {
+	return bug.lastCommit
+}
+
 // Id return the Bug identifier
 func (bug *Bug) Id() entity.Id {
 	if bug.id == "" {
@@ -662,20 +758,7 @@ func (bug *Bug) LastOp() Operation {
 
 // Compile a bug in a easily usable snapshot
 func (bug *Bug) Compile() Snapshot {
-	snap := Snapshot{
-		id:     bug.id,
-		Status: OpenStatus,
-	}
-
-	it := NewOperationIterator(bug)
-
-	for it.Next() {
-		op := it.Value()
-		op.Apply(&snap)
-		snap.Operations = append(snap.Operations, op)
-	}
-
-	return snap
+	return bug.CompileStages(AllStages)
 }
 
 // Sign post method for gqlgen
@@ -9,18 +9,36 @@ import (
 	"github.com/pkg/errors"
 )
 
+// FetchRefSpec return the refspec used to fetch the bugs of a remote
+func FetchRefSpec(remote string) string {
+	remoteRefSpec := fmt.Sprintf(bugsRemoteRefPattern, remote)
+	return fmt.Sprintf("%s*:%s*", bugsRefPattern, remoteRefSpec)
+}
+
+// PushRefSpec return the refspec used to push the bugs to a remote
+func PushRefSpec() string {
+	return bugsRefPattern + "*"
+}
+
+// RefName return the git reference of a bug
+func RefName(id entity.Id) string {
+	return bugsRefPattern + id.String()
+}
+
+// RemoteRefName return the git reference of a bug fetched from a remote
+func RemoteRefName(remote string, id entity.Id) string {
+	return fmt.Sprintf(bugsRemoteRefPattern, remote) + id.String()
+}
+
 // Fetch retrieve updates from a remote
 // This does not change the local bugs state
 func Fetch(repo repository.Repo, remote string) (string, error) {
-	remoteRefSpec := fmt.Sprintf(bugsRemoteRefPattern, remote)
-	fetchRefSpec := fmt.Sprintf("%s*:%s*", bugsRefPattern, remoteRefSpec)
-
-	return repo.FetchRefs(remote, fetchRefSpec)
+	return repo.FetchRefs(remote, FetchRefSpec(remote))
 }
 
 // Push update a remote with the local changes
 func Push(repo repository.Repo, remote string) (string, error) {
-	return repo.PushRefs(remote, bugsRefPattern+"*")
+	return repo.PushRefs(remote, PushRefSpec())
 }
 
 // Pull will do a Fetch + MergeAll
@@ -50,12 +68,23 @@ func Pull(repo repository.ClockedRepo, remote string) error {
 // - if the local bug has new commits but the remote don't, nothing is changed
 // - if both local and remote bug have new commits (that is, we have a concurrent edition),
 //   new local commits are rewritten at the head of the remote history (that is, a rebase)
+//
+// The bugs removed locally are ignored.
+//
+// Before being merged, each remote bug is checked with the registered verify hooks.
+// What happen to a bug failing the verification depend on the configured VerifyPolicy.
 func MergeAll(repo repository.ClockedRepo, remote string) <-chan entity.MergeResult {
 	out := make(chan entity.MergeResult)
 
 	go func() {
 		defer close(out)
 
+		policy, err := GetVerifyPolicy(repo)
+		if err != nil {
+			out <- entity.MergeResult{Err: err}
+			return
+		}
+
 		remoteRefSpec := fmt.Sprintf(bugsRemoteRefPattern, remote)
 		remoteRefs, err := repo.ListRefs(remoteRefSpec)
 
@@ -73,6 +102,17 @@ func MergeAll(repo repository.ClockedRepo, remote string) <-chan entity.MergeRes
 				continue
 			}
 
+			// the bug was removed locally, don't resurrect it
+			removed, err := IsRemoved(repo, id)
+			if err != nil {
+				out <- entity.NewMergeError(err, id)
+				continue
+			}
+			if removed {
+				out <- entity.NewMergeStatus(entity.MergeStatusNothing, id, nil)
+				continue
+			}
+
 			remoteBug, err := readBug(repo, remoteRef)
 
 			if err != nil {
@@ -86,6 +126,24 @@ func MergeAll(repo repository.ClockedRepo, remote string) <-chan entity.MergeRes
 				continue
 			}
 
+			warning := ""
+			if err := Verify(repo, remoteBug); err != nil {
+				switch policy {
+				case VerifyPolicyReject:
+					out <- entity.NewMergeInvalidStatus(id, errors.Wrap(err, "remote bug rejected").Error())
+					continue
+				case VerifyPolicyQuarantine:
+					if err := quarantine(repo, remote, remoteRef, id); err != nil {
+						out <- entity.NewMergeError(err, id)
+						continue
+					}
+					out <- entity.NewMergeInvalidStatus(id, errors.Wrap(err, "remote bug quarantined").Error())
+					continue
+				default:
+					warning = err.Error()
+				}
+			}
+
 			localRef := bugsRefPattern + remoteBug.Id().String()
 			localExist, err := repo.RefExist(localRef)
 
@@ -103,7 +161,7 @@ func MergeAll(repo repository.ClockedRepo, remote string) <-chan entity.MergeRes
 					return
 				}
 
-				out <- entity.NewMergeStatus(entity.MergeStatusNew, id, remoteBug)
+				out <- entity.NewMergeStatus(entity.MergeStatusNew, id, remoteBug).WithWarning(warning)
 				continue
 			}
 
@@ -122,9 +180,9 @@ func MergeAll(repo repository.ClockedRepo, remote string) <-chan entity.MergeRes
 			}
 
 			if updated {
-				out <- entity.NewMergeStatus(entity.MergeStatusUpdated, id, localBug)
+				out <- entity.NewMergeStatus(entity.MergeStatusUpdated, id, localBug).WithWarning(warning)
 			} else {
-				out <- entity.NewMergeStatus(entity.MergeStatusNothing, id, localBug)
+				out <- entity.NewMergeStatus(entity.MergeStatusNothing, id, localBug).WithWarning(warning)
 			}
 		}
 	}()
@@ -0,0 +1,341 @@
+package bug
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/git"
+	"github.com/MichaelMure/git-bug/util/lamport"
+)
+
+// Compact squash into a single commit the successive commits of a bug, after
+// the first one, whose operations are all older than the given time. The
+// operations themselves are kept untouched, only the number of git objects
+// is reduced.
+//
+// The first commit is never touched as it define the id of the bug. The
+// commits storing an operation authored by an identity with keys are not
+// squashed either, as their signature would be lost. As the commits after the
+// squashed ones are rewritten on top of it, nothing is done if one of them is
+// signed.
+//
+// As the history of the bug is rewritten, the next merge would fail if the
+// bug was shared. Nothing is rewritten unless the remotes can be reached and
+// don't have the bug.
+//
+// It return the number of commits removed.
+func (bug *Bug) Compact(repo repository.ClockedRepo, before time.Time) (int, error) {
+	if bug.NeedCommit() {
+		return 0, fmt.Errorf("can't compact a bug with pending operations")
+	}
+
+	// find the range of packs to squash, starting after the first one
+	end := 1
+	for end < len(bug.packs) && packCompactable(bug.packs[end], before) {
+		end++
+	}
+
+	if end-1 < 2 {
+		// nothing to squash
+		return 0, nil
+	}
+
+	signed, err := bug.packsSigned(repo, end)
+	if err != nil {
+		return 0, err
+	}
+	if signed {
+		return 0, nil
+	}
+
+	if err := bug.ensureUnpublished(repo); err != nil {
+		return 0, err
+	}
+
+	squashed := OperationPack{}
+	for _, pack := range bug.packs[1:end] {
+		squashed.Operations = append(squashed.Operations, pack.Operations...)
+		if pack.editTime > squashed.editTime {
+			squashed.editTime = pack.editTime
+		}
+	}
+
+	err = bug.replacePacks(repo, end, squashed)
+	if err != nil {
+		return 0, err
+	}
+
+	return end - 2, nil
+}
+
+// archive ref of the history of the bugs replaced by CollapseHistory, one
+// per collapse
+const bugsArchiveRefPattern = "refs/archive/bugs/%s/%s"
+
+// CollapseHistory replace the operations of a bug after the first one that
+// are all older than the given time with a single SnapshotOperation holding
+// the state they lead to, authored by the given identity. This bound the cost
+// of reading bugs with a long history, like the imported ones.
+//
+// The replaced history is kept untouched in an archive ref, so the original
+// commits and operations stay available. The ids and the metadata of the
+// replaced operations are kept in the SnapshotOperation, to keep finding the
+// imported data. Like for Compact, the operations signed by an identity with
+// keys are not replaced, and nothing is done if one of the commits after the
+// replaced ones is signed.
+//
+// Like for Compact, nothing is rewritten unless the remotes can be reached
+// and don't have the bug.
+//
+// It return the number of operations replaced.
+func (bug *Bug) CollapseHistory(repo repository.ClockedRepo, author identity.Interface, before time.Time) (int, error) {
+	if bug.NeedCommit() {
+		return 0, fmt.Errorf("can't collapse a bug with pending operations")
+	}
+
+	end := 1
+	for end < len(bug.packs) && packCompactable(bug.packs[end], before) {
+		end++
+	}
+
+	// the state at the end of the collapsed packs, compiled first to have
+	// the metadata added to the operations
+	collapsed := &Bug{id: bug.id, packs: bug.packs[:end]}
+	snap := collapsed.Compile()
+
+	var archived []ArchivedOperation
+	replaced := 0
+	editTime := lamport.Time(0)
+	for _, pack := range bug.packs[1:end] {
+		replaced += len(pack.Operations)
+		for _, op := range pack.Operations {
+			if snap, ok := op.(*SnapshotOperation); ok {
+				// collapsed again
+				for _, a := range snap.Archived {
+					metadata, _ := snap.ArchivedMetadata(a.Id)
+					archived = append(archived, ArchivedOperation{Id: a.Id, Metadata: metadata})
+				}
+			}
+			archived = append(archived, ArchivedOperation{Id: op.Id(), Metadata: op.AllMetadata()})
+		}
+		if pack.editTime > editTime {
+			editTime = pack.editTime
+		}
+	}
+
+	if replaced < 2 {
+		// nothing to gain
+		return 0, nil
+	}
+
+	signed, err := bug.packsSigned(repo, end)
+	if err != nil {
+		return 0, err
+	}
+	if signed {
+		return 0, nil
+	}
+
+	if err := bug.ensureUnpublished(repo); err != nil {
+		return 0, err
+	}
+
+	archive := bug.packs[end-1].commitHash
+
+	op := NewSnapshotOperation(author, collapsed.LastOp().GetUnixTime(), &snap, archived, archive)
+	if err := op.Validate(); err != nil {
+		return 0, err
+	}
+
+	err = repo.UpdateRef(fmt.Sprintf(bugsArchiveRefPattern, bug.id, archive), archive)
+	if err != nil {
+		return 0, err
+	}
+
+	pack := OperationPack{editTime: editTime}
+	pack.Append(op)
+
+	err = bug.replacePacks(repo, end, pack)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(archived), nil
+}
+
+// PublishedBugs return the bugs that exist on a remote, known from the refs
+// of the remotes fetched locally and from the remotes themselves, as a push
+// doesn't create the refs of the remote. A remote that can't be reached give
+// an error, as its bugs are unknown.
+func PublishedBugs(repo repository.Repo) (map[entity.Id]bool, error) {
+	published := make(map[entity.Id]bool)
+
+	tracked, err := repo.ListRefs("refs/remotes/")
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range tracked {
+		split := strings.Split(ref, "/")
+		if len(split) >= 5 && split[len(split)-2] == "bugs" {
+			published[entity.Id(split[len(split)-1])] = true
+		}
+	}
+
+	remotes, err := repo.GetRemotes()
+	if err != nil {
+		return nil, err
+	}
+	for remote := range remotes {
+		refs, err := repo.ListRemoteRefs(remote, bugsRefPattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, ref := range refs {
+			published[entity.Id(strings.TrimPrefix(ref, bugsRefPattern))] = true
+		}
+	}
+
+	return published, nil
+}
+
+// ensureUnpublished return an error if the bug exist on a remote, or if a
+// remote can't be reached to know it
+func (bug *Bug) ensureUnpublished(repo repository.Repo) error {
+	published, err := PublishedBugs(repo)
+	if err != nil {
+		return errors.Wrap(err, "can't check that the bug is not shared")
+	}
+	if published[bug.id] {
+		return fmt.Errorf("the bug %s exist on a remote, its history can't be rewritten", bug.id.Human())
+	}
+	return nil
+}
+
+// replacePacks replace the packs of a bug after the first one up to end
+// with a new pack, and rebase the following ones on it
+func (bug *Bug) replacePacks(repo repository.ClockedRepo, end int, replacement OperationPack) error {
+	hash, err := replacement.Write(repo)
+	if err != nil {
+		return err
+	}
+
+	tree := []repository.TreeEntry{
+		{ObjectType: repository.Blob, Hash: hash, Name: opsEntryName},
+		{ObjectType: repository.Blob, Hash: bug.rootPack, Name: rootEntryName},
+	}
+
+	mediaTree := makeMediaTree(replacement)
+	if len(mediaTree) > 0 {
+		mediaTreeHash, err := repo.StoreTree(mediaTree)
+		if err != nil {
+			return err
+		}
+		tree = append(tree, repository.TreeEntry{
+			ObjectType: repository.Tree,
+			Hash:       mediaTreeHash,
+			Name:       mediaEntryName,
+		})
+	}
+
+	emptyBlobHash, err := repo.StoreData([]byte{})
+	if err != nil {
+		return err
+	}
+
+	tree = append(tree, repository.TreeEntry{
+		ObjectType: repository.Blob,
+		Hash:       emptyBlobHash,
+		Name:       fmt.Sprintf(editClockEntryPattern, replacement.editTime),
+	})
+
+	treeHash, err := repo.StoreTree(tree)
+	if err != nil {
+		return err
+	}
+
+	replacement.commitHash, err = repo.StoreCommitWithParent(treeHash, bug.packs[0].commitHash)
+	if err != nil {
+		return err
+	}
+
+	newPacks := []OperationPack{bug.packs[0], replacement}
+	lastCommit := replacement.commitHash
+
+	// rebase the following packs on the new one
+	for _, pack := range bug.packs[end:] {
+		lastCommit, err = rebaseCommit(repo, pack.commitHash, lastCommit)
+		if err != nil {
+			return err
+		}
+
+		newPack := pack.Clone()
+		newPack.commitHash = lastCommit
+		newPacks = append(newPacks, newPack)
+	}
+
+	err = repo.UpdateRef(bugsRefPattern+bug.id.String(), lastCommit)
+	if err != nil {
+		return err
+	}
+
+	bug.packs = newPacks
+	bug.lastCommit = lastCommit
+
+	return nil
+}
+
+// packCompactable tell if the operations of a pack are all older than the
+// given time, and not signed
+func packCompactable(pack OperationPack, before time.Time) bool {
+	for _, op := range pack.Operations {
+		if !op.Time().Before(before) {
+			return false
+		}
+		if len(op.GetAuthor().ValidKeysAtTime(pack.editTime)) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// packsSigned tell if one of the packs of a bug from start is signed, or
+// authored by an identity with keys. These packs would lose their signature
+// if rebased.
+func (bug *Bug) packsSigned(repo repository.Repo, start int) (bool, error) {
+	for _, pack := range bug.packs[start:] {
+		for _, op := range pack.Operations {
+			if len(op.GetAuthor().ValidKeysAtTime(pack.editTime)) > 0 {
+				return true, nil
+			}
+		}
+
+		signer, err := repo.ReadCommitSigner(pack.commitHash)
+		if err == repository.ErrInvalidSignature || err == repository.ErrSignatureUnverifiable {
+			return true, nil
+		}
+		if err != nil {
+			return false, errors.Wrap(err, "can't read commit signature")
+		}
+		if signer != "" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// rebaseCommit create a new commit with the same tree as the given one, on
+// top of a new parent
+func rebaseCommit(repo repository.Repo, commit git.Hash, parent git.Hash) (git.Hash, error) {
+	treeHash, err := repo.GetTreeHash(commit)
+	if err != nil {
+		return "", errors.Wrap(err, "can't read the commit tree")
+	}
+
+	return repo.StoreCommitWithParent(treeHash, parent)
+}
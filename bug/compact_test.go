@@ -0,0 +1,236 @@
+package bug
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestCompact(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repo))
+
+	old := time.Now().Add(-48 * time.Hour)
+
+	bug1, _, err := Create(rene, old.Unix(), "title", "message")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit(repo))
+
+	for i := 0; i < 4; i++ {
+		_, err = AddComment(bug1, rene, old.Unix(), fmt.Sprintf("old comment %d", i))
+		require.NoError(t, err)
+		require.NoError(t, bug1.Commit(repo))
+	}
+
+	_, err = AddComment(bug1, rene, time.Now().Unix(), "recent comment")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit(repo))
+
+	var opIds []string
+	it := NewOperationIterator(bug1)
+	for it.Next() {
+		opIds = append(opIds, it.Value().Id().String())
+	}
+
+	removed, err := bug1.Compact(repo, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 3, removed)
+	assert.Len(t, bug1.packs, 3)
+
+	// nothing more to compact
+	removed, err = bug1.Compact(repo, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 0, removed)
+
+	read, err := ReadLocalBug(repo, bug1.Id())
+	require.NoError(t, err)
+	require.NoError(t, read.Validate())
+	assert.Len(t, read.packs, 3)
+
+	var readOpIds []string
+	it = NewOperationIterator(read)
+	for it.Next() {
+		readOpIds = append(readOpIds, it.Value().Id().String())
+	}
+	assert.Equal(t, opIds, readOpIds)
+
+	assert.Empty(t, Fsck(repo, bug1.Id()))
+}
+
+func TestCompactSignedPack(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repo))
+
+	signer := signingIdentity{
+		Identity: rene,
+		keys:     []identity.Key{{Fingerprint: "A2E3F9E4B5C6D7E8F9A0B1C2D3E4F5A6B7C8D9E0"}},
+	}
+
+	old := time.Now().Add(-48 * time.Hour)
+
+	bug1, _, err := Create(rene, old.Unix(), "title", "message")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit(repo))
+
+	for i := 0; i < 3; i++ {
+		_, err = AddComment(bug1, rene, old.Unix(), fmt.Sprintf("old comment %d", i))
+		require.NoError(t, err)
+		require.NoError(t, bug1.Commit(repo))
+	}
+
+	// a signed pack after the compactable ones
+	_, err = AddComment(bug1, signer, old.Unix(), "signed comment")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit(repo))
+
+	var commits []string
+	for _, pack := range bug1.packs {
+		commits = append(commits, string(pack.commitHash))
+	}
+
+	// the signed pack would be rewritten, nothing is done
+	removed, err := bug1.Compact(repo, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 0, removed)
+
+	archived, err := bug1.CollapseHistory(repo, rene, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 0, archived)
+
+	var after []string
+	for _, pack := range bug1.packs {
+		after = append(after, string(pack.commitHash))
+	}
+	assert.Equal(t, commits, after)
+
+	refs, err := repo.ResolveRefs(fmt.Sprintf("refs/archive/bugs/%s/", bug1.Id()))
+	require.NoError(t, err)
+	assert.Empty(t, refs)
+}
+
+func TestCompactPushed(t *testing.T) {
+	repo, _, remote := repository.SetupReposAndRemote(t)
+	defer repository.CleanupTestRepos(t, repo, remote)
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repo))
+
+	old := time.Now().Add(-48 * time.Hour)
+
+	bug1, _, err := Create(rene, old.Unix(), "title", "message")
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, bug1.Commit(repo))
+		_, err = AddComment(bug1, rene, old.Unix(), fmt.Sprintf("old comment %d", i))
+		require.NoError(t, err)
+	}
+	require.NoError(t, bug1.Commit(repo))
+
+	_, err = Push(repo, "origin")
+	require.NoError(t, err)
+
+	// the remote has the bug, even without a local ref of the remote
+	_, err = bug1.Compact(repo, time.Now().Add(-time.Hour))
+	assert.Error(t, err)
+	_, err = bug1.CollapseHistory(repo, rene, time.Now().Add(-time.Hour))
+	assert.Error(t, err)
+
+	read, err := ReadLocalBug(repo, bug1.Id())
+	require.NoError(t, err)
+	assert.Len(t, read.packs, 4)
+}
+
+func TestCollapseHistory(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repo))
+
+	old := time.Now().Add(-48 * time.Hour)
+
+	bug1, _, err := Create(rene, old.Unix(), "title", "message")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit(repo))
+
+	comment, err := AddComment(bug1, rene, old.Unix(), "old comment")
+	require.NoError(t, err)
+	comment.SetMetadata("origin", "github")
+	require.NoError(t, bug1.Commit(repo))
+
+	_, err = EditComment(bug1, rene, old.Unix(), comment.Id(), "edited comment")
+	require.NoError(t, err)
+	_, _, err = ChangeLabels(bug1, rene, old.Unix(), []string{"bug"}, nil)
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit(repo))
+
+	_, err = Close(bug1, rene, old.Unix())
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit(repo))
+
+	_, err = AddComment(bug1, rene, time.Now().Unix(), "recent comment")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit(repo))
+
+	before := bug1.Compile()
+	oldHead := bug1.packs[len(bug1.packs)-2].commitHash
+
+	archived, err := bug1.CollapseHistory(repo, rene, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 4, archived)
+	assert.Len(t, bug1.packs, 3)
+
+	// nothing more to collapse
+	archived, err = bug1.CollapseHistory(repo, rene, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 0, archived)
+
+	// the history is kept aside
+	refs, err := repo.ResolveRefs(fmt.Sprintf("refs/archive/bugs/%s/", bug1.Id()))
+	require.NoError(t, err)
+	assert.Len(t, refs, 1)
+	for _, archive := range refs {
+		assert.Equal(t, oldHead, archive)
+	}
+
+	read, err := ReadLocalBug(repo, bug1.Id())
+	require.NoError(t, err)
+	require.NoError(t, read.Validate())
+
+	after := read.Compile()
+	assert.Equal(t, before.Title, after.Title)
+	assert.Equal(t, before.Status, after.Status)
+	assert.Equal(t, before.Labels, after.Labels)
+	assert.Equal(t, before.ClosedUnix(), after.ClosedUnix())
+	require.Len(t, after.Comments, len(before.Comments))
+	for i, c := range before.Comments {
+		assert.Equal(t, c.Id(), after.Comments[i].Id())
+		assert.Equal(t, c.Message, after.Comments[i].Message)
+	}
+
+	// the metadata of the archived operations are still found
+	snapOp, ok := read.packs[1].Operations[0].(*SnapshotOperation)
+	require.True(t, ok)
+	metadata, ok := snapOp.ArchivedMetadata(comment.Id())
+	require.True(t, ok)
+	assert.Equal(t, "github", metadata["origin"])
+
+	// the collapsed comments can still be edited
+	_, err = EditComment(read, rene, time.Now().Unix(), comment.Id(), "edited again")
+	require.NoError(t, err)
+	require.NoError(t, read.Commit(repo))
+
+	assert.Empty(t, Fsck(repo, bug1.Id()))
+}
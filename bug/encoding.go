@@ -0,0 +1,91 @@
+package bug
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/cbor"
+)
+
+const encodingConfigKey = "git-bug.encoding"
+
+// Encoding define how the operation packs are stored in git
+type Encoding int
+
+const (
+	_ Encoding = iota
+	// EncodingJSON store the packs as JSON, readable by every version
+	EncodingJSON
+	// EncodingCBOR store the packs as CBOR, more compact but not readable by
+	// the versions of git-bug before it
+	EncodingCBOR
+)
+
+func (e Encoding) String() string {
+	switch e {
+	case EncodingJSON:
+		return "json"
+	case EncodingCBOR:
+		return "cbor"
+	default:
+		return "unknown encoding"
+	}
+}
+
+func EncodingFromString(str string) (Encoding, error) {
+	cleaned := strings.ToLower(strings.TrimSpace(str))
+
+	switch cleaned {
+	case "json":
+		return EncodingJSON, nil
+	case "cbor":
+		return EncodingCBOR, nil
+	default:
+		return 0, fmt.Errorf("unknown encoding %s", str)
+	}
+}
+
+// GetEncoding read the encoding of the packs configured for the repository.
+// It default to EncodingJSON.
+func GetEncoding(repo repository.RepoCommon) (Encoding, error) {
+	val, err := repo.LocalConfig().ReadString(encodingConfigKey)
+	if err == repository.ErrNoConfigEntry {
+		return EncodingJSON, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return EncodingFromString(val)
+}
+
+// encodePack encode the JSON of a pack as configured. The JSON is kept if it
+// can't be transcoded back exactly, as the ids of the operations are derived
+// from it.
+func encodePack(data []byte, encoding Encoding) ([]byte, error) {
+	if encoding != EncodingCBOR {
+		return data, nil
+	}
+
+	encoded, err := cbor.FromJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := cbor.ToJSON(encoded)
+	if err != nil || !bytes.Equal(decoded, data) {
+		return data, nil
+	}
+
+	return encoded, nil
+}
+
+// decodePack return the JSON of a pack stored with any encoding
+func decodePack(data []byte) ([]byte, error) {
+	if cbor.IsCBOR(data) {
+		return cbor.ToJSON(data)
+	}
+	return data, nil
+}
@@ -0,0 +1,81 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/cbor"
+)
+
+func TestEncodingFromString(t *testing.T) {
+	encoding, err := EncodingFromString(" CBOR ")
+	require.NoError(t, err)
+	assert.Equal(t, EncodingCBOR, encoding)
+
+	_, err = EncodingFromString("xml")
+	assert.Error(t, err)
+}
+
+func TestCBOREncoding(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repo))
+
+	bug1, _, err := Create(rene, time.Now().Unix(), "title", "message")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit(repo))
+
+	jsonData, err := repo.ReadData(bug1.rootPack)
+	require.NoError(t, err)
+
+	// the packs written from now on are CBOR, the old ones are still read
+	require.NoError(t, repo.LocalConfig().StoreString(encodingConfigKey, "cbor"))
+
+	_, err = AddComment(bug1, rene, time.Now().Unix(), "comment")
+	require.NoError(t, err)
+	_, _, err = ChangeLabels(bug1, rene, time.Now().Unix(), []string{"bug"}, nil)
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit(repo))
+
+	bug2, _, err := Create(rene, time.Now().Unix(), "title", "message")
+	require.NoError(t, err)
+	require.NoError(t, bug2.Commit(repo))
+
+	var opIds []string
+	it := NewOperationIterator(bug1)
+	for it.Next() {
+		opIds = append(opIds, it.Value().Id().String())
+	}
+
+	read, err := ReadLocalBug(repo, bug1.Id())
+	require.NoError(t, err)
+	require.NoError(t, read.Validate())
+
+	var readOpIds []string
+	it = NewOperationIterator(read)
+	for it.Next() {
+		readOpIds = append(readOpIds, it.Value().Id().String())
+	}
+	assert.Equal(t, opIds, readOpIds)
+	assert.Equal(t, "comment", read.Compile().Comments[1].Message)
+
+	// the new bug is CBOR from its creation, and smaller
+	read2, err := ReadLocalBug(repo, bug2.Id())
+	require.NoError(t, err)
+	assert.Equal(t, bug2.FirstOp().Id(), read2.FirstOp().Id())
+
+	cborData, err := repo.ReadData(read2.rootPack)
+	require.NoError(t, err)
+	assert.True(t, cbor.IsCBOR(cborData))
+	assert.True(t, len(cborData) < len(jsonData))
+
+	assert.Empty(t, Fsck(repo, bug1.Id()))
+	assert.Empty(t, Fsck(repo, bug2.Id()))
+}
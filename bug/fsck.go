@@ -0,0 +1,184 @@
+package bug
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const bugsFsckQuarantineRefPattern = "refs/quarantine/fsck/bugs/"
+
+// Fsck check the integrity of a local bug: the structure of its git data,
+// the validity of its operations, the ordering of its clocks, the signatures
+// of its authors and the entities it reference. It return all the problems
+// found, or nil if the bug is sound.
+func Fsck(repo repository.ClockedRepo, id entity.Id) []error {
+	ref := bugsRefPattern + id.String()
+
+	bug, err := readBugData(repo, ref)
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+
+	errs = append(errs, fsckReferences(repo, bug)...)
+	errs = append(errs, fsckClocks(repo, bug)...)
+
+	// the operations and the signatures can only be verified once all the
+	// authors are loaded, the unknown ones have been reported already
+	resolver := identity.NewSimpleResolver(repo)
+	if err := bug.EnsureIdentities(resolver); err != nil {
+		return errs
+	}
+
+	if err := bug.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := VerifyAuthorSignatures(repo, bug); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// fsckClocks check that the first commit of a bug, and only this one,
+// store a creation time, and that all the commits store an edition time.
+func fsckClocks(repo repository.Repo, bug *Bug) []error {
+	var errs []error
+
+	for i, pack := range bug.packs {
+		entries, err := repo.ListEntries(pack.commitHash)
+		if err != nil {
+			errs = append(errs, errors.Wrap(err, "can't list git tree entries"))
+			continue
+		}
+
+		hasCreate, hasEdit := false, false
+		for _, entry := range entries {
+			hasCreate = hasCreate || strings.HasPrefix(entry.Name, createClockEntryPrefix)
+			hasEdit = hasEdit || strings.HasPrefix(entry.Name, editClockEntryPrefix)
+		}
+
+		if i == 0 && !hasCreate {
+			errs = append(errs, fmt.Errorf("commit %s: missing the creation time", pack.commitHash))
+		}
+		if i > 0 && hasCreate {
+			errs = append(errs, fmt.Errorf("commit %s: unexpected creation time", pack.commitHash))
+		}
+		if !hasEdit || pack.editTime == 0 {
+			errs = append(errs, fmt.Errorf("commit %s: missing the edition time", pack.commitHash))
+		}
+	}
+
+	return errs
+}
+
+// fsckReferences check that the identities, operations and files referenced
+// by the operations of a bug exist.
+func fsckReferences(repo repository.Repo, bug *Bug) []error {
+	var errs []error
+
+	opIds := make(map[entity.Id]OperationType)
+	it := NewOperationIterator(bug)
+	for it.Next() {
+		opIds[it.Value().Id()] = it.Value().base().OperationType
+
+		// the operations replaced by a snapshot can still be referenced
+		if snap, ok := it.Value().(*SnapshotOperation); ok {
+			for _, archived := range snap.Archived {
+				opIds[archived.Id] = SnapshotOp
+			}
+			for _, c := range snap.Comments[1:] {
+				opIds[c.Id] = AddCommentOp
+			}
+		}
+	}
+
+	knownIdentities := make(map[entity.Id]bool)
+	isKnown := func(i identity.Interface) bool {
+		stub, ok := i.(*identity.IdentityStub)
+		if !ok {
+			return true
+		}
+		known, checked := knownIdentities[stub.Id()]
+		if !checked {
+			_, err := identity.ReadLocal(repo, stub.Id())
+			known = err == nil
+			knownIdentities[stub.Id()] = known
+		}
+		return known
+	}
+
+	it = NewOperationIterator(bug)
+	for it.Next() {
+		op := it.Value()
+
+		if !isKnown(op.base().Author) {
+			errs = append(errs, fmt.Errorf("operation %s: unknown author identity %s",
+				op.Id().Human(), op.base().Author.Id().Human()))
+		}
+
+		switch op := op.(type) {
+		case *AssigneeChangeOperation:
+			for _, list := range [][]identity.Interface{op.Added, op.Removed} {
+				for _, assignee := range list {
+					if !isKnown(assignee) {
+						errs = append(errs, fmt.Errorf("operation %s: unknown assignee identity %s",
+							op.Id().Human(), assignee.Id().Human()))
+					}
+				}
+			}
+		case *SnapshotOperation:
+			for _, list := range [][]identity.Interface{op.Assignees, op.Actors, op.Participants} {
+				for _, i := range list {
+					if !isKnown(i) {
+						errs = append(errs, fmt.Errorf("operation %s: unknown identity %s",
+							op.Id().Human(), i.Id().Human()))
+					}
+				}
+			}
+		case *EditCommentOperation:
+			t, ok := opIds[op.Target]
+			if !ok {
+				errs = append(errs, fmt.Errorf("operation %s: unknown target comment %s",
+					op.Id().Human(), op.Target.Human()))
+			} else if t != CreateOp && t != AddCommentOp {
+				errs = append(errs, fmt.Errorf("operation %s: target %s is not a comment",
+					op.Id().Human(), op.Target.Human()))
+			}
+		case *SetMetadataOperation:
+			if _, ok := opIds[op.Target]; !ok {
+				errs = append(errs, fmt.Errorf("operation %s: unknown target operation %s",
+					op.Id().Human(), op.Target.Human()))
+			}
+		}
+
+		for _, file := range op.GetFiles() {
+			if _, err := repo.ReadData(file); err != nil {
+				errs = append(errs, fmt.Errorf("operation %s: missing file %s",
+					op.Id().Human(), file))
+			}
+		}
+	}
+
+	return errs
+}
+
+// QuarantineLocal move a local bug aside, out of refs/bugs/, so that it's not
+// read or pushed anymore but still available for inspection.
+func QuarantineLocal(repo repository.Repo, id entity.Id) error {
+	ref := bugsRefPattern + id.String()
+
+	err := repo.CopyRef(ref, bugsFsckQuarantineRefPattern+id.String())
+	if err != nil {
+		return err
+	}
+
+	return repo.RemoveRef(ref)
+}
@@ -0,0 +1,47 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestFsck(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repo))
+
+	bug1, _, err := Create(rene, time.Now().Unix(), "title", "message")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit(repo))
+
+	_, err = AddComment(bug1, rene, time.Now().Unix(), "comment")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit(repo))
+
+	assert.Empty(t, Fsck(repo, bug1.Id()))
+
+	// the author disappear
+	require.NoError(t, repo.RemoveRef("refs/identities/"+rene.Id().String()))
+
+	errs := Fsck(repo, bug1.Id())
+	assert.Len(t, errs, 2)
+	assert.Contains(t, errs[0].Error(), "unknown author identity")
+
+	require.NoError(t, QuarantineLocal(repo, bug1.Id()))
+
+	ids, err := ListLocalIds(repo)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+
+	exist, err := repo.RefExist("refs/quarantine/fsck/bugs/" + bug1.Id().String())
+	require.NoError(t, err)
+	assert.True(t, exist)
+}
@@ -14,14 +14,60 @@ func (bug *Bug) EnsureIdentities(resolver identity.Resolver) error {
 		op := it.Value()
 		base := op.base()
 
-		if stub, ok := base.Author.(*identity.IdentityStub); ok {
-			i, err := resolver.ResolveIdentity(stub.Id())
-			if err != nil {
-				return err
+		if err := resolveIdentity(resolver, &base.Author); err != nil {
+			return err
+		}
+
+		switch op := op.(type) {
+		case *AssigneeChangeOperation:
+			for _, list := range [][]identity.Interface{op.Added, op.Removed} {
+				if err := resolveIdentities(resolver, list); err != nil {
+					return err
+				}
+			}
+
+		case *SnapshotOperation:
+			for _, list := range [][]identity.Interface{op.Assignees, op.Actors, op.Participants} {
+				if err := resolveIdentities(resolver, list); err != nil {
+					return err
+				}
 			}
+			for i := range op.Comments {
+				c := &op.Comments[i]
+				if err := resolveIdentity(resolver, &c.Author); err != nil {
+					return err
+				}
+				for j := range c.Edits {
+					if err := resolveIdentity(resolver, &c.Edits[j].Author); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
 
-			base.Author = i
+// resolveIdentities replace in place the IdentityStub of a list
+func resolveIdentities(resolver identity.Resolver, list []identity.Interface) error {
+	for i := range list {
+		if err := resolveIdentity(resolver, &list[i]); err != nil {
+			return err
 		}
 	}
 	return nil
 }
+
+// resolveIdentity replace an IdentityStub with the full Identity
+func resolveIdentity(resolver identity.Resolver, i *identity.Interface) error {
+	stub, ok := (*i).(*identity.IdentityStub)
+	if !ok {
+		return nil
+	}
+	resolved, err := resolver.ResolveIdentity(stub.Id())
+	if err != nil {
+		return err
+	}
+	*i = resolved
+	return nil
+}
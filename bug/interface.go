@@ -3,6 +3,7 @@ package bug
 import (
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/git"
 	"github.com/MichaelMure/git-bug/util/lamport"
 )
 
@@ -38,11 +39,18 @@ type Interface interface {
 	// Compile a bug in a easily usable snapshot
 	Compile() Snapshot
 
+	// Compile a bug in a snapshot where only the given stages are computed
+	CompileStages(stages SnapshotStage) Snapshot
+
 	// CreateLamportTime return the Lamport time of creation
 	CreateLamportTime() lamport.Time
 
 	// EditLamportTime return the Lamport time of the last edit
 	EditLamportTime() lamport.Time
+
+	// LastCommit return the hash of the last commit of the bug, empty if the
+	// bug has never been stored
+	LastCommit() git.Hash
 }
 
 func bugFromInterface(bug Interface) *Bug {
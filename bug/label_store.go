@@ -0,0 +1,353 @@
+package bug
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const labelConfigKeyPrefix = "git-bug.label."
+
+const (
+	labelConfigColor       = "color"
+	labelConfigDescription = "description"
+	labelConfigRenamedTo   = "renamed-to"
+)
+
+// LabelDefinition hold the repository level settings of a label
+type LabelDefinition struct {
+	Name Label
+	// Color is nil when the color is derived from the name
+	Color       *LabelColor
+	Description string
+}
+
+// LabelStore hold the label definitions of a repository, stored in the git
+// config:
+//   git-bug.label.<name>.color = #rrggbb
+//   git-bug.label.<name>.description = <text>
+//   git-bug.label.<old name>.renamed-to = <new name>
+//
+// Renaming a label doesn't rewrite the existing operations. Instead, an alias
+// is recorded so that the old name resolve to the new one.
+//
+// A nil *LabelStore is valid and behave as an empty store.
+type LabelStore struct {
+	definitions map[Label]*LabelDefinition
+	renames     map[Label]Label
+}
+
+func NewLabelStore() *LabelStore {
+	return &LabelStore{
+		definitions: make(map[Label]*LabelDefinition),
+		renames:     make(map[Label]Label),
+	}
+}
+
+// ReadLabelStore read the label definitions from the repository config
+func ReadLabelStore(repo repository.RepoCommon) (*LabelStore, error) {
+	configs, err := repo.LocalConfig().ReadAll(labelConfigKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	s := NewLabelStore()
+
+	for key, value := range configs {
+		// the label name can contain dots, the setting can't
+		key = strings.TrimPrefix(key, labelConfigKeyPrefix)
+		i := strings.LastIndex(key, ".")
+		if i <= 0 {
+			continue
+		}
+		name, setting := Label(key[:i]), key[i+1:]
+
+		switch setting {
+		case labelConfigColor:
+			color, err := ParseLabelColor(value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "label %s", name)
+			}
+			s.definition(name).Color = &color
+		case labelConfigDescription:
+			s.definition(name).Description = value
+		case labelConfigRenamedTo:
+			s.renames[name] = Label(value)
+		}
+	}
+
+	return s, nil
+}
+
+// definition return the definition of a label, creating it if needed
+func (s *LabelStore) definition(name Label) *LabelDefinition {
+	def, ok := s.definitions[name]
+	if !ok {
+		def = &LabelDefinition{Name: name}
+		s.definitions[name] = def
+	}
+	return def
+}
+
+// Resolve follow the renames of a label and return its current name
+func (s *LabelStore) Resolve(label Label) Label {
+	if s == nil {
+		return label
+	}
+
+	// guard against a loop in a manually edited config
+	for i := 0; i <= len(s.renames); i++ {
+		next, ok := s.renames[label]
+		if !ok {
+			return label
+		}
+		label = next
+	}
+
+	return label
+}
+
+// ResolveAll resolve a list of labels, removing the duplicates
+func (s *LabelStore) ResolveAll(labels []Label) []Label {
+	result := make([]Label, 0, len(labels))
+	seen := make(map[Label]bool, len(labels))
+
+	for _, label := range labels {
+		label = s.Resolve(label)
+		if seen[label] {
+			continue
+		}
+		seen[label] = true
+		result = append(result, label)
+	}
+
+	return result
+}
+
+// Color return the configured color of a label, or the color derived from its
+// name if there is none
+func (s *LabelStore) Color(label Label) LabelColor {
+	label = s.Resolve(label)
+
+	if s != nil {
+		if def, ok := s.definitions[label]; ok && def.Color != nil {
+			return *def.Color
+		}
+	}
+
+	return label.Color()
+}
+
+// Description return the configured description of a label
+func (s *LabelStore) Description(label Label) string {
+	if s == nil {
+		return ""
+	}
+
+	if def, ok := s.definitions[s.Resolve(label)]; ok {
+		return def.Description
+	}
+
+	return ""
+}
+
+// IsDefined tell if a label has a color or a description defined
+func (s *LabelStore) IsDefined(label Label) bool {
+	if s == nil {
+		return false
+	}
+
+	_, ok := s.definitions[s.Resolve(label)]
+	return ok
+}
+
+// Aliases return the previous names of a label
+func (s *LabelStore) Aliases(label Label) []Label {
+	if s == nil {
+		return nil
+	}
+
+	label = s.Resolve(label)
+
+	var result []Label
+	for old := range s.renames {
+		if old != label && s.Resolve(old) == label {
+			result = append(result, old)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+
+	return result
+}
+
+// Definitions return the defined labels, sorted by name
+func (s *LabelStore) Definitions() []LabelDefinition {
+	if s == nil {
+		return nil
+	}
+
+	result := make([]LabelDefinition, 0, len(s.definitions))
+	for _, def := range s.definitions {
+		result = append(result, *def)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+// SetColor define the color of a label
+func (s *LabelStore) SetColor(repo repository.RepoCommon, label Label, color LabelColor) error {
+	label = s.Resolve(label)
+
+	err := repo.LocalConfig().StoreString(labelConfigKey(label, labelConfigColor), color.String())
+	if err != nil {
+		return err
+	}
+
+	s.definition(label).Color = &color
+	return nil
+}
+
+// SetDescription define the description of a label
+func (s *LabelStore) SetDescription(repo repository.RepoCommon, label Label, description string) error {
+	label = s.Resolve(label)
+
+	err := repo.LocalConfig().StoreString(labelConfigKey(label, labelConfigDescription), description)
+	if err != nil {
+		return err
+	}
+
+	s.definition(label).Description = description
+	return nil
+}
+
+// Rename rename a label. The existing operations are left untouched, the old
+// name is recorded as an alias of the new one.
+func (s *LabelStore) Rename(repo repository.RepoCommon, old Label, new Label) error {
+	if err := new.Validate(); err != nil {
+		return err
+	}
+
+	// renaming an alias rename the label it resolve to
+	old = s.Resolve(old)
+
+	if old == new {
+		return fmt.Errorf("label %s already has this name", old)
+	}
+
+	config := repo.LocalConfig()
+
+	// renaming back to a previous name
+	if _, ok := s.renames[new]; ok {
+		err := config.RemoveAll(labelConfigKey(new, labelConfigRenamedTo))
+		if err != nil {
+			return err
+		}
+		delete(s.renames, new)
+	}
+
+	if s.Resolve(new) != new {
+		return fmt.Errorf("label %s is already renamed to %s", new, s.Resolve(new))
+	}
+
+	// move the definition to the new name
+	if def, ok := s.definitions[old]; ok {
+		err := s.removeDefinition(repo, old)
+		if err != nil {
+			return err
+		}
+		if def.Color != nil {
+			err = s.SetColor(repo, new, *def.Color)
+			if err != nil {
+				return err
+			}
+		}
+		if def.Description != "" {
+			err = s.SetDescription(repo, new, def.Description)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err := config.StoreString(labelConfigKey(old, labelConfigRenamedTo), new.String())
+	if err != nil {
+		return err
+	}
+
+	s.renames[old] = new
+	return nil
+}
+
+// Remove delete the color and description of a label. Its aliases are kept.
+func (s *LabelStore) Remove(repo repository.RepoCommon, label Label) error {
+	label = s.Resolve(label)
+
+	if _, ok := s.definitions[label]; !ok {
+		return fmt.Errorf("label %s is not defined", label)
+	}
+
+	return s.removeDefinition(repo, label)
+}
+
+func (s *LabelStore) removeDefinition(repo repository.RepoCommon, label Label) error {
+	def := s.definitions[label]
+	config := repo.LocalConfig()
+
+	if def.Color != nil {
+		err := config.RemoveAll(labelConfigKey(label, labelConfigColor))
+		if err != nil {
+			return err
+		}
+	}
+
+	if def.Description != "" {
+		err := config.RemoveAll(labelConfigKey(label, labelConfigDescription))
+		if err != nil {
+			return err
+		}
+	}
+
+	delete(s.definitions, label)
+	return nil
+}
+
+func labelConfigKey(label Label, setting string) string {
+	return labelConfigKeyPrefix + label.String() + "." + setting
+}
+
+// ParseLabelColor parse a color in the #rrggbb form
+func ParseLabelColor(str string) (LabelColor, error) {
+	str = strings.TrimPrefix(strings.TrimSpace(str), "#")
+	if len(str) != 6 {
+		return LabelColor{}, fmt.Errorf("invalid color \"%s\", expected #rrggbb", str)
+	}
+
+	value, err := strconv.ParseUint(str, 16, 32)
+	if err != nil {
+		return LabelColor{}, fmt.Errorf("invalid color \"%s\", expected #rrggbb", str)
+	}
+
+	return LabelColor{
+		R: uint8(value >> 16),
+		G: uint8(value >> 8),
+		B: uint8(value),
+		A: 255,
+	}, nil
+}
+
+// String return the color in the #rrggbb form
+func (lc LabelColor) String() string {
+	return fmt.Sprintf("#%02x%02x%02x", lc.R, lc.G, lc.B)
+}
@@ -0,0 +1,81 @@
+package bug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestLabelStore(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+
+	s, err := ReadLabelStore(repo)
+	require.NoError(t, err)
+
+	// no definition, the color is derived from the name
+	require.Equal(t, Label("bug").Color(), s.Color("bug"))
+	require.Equal(t, "", s.Description("bug"))
+	require.False(t, s.IsDefined("bug"))
+
+	red := LabelColor{R: 255, A: 255}
+	require.NoError(t, s.SetColor(repo, "bug", red))
+	require.True(t, s.IsDefined("bug"))
+	require.NoError(t, s.SetDescription(repo, "bug", "Something isn't working"))
+
+	require.NoError(t, s.Rename(repo, "bug", "defect"))
+	require.Equal(t, Label("defect"), s.Resolve("bug"))
+	require.Equal(t, red, s.Color("bug"))
+	require.Equal(t, red, s.Color("defect"))
+	require.Equal(t, []Label{"bug"}, s.Aliases("defect"))
+	require.True(t, s.IsDefined("bug"))
+
+	// chained renames
+	require.NoError(t, s.Rename(repo, "bug", "kind/bug"))
+	require.Equal(t, Label("kind/bug"), s.Resolve("bug"))
+	require.Equal(t, Label("kind/bug"), s.Resolve("defect"))
+	require.Equal(t, []Label{"bug", "defect"}, s.Aliases("kind/bug"))
+	require.Equal(t, []Label{"kind/bug"}, s.ResolveAll([]Label{"bug", "defect", "kind/bug"}))
+
+	// the store can be read back from the config
+	s2, err := ReadLabelStore(repo)
+	require.NoError(t, err)
+	require.Equal(t, Label("kind/bug"), s2.Resolve("bug"))
+	require.Equal(t, red, s2.Color("bug"))
+	require.Equal(t, "Something isn't working", s2.Description("defect"))
+	require.Len(t, s2.Definitions(), 1)
+
+	// renaming back to a previous name
+	require.NoError(t, s.Rename(repo, "kind/bug", "bug"))
+	require.Equal(t, Label("bug"), s.Resolve("defect"))
+	require.Equal(t, Label("bug"), s.Resolve("kind/bug"))
+
+	require.NoError(t, s.Remove(repo, "bug"))
+	require.Equal(t, Label("bug").Color(), s.Color("bug"))
+	require.Error(t, s.Remove(repo, "bug"))
+
+	require.Error(t, s.Rename(repo, "bug", "bug"))
+}
+
+func TestLabelStoreNil(t *testing.T) {
+	var s *LabelStore
+
+	require.Equal(t, Label("bug"), s.Resolve("bug"))
+	require.Equal(t, Label("bug").Color(), s.Color("bug"))
+	require.Equal(t, "", s.Description("bug"))
+	require.Nil(t, s.Aliases("bug"))
+	require.False(t, s.IsDefined("bug"))
+}
+
+func TestParseLabelColor(t *testing.T) {
+	c, err := ParseLabelColor("#ff8000")
+	require.NoError(t, err)
+	require.Equal(t, LabelColor{R: 255, G: 128, B: 0, A: 255}, c)
+	require.Equal(t, "#ff8000", c.String())
+
+	_, err = ParseLabelColor("red")
+	require.Error(t, err)
+	_, err = ParseLabelColor("#12345z")
+	require.Error(t, err)
+}
@@ -0,0 +1,213 @@
+package bug
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/timestamp"
+)
+
+var _ Operation = &AssigneeChangeOperation{}
+
+// AssigneeChangeOperation define a Bug operation to assign or unassign
+// identities to the bug
+type AssigneeChangeOperation struct {
+	OpBase
+	Added   []identity.Interface `json:"added"`
+	Removed []identity.Interface `json:"removed"`
+}
+
+func (op *AssigneeChangeOperation) base() *OpBase {
+	return &op.OpBase
+}
+
+func (op *AssigneeChangeOperation) Id() entity.Id {
+	return idOperation(op)
+}
+
+// Apply apply the operation
+func (op *AssigneeChangeOperation) Apply(snapshot *Snapshot) {
+	snapshot.addActor(op.Author)
+
+AddLoop:
+	for _, added := range op.Added {
+		for _, assignee := range snapshot.Assignees {
+			if assignee.Id() == added.Id() {
+				continue AddLoop
+			}
+		}
+
+		snapshot.Assignees = append(snapshot.Assignees, added)
+	}
+
+	for _, removed := range op.Removed {
+		for i, assignee := range snapshot.Assignees {
+			if assignee.Id() == removed.Id() {
+				snapshot.Assignees = append(snapshot.Assignees[:i], snapshot.Assignees[i+1:]...)
+				break
+			}
+		}
+	}
+
+	sort.Slice(snapshot.Assignees, func(i, j int) bool {
+		return snapshot.Assignees[i].Id() < snapshot.Assignees[j].Id()
+	})
+
+	item := &AssigneeChangeTimelineItem{
+		id:       op.Id(),
+		Author:   op.Author,
+		UnixTime: timestamp.Timestamp(op.UnixTime),
+		Added:    op.Added,
+		Removed:  op.Removed,
+	}
+
+	snapshot.Timeline = append(snapshot.Timeline, item)
+}
+
+func (op *AssigneeChangeOperation) Validate() error {
+	if err := opBaseValidate(op, AssigneeChangeOp); err != nil {
+		return err
+	}
+
+	// only the ids are checked, as the identities may not be loaded
+	seen := make(map[entity.Id]bool)
+	for _, list := range [][]identity.Interface{op.Added, op.Removed} {
+		for _, i := range list {
+			if i == nil {
+				return fmt.Errorf("nil assignee")
+			}
+			if err := i.Id().Validate(); err != nil {
+				return errors.Wrap(err, "assignee")
+			}
+			if seen[i.Id()] {
+				return fmt.Errorf("assignee %s added or removed more than once", i.Id().Human())
+			}
+			seen[i.Id()] = true
+		}
+	}
+
+	if len(op.Added)+len(op.Removed) <= 0 {
+		return fmt.Errorf("no assignee change")
+	}
+
+	return nil
+}
+
+// UnmarshalJSON is a two step JSON unmarshaling
+// This workaround is necessary to avoid the inner OpBase.MarshalJSON
+// overriding the outer op's MarshalJSON
+func (op *AssigneeChangeOperation) UnmarshalJSON(data []byte) error {
+	// Unmarshal OpBase and the op separately
+
+	base := OpBase{}
+	err := json.Unmarshal(data, &base)
+	if err != nil {
+		return err
+	}
+
+	aux := struct {
+		Added   []json.RawMessage `json:"added"`
+		Removed []json.RawMessage `json:"removed"`
+	}{}
+
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	op.OpBase = base
+
+	// delegate the decoding of the identities
+	for _, raw := range aux.Added {
+		i, err := identity.UnmarshalJSON(raw)
+		if err != nil {
+			return err
+		}
+		op.Added = append(op.Added, i)
+	}
+	for _, raw := range aux.Removed {
+		i, err := identity.UnmarshalJSON(raw)
+		if err != nil {
+			return err
+		}
+		op.Removed = append(op.Removed, i)
+	}
+
+	return nil
+}
+
+// Sign post method for gqlgen
+func (op *AssigneeChangeOperation) IsAuthored() {}
+
+func NewAssigneeChangeOperation(author identity.Interface, unixTime int64, added, removed []identity.Interface) *AssigneeChangeOperation {
+	return &AssigneeChangeOperation{
+		OpBase:  newOpBase(AssigneeChangeOp, author, unixTime),
+		Added:   added,
+		Removed: removed,
+	}
+}
+
+type AssigneeChangeTimelineItem struct {
+	id       entity.Id
+	Author   identity.Interface
+	UnixTime timestamp.Timestamp
+	Added    []identity.Interface
+	Removed  []identity.Interface
+}
+
+func (a AssigneeChangeTimelineItem) Id() entity.Id {
+	return a.id
+}
+
+// Sign post method for gqlgen
+func (a *AssigneeChangeTimelineItem) IsAuthored() {}
+
+// ChangeAssignees is a convenience function to apply the operation. The
+// identities already assigned are not added again, and the ones not assigned
+// are not removed.
+func ChangeAssignees(b Interface, author identity.Interface, unixTime int64, add, remove []identity.Interface) (*AssigneeChangeOperation, error) {
+	var added, removed []identity.Interface
+
+	snap := b.CompileStages(AssigneesStage)
+
+	for _, i := range add {
+		if !identityExist(snap.Assignees, i) && !identityExist(added, i) {
+			added = append(added, i)
+		}
+	}
+
+	for _, i := range remove {
+		if identityExist(snap.Assignees, i) && !identityExist(removed, i) {
+			removed = append(removed, i)
+		}
+	}
+
+	if len(added) == 0 && len(removed) == 0 {
+		return nil, fmt.Errorf("no assignee added or removed")
+	}
+
+	assigneeOp := NewAssigneeChangeOperation(author, unixTime, added, removed)
+
+	if err := assigneeOp.Validate(); err != nil {
+		return nil, err
+	}
+
+	b.Append(assigneeOp)
+
+	return assigneeOp, nil
+}
+
+func identityExist(identities []identity.Interface, i identity.Interface) bool {
+	for _, other := range identities {
+		if other.Id() == i.Id() {
+			return true
+		}
+	}
+
+	return false
+}
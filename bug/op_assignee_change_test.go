@@ -0,0 +1,61 @@
+package bug
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestAssigneeChangeSerialize(t *testing.T) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	var blaise = identity.NewIdentity("Blaise Pascal", "blaise@pascal.fr")
+	require.NoError(t, blaise.Commit(repository.NewMockRepoForTest()))
+
+	unix := time.Now().Unix()
+	before := NewAssigneeChangeOperation(rene, unix, []identity.Interface{blaise}, nil)
+
+	data, err := json.Marshal(before)
+	require.NoError(t, err)
+
+	var after AssigneeChangeOperation
+	err = json.Unmarshal(data, &after)
+	require.NoError(t, err)
+
+	require.Len(t, after.Added, 1)
+	assert.IsType(t, &identity.IdentityStub{}, after.Added[0])
+	assert.Equal(t, blaise.Id(), after.Added[0].Id())
+	assert.Empty(t, after.Removed)
+	assert.Equal(t, before.Id(), after.Id())
+}
+
+func TestAssigneeChangeApply(t *testing.T) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	var blaise = identity.NewBare("Blaise Pascal", "blaise@pascal.fr")
+	unix := time.Now().Unix()
+
+	b := NewBug()
+	b.Append(NewCreateOp(rene, unix, "title", "message", nil))
+
+	_, err := ChangeAssignees(b, rene, unix, []identity.Interface{rene, blaise, rene}, nil)
+	require.NoError(t, err)
+	assert.Len(t, b.Compile().Assignees, 2)
+
+	// removing an identity not assigned does nothing
+	_, err = ChangeAssignees(b, rene, unix, nil, []identity.Interface{identity.NewBare("Nobody", "")})
+	assert.Error(t, err)
+
+	op, err := ChangeAssignees(b, rene, unix, []identity.Interface{blaise}, []identity.Interface{rene})
+	require.NoError(t, err)
+	assert.Empty(t, op.Added)
+
+	snap := b.Compile()
+	require.Len(t, snap.Assignees, 1)
+	assert.Equal(t, blaise.Id(), snap.Assignees[0].Id())
+	assert.IsType(t, &AssigneeChangeTimelineItem{}, snap.Timeline[len(snap.Timeline)-1])
+}
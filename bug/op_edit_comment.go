@@ -54,6 +54,7 @@ func (op *EditCommentOperation) Apply(snapshot *Snapshot) {
 
 	comment := Comment{
 		id:       op.Target,
+		Author:   op.Author,
 		Message:  op.Message,
 		Files:    op.Files,
 		UnixTime: timestamp.Timestamp(op.UnixTime),
@@ -156,7 +156,7 @@ func ChangeLabels(b Interface, author identity.Interface, unixTime int64, add, r
 	var added, removed []Label
 	var results []LabelChangeResult
 
-	snap := b.Compile()
+	snap := b.CompileStages(LabelsStage)
 
 	for _, str := range add {
 		label := Label(str)
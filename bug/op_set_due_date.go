@@ -0,0 +1,143 @@
+package bug
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/timestamp"
+)
+
+var _ Operation = &SetDueDateOperation{}
+
+// SetDueDateOperation will change the due date of a bug
+type SetDueDateOperation struct {
+	OpBase
+	// unix time of the due date, 0 to clear it
+	Due int64 `json:"due"`
+}
+
+func (op *SetDueDateOperation) base() *OpBase {
+	return &op.OpBase
+}
+
+func (op *SetDueDateOperation) Id() entity.Id {
+	return idOperation(op)
+}
+
+func (op *SetDueDateOperation) Apply(snapshot *Snapshot) {
+	if op.Due == 0 {
+		snapshot.DueDate = time.Time{}
+	} else {
+		snapshot.DueDate = time.Unix(op.Due, 0)
+	}
+	snapshot.addActor(op.Author)
+
+	item := &SetDueDateTimelineItem{
+		id:       op.Id(),
+		Author:   op.Author,
+		UnixTime: timestamp.Timestamp(op.UnixTime),
+		Due:      timestamp.Timestamp(op.Due),
+	}
+
+	snapshot.Timeline = append(snapshot.Timeline, item)
+}
+
+func (op *SetDueDateOperation) Validate() error {
+	if err := opBaseValidate(op, SetDueDateOp); err != nil {
+		return err
+	}
+
+	if op.Due < 0 {
+		return fmt.Errorf("negative due date")
+	}
+
+	return nil
+}
+
+// UnmarshalJSON is a two step JSON unmarshaling
+// This workaround is necessary to avoid the inner OpBase.MarshalJSON
+// overriding the outer op's MarshalJSON
+func (op *SetDueDateOperation) UnmarshalJSON(data []byte) error {
+	// Unmarshal OpBase and the op separately
+
+	base := OpBase{}
+	err := json.Unmarshal(data, &base)
+	if err != nil {
+		return err
+	}
+
+	aux := struct {
+		Due int64 `json:"due"`
+	}{}
+
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	op.OpBase = base
+	op.Due = aux.Due
+
+	return nil
+}
+
+// Sign post method for gqlgen
+func (op *SetDueDateOperation) IsAuthored() {}
+
+// IsClear tell if the operation remove the due date
+func (op *SetDueDateOperation) IsClear() bool {
+	return op.Due == 0
+}
+
+func NewSetDueDateOp(author identity.Interface, unixTime int64, due int64) *SetDueDateOperation {
+	return &SetDueDateOperation{
+		OpBase: newOpBase(SetDueDateOp, author, unixTime),
+		Due:    due,
+	}
+}
+
+type SetDueDateTimelineItem struct {
+	id       entity.Id
+	Author   identity.Interface
+	UnixTime timestamp.Timestamp
+	// 0 if the due date is cleared
+	Due timestamp.Timestamp
+}
+
+func (s SetDueDateTimelineItem) Id() entity.Id {
+	return s.id
+}
+
+// Sign post method for gqlgen
+func (s *SetDueDateTimelineItem) IsAuthored() {}
+
+// Convenience function to apply the operation
+func SetDueDate(b Interface, author identity.Interface, unixTime int64, due time.Time) (*SetDueDateOperation, error) {
+	if due.IsZero() {
+		return nil, fmt.Errorf("no due date, use ClearDueDate to remove it")
+	}
+
+	op := NewSetDueDateOp(author, unixTime, due.Unix())
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(op)
+	return op, nil
+}
+
+// Convenience function to apply the operation
+func ClearDueDate(b Interface, author identity.Interface, unixTime int64) (*SetDueDateOperation, error) {
+	if b.CompileStages(DueDateStage).DueDate.IsZero() {
+		return nil, fmt.Errorf("no due date to clear")
+	}
+
+	op := NewSetDueDateOp(author, unixTime, 0)
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(op)
+	return op, nil
+}
@@ -0,0 +1,51 @@
+package bug
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+func TestSetDueDateSerialize(t *testing.T) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+	before := NewSetDueDateOp(rene, unix, unix+3600)
+
+	data, err := json.Marshal(before)
+	assert.NoError(t, err)
+
+	var after SetDueDateOperation
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	// enforce creating the IDs
+	before.Id()
+	rene.Id()
+
+	assert.Equal(t, before, &after)
+}
+
+func TestSetDueDateApply(t *testing.T) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+	due := time.Unix(unix+3600, 0)
+
+	b := NewBug()
+	b.Append(NewCreateOp(rene, unix, "title", "message", nil))
+
+	_, err := ClearDueDate(b, rene, unix)
+	assert.Error(t, err)
+
+	_, err = SetDueDate(b, rene, unix, due)
+	require.NoError(t, err)
+	assert.True(t, due.Equal(b.Compile().DueDate))
+
+	_, err = ClearDueDate(b, rene, unix)
+	require.NoError(t, err)
+	assert.True(t, b.Compile().DueDate.IsZero())
+}
@@ -45,6 +45,15 @@ func (op *SetMetadataOperation) Apply(snapshot *Snapshot) {
 			return
 		}
 	}
+
+	// the target might have been replaced by a snapshot
+	for _, target := range snapshot.Operations {
+		if snap, ok := target.(*SnapshotOperation); ok {
+			if snap.addArchivedMetadata(op.Target, op.NewMetadata) {
+				return
+			}
+		}
+	}
 }
 
 func (op *SetMetadataOperation) Validate() error {
@@ -0,0 +1,406 @@
+package bug
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/git"
+	"github.com/MichaelMure/git-bug/util/timestamp"
+)
+
+var _ Operation = &SnapshotOperation{}
+
+// SnapshotOperation define a Bug operation replacing the operations before it,
+// except the creation, with the state of the bug they lead to. It's added by
+// CollapseHistory, the replaced operations being kept aside in an archive ref.
+//
+// The comments keep their ids and their edition history, so that the later
+// operations can still refer to them. The other events of the timeline, like
+// the label or status changes, are only in the archive.
+type SnapshotOperation struct {
+	OpBase
+	Title  string `json:"title"`
+	Status Status `json:"status"`
+	// when the bug was last closed, 0 if it's open
+	ClosedTime   int64                `json:"closed,omitempty"`
+	Labels       []Label              `json:"labels"`
+	Assignees    []identity.Interface `json:"assignees"`
+	Actors       []identity.Interface `json:"actors"`
+	Participants []identity.Interface `json:"participants"`
+	// 0 if the bug has no due date
+	DueDate  int64             `json:"due,omitempty"`
+	Comments []SnapshotComment `json:"comments"`
+
+	// the replaced operations
+	Archived []ArchivedOperation `json:"archived"`
+	// the last commit of the replaced history
+	Archive git.Hash `json:"archive"`
+
+	// Not serialized. The metadata added to the archived operations by the
+	// following SetMetadataOperation.
+	archivedExtraMetadata map[entity.Id]map[string]string
+}
+
+// SnapshotComment is a comment as stored by a SnapshotOperation
+type SnapshotComment struct {
+	Id     entity.Id          `json:"id"`
+	Author identity.Interface `json:"author"`
+	// the original message
+	Message string `json:"message"`
+	// the files of the last version
+	Files    []git.Hash            `json:"files,omitempty"`
+	UnixTime int64                 `json:"timestamp"`
+	Edits    []SnapshotCommentEdit `json:"edits,omitempty"`
+}
+
+// SnapshotCommentEdit is an edition of a comment stored by a
+// SnapshotOperation
+type SnapshotCommentEdit struct {
+	Author   identity.Interface `json:"author"`
+	Message  string             `json:"message"`
+	UnixTime int64              `json:"timestamp"`
+}
+
+// ArchivedOperation is an operation replaced by a SnapshotOperation, of
+// which only the id and the metadata are kept to keep finding the imported
+// data
+type ArchivedOperation struct {
+	Id       entity.Id         `json:"id"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+func (op *SnapshotOperation) base() *OpBase {
+	return &op.OpBase
+}
+
+func (op *SnapshotOperation) Id() entity.Id {
+	return idOperation(op)
+}
+
+func (op *SnapshotOperation) Apply(snapshot *Snapshot) {
+	snapshot.Title = op.Title
+	snapshot.Status = op.Status
+	snapshot.Labels = append([]Label(nil), op.Labels...)
+	snapshot.Assignees = append([]identity.Interface(nil), op.Assignees...)
+	snapshot.Actors = append([]identity.Interface(nil), op.Actors...)
+	snapshot.Participants = append([]identity.Interface(nil), op.Participants...)
+
+	if op.DueDate == 0 {
+		snapshot.DueDate = time.Time{}
+	} else {
+		snapshot.DueDate = time.Unix(op.DueDate, 0)
+	}
+
+	snapshot.Comments = make([]Comment, len(op.Comments))
+	snapshot.Timeline = make([]TimelineItem, len(op.Comments))
+
+	for i, c := range op.Comments {
+		item := NewCommentTimelineItem(c.Id, Comment{
+			id:       c.Id,
+			Author:   c.Author,
+			Message:  c.Message,
+			Files:    c.Files,
+			UnixTime: timestamp.Timestamp(c.UnixTime),
+		})
+		for _, edit := range c.Edits {
+			item.Append(Comment{
+				Author:   edit.Author,
+				Message:  edit.Message,
+				Files:    c.Files,
+				UnixTime: timestamp.Timestamp(edit.UnixTime),
+			})
+		}
+
+		snapshot.Comments[i] = Comment{
+			id:       c.Id,
+			Author:   c.Author,
+			Message:  item.Message,
+			Files:    c.Files,
+			UnixTime: timestamp.Timestamp(c.UnixTime),
+		}
+
+		if i == 0 {
+			snapshot.Timeline[i] = &CreateTimelineItem{CommentTimelineItem: item}
+		} else {
+			snapshot.Timeline[i] = &AddCommentTimelineItem{CommentTimelineItem: item}
+		}
+	}
+}
+
+func (op *SnapshotOperation) GetFiles() []git.Hash {
+	var files []git.Hash
+	for _, c := range op.Comments {
+		files = append(files, c.Files...)
+	}
+	return files
+}
+
+func (op *SnapshotOperation) Validate() error {
+	if err := opBaseValidate(op, SnapshotOp); err != nil {
+		return err
+	}
+
+	if err := op.Status.Validate(); err != nil {
+		return errors.Wrap(err, "status")
+	}
+
+	for _, l := range op.Labels {
+		if err := l.Validate(); err != nil {
+			return errors.Wrap(err, "label")
+		}
+	}
+
+	for _, list := range [][]identity.Interface{op.Assignees, op.Actors, op.Participants} {
+		for _, i := range list {
+			if i == nil {
+				return fmt.Errorf("nil identity")
+			}
+			if err := i.Id().Validate(); err != nil {
+				return errors.Wrap(err, "identity")
+			}
+		}
+	}
+
+	if len(op.Comments) == 0 {
+		return fmt.Errorf("no comment")
+	}
+
+	for _, c := range op.Comments {
+		if err := c.Id.Validate(); err != nil {
+			return errors.Wrap(err, "comment id")
+		}
+		if c.Author == nil {
+			return fmt.Errorf("comment author not set")
+		}
+		for _, edit := range c.Edits {
+			if edit.Author == nil {
+				return fmt.Errorf("comment edition author not set")
+			}
+		}
+	}
+
+	for _, archived := range op.Archived {
+		if err := archived.Id.Validate(); err != nil {
+			return errors.Wrap(err, "archived operation id")
+		}
+	}
+
+	if !op.Archive.IsValid() {
+		return fmt.Errorf("invalid archive commit %v", op.Archive)
+	}
+
+	return nil
+}
+
+// ArchivedMetadata return the metadata of an archived operation, and if it's
+// one
+func (op *SnapshotOperation) ArchivedMetadata(id entity.Id) (map[string]string, bool) {
+	for _, archived := range op.Archived {
+		if archived.Id != id {
+			continue
+		}
+
+		result := make(map[string]string)
+		for key, val := range op.archivedExtraMetadata[id] {
+			result[key] = val
+		}
+		// Original metadata take precedence
+		for key, val := range archived.Metadata {
+			result[key] = val
+		}
+		return result, true
+	}
+
+	return nil, false
+}
+
+// addArchivedMetadata add metadata to an archived operation, without
+// overriding the existing ones. It return false if the operation is not
+// archived here.
+func (op *SnapshotOperation) addArchivedMetadata(id entity.Id, metadata map[string]string) bool {
+	existing, ok := op.ArchivedMetadata(id)
+	if !ok {
+		return false
+	}
+
+	if op.archivedExtraMetadata == nil {
+		op.archivedExtraMetadata = make(map[entity.Id]map[string]string)
+	}
+	if op.archivedExtraMetadata[id] == nil {
+		op.archivedExtraMetadata[id] = make(map[string]string)
+	}
+
+	for key, val := range metadata {
+		if _, exist := existing[key]; !exist {
+			op.archivedExtraMetadata[id][key] = val
+		}
+	}
+
+	return true
+}
+
+// UnmarshalJSON is a two step JSON unmarshaling
+// This workaround is necessary to avoid the inner OpBase.MarshalJSON
+// overriding the outer op's MarshalJSON
+func (op *SnapshotOperation) UnmarshalJSON(data []byte) error {
+	// Unmarshal OpBase and the op separately
+
+	base := OpBase{}
+	err := json.Unmarshal(data, &base)
+	if err != nil {
+		return err
+	}
+
+	type rawEdit struct {
+		Author   json.RawMessage `json:"author"`
+		Message  string          `json:"message"`
+		UnixTime int64           `json:"timestamp"`
+	}
+
+	aux := struct {
+		Title        string              `json:"title"`
+		Status       Status              `json:"status"`
+		ClosedTime   int64               `json:"closed"`
+		Labels       []Label             `json:"labels"`
+		Assignees    []json.RawMessage   `json:"assignees"`
+		Actors       []json.RawMessage   `json:"actors"`
+		Participants []json.RawMessage   `json:"participants"`
+		DueDate      int64               `json:"due"`
+		Archived     []ArchivedOperation `json:"archived"`
+		Archive      git.Hash            `json:"archive"`
+		Comments     []struct {
+			Id       entity.Id       `json:"id"`
+			Author   json.RawMessage `json:"author"`
+			Message  string          `json:"message"`
+			Files    []git.Hash      `json:"files"`
+			UnixTime int64           `json:"timestamp"`
+			Edits    []rawEdit       `json:"edits"`
+		} `json:"comments"`
+	}{}
+
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	op.OpBase = base
+	op.Title = aux.Title
+	op.Status = aux.Status
+	op.ClosedTime = aux.ClosedTime
+	op.Labels = aux.Labels
+	op.DueDate = aux.DueDate
+	op.Archived = aux.Archived
+	op.Archive = aux.Archive
+
+	// delegate the decoding of the identities
+	op.Assignees, err = unmarshalIdentities(aux.Assignees)
+	if err != nil {
+		return err
+	}
+	op.Actors, err = unmarshalIdentities(aux.Actors)
+	if err != nil {
+		return err
+	}
+	op.Participants, err = unmarshalIdentities(aux.Participants)
+	if err != nil {
+		return err
+	}
+
+	for _, raw := range aux.Comments {
+		c := SnapshotComment{
+			Id:       raw.Id,
+			Message:  raw.Message,
+			Files:    raw.Files,
+			UnixTime: raw.UnixTime,
+		}
+		c.Author, err = identity.UnmarshalJSON(raw.Author)
+		if err != nil {
+			return err
+		}
+		for _, rawEdit := range raw.Edits {
+			edit := SnapshotCommentEdit{
+				Message:  rawEdit.Message,
+				UnixTime: rawEdit.UnixTime,
+			}
+			edit.Author, err = identity.UnmarshalJSON(rawEdit.Author)
+			if err != nil {
+				return err
+			}
+			c.Edits = append(c.Edits, edit)
+		}
+		op.Comments = append(op.Comments, c)
+	}
+
+	return nil
+}
+
+func unmarshalIdentities(raws []json.RawMessage) ([]identity.Interface, error) {
+	var result []identity.Interface
+	for _, raw := range raws {
+		i, err := identity.UnmarshalJSON(raw)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, i)
+	}
+	return result, nil
+}
+
+// Sign post method for gqlgen
+func (op *SnapshotOperation) IsAuthored() {}
+
+// NewSnapshotOperation create a SnapshotOperation holding the state of a
+// snapshot, replacing the given operations whose last commit is archive
+func NewSnapshotOperation(author identity.Interface, unixTime int64, snap *Snapshot, archived []ArchivedOperation, archive git.Hash) *SnapshotOperation {
+	op := &SnapshotOperation{
+		OpBase:       newOpBase(SnapshotOp, author, unixTime),
+		Title:        snap.Title,
+		Status:       snap.Status,
+		ClosedTime:   snap.ClosedUnix(),
+		Labels:       snap.Labels,
+		Assignees:    snap.Assignees,
+		Actors:       snap.Actors,
+		Participants: snap.Participants,
+		Archived:     archived,
+		Archive:      archive,
+	}
+
+	if !snap.DueDate.IsZero() {
+		op.DueDate = snap.DueDate.Unix()
+	}
+
+	for _, item := range snap.Timeline {
+		var commentItem *CommentTimelineItem
+		switch item := item.(type) {
+		case *CreateTimelineItem:
+			commentItem = &item.CommentTimelineItem
+		case *AddCommentTimelineItem:
+			commentItem = &item.CommentTimelineItem
+		default:
+			continue
+		}
+
+		c := SnapshotComment{
+			Id:       commentItem.Id(),
+			Author:   commentItem.Author,
+			Message:  commentItem.History[0].Message,
+			Files:    commentItem.Files,
+			UnixTime: int64(commentItem.CreatedAt),
+		}
+		for _, step := range commentItem.History[1:] {
+			c.Edits = append(c.Edits, SnapshotCommentEdit{
+				Author:   step.Author,
+				Message:  step.Message,
+				UnixTime: int64(step.UnixTime),
+			})
+		}
+		op.Comments = append(op.Comments, c)
+	}
+
+	return op
+}
@@ -0,0 +1,93 @@
+package bug
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+func makeSnapshotBug(t testing.TB, author identity.Interface, unix int64) *Bug {
+	b := NewBug()
+	b.Append(NewCreateOp(author, unix, "title", "message", nil))
+
+	comment, err := AddComment(b, author, unix, "comment")
+	require.NoError(t, err)
+	_, err = EditComment(b, author, unix+1, comment.Id(), "edited comment")
+	require.NoError(t, err)
+	_, _, err = ChangeLabels(b, author, unix, []string{"bug", "priority:high"}, nil)
+	require.NoError(t, err)
+	_, err = SetDueDate(b, author, unix, time.Unix(unix+3600, 0))
+	require.NoError(t, err)
+	_, err = Close(b, author, unix+2)
+	require.NoError(t, err)
+
+	return b
+}
+
+func TestSnapshotSerialize(t *testing.T) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	b := makeSnapshotBug(t, rene, unix)
+	snap := b.Compile()
+
+	archived := []ArchivedOperation{
+		{Id: b.LastOp().Id(), Metadata: map[string]string{"key": "value"}},
+	}
+	before := NewSnapshotOperation(rene, unix, &snap, archived, git.Hash("4b825dc642cb6eb9a060e54bf8d69288fbee4904"))
+
+	data, err := json.Marshal(before)
+	assert.NoError(t, err)
+
+	var after SnapshotOperation
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	// enforce creating the IDs
+	before.Id()
+	rene.Id()
+
+	assert.Equal(t, before, &after)
+}
+
+func TestSnapshotApply(t *testing.T) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	b := makeSnapshotBug(t, rene, unix)
+	snap := b.Compile()
+
+	op := NewSnapshotOperation(rene, unix, &snap, nil, git.Hash("4b825dc642cb6eb9a060e54bf8d69288fbee4904"))
+
+	collapsed := NewBug()
+	collapsed.Append(b.FirstOp())
+	collapsed.Append(op)
+	result := collapsed.Compile()
+
+	assert.Equal(t, snap.Title, result.Title)
+	assert.Equal(t, snap.Status, result.Status)
+	assert.Equal(t, snap.Labels, result.Labels)
+	assert.Equal(t, snap.Participants, result.Participants)
+	assert.Equal(t, snap.ClosedUnix(), result.ClosedUnix())
+	assert.True(t, snap.DueDate.Equal(result.DueDate))
+
+	require.Len(t, result.Comments, 2)
+	for i, comment := range snap.Comments {
+		assert.Equal(t, comment.Id(), result.Comments[i].Id())
+		assert.Equal(t, comment.Message, result.Comments[i].Message)
+	}
+
+	// only the comments are left in the timeline, with their history
+	require.Len(t, result.Timeline, 2)
+	item, ok := result.Timeline[1].(*AddCommentTimelineItem)
+	require.True(t, ok)
+	assert.Len(t, item.History, 2)
+	assert.Equal(t, "comment", item.History[0].Message)
+	assert.Equal(t, "edited comment", item.History[1].Message)
+}
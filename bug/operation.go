@@ -26,6 +26,9 @@ const (
 	EditCommentOp
 	NoOpOp
 	SetMetadataOp
+	AssigneeChangeOp
+	SetDueDateOp
+	SnapshotOp
 )
 
 // Operation define the interface to fulfill for an edit operation of a Bug
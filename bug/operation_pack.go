@@ -6,6 +6,7 @@ import (
 
 	"github.com/MichaelMure/git-bug/repository"
 	"github.com/MichaelMure/git-bug/util/git"
+	"github.com/MichaelMure/git-bug/util/lamport"
 	"github.com/pkg/errors"
 )
 
@@ -22,6 +23,7 @@ type OperationPack struct {
 
 	// Private field so not serialized
 	commitHash git.Hash
+	editTime   lamport.Time
 }
 
 func (opp *OperationPack) MarshalJSON() ([]byte, error) {
@@ -75,6 +77,10 @@ func (opp *OperationPack) unmarshalOp(raw []byte, _type OperationType) (Operatio
 		op := &AddCommentOperation{}
 		err := json.Unmarshal(raw, &op)
 		return op, err
+	case AssigneeChangeOp:
+		op := &AssigneeChangeOperation{}
+		err := json.Unmarshal(raw, &op)
+		return op, err
 	case CreateOp:
 		op := &CreateOperation{}
 		err := json.Unmarshal(raw, &op)
@@ -91,6 +97,10 @@ func (opp *OperationPack) unmarshalOp(raw []byte, _type OperationType) (Operatio
 		op := &NoOpOperation{}
 		err := json.Unmarshal(raw, &op)
 		return op, err
+	case SetDueDateOp:
+		op := &SetDueDateOperation{}
+		err := json.Unmarshal(raw, &op)
+		return op, err
 	case SetMetadataOp:
 		op := &SetMetadataOperation{}
 		err := json.Unmarshal(raw, &op)
@@ -103,6 +113,10 @@ func (opp *OperationPack) unmarshalOp(raw []byte, _type OperationType) (Operatio
 		op := &SetTitleOperation{}
 		err := json.Unmarshal(raw, &op)
 		return op, err
+	case SnapshotOp:
+		op := &SnapshotOperation{}
+		err := json.Unmarshal(raw, &op)
+		return op, err
 	default:
 		return nil, fmt.Errorf("unknown operation type %v", _type)
 	}
@@ -156,6 +170,16 @@ func (opp *OperationPack) Write(repo repository.ClockedRepo) (git.Hash, error) {
 		return "", err
 	}
 
+	encoding, err := GetEncoding(repo)
+	if err != nil {
+		return "", err
+	}
+
+	data, err = encodePack(data, encoding)
+	if err != nil {
+		return "", err
+	}
+
 	hash, err := repo.StoreData(data)
 
 	if err != nil {
@@ -171,6 +195,7 @@ func (opp *OperationPack) Clone() OperationPack {
 	clone := OperationPack{
 		Operations: make([]Operation, len(opp.Operations)),
 		commitHash: opp.commitHash,
+		editTime:   opp.editTime,
 	}
 
 	for i, op := range opp.Operations {
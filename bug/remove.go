@@ -0,0 +1,102 @@
+package bug
+
+import (
+	"fmt"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// the removal markers of the bugs removed locally
+const bugsRemovedRefPattern = "refs/removed/bugs/"
+
+// Remove delete a bug locally, along with its copies fetched from the
+// remotes, and record a removal marker so that the bug is not merged back
+// by a later pull. The marker can be deleted with RestoreRemoved.
+func Remove(repo repository.ClockedRepo, id entity.Id) error {
+	ref := bugsRefPattern + id.String()
+
+	exist, err := repo.RefExist(ref)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return ErrBugNotExist
+	}
+
+	// the marker doesn't need any data, it only has to exist
+	hash, err := repo.StoreData(nil)
+	if err != nil {
+		return err
+	}
+
+	err = repo.UpdateRef(bugsRemovedRefPattern+id.String(), hash)
+	if err != nil {
+		return err
+	}
+
+	err = repo.RemoveRef(ref)
+	if err != nil {
+		return err
+	}
+
+	// the history archived by CollapseHistory
+	archives, err := repo.ListRefs(fmt.Sprintf(bugsArchiveRefPattern, id, ""))
+	if err != nil {
+		return err
+	}
+	for _, archive := range archives {
+		err = repo.RemoveRef(archive)
+		if err != nil {
+			return err
+		}
+	}
+
+	remotes, err := repo.GetRemotes()
+	if err != nil {
+		return err
+	}
+
+	for remote := range remotes {
+		remoteRef := fmt.Sprintf(bugsRemoteRefPattern, remote) + id.String()
+
+		exist, err := repo.RefExist(remoteRef)
+		if err != nil {
+			return err
+		}
+		if !exist {
+			continue
+		}
+
+		err = repo.RemoveRef(remoteRef)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemoveRemote delete a bug on a remote
+func RemoveRemote(repo repository.Repo, remote string, id entity.Id) (string, error) {
+	return repo.PushRefs(remote, ":"+bugsRefPattern+id.String())
+}
+
+// IsRemoved tell if a bug has been removed locally
+func IsRemoved(repo repository.Repo, id entity.Id) (bool, error) {
+	return repo.RefExist(bugsRemovedRefPattern + id.String())
+}
+
+// RestoreRemoved delete the removal marker of a bug, so that it can be merged
+// again from a remote
+func RestoreRemoved(repo repository.Repo, id entity.Id) error {
+	removed, err := IsRemoved(repo, id)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return fmt.Errorf("bug %s is not removed", id.Human())
+	}
+
+	return repo.RemoveRef(bugsRemovedRefPattern + id.String())
+}
@@ -0,0 +1,55 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestRemove(t *testing.T) {
+	repoA, repoB, remote := repository.SetupReposAndRemote(t)
+	defer repository.CleanupTestRepos(t, repoA, repoB, remote)
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repoA))
+	_, err := identity.Push(repoA, "origin")
+	require.NoError(t, err)
+
+	bug1, _, err := Create(rene, time.Now().Unix(), "spam", "message")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit(repoA))
+
+	_, err = Push(repoA, "origin")
+	require.NoError(t, err)
+	require.NoError(t, Pull(repoA, "origin"))
+
+	require.NoError(t, Remove(repoA, bug1.Id()))
+	assert.Equal(t, ErrBugNotExist, Remove(repoA, bug1.Id()))
+
+	removed, err := IsRemoved(repoA, bug1.Id())
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	// a pull doesn't bring the bug back
+	require.NoError(t, Pull(repoA, "origin"))
+	_, err = ReadLocalBug(repoA, bug1.Id())
+	assert.Error(t, err)
+
+	// until the marker is removed
+	require.NoError(t, RestoreRemoved(repoA, bug1.Id()))
+	require.NoError(t, Pull(repoA, "origin"))
+	_, err = ReadLocalBug(repoA, bug1.Id())
+	assert.NoError(t, err)
+
+	// removing on the remote
+	_, err = RemoveRemote(repoA, "origin", bug1.Id())
+	require.NoError(t, err)
+	require.NoError(t, identity.Pull(repoB, "origin"))
+	require.NoError(t, Pull(repoB, "origin"))
+	assert.Empty(t, allBugs(t, ReadAllLocalBugs(repoB)))
+}
@@ -16,10 +16,12 @@ type Snapshot struct {
 	Title        string
 	Comments     []Comment
 	Labels       []Label
+	Assignees    []identity.Interface
 	Author       identity.Interface
 	Actors       []identity.Interface
 	Participants []identity.Interface
 	CreatedAt    time.Time
+	DueDate      time.Time // zero if the bug has no due date
 
 	Timeline []TimelineItem
 
@@ -49,6 +51,26 @@ func (snap *Snapshot) LastEditUnix() int64 {
 	return snap.Operations[len(snap.Operations)-1].GetUnixTime()
 }
 
+// Return the last timestamp the bug was closed, or 0 if the bug is open
+func (snap *Snapshot) ClosedUnix() int64 {
+	if snap.Status != ClosedStatus {
+		return 0
+	}
+
+	for i := len(snap.Operations) - 1; i >= 0; i-- {
+		switch op := snap.Operations[i].(type) {
+		case *SetStatusOperation:
+			if op.Status == ClosedStatus {
+				return op.GetUnixTime()
+			}
+		case *SnapshotOperation:
+			return op.ClosedTime
+		}
+	}
+
+	return 0
+}
+
 // GetCreateMetadata return the creation metadata
 func (snap *Snapshot) GetCreateMetadata(key string) (string, bool) {
 	return snap.Operations[0].GetMetadata(key)
@@ -0,0 +1,74 @@
+package bug
+
+// SnapshotStage is a set of fields of a Snapshot that can be computed
+// without the others, to compile only what is needed.
+//
+// The identifier, the author, the creation time and the operations of the
+// snapshot are always computed.
+type SnapshotStage int
+
+const (
+	// Title
+	TitleStage SnapshotStage = 1 << iota
+	// Status
+	StatusStage
+	// Labels
+	LabelsStage
+	// Assignees
+	AssigneesStage
+	// DueDate
+	DueDateStage
+	// Actors and Participants
+	ActorsStage
+	// Comments and Timeline, the most expensive
+	CommentsStage
+
+	AllStages = TitleStage | StatusStage | LabelsStage | AssigneesStage | DueDateStage | ActorsStage | CommentsStage
+)
+
+// opStages return the stages of a snapshot changed by an operation
+func opStages(op Operation) SnapshotStage {
+	switch op.(type) {
+	case *SetTitleOperation:
+		return TitleStage | ActorsStage | CommentsStage
+	case *AddCommentOperation, *EditCommentOperation:
+		return ActorsStage | CommentsStage
+	case *SetStatusOperation:
+		return StatusStage | ActorsStage | CommentsStage
+	case *LabelChangeOperation:
+		return LabelsStage | ActorsStage | CommentsStage
+	case *AssigneeChangeOperation:
+		return AssigneesStage | ActorsStage | CommentsStage
+	case *SetDueDateOperation:
+		return DueDateStage | ActorsStage | CommentsStage
+	case *NoOpOperation:
+		return 0
+	default:
+		// the creation, the snapshots and the metadata are always applied
+		return AllStages
+	}
+}
+
+// CompileStages compile a bug in a snapshot where only the fields of the
+// given stages are computed, the other ones being incomplete. The
+// operations changing none of these fields are not applied, which make
+// it cheaper than Compile when only a few fields are needed, like the
+// status and the labels to match a query.
+func (bug *Bug) CompileStages(stages SnapshotStage) Snapshot {
+	snap := Snapshot{
+		id:     bug.id,
+		Status: OpenStatus,
+	}
+
+	it := NewOperationIterator(bug)
+
+	for it.Next() {
+		op := it.Value()
+		if opStages(op)&stages != 0 {
+			op.Apply(&snap)
+		}
+		snap.Operations = append(snap.Operations, op)
+	}
+
+	return snap
+}
@@ -0,0 +1,74 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+func TestCompileStages(t *testing.T) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	b := makeSnapshotBug(t, rene, unix)
+	full := b.Compile()
+
+	snap := b.CompileStages(StatusStage | LabelsStage)
+	assert.Equal(t, full.Status, snap.Status)
+	assert.Equal(t, full.Labels, snap.Labels)
+	assert.Equal(t, full.Title, snap.Title)
+	assert.Equal(t, full.Author, snap.Author)
+	assert.Equal(t, full.CreatedAt, snap.CreatedAt)
+	assert.Equal(t, full.ClosedUnix(), snap.ClosedUnix())
+	assert.Len(t, snap.Operations, len(full.Operations))
+
+	// the comments are not materialized
+	assert.Len(t, snap.Comments, 1)
+	assert.True(t, snap.DueDate.IsZero())
+
+	snap = b.CompileStages(DueDateStage)
+	assert.True(t, full.DueDate.Equal(snap.DueDate))
+	assert.Empty(t, snap.Labels)
+
+	assert.Equal(t, full, b.CompileStages(AllStages))
+}
+
+func TestWithSnapshotCompileStages(t *testing.T) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	b := &WithSnapshot{Bug: makeSnapshotBug(t, rene, unix)}
+	assert.Empty(t, b.CompileStages(DueDateStage).Labels)
+
+	// the maintained snapshot is used once there
+	b.Snapshot()
+	_, _, err := ChangeLabels(b, rene, unix, []string{"question"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, b.Snapshot().Labels, b.CompileStages(LabelsStage).Labels)
+	assert.Contains(t, b.CompileStages(LabelsStage).Labels, Label("question"))
+}
+
+func benchmarkCompileStages(stages SnapshotStage, b *testing.B) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	bug1 := makeSnapshotBug(b, rene, unix)
+	for i := 0; i < 200; i++ {
+		comment, err := AddComment(bug1, rene, unix, "comment")
+		require.NoError(b, err)
+		_, err = EditComment(bug1, rene, unix, comment.Id(), "edited comment")
+		require.NoError(b, err)
+	}
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		bug1.CompileStages(stages)
+	}
+}
+
+func BenchmarkCompile(b *testing.B)             { benchmarkCompileStages(AllStages, b) }
+func BenchmarkCompileStatusLabels(b *testing.B) { benchmarkCompileStages(StatusStage|LabelsStage, b) }
@@ -0,0 +1,88 @@
+package bug
+
+import (
+	"path"
+	"strings"
+)
+
+// PriorityLabelPrefix start the labels giving the priority of a bug, like
+// "priority:high"
+const PriorityLabelPrefix = "priority:"
+
+// MilestoneLabelPrefix start the labels giving the milestone of a bug, like
+// "milestone:v1.0"
+const MilestoneLabelPrefix = "milestone:"
+
+// ScopeLabelPrefix start the labels giving the scope of a bug in a monorepo,
+// the directory of the component it belong to, like "scope:services/api"
+const ScopeLabelPrefix = "scope:"
+
+// Priorities are the priorities of the bugs, from the lowest. The first one
+// is the absence of priority label.
+var Priorities = []string{"none", "low", "medium", "high"}
+
+// PriorityLevel return the level of a priority in Priorities, or -1 if it's
+// not one of them
+func PriorityLevel(priority string) int {
+	for i, p := range Priorities {
+		if strings.EqualFold(p, priority) {
+			return i
+		}
+	}
+	return -1
+}
+
+// LabelsPriority return the level of the highest priority given by the
+// labels, 0 if there is none
+func LabelsPriority(labels []Label) int {
+	result := 0
+	for _, label := range labels {
+		if !strings.HasPrefix(label.String(), PriorityLabelPrefix) {
+			continue
+		}
+		level := PriorityLevel(strings.TrimPrefix(label.String(), PriorityLabelPrefix))
+		if level > result {
+			result = level
+		}
+	}
+	return result
+}
+
+// LabelsMilestone return the milestone given by the labels, the first one if
+// there is many, or an empty string
+func LabelsMilestone(labels []Label) string {
+	for _, label := range labels {
+		if strings.HasPrefix(label.String(), MilestoneLabelPrefix) {
+			return strings.TrimPrefix(label.String(), MilestoneLabelPrefix)
+		}
+	}
+	return ""
+}
+
+// LabelsScope return the scope given by the labels, the first one if there is
+// many, or an empty string
+func LabelsScope(labels []Label) string {
+	for _, label := range labels {
+		if strings.HasPrefix(label.String(), ScopeLabelPrefix) {
+			return CleanScope(strings.TrimPrefix(label.String(), ScopeLabelPrefix))
+		}
+	}
+	return ""
+}
+
+// CleanScope normalize a scope: a slash separated directory relative to the
+// root of the repository, without leading or trailing slash. The root itself
+// is the empty scope.
+func CleanScope(scope string) string {
+	scope = strings.TrimSpace(strings.Replace(scope, "\\", "/", -1))
+	scope = strings.Trim(path.Clean("/"+scope), "/")
+	return scope
+}
+
+// ScopeContains tell if a scope is the given one or one of its
+// sub-directories. Every scope is in the empty one.
+func ScopeContains(scope string, sub string) bool {
+	scope = CleanScope(scope)
+	sub = CleanScope(sub)
+	return scope == "" || sub == scope || strings.HasPrefix(sub, scope+"/")
+}
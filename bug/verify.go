@@ -0,0 +1,179 @@
+package bug
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const verifyPolicyConfigKey = "git-bug.verify.policy"
+const bugsQuarantineRefPattern = "refs/quarantine/%s/bugs/"
+
+// VerifyPolicy define what happen when a fetched bug fail the verification
+type VerifyPolicy int
+
+const (
+	_ VerifyPolicy = iota
+	// VerifyPolicyWarn merge the bug anyway, but report the problem
+	VerifyPolicyWarn
+	// VerifyPolicyQuarantine doesn't merge the bug and keep a copy of the
+	// remote ref aside for inspection
+	VerifyPolicyQuarantine
+	// VerifyPolicyReject doesn't merge the bug
+	VerifyPolicyReject
+)
+
+func (p VerifyPolicy) String() string {
+	switch p {
+	case VerifyPolicyWarn:
+		return "warn"
+	case VerifyPolicyQuarantine:
+		return "quarantine"
+	case VerifyPolicyReject:
+		return "reject"
+	default:
+		return "unknown policy"
+	}
+}
+
+func VerifyPolicyFromString(str string) (VerifyPolicy, error) {
+	cleaned := strings.ToLower(strings.TrimSpace(str))
+
+	switch cleaned {
+	case "warn":
+		return VerifyPolicyWarn, nil
+	case "quarantine":
+		return VerifyPolicyQuarantine, nil
+	case "reject":
+		return VerifyPolicyReject, nil
+	default:
+		return 0, fmt.Errorf("unknown verify policy %s", str)
+	}
+}
+
+// GetVerifyPolicy read the verification policy configured for the repository.
+// It default to VerifyPolicyWarn.
+func GetVerifyPolicy(repo repository.RepoCommon) (VerifyPolicy, error) {
+	val, err := repo.LocalConfig().ReadString(verifyPolicyConfigKey)
+	if err == repository.ErrNoConfigEntry {
+		return VerifyPolicyWarn, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return VerifyPolicyFromString(val)
+}
+
+// ErrImpersonation is returned when some operations claim an author identity
+// but were not signed with one of the identity's keys.
+type ErrImpersonation struct {
+	Operations []entity.Id
+}
+
+func (e ErrImpersonation) Error() string {
+	ids := make([]string, len(e.Operations))
+	for i, id := range e.Operations {
+		ids[i] = id.Human()
+	}
+
+	return fmt.Sprintf("operations not signed by their author: %s", strings.Join(ids, ", "))
+}
+
+// VerifyHook is a check run on a remote bug before it get merged locally.
+// It should return an error if the bug can't be trusted.
+type VerifyHook func(repo repository.Repo, bug *Bug) error
+
+var verifyHooks = []VerifyHook{VerifyAuthorSignatures}
+
+// RegisterVerifyHook add a new check to run on fetched bugs
+func RegisterVerifyHook(hook VerifyHook) {
+	verifyHooks = append(verifyHooks, hook)
+}
+
+// Verify run all the registered verify hooks on a bug
+func Verify(repo repository.Repo, bug *Bug) error {
+	for _, hook := range verifyHooks {
+		if err := hook(repo, bug); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyAuthorSignatures check that each operation authored by an identity
+// that declared keys is stored in a commit signed by one of these keys. As
+// the author is stored in the operation data, anyone able to push could
+// otherwise forge operations in the name of someone else.
+func VerifyAuthorSignatures(repo repository.Repo, bug *Bug) error {
+	var forged []entity.Id
+
+	for _, pack := range bug.packs {
+		signer := ""
+		signerLoaded := false
+
+		for _, op := range pack.Operations {
+			keys := op.GetAuthor().ValidKeysAtTime(pack.editTime)
+			if len(keys) == 0 {
+				// the author doesn't sign, nothing to verify
+				continue
+			}
+
+			if !signerLoaded {
+				var err error
+				signer, err = repo.ReadCommitSigner(pack.commitHash)
+				if err == repository.ErrInvalidSignature {
+					// a bad signature doesn't prove anything
+					signer = ""
+				} else if err != nil {
+					return errors.Wrap(err, "can't read commit signature")
+				}
+				signerLoaded = true
+			}
+
+			if !signedByAny(signer, keys) {
+				forged = append(forged, op.Id())
+			}
+		}
+	}
+
+	if len(forged) > 0 {
+		return ErrImpersonation{Operations: forged}
+	}
+
+	return nil
+}
+
+// signedByAny tell if the fingerprint of a signer match the one of a key.
+// The whole fingerprints are compared, as a key id can be forged.
+func signedByAny(signer string, keys []identity.Key) bool {
+	signer = normalizeFingerprint(signer)
+	if signer == "" {
+		return false
+	}
+
+	for _, key := range keys {
+		if normalizeFingerprint(key.Fingerprint) == signer {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeFingerprint remove the spaces of a fingerprint as displayed by
+// gpg, and ignore the case
+func normalizeFingerprint(fingerprint string) string {
+	return strings.ToUpper(strings.Replace(fingerprint, " ", "", -1))
+}
+
+// quarantine keep a copy of a remote bug ref that failed the verification
+func quarantine(repo repository.Repo, remote string, remoteRef string, id entity.Id) error {
+	ref := fmt.Sprintf(bugsQuarantineRefPattern, remote) + id.String()
+	return repo.CopyRef(remoteRef, ref)
+}
@@ -0,0 +1,79 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/lamport"
+)
+
+// signingIdentity is an Identity that declare signing keys
+type signingIdentity struct {
+	*identity.Identity
+	keys []identity.Key
+}
+
+func (i signingIdentity) ValidKeysAtTime(time lamport.Time) []identity.Key {
+	return i.keys
+}
+
+func TestVerifyAuthorSignatures(t *testing.T) {
+	mockRepo := repository.NewMockRepoForTest()
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+
+	bug1, _, err := Create(rene, time.Now().Unix(), "title", "message")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit(mockRepo))
+
+	// no keys declared, nothing to verify
+	assert.NoError(t, Verify(mockRepo, bug1))
+
+	impostor := signingIdentity{
+		Identity: rene,
+		keys:     []identity.Key{{Fingerprint: "A2E3F9E4B5C6D7E8F9A0B1C2D3E4F5A6B7C8D9E0"}},
+	}
+
+	_, err = AddComment(bug1, impostor, time.Now().Unix(), "forged")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit(mockRepo))
+
+	// the mock repo never sign commits
+	err = Verify(mockRepo, bug1)
+	assert.IsType(t, ErrImpersonation{}, err)
+	assert.Len(t, err.(ErrImpersonation).Operations, 1)
+}
+
+func TestSignedByAny(t *testing.T) {
+	keys := []identity.Key{{Fingerprint: "A2E3F9E4B5C6D7E8F9A0B1C2D3E4F5A6B7C8D9E0"}}
+
+	assert.True(t, signedByAny("A2E3F9E4B5C6D7E8F9A0B1C2D3E4F5A6B7C8D9E0", keys))
+	assert.True(t, signedByAny("a2e3f9e4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0", keys))
+	assert.True(t, signedByAny("A2E3 F9E4 B5C6 D7E8 F9A0  B1C2 D3E4 F5A6 B7C8 D9E0", keys))
+	// a key id is not enough
+	assert.False(t, signedByAny("D3E4F5A6B7C8D9E0", keys))
+	assert.False(t, signedByAny("", keys))
+	assert.False(t, signedByAny("0000000000000000", keys))
+}
+
+func TestVerifyPolicyFromString(t *testing.T) {
+	for _, policy := range []VerifyPolicy{VerifyPolicyWarn, VerifyPolicyQuarantine, VerifyPolicyReject} {
+		parsed, err := VerifyPolicyFromString(policy.String())
+		assert.NoError(t, err)
+		assert.Equal(t, policy, parsed)
+	}
+
+	_, err := VerifyPolicyFromString("foo")
+	assert.Error(t, err)
+
+	mockRepo := repository.NewMockRepoForTest()
+
+	policy, err := GetVerifyPolicy(mockRepo)
+	assert.NoError(t, err)
+	assert.Equal(t, VerifyPolicyWarn, policy)
+}
@@ -1,6 +1,11 @@
 package bug
 
-import "github.com/MichaelMure/git-bug/repository"
+import (
+	"time"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
 
 var _ Interface = &WithSnapshot{}
 
@@ -19,6 +24,16 @@ func (b *WithSnapshot) Snapshot() *Snapshot {
 	return b.snap
 }
 
+// CompileStages intercept Bug.CompileStages() to use the maintained snapshot
+// when there is one, as it has all the stages. It shares its data with it, so
+// it must not be modified.
+func (b *WithSnapshot) CompileStages(stages SnapshotStage) Snapshot {
+	if b.snap != nil {
+		return *b.snap
+	}
+	return b.Bug.CompileStages(stages)
+}
+
 // Append intercept Bug.Append() to update the snapshot efficiently
 func (b *WithSnapshot) Append(op Operation) {
 	b.Bug.Append(op)
@@ -56,3 +71,10 @@ func (b *WithSnapshot) Merge(repo repository.Repo, other Interface) (bool, error
 	b.snap = nil
 	return b.Bug.Merge(repo, other)
 }
+
+// CollapseHistory intercept Bug.CollapseHistory() to compile the snapshot
+// again, the timeline being changed
+func (b *WithSnapshot) CollapseHistory(repo repository.ClockedRepo, author identity.Interface, before time.Time) (int, error) {
+	b.snap = nil
+	return b.Bug.CollapseHistory(repo, author, before)
+}
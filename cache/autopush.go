@@ -0,0 +1,308 @@
+package cache
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const autoPushConfigKey = "git-bug.autoPush"
+
+// the refs waiting to be pushed, one "remote ref" per line
+const pushQueueFile = "push-queue"
+
+func pushQueueFilePath(repo repository.Repo) string {
+	return path.Join(repo.GetPath(), "git-bug", pushQueueFile)
+}
+
+// queuedPush is a ref waiting to be pushed to a remote
+type queuedPush struct {
+	remote string
+	ref    string
+}
+
+// readAutoPushRemote read the remote the local changes are pushed to, or an
+// empty string if the auto-push is disabled
+func readAutoPushRemote(repo repository.RepoCommon) (string, error) {
+	val, err := repo.LocalConfig().ReadString(autoPushConfigKey)
+	if err == repository.ErrNoConfigEntry {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(val), nil
+}
+
+func readPushQueue(repo repository.Repo) ([]queuedPush, error) {
+	data, err := ioutil.ReadFile(pushQueueFilePath(repo))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var queue []queuedPush
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		queue = append(queue, queuedPush{remote: fields[0], ref: fields[1]})
+	}
+
+	return queue, scanner.Err()
+}
+
+func writePushQueue(repo repository.Repo, queue []queuedPush) error {
+	if len(queue) == 0 {
+		err := os.Remove(pushQueueFilePath(repo))
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	var buf bytes.Buffer
+	for _, entry := range queue {
+		_, _ = fmt.Fprintf(&buf, "%s %s\n", entry.remote, entry.ref)
+	}
+
+	return ioutil.WriteFile(pushQueueFilePath(repo), buf.Bytes(), 0644)
+}
+
+// bugCommitted queue the ref of a bug committed locally, along with the
+// identity of the user authoring it, to be pushed in the background. With a
+// share query for the remote, only a matching bug is queued, along with the
+// identities it reference.
+func (c *RepoCache) bugCommitted(id entity.Id) error {
+	if c.autoPushRemote == "" {
+		return nil
+	}
+
+	refs := []string{bug.RefName(id)}
+
+	if c.autoPushQuery != nil {
+		excerpt, err := c.ResolveBugExcerpt(id)
+		if err != nil || !c.autoPushQuery.Match(c, excerpt) {
+			return nil
+		}
+		for _, identityId := range c.sharedIdentities(excerpt) {
+			refs = append(refs, identity.RefName(identityId))
+		}
+		return c.queuePush(refs...)
+	}
+
+	// the remote can't read the bug without the identity of its author
+	if user, err := c.GetUserIdentity(); err == nil {
+		refs = append(refs, identity.RefName(user.Id()))
+	}
+
+	return c.queuePush(refs...)
+}
+
+// identityCommitted queue the ref of an identity committed locally, to be
+// pushed in the background
+func (c *RepoCache) identityCommitted(id entity.Id) error {
+	if c.autoPushRemote == "" {
+		return nil
+	}
+
+	// with a share query, the identities are pushed along with the bugs
+	if c.autoPushQuery != nil {
+		return nil
+	}
+
+	return c.queuePush(identity.RefName(id))
+}
+
+// queuePush add refs to the push queue, and push the queue in the background
+// unless in a batch
+func (c *RepoCache) queuePush(refs ...string) error {
+	c.pushMutex.Lock()
+	defer c.pushMutex.Unlock()
+
+	queue, err := readPushQueue(c.repo)
+	if err != nil {
+		return err
+	}
+
+	for _, ref := range refs {
+		entry := queuedPush{remote: c.autoPushRemote, ref: ref}
+		if !containsPush(queue, entry) {
+			queue = append(queue, entry)
+		}
+		// a push already running may send the previous version of the ref
+		c.pushRequeued[entry] = true
+	}
+
+	err = writePushQueue(c.repo, queue)
+	if err != nil {
+		return err
+	}
+
+	if c.pushBatchDepth == 0 {
+		c.startPushLocked()
+	}
+
+	return nil
+}
+
+func containsPush(queue []queuedPush, entry queuedPush) bool {
+	for _, e := range queue {
+		if e == entry {
+			return true
+		}
+	}
+	return false
+}
+
+// startPushLocked push the queue in the background, or again after the push
+// already running
+func (c *RepoCache) startPushLocked() {
+	if c.pushRunning {
+		c.pushAgain = true
+		return
+	}
+
+	c.pushRunning = true
+	c.pushWait.Add(1)
+
+	go func() {
+		defer c.pushWait.Done()
+
+		for {
+			_, err := c.pushQueue()
+
+			c.pushMutex.Lock()
+			c.pushErr = err
+			if !c.pushAgain {
+				c.pushRunning = false
+				c.pushMutex.Unlock()
+				return
+			}
+			c.pushAgain = false
+			c.pushMutex.Unlock()
+		}
+	}()
+}
+
+// pushQueue push the queued refs, with one push per remote, and remove the
+// pushed ones from the queue. The refs failing to be pushed stay in the queue.
+// It return the number of refs pushed and the first failure.
+func (c *RepoCache) pushQueue() (int, error) {
+	c.pushMutex.Lock()
+	queue, err := readPushQueue(c.repo)
+	// only the refs queued from now on need to be pushed again
+	c.pushRequeued = make(map[queuedPush]bool)
+	c.pushMutex.Unlock()
+	if err != nil {
+		return 0, err
+	}
+
+	var remotes []string
+	refs := make(map[string][]string)
+	for _, entry := range queue {
+		if _, ok := refs[entry.remote]; !ok {
+			remotes = append(remotes, entry.remote)
+		}
+		refs[entry.remote] = append(refs[entry.remote], entry.ref)
+	}
+
+	pushed := make(map[queuedPush]bool)
+	var firstErr error
+
+	for _, remote := range remotes {
+		_, err := c.repo.PushRefs(remote, refs[remote]...)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		for _, ref := range refs[remote] {
+			pushed[queuedPush{remote: remote, ref: ref}] = true
+		}
+	}
+
+	c.pushMutex.Lock()
+	defer c.pushMutex.Unlock()
+
+	// the queue may have grown during the push
+	queue, err = readPushQueue(c.repo)
+	if err != nil {
+		return len(pushed), err
+	}
+
+	var kept []queuedPush
+	for _, entry := range queue {
+		if !pushed[entry] || c.pushRequeued[entry] {
+			kept = append(kept, entry)
+		}
+	}
+
+	err = writePushQueue(c.repo, kept)
+	if err != nil {
+		return len(pushed), err
+	}
+
+	return len(pushed), firstErr
+}
+
+// FlushPushQueue push the refs left in the queue by a failed auto-push, and
+// return the number of refs pushed
+func (c *RepoCache) FlushPushQueue() (int, error) {
+	if err := c.writable(); err != nil {
+		return 0, err
+	}
+
+	c.waitPush()
+
+	return c.pushQueue()
+}
+
+// clearPushQueue remove the refs queued for a remote, after everything was
+// pushed to it
+func (c *RepoCache) clearPushQueue(remote string) error {
+	c.pushMutex.Lock()
+	defer c.pushMutex.Unlock()
+
+	queue, err := readPushQueue(c.repo)
+	if err != nil {
+		return err
+	}
+
+	var kept []queuedPush
+	for _, entry := range queue {
+		if entry.remote != remote {
+			kept = append(kept, entry)
+		}
+	}
+
+	return writePushQueue(c.repo, kept)
+}
+
+// waitPush wait for the background push, and return its failure if any
+func (c *RepoCache) waitPush() error {
+	c.pushWait.Wait()
+
+	c.pushMutex.Lock()
+	defer c.pushMutex.Unlock()
+
+	err := c.pushErr
+	c.pushErr = nil
+	return err
+}
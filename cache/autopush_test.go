@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestAutoPush(t *testing.T) {
+	repoA, repoB, remote := repository.SetupReposAndRemote(t)
+	defer repository.CleanupTestRepos(t, repoA, repoB, remote)
+
+	require.NoError(t, repoA.LocalConfig().StoreString(autoPushConfigKey, "origin"))
+
+	cache, err := NewRepoCache(repoA)
+	require.NoError(t, err)
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(rene))
+
+	b, _, err := cache.NewBug("bug1", "message")
+	require.NoError(t, err)
+
+	// the push is done when the cache is closed at the latest
+	require.NoError(t, cache.Close())
+
+	exist, err := remote.RefExist(bug.RefName(b.Id()))
+	require.NoError(t, err)
+	assert.True(t, exist)
+	exist, err = remote.RefExist(identity.RefName(rene.Id()))
+	require.NoError(t, err)
+	assert.True(t, exist)
+
+	_, err = os.Stat(pushQueueFilePath(repoA))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestAutoPushFailure(t *testing.T) {
+	repoA, repoB, remote := repository.SetupReposAndRemote(t)
+	defer repository.CleanupTestRepos(t, repoA, repoB, remote)
+
+	require.NoError(t, repoA.LocalConfig().StoreString(autoPushConfigKey, "origin"))
+	require.NoError(t, repoA.LocalConfig().StoreString("remote.origin.url", "file:///nonexistent"))
+
+	cache, err := NewRepoCache(repoA)
+	require.NoError(t, err)
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(rene))
+
+	b, _, err := cache.NewBug("bug1", "message")
+	require.NoError(t, err)
+
+	// the failure leave the refs queued
+	require.Error(t, cache.waitPush())
+
+	queue, err := readPushQueue(repoA)
+	require.NoError(t, err)
+	assert.Equal(t, []queuedPush{
+		{remote: "origin", ref: identity.RefName(rene.Id())},
+		{remote: "origin", ref: bug.RefName(b.Id())},
+	}, queue)
+
+	require.NoError(t, repoA.LocalConfig().StoreString("remote.origin.url", "file://"+remote.GetPath()))
+
+	pushed, err := cache.FlushPushQueue()
+	require.NoError(t, err)
+	assert.Equal(t, 2, pushed)
+
+	exist, err := remote.RefExist(bug.RefName(b.Id()))
+	require.NoError(t, err)
+	assert.True(t, exist)
+
+	queue, err = readPushQueue(repoA)
+	require.NoError(t, err)
+	assert.Empty(t, queue)
+
+	require.NoError(t, cache.Close())
+}
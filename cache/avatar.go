@@ -0,0 +1,447 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const avatarCacheDir = "avatars"
+
+// size in pixel requested to the avatar providers, and the biggest one served
+const avatarSize = 128
+
+// the smallest size served, the sizes in between are rounded up to a multiple
+// of it to not keep too many copies
+const minAvatarSize = 16
+
+// avatars bigger than that are refused
+const maxAvatarSize = 1024 * 1024
+
+// avatars wider or higher than that in pixels are refused, as they would take
+// too much memory once decoded
+const maxAvatarDimension = 2048
+
+// an avatar that couldn't be fetched is not fetched again before that delay
+const avatarRetryDelay = time.Hour
+
+// the extension of the file recording a failure to fetch an avatar
+const avatarFailureExt = ".failed"
+
+// allow to fetch the avatars from the loopback, private and link-local
+// addresses, like from a provider of the local network
+const avatarPrivateHostsConfigKey = "git-bug.avatar.private-hosts"
+
+// ErrNoAvatar is returned when no avatar can be found for an identity
+var ErrNoAvatar = errors.New("no avatar available")
+
+var errPrivateAvatarHost = errors.New("the avatar host has a private address")
+
+// the networks the avatars are not fetched from, besides the loopback and
+// link-local addresses
+var privateNetworks = func() []*net.IPNet {
+	var result []*net.IPNet
+	for _, cidr := range []string{"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7"} {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		result = append(result, network)
+	}
+	return result
+}()
+
+// loadAvatarConfig read the avatar provider and the allowed hosts once
+func (c *RepoCache) loadAvatarConfig() {
+	c.avatarConfigOnce.Do(func() {
+		provider, err := identity.GetAvatarProvider(c.repo)
+		if err != nil {
+			provider = identity.AvatarProviderNone
+		}
+		c.avatarProvider = provider
+
+		privateHosts := false
+		val, err := repository.ReadConfigAnyScope(c.repo, avatarPrivateHostsConfigKey)
+		if err == nil && val != "" {
+			privateHosts, _ = strconv.ParseBool(val)
+		}
+		c.avatarClient = newAvatarClient(privateHosts)
+	})
+}
+
+// AvatarSourceUrl return the remote URL of the avatar of an identity, using
+// the configured avatar provider. An empty string is returned if there is none.
+func (c *RepoCache) AvatarSourceUrl(i identity.Interface) string {
+	c.loadAvatarConfig()
+	return identity.AvatarSourceUrl(i, c.avatarProvider, avatarSize)
+}
+
+// Avatar return the avatar image of an identity, along with its content type.
+// The image is fetched once and kept on disk, so that it's still available
+// offline and remote services are not hit over and over. ErrNoAvatar is
+// returned if the identity has no avatar.
+func (c *RepoCache) Avatar(id entity.Id) ([]byte, string, error) {
+	i, err := c.ResolveIdentity(id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	url := c.AvatarSourceUrl(i.Identity)
+	if url == "" || !isHttpUrl(url) {
+		return nil, "", ErrNoAvatar
+	}
+
+	// the file is named after the source, so that a new avatar URL
+	// invalidate the cached copy
+	filePath := avatarFilePath(c, url)
+
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, "", err
+	}
+
+	if os.IsNotExist(err) {
+		data, err = c.fetchAvatarOnce(url, filePath)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	if len(data) == 0 {
+		return nil, "", ErrNoAvatar
+	}
+
+	return data, http.DetectContentType(data), nil
+}
+
+// NormalizeAvatarSize return the size an avatar is actually served at for a
+// requested size, 0 being the default one
+func NormalizeAvatarSize(size int) int {
+	if size <= 0 || size >= avatarSize {
+		return avatarSize
+	}
+	return (size + minAvatarSize - 1) / minAvatarSize * minAvatarSize
+}
+
+// AvatarSized return the avatar of an identity as a square PNG image of a
+// normalized size, cropped in the center. The resized copy is kept on disk
+// as well. The images that can't be decoded are returned as is.
+func (c *RepoCache) AvatarSized(id entity.Id, size int) ([]byte, string, error) {
+	size = NormalizeAvatarSize(size)
+
+	i, err := c.ResolveIdentity(id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	url := c.AvatarSourceUrl(i.Identity)
+	if url == "" {
+		return nil, "", ErrNoAvatar
+	}
+
+	filePath := fmt.Sprintf("%s-%d.png", avatarFilePath(c, url), size)
+
+	data, err := ioutil.ReadFile(filePath)
+	if err == nil {
+		return data, "image/png", nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, "", err
+	}
+
+	data, contentType, err := c.Avatar(id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return data, contentType, nil
+	}
+	if config.Width > maxAvatarDimension || config.Height > maxAvatarDimension {
+		return nil, "", ErrNoAvatar
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return data, contentType, nil
+	}
+
+	var buf bytes.Buffer
+	err = png.Encode(&buf, resizeSquare(src, size))
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = ioutil.WriteFile(filePath, buf.Bytes(), 0644)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return buf.Bytes(), "image/png", nil
+}
+
+// resizeSquare crop the center of an image to a square, and scale it to the
+// given size, each pixel being the average of the ones it covers
+func resizeSquare(src image.Image, size int) image.Image {
+	bounds := src.Bounds()
+	side := bounds.Dx()
+	if bounds.Dy() < side {
+		side = bounds.Dy()
+	}
+	x0 := bounds.Min.X + (bounds.Dx()-side)/2
+	y0 := bounds.Min.Y + (bounds.Dy()-side)/2
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+
+	for y := 0; y < size; y++ {
+		sy0 := y0 + y*side/size
+		sy1 := y0 + (y+1)*side/size
+		if sy1 == sy0 {
+			sy1++
+		}
+		for x := 0; x < size; x++ {
+			sx0 := x0 + x*side/size
+			sx1 := x0 + (x+1)*side/size
+			if sx1 == sx0 {
+				sx1++
+			}
+
+			var r, g, b, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				for sx := sx0; sx < sx1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(b / n),
+				A: uint16(a / n),
+			})
+		}
+	}
+
+	return dst
+}
+
+func avatarFilePath(c *RepoCache, url string) string {
+	name := fmt.Sprintf("%x", sha256.Sum256([]byte(url)))
+	return path.Join(c.repo.GetPath(), "git-bug", avatarCacheDir, name)
+}
+
+// avatarFetch is a running fetch of an avatar, waited for by the other
+// requests of the same avatar
+type avatarFetch struct {
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+// fetchAvatarOnce download an avatar and keep it on disk. A failure is kept
+// as well, so that the source is not hit on each request while unavailable.
+// The concurrent calls for the same avatar wait for a single download.
+func (c *RepoCache) fetchAvatarOnce(url string, filePath string) ([]byte, error) {
+	c.avatarFetchesLock.Lock()
+	if fetch, ok := c.avatarFetches[filePath]; ok {
+		c.avatarFetchesLock.Unlock()
+		<-fetch.done
+		return fetch.data, fetch.err
+	}
+	if c.avatarFetches == nil {
+		c.avatarFetches = make(map[string]*avatarFetch)
+	}
+	fetch := &avatarFetch{done: make(chan struct{})}
+	c.avatarFetches[filePath] = fetch
+	c.avatarFetchesLock.Unlock()
+
+	fetch.data, fetch.err = c.fetchAvatarFile(url, filePath)
+
+	c.avatarFetchesLock.Lock()
+	delete(c.avatarFetches, filePath)
+	c.avatarFetchesLock.Unlock()
+	close(fetch.done)
+
+	return fetch.data, fetch.err
+}
+
+func (c *RepoCache) fetchAvatarFile(url string, filePath string) ([]byte, error) {
+	// fetched by a previous call meanwhile
+	data, err := ioutil.ReadFile(filePath)
+	if err == nil {
+		return data, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	failurePath := filePath + avatarFailureExt
+
+	info, err := os.Stat(failurePath)
+	if err == nil && time.Since(info.ModTime()) < avatarRetryDelay {
+		msg, err := ioutil.ReadFile(failurePath)
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.New(string(msg))
+	}
+
+	err = os.MkdirAll(path.Dir(filePath), 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	data, fetchErr := fetchAvatar(c.avatarClient, url)
+	if fetchErr != nil {
+		err = ioutil.WriteFile(failurePath, []byte(fetchErr.Error()), 0644)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fetchErr
+	}
+
+	// an empty file record that the source has no avatar
+	err = ioutil.WriteFile(filePath, data, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = os.Remove(failurePath)
+
+	return data, nil
+}
+
+// fetchAvatar download an avatar. A nil result without error means that the
+// source has no avatar, or not one that can be served.
+func fetchAvatar(client *http.Client, url string) ([]byte, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't fetch avatar")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("can't fetch avatar: unexpected status %s", resp.Status)
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxAvatarSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "can't fetch avatar")
+	}
+	if len(data) > maxAvatarSize {
+		return nil, nil
+	}
+
+	if !strings.HasPrefix(http.DetectContentType(data), "image/") {
+		return nil, nil
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err == nil && (config.Width > maxAvatarDimension || config.Height > maxAvatarDimension) {
+		return nil, nil
+	}
+
+	return data, nil
+}
+
+// newAvatarClient return a http client only fetching the avatars over http
+// or https, and from public addresses unless the private hosts are allowed.
+// The URLs of the avatars come from the identities, which could otherwise
+// make the web UI request the services of its local network.
+func newAvatarClient(privateHosts bool) *http.Client {
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+
+	// the proxies are not used, as they would connect to the hosts instead
+	// of the checked dial
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			host, port, err := net.SplitHostPort(addr)
+			if err != nil {
+				return nil, err
+			}
+
+			addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+			if err != nil {
+				return nil, err
+			}
+			if len(addrs) == 0 {
+				return nil, fmt.Errorf("no address for %s", host)
+			}
+			for _, a := range addrs {
+				if !privateHosts && !isPublicIP(a.IP) {
+					return nil, errPrivateAvatarHost
+				}
+			}
+
+			// the checked address is dialed, as resolving the host again
+			// could give another one
+			return dialer.DialContext(ctx, network, net.JoinHostPort(addrs[0].IP.String(), port))
+		},
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+
+	return &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return errors.New("too many redirects")
+			}
+			if !isHttpUrl(req.URL.String()) {
+				return fmt.Errorf("redirected to a non-http URL")
+			}
+			return nil
+		},
+	}
+}
+
+func isHttpUrl(rawUrl string) bool {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// isPublicIP tell if an address is not a loopback, private, link-local or
+// otherwise special address
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range privateNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,204 @@
+package cache
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestAvatarSized(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	require.NoError(t, repo.LocalConfig().StoreString(avatarPrivateHostsConfigKey, "true"))
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	// a red square between two blue bands
+	src := image.NewRGBA(image.Rect(0, 0, 300, 100))
+	for x := 0; x < 300; x++ {
+		for y := 0; y < 100; y++ {
+			c := color.RGBA{B: 255, A: 255}
+			if x >= 100 && x < 200 {
+				c = color.RGBA{R: 255, A: 255}
+			}
+			src.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, src))
+
+	provider := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write(buf.Bytes())
+	}))
+
+	iden, err := cache.NewIdentityFull("René Descartes", "rene@descartes.fr", "", provider.URL+"/rene.png")
+	require.NoError(t, err)
+
+	decode := func(size int) image.Image {
+		data, contentType, err := cache.AvatarSized(iden.Id(), size)
+		require.NoError(t, err)
+		require.Equal(t, "image/png", contentType)
+		img, err := png.Decode(bytes.NewReader(data))
+		require.NoError(t, err)
+		return img
+	}
+
+	img := decode(50)
+	require.Equal(t, image.Rect(0, 0, 64, 64), img.Bounds())
+	r, g, b, _ := img.At(0, 0).RGBA()
+	require.Equal(t, [3]uint32{0xffff, 0, 0}, [3]uint32{r, g, b})
+
+	require.Equal(t, image.Rect(0, 0, avatarSize, avatarSize), decode(0).Bounds())
+	require.Equal(t, image.Rect(0, 0, avatarSize, avatarSize), decode(4096).Bounds())
+
+	// offline, the copy on disk is still served
+	provider.Close()
+	require.Equal(t, image.Rect(0, 0, 16, 16), decode(10).Bounds())
+
+	other, err := cache.NewIdentityFull("Isaac Newton", "isaac@newton.uk", "", provider.URL+"/isaac.png")
+	require.NoError(t, err)
+	_, _, err = cache.AvatarSized(other.Id(), 32)
+	require.Error(t, err)
+	require.NotEqual(t, ErrNoAvatar, err)
+
+	nobody, err := cache.NewIdentity("Nobody", "")
+	require.NoError(t, err)
+	_, _, err = cache.AvatarSized(nobody.Id(), 32)
+	require.Equal(t, ErrNoAvatar, err)
+}
+
+func TestAvatarFetch(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	var huge bytes.Buffer
+	require.NoError(t, png.Encode(&huge, image.NewGray(image.Rect(0, 0, maxAvatarDimension+1, 1))))
+
+	var small bytes.Buffer
+	require.NoError(t, png.Encode(&small, image.NewGray(image.Rect(0, 0, 8, 8))))
+
+	var hits int32
+	release := make(chan struct{})
+	provider := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		switch r.URL.Path {
+		case "/slow.png":
+			<-release
+			_, _ = rw.Write(small.Bytes())
+		case "/error.png":
+			rw.WriteHeader(http.StatusInternalServerError)
+		case "/page.png":
+			_, _ = rw.Write([]byte("<html><body>Sign in</body></html>"))
+		case "/huge.png":
+			_, _ = rw.Write(huge.Bytes())
+		case "/redirect.png":
+			http.Redirect(rw, r, "file:///etc/passwd", http.StatusFound)
+		}
+	}))
+	defer provider.Close()
+
+	// the loopback and private addresses are refused by default
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	local, err := cache.NewIdentityFull("René Descartes", "rene@descartes.fr", "", provider.URL+"/rene.png")
+	require.NoError(t, err)
+	_, _, err = cache.Avatar(local.Id())
+	require.Error(t, err)
+	require.NotEqual(t, ErrNoAvatar, err)
+	require.Equal(t, int32(0), atomic.LoadInt32(&hits))
+	require.NoError(t, cache.Close())
+
+	require.NoError(t, repo.LocalConfig().StoreString(avatarPrivateHostsConfigKey, "true"))
+	cache, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	// twice, to check that the result is kept
+	avatar := func(name string, url string) (error, error) {
+		i, err := cache.NewIdentityFull(name, "", "", url)
+		require.NoError(t, err)
+		_, _, err1 := cache.AvatarSized(i.Id(), 32)
+		_, _, err2 := cache.AvatarSized(i.Id(), 32)
+		return err1, err2
+	}
+
+	err1, err2 := avatar("Isaac Newton", "file:///etc/passwd")
+	require.Equal(t, ErrNoAvatar, err1)
+	require.Equal(t, ErrNoAvatar, err2)
+	require.Equal(t, int32(0), atomic.LoadInt32(&hits))
+
+	err1, err2 = avatar("Blaise Pascal", provider.URL+"/error.png")
+	require.Error(t, err1)
+	require.NotEqual(t, ErrNoAvatar, err1)
+	require.Equal(t, err1.Error(), err2.Error())
+	require.Equal(t, int32(1), atomic.LoadInt32(&hits))
+
+	err1, err2 = avatar("Pierre de Fermat", provider.URL+"/page.png")
+	require.Equal(t, ErrNoAvatar, err1)
+	require.Equal(t, ErrNoAvatar, err2)
+	require.Equal(t, int32(2), atomic.LoadInt32(&hits))
+
+	err1, _ = avatar("Leonhard Euler", provider.URL+"/huge.png")
+	require.Equal(t, ErrNoAvatar, err1)
+
+	err1, _ = avatar("Carl Gauss", provider.URL+"/redirect.png")
+	require.Error(t, err1)
+	require.NotEqual(t, ErrNoAvatar, err1)
+
+	// the failure of the default config is kept as well
+	_, _, err = cache.Avatar(local.Id())
+	require.Error(t, err)
+	require.NotEqual(t, ErrNoAvatar, err)
+
+	// the concurrent requests of an avatar share the fetch
+	slow, err := cache.NewIdentityFull("Ada Lovelace", "", "", provider.URL+"/slow.png")
+	require.NoError(t, err)
+	before := atomic.LoadInt32(&hits)
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, _, errs[i] = cache.Avatar(slow.Id())
+		}(i)
+	}
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, before+1, atomic.LoadInt32(&hits))
+}
+
+func TestIsPublicIP(t *testing.T) {
+	for _, ip := range []string{"127.0.0.1", "10.1.2.3", "172.20.0.1", "192.168.1.1", "169.254.169.254", "0.0.0.0", "::1", "fe80::1", "fd00::1", "::ffff:192.168.1.1"} {
+		require.False(t, isPublicIP(net.ParseIP(ip)), ip)
+	}
+	for _, ip := range []string{"8.8.8.8", "172.32.0.1", "2001:4860:4860::8888"} {
+		require.True(t, isPublicIP(net.ParseIP(ip)), ip)
+	}
+}
+
+func TestNormalizeAvatarSize(t *testing.T) {
+	require.Equal(t, avatarSize, NormalizeAvatarSize(0))
+	require.Equal(t, 16, NormalizeAvatarSize(1))
+	require.Equal(t, 32, NormalizeAvatarSize(17))
+	require.Equal(t, 48, NormalizeAvatarSize(48))
+	require.Equal(t, avatarSize, NormalizeAvatarSize(1000))
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
 	"github.com/MichaelMure/git-bug/util/git"
 )
 
@@ -18,6 +19,15 @@ var ErrNoMatchingOp = fmt.Errorf("no matching operation found")
 type BugCache struct {
 	repoCache *RepoCache
 	bug       *bug.WithSnapshot
+
+	// the post- hooks of the operations not committed yet, whose handlers
+	// are run on the commit
+	pendingHooks []pendingHook
+}
+
+type pendingHook struct {
+	hook Hook
+	op   bug.Operation
 }
 
 func NewBugCache(repoCache *RepoCache, b *bug.Bug) *BugCache {
@@ -36,7 +46,39 @@ func (c *BugCache) Id() entity.Id {
 }
 
 func (c *BugCache) notifyUpdated() error {
-	return c.repoCache.bugUpdated(c.bug.Id())
+	return c.repoCache.bugUpdated(c)
+}
+
+// operationAdded update the cache with a new operation, and run the post-op
+// hook
+func (c *BugCache) operationAdded(op bug.Operation) error {
+	err := c.notifyUpdated()
+	if err != nil {
+		return err
+	}
+	return c.runHook(HookPostOperation, op)
+}
+
+// runHook run the script of a post- hook of an operation, and its handlers
+// once the operation is committed
+func (c *BugCache) runHook(hook Hook, op bug.Operation) error {
+	err := c.repoCache.runHookScript(hook, c.Id(), op)
+	if err != nil {
+		return err
+	}
+	c.pendingHooks = append(c.pendingHooks, pendingHook{hook: hook, op: op})
+	return nil
+}
+
+// committed run the hook handlers of the committed operations
+func (c *BugCache) committed() error {
+	pending := c.pendingHooks
+	c.pendingHooks = nil
+	for _, p := range pending {
+		c.repoCache.runHookHandlers(p.hook, c.Id(), p.op)
+	}
+
+	return c.repoCache.bugCommitted(c.Id())
 }
 
 // ResolveOperationWithMetadata will find an operation that has the matching metadata
@@ -51,6 +93,16 @@ func (c *BugCache) ResolveOperationWithMetadata(key string, value string) (entit
 		if ok && value == opValue {
 			matching = append(matching, op.Id())
 		}
+
+		// the operations replaced by a snapshot keep their metadata
+		if snap, ok := op.(*bug.SnapshotOperation); ok {
+			for _, archived := range snap.Archived {
+				metadata, _ := snap.ArchivedMetadata(archived.Id)
+				if opValue, ok := metadata[key]; ok && value == opValue {
+					matching = append(matching, archived.Id)
+				}
+			}
+		}
 	}
 
 	if len(matching) == 0 {
@@ -87,7 +139,12 @@ func (c *BugCache) AddCommentRaw(author *IdentityCache, unixTime int64, message
 		op.SetMetadata(key, value)
 	}
 
-	return op, c.notifyUpdated()
+	err = c.operationAdded(op)
+	if err != nil {
+		return nil, err
+	}
+
+	return op, c.runHook(HookPostComment, op)
 }
 
 func (c *BugCache) ChangeLabels(added []string, removed []string) ([]bug.LabelChangeResult, *bug.LabelChangeOperation, error) {
@@ -96,9 +153,36 @@ func (c *BugCache) ChangeLabels(added []string, removed []string) ([]bug.LabelCh
 		return nil, nil, err
 	}
 
+	added = c.MatchLabelNames(added)
+	removed = c.MatchLabelNames(removed)
+
 	return c.ChangeLabelsRaw(author, time.Now().Unix(), added, removed, nil)
 }
 
+// MatchLabelNames map the given label names onto the labels set on the bug
+// with the same resolved name, so that a renamed label can be removed with its
+// new name. Names not present on the bug are resolved to their current name.
+func (c *BugCache) MatchLabelNames(names []string) []string {
+	labels := c.repoCache.labels
+	current := c.bug.Snapshot().Labels
+
+	result := make([]string, len(names))
+
+	for i, name := range names {
+		resolved := labels.Resolve(bug.Label(name))
+		result[i] = resolved.String()
+
+		for _, l := range current {
+			if labels.Resolve(l) == resolved {
+				result[i] = l.String()
+				break
+			}
+		}
+	}
+
+	return result
+}
+
 func (c *BugCache) ChangeLabelsRaw(author *IdentityCache, unixTime int64, added []string, removed []string, metadata map[string]string) ([]bug.LabelChangeResult, *bug.LabelChangeOperation, error) {
 	changes, op, err := bug.ChangeLabels(c.bug, author.Identity, unixTime, added, removed)
 	if err != nil {
@@ -109,7 +193,7 @@ func (c *BugCache) ChangeLabelsRaw(author *IdentityCache, unixTime int64, added
 		op.SetMetadata(key, value)
 	}
 
-	err = c.notifyUpdated()
+	err = c.operationAdded(op)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -117,17 +201,18 @@ func (c *BugCache) ChangeLabelsRaw(author *IdentityCache, unixTime int64, added
 	return changes, op, nil
 }
 
-func (c *BugCache) ForceChangeLabels(added []string, removed []string) (*bug.LabelChangeOperation, error) {
+// ChangeAssignees assign or unassign identities to the bug
+func (c *BugCache) ChangeAssignees(added []*IdentityCache, removed []*IdentityCache) (*bug.AssigneeChangeOperation, error) {
 	author, err := c.repoCache.GetUserIdentity()
 	if err != nil {
 		return nil, err
 	}
 
-	return c.ForceChangeLabelsRaw(author, time.Now().Unix(), added, removed, nil)
+	return c.ChangeAssigneesRaw(author, time.Now().Unix(), added, removed, nil)
 }
 
-func (c *BugCache) ForceChangeLabelsRaw(author *IdentityCache, unixTime int64, added []string, removed []string, metadata map[string]string) (*bug.LabelChangeOperation, error) {
-	op, err := bug.ForceChangeLabels(c.bug, author.Identity, unixTime, added, removed)
+func (c *BugCache) ChangeAssigneesRaw(author *IdentityCache, unixTime int64, added []*IdentityCache, removed []*IdentityCache, metadata map[string]string) (*bug.AssigneeChangeOperation, error) {
+	op, err := bug.ChangeAssignees(c.bug, author.Identity, unixTime, cachedIdentities(added), cachedIdentities(removed))
 	if err != nil {
 		return nil, err
 	}
@@ -136,47 +221,74 @@ func (c *BugCache) ForceChangeLabelsRaw(author *IdentityCache, unixTime int64, a
 		op.SetMetadata(key, value)
 	}
 
-	err = c.notifyUpdated()
+	return op, c.operationAdded(op)
+}
+
+func cachedIdentities(identities []*IdentityCache) []identity.Interface {
+	result := make([]identity.Interface, len(identities))
+	for i, cached := range identities {
+		result[i] = cached.Identity
+	}
+	return result
+}
+
+// SetDueDate set the due date of the bug
+func (c *BugCache) SetDueDate(due time.Time) (*bug.SetDueDateOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
 	if err != nil {
 		return nil, err
 	}
 
-	return op, nil
+	return c.SetDueDateRaw(author, time.Now().Unix(), due, nil)
 }
 
-func (c *BugCache) Open() (*bug.SetStatusOperation, error) {
+func (c *BugCache) SetDueDateRaw(author *IdentityCache, unixTime int64, due time.Time, metadata map[string]string) (*bug.SetDueDateOperation, error) {
+	op, err := bug.SetDueDate(c.bug, author.Identity, unixTime, due)
+	if err != nil {
+		return nil, err
+	}
+
+	return op, c.dueDateChanged(op, metadata)
+}
+
+// ClearDueDate remove the due date of the bug
+func (c *BugCache) ClearDueDate() (*bug.SetDueDateOperation, error) {
 	author, err := c.repoCache.GetUserIdentity()
 	if err != nil {
 		return nil, err
 	}
 
-	return c.OpenRaw(author, time.Now().Unix(), nil)
+	return c.ClearDueDateRaw(author, time.Now().Unix(), nil)
 }
 
-func (c *BugCache) OpenRaw(author *IdentityCache, unixTime int64, metadata map[string]string) (*bug.SetStatusOperation, error) {
-	op, err := bug.Open(c.bug, author.Identity, unixTime)
+func (c *BugCache) ClearDueDateRaw(author *IdentityCache, unixTime int64, metadata map[string]string) (*bug.SetDueDateOperation, error) {
+	op, err := bug.ClearDueDate(c.bug, author.Identity, unixTime)
 	if err != nil {
 		return nil, err
 	}
 
+	return op, c.dueDateChanged(op, metadata)
+}
+
+func (c *BugCache) dueDateChanged(op *bug.SetDueDateOperation, metadata map[string]string) error {
 	for key, value := range metadata {
 		op.SetMetadata(key, value)
 	}
 
-	return op, c.notifyUpdated()
+	return c.operationAdded(op)
 }
 
-func (c *BugCache) Close() (*bug.SetStatusOperation, error) {
+func (c *BugCache) ForceChangeLabels(added []string, removed []string) (*bug.LabelChangeOperation, error) {
 	author, err := c.repoCache.GetUserIdentity()
 	if err != nil {
 		return nil, err
 	}
 
-	return c.CloseRaw(author, time.Now().Unix(), nil)
+	return c.ForceChangeLabelsRaw(author, time.Now().Unix(), added, removed, nil)
 }
 
-func (c *BugCache) CloseRaw(author *IdentityCache, unixTime int64, metadata map[string]string) (*bug.SetStatusOperation, error) {
-	op, err := bug.Close(c.bug, author.Identity, unixTime)
+func (c *BugCache) ForceChangeLabelsRaw(author *IdentityCache, unixTime int64, added []string, removed []string, metadata map[string]string) (*bug.LabelChangeOperation, error) {
+	op, err := bug.ForceChangeLabels(c.bug, author.Identity, unixTime, added, removed)
 	if err != nil {
 		return nil, err
 	}
@@ -185,7 +297,60 @@ func (c *BugCache) CloseRaw(author *IdentityCache, unixTime int64, metadata map[
 		op.SetMetadata(key, value)
 	}
 
-	return op, c.notifyUpdated()
+	err = c.operationAdded(op)
+	if err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func (c *BugCache) Open() (*bug.SetStatusOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.OpenRaw(author, time.Now().Unix(), nil)
+}
+
+func (c *BugCache) OpenRaw(author *IdentityCache, unixTime int64, metadata map[string]string) (*bug.SetStatusOperation, error) {
+	return c.setStatusRaw(author, unixTime, bug.OpenStatus, metadata)
+}
+
+func (c *BugCache) Close() (*bug.SetStatusOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CloseRaw(author, time.Now().Unix(), nil)
+}
+
+func (c *BugCache) CloseRaw(author *IdentityCache, unixTime int64, metadata map[string]string) (*bug.SetStatusOperation, error) {
+	return c.setStatusRaw(author, unixTime, bug.ClosedStatus, metadata)
+}
+
+func (c *BugCache) setStatusRaw(author *IdentityCache, unixTime int64, status bug.Status, metadata map[string]string) (*bug.SetStatusOperation, error) {
+	op := bug.NewSetStatusOp(author.Identity, unixTime, status)
+
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+
+	// give a chance to the pre-status hook to reject the change
+	err := c.repoCache.RunHook(HookPreStatus, c.Id(), op)
+	if err != nil {
+		return nil, err
+	}
+
+	c.bug.Append(op)
+
+	return op, c.operationAdded(op)
 }
 
 func (c *BugCache) SetTitle(title string) (*bug.SetTitleOperation, error) {
@@ -207,7 +372,7 @@ func (c *BugCache) SetTitleRaw(author *IdentityCache, unixTime int64, title stri
 		op.SetMetadata(key, value)
 	}
 
-	return op, c.notifyUpdated()
+	return op, c.operationAdded(op)
 }
 
 func (c *BugCache) EditComment(target entity.Id, message string) (*bug.EditCommentOperation, error) {
@@ -229,7 +394,7 @@ func (c *BugCache) EditCommentRaw(author *IdentityCache, unixTime int64, target
 		op.SetMetadata(key, value)
 	}
 
-	return op, c.notifyUpdated()
+	return op, c.operationAdded(op)
 }
 
 func (c *BugCache) SetMetadata(target entity.Id, newMetadata map[string]string) (*bug.SetMetadataOperation, error) {
@@ -247,23 +412,43 @@ func (c *BugCache) SetMetadataRaw(author *IdentityCache, unixTime int64, target
 		return nil, err
 	}
 
-	return op, c.notifyUpdated()
+	return op, c.operationAdded(op)
 }
 
 func (c *BugCache) Commit() error {
+	if err := c.repoCache.writable(); err != nil {
+		return err
+	}
+
 	err := c.bug.Commit(c.repoCache.repo)
 	if err != nil {
 		return err
 	}
-	return c.notifyUpdated()
+
+	err = c.notifyUpdated()
+	if err != nil {
+		return err
+	}
+	return c.committed()
 }
 
 func (c *BugCache) CommitAsNeeded() error {
+	if err := c.repoCache.writable(); err != nil {
+		return err
+	}
+
+	committing := c.bug.NeedCommit()
+
 	err := c.bug.CommitAsNeeded(c.repoCache.repo)
 	if err != nil {
 		return err
 	}
-	return c.notifyUpdated()
+
+	err = c.notifyUpdated()
+	if err != nil || !committing {
+		return err
+	}
+	return c.committed()
 }
 
 func (c *BugCache) NeedCommit() bool {
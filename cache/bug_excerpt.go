@@ -3,10 +3,13 @@ package cache
 import (
 	"encoding/gob"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/git"
 	"github.com/MichaelMure/git-bug/util/lamport"
 )
 
@@ -19,11 +22,17 @@ func init() {
 // efficiently without having to read and compile each raw bugs.
 type BugExcerpt struct {
 	Id entity.Id
+	// the last commit of the bug, to find the bugs changed outside of the cache
+	Head git.Hash
 
 	CreateLamportTime lamport.Time
 	EditLamportTime   lamport.Time
 	CreateUnixTime    int64
 	EditUnixTime      int64
+	// 0 if the bug is open
+	CloseUnixTime int64
+	// 0 if the bug has no due date
+	DueUnixTime int64
 
 	Status       bug.Status
 	Labels       []bug.Label
@@ -31,6 +40,14 @@ type BugExcerpt struct {
 	LenComments  int
 	Actors       []entity.Id
 	Participants []entity.Id
+	Assignees    []entity.Id
+	// the level in bug.Priorities given by the labels, 0 if there is none
+	Priority int
+	// given by the labels, empty if there is none
+	Milestone string
+	// the directory of the component of a monorepo the bug belong to, given
+	// by the labels, empty if there is none
+	Scope string
 
 	// If author is identity.Bare, LegacyAuthor is set
 	// If author is identity.Identity, AuthorId is set and data is deported
@@ -71,16 +88,28 @@ func NewBugExcerpt(b bug.Interface, snap *bug.Snapshot) *BugExcerpt {
 		actorsIds[i] = actor.Id()
 	}
 
+	assigneesIds := make([]entity.Id, len(snap.Assignees))
+	for i, assignee := range snap.Assignees {
+		assigneesIds[i] = assignee.Id()
+	}
+
 	e := &BugExcerpt{
 		Id:                b.Id(),
+		Head:              b.LastCommit(),
 		CreateLamportTime: b.CreateLamportTime(),
 		EditLamportTime:   b.EditLamportTime(),
 		CreateUnixTime:    b.FirstOp().GetUnixTime(),
 		EditUnixTime:      snap.LastEditUnix(),
+		CloseUnixTime:     snap.ClosedUnix(),
+		DueUnixTime:       dueUnix(snap),
 		Status:            snap.Status,
 		Labels:            snap.Labels,
 		Actors:            actorsIds,
 		Participants:      participantsIds,
+		Assignees:         assigneesIds,
+		Priority:          bug.LabelsPriority(snap.Labels),
+		Milestone:         bug.LabelsMilestone(snap.Labels),
+		Scope:             bug.LabelsScope(snap.Labels),
 		Title:             snap.Title,
 		LenComments:       len(snap.Comments),
 		CreateMetadata:    b.FirstOp().AllMetadata(),
@@ -101,6 +130,18 @@ func NewBugExcerpt(b bug.Interface, snap *bug.Snapshot) *BugExcerpt {
 	return e
 }
 
+func dueUnix(snap *bug.Snapshot) int64 {
+	if snap.DueDate.IsZero() {
+		return 0
+	}
+	return snap.DueDate.Unix()
+}
+
+// Overdue tell if the bug is still open after its due date
+func (b *BugExcerpt) Overdue(now time.Time) bool {
+	return b.Status == bug.OpenStatus && b.DueUnixTime != 0 && b.DueUnixTime < now.Unix()
+}
+
 /*
  * Sorting
  */
@@ -174,3 +215,117 @@ func (b BugsByEditTime) Less(i, j int) bool {
 func (b BugsByEditTime) Swap(i, j int) {
 	b[i], b[j] = b[j], b[i]
 }
+
+type BugsByStatus []*BugExcerpt
+
+func (b BugsByStatus) Len() int {
+	return len(b)
+}
+
+func (b BugsByStatus) Less(i, j int) bool {
+	return b[i].Status < b[j].Status
+}
+
+func (b BugsByStatus) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+type BugsByLenComments []*BugExcerpt
+
+func (b BugsByLenComments) Len() int {
+	return len(b)
+}
+
+func (b BugsByLenComments) Less(i, j int) bool {
+	return b[i].LenComments < b[j].LenComments
+}
+
+func (b BugsByLenComments) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+type BugsByLenParticipants []*BugExcerpt
+
+func (b BugsByLenParticipants) Len() int {
+	return len(b)
+}
+
+func (b BugsByLenParticipants) Less(i, j int) bool {
+	return len(b[i].Participants) < len(b[j].Participants)
+}
+
+func (b BugsByLenParticipants) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+type BugsByTitle []*BugExcerpt
+
+func (b BugsByTitle) Len() int {
+	return len(b)
+}
+
+func (b BugsByTitle) Less(i, j int) bool {
+	return strings.ToLower(b[i].Title) < strings.ToLower(b[j].Title)
+}
+
+func (b BugsByTitle) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+type BugsByPriority []*BugExcerpt
+
+func (b BugsByPriority) Len() int {
+	return len(b)
+}
+
+func (b BugsByPriority) Less(i, j int) bool {
+	return b[i].Priority < b[j].Priority
+}
+
+func (b BugsByPriority) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+// BugsByDueDate sort the bugs by due date, the bugs without one being after
+// all the others
+type BugsByDueDate []*BugExcerpt
+
+func (b BugsByDueDate) Len() int {
+	return len(b)
+}
+
+func (b BugsByDueDate) Less(i, j int) bool {
+	switch {
+	case b[i].DueUnixTime == 0:
+		return false
+	case b[j].DueUnixTime == 0:
+		return true
+	}
+	return b[i].DueUnixTime < b[j].DueUnixTime
+}
+
+func (b BugsByDueDate) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+// BugsByMilestone sort the bugs by milestone, the bugs without one being
+// after all the others
+type BugsByMilestone []*BugExcerpt
+
+func (b BugsByMilestone) Len() int {
+	return len(b)
+}
+
+func (b BugsByMilestone) Less(i, j int) bool {
+	switch {
+	case b[i].Milestone == "":
+		return false
+	case b[j].Milestone == "":
+		return true
+	}
+	return strings.ToLower(b[i].Milestone) < strings.ToLower(b[j].Milestone)
+}
+
+func (b BugsByMilestone) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
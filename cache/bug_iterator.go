@@ -0,0 +1,122 @@
+package cache
+
+// BugIterator iterate over the excerpts of the bugs matching a query, in the
+// order of the query. The bugs are sorted as they are iterated over: the
+// first ones come without having to sort all of them, and no list of ids is
+// allocated.
+type BugIterator struct {
+	sorter bugsMultiSorter
+	// the bugs not iterated over yet are a heap in sorter.bugs[:remaining]
+	remaining int
+	sorted    bool
+	current   *BugExcerpt
+}
+
+// IterateBugs return an iterator over the bugs matching the given query, or
+// over all the bugs in no particular order if the query is nil.
+//
+// The cache must not be changed during the iteration.
+func (c *RepoCache) IterateBugs(query *Query) *BugIterator {
+	var keys []SortKey
+	if query != nil {
+		keys = query.Sorting
+	}
+
+	matching := c.matchingExcerpts(query)
+
+	it := &BugIterator{
+		sorter:    newBugsMultiSorter(matching, keys),
+		remaining: len(matching),
+		sorted:    len(keys) > 0,
+	}
+
+	if it.sorted {
+		for i := it.remaining/2 - 1; i >= 0; i-- {
+			it.down(i)
+		}
+	}
+
+	return it
+}
+
+// matchingExcerpts return the excerpts of the bugs matching a query, in no
+// particular order
+func (c *RepoCache) matchingExcerpts(query *Query) []*BugExcerpt {
+	c.prepareCommitLinks(query)
+
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
+	if query != nil && len(query.fullTextWords) > 0 {
+		// only the bugs having all the words in the index can match
+		var result []*BugExcerpt
+		for _, id := range c.fullTextIndex.lookup(query.fullTextWords) {
+			excerpt, ok := c.bugExcerpts[id]
+			if ok && query.Match(c, excerpt) {
+				result = append(result, excerpt)
+			}
+		}
+		return result
+	}
+
+	result := make([]*BugExcerpt, 0, len(c.bugExcerpts))
+	for _, excerpt := range c.bugExcerpts {
+		if query == nil || query.Match(c, excerpt) {
+			result = append(result, excerpt)
+		}
+	}
+	return result
+}
+
+// Next advance to the next bug, and return false when there is none
+func (it *BugIterator) Next() bool {
+	if it.remaining == 0 {
+		it.current = nil
+		return false
+	}
+
+	it.remaining--
+
+	if !it.sorted {
+		it.current = it.sorter.bugs[it.remaining]
+		return true
+	}
+
+	// pop the first bug of the heap, put aside at its end
+	it.sorter.Swap(0, it.remaining)
+	it.down(0)
+	it.current = it.sorter.bugs[it.remaining]
+	return true
+}
+
+// Value return the current bug excerpt
+func (it *BugIterator) Value() *BugExcerpt {
+	if it.current == nil {
+		panic("no current value, Next() must be called first and return true")
+	}
+	return it.current
+}
+
+// Len return the number of bugs left to iterate over
+func (it *BugIterator) Len() int {
+	return it.remaining
+}
+
+// down move down the bug at i in the heap until it's before its children
+func (it *BugIterator) down(i int) {
+	s := it.sorter
+	for {
+		child := 2*i + 1
+		if child >= it.remaining {
+			return
+		}
+		if right := child + 1; right < it.remaining && s.Less(right, child) {
+			child = right
+		}
+		if !s.Less(child, i) {
+			return
+		}
+		s.Swap(i, child)
+		i = child
+	}
+}
@@ -0,0 +1,122 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/lamport"
+)
+
+// newExcerptsCache return a cache holding only generated excerpts, all
+// different for every sorting
+func newExcerptsCache(n int) *RepoCache {
+	c := &RepoCache{
+		bugExcerpts:   make(map[entity.Id]*BugExcerpt, n),
+		fullTextIndex: newFullTextIndex(),
+		labels:        bug.NewLabelStore(),
+	}
+
+	for i := 0; i < n; i++ {
+		excerpt := &BugExcerpt{
+			Id:                entity.Id(fmt.Sprintf("%040d", i)),
+			CreateLamportTime: lamport.Time(i),
+			EditLamportTime:   lamport.Time((i * 7919) % n),
+			Status:            bug.OpenStatus,
+			Title:             fmt.Sprintf("bug %d", (i*104729)%n),
+			LenComments:       i % 10,
+		}
+		if i%3 == 0 {
+			excerpt.Status = bug.ClosedStatus
+		}
+		if i%5 == 0 {
+			excerpt.Labels = []bug.Label{"bug"}
+		}
+		c.bugExcerpts[excerpt.Id] = excerpt
+	}
+
+	return c
+}
+
+func TestIterateBugs(t *testing.T) {
+	c := newExcerptsCache(100)
+
+	for _, str := range []string{
+		"status:open",
+		"label:bug sort:edit-asc",
+		"-label:bug sort:title",
+		"sort:comments,creation",
+		"status:closed label:bug sort:id-desc",
+		"label:missing",
+	} {
+		query, err := ParseQuery(str)
+		require.NoError(t, err, str)
+
+		expected := c.QueryBugs(query)
+
+		it := c.IterateBugs(query)
+		assert.Equal(t, len(expected), it.Len(), str)
+
+		ids := []entity.Id{}
+		for it.Next() {
+			ids = append(ids, it.Value().Id)
+		}
+		assert.Equal(t, expected, ids, str)
+		assert.Equal(t, 0, it.Len())
+	}
+
+	// all the bugs, in no particular order
+	it := c.IterateBugs(nil)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	assert.Equal(t, 100, count)
+}
+
+// the listing of a repository of 100k bugs, when they are all listed or only
+// the first ones are read
+func BenchmarkQueryBugs100k(b *testing.B) {
+	c := newExcerptsCache(100000)
+	query, err := ParseQuery("status:open sort:edit")
+	require.NoError(b, err)
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		for _, id := range c.QueryBugs(query) {
+			_, _ = c.ResolveBugExcerpt(id)
+		}
+	}
+}
+
+func BenchmarkIterateBugs100k(b *testing.B) {
+	c := newExcerptsCache(100000)
+	query, err := ParseQuery("status:open sort:edit")
+	require.NoError(b, err)
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		it := c.IterateBugs(query)
+		for it.Next() {
+			_ = it.Value()
+		}
+	}
+}
+
+func BenchmarkIterateBugs100kFirst20(b *testing.B) {
+	c := newExcerptsCache(100000)
+	query, err := ParseQuery("status:open sort:edit")
+	require.NoError(b, err)
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		it := c.IterateBugs(query)
+		for i := 0; i < 20 && it.Next(); i++ {
+			_ = it.Value()
+		}
+	}
+}
@@ -0,0 +1,353 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+const commitIndexFile = "commit-index"
+
+// the local branches, whose commits are indexed
+const branchRefPattern = "refs/heads/"
+
+// the shortest hexadecimal word of a commit message taken as the id of a bug,
+// the length of a human id
+const minMentionLength = 7
+
+// CommitExcerpt is a commit of the source code mentioning bugs in its message
+type CommitExcerpt struct {
+	Hash git.Hash
+	// the name and email of the author, as "Name <email>"
+	Author   string
+	UnixTime int64
+	// the first line of the message
+	Subject string
+	// the files changed by the commit, relative to the root of the repository
+	Files []string
+	// the hexadecimal words of the message, that can be the start of a bug id
+	Mentions []string
+}
+
+// Time return the time the commit was authored
+func (c *CommitExcerpt) Time() time.Time {
+	return time.Unix(c.UnixTime, 0)
+}
+
+// ShortHash return the abbreviated hash of the commit
+func (c *CommitExcerpt) ShortHash() string {
+	if len(c.Hash) <= minMentionLength {
+		return string(c.Hash)
+	}
+	return string(c.Hash[:minMentionLength])
+}
+
+// Touches tell if the commit changed a file or a file of a directory, the
+// path being relative to the root of the repository
+func (c *CommitExcerpt) Touches(p string) bool {
+	for _, file := range c.Files {
+		if bug.ScopeContains(p, file) {
+			return true
+		}
+	}
+	return false
+}
+
+// commitIndex keep the commits of the local branches mentioning bugs, so that
+// they are linked to the bugs without going through the history again. The
+// mentions are resolved into bugs when linking, as a bug can be pulled after
+// the commits mentioning it.
+type commitIndex struct {
+	// the heads of the local branches, when the index was last updated
+	Heads map[string]git.Hash
+	// the commits with mentions
+	Commits map[git.Hash]*CommitExcerpt
+}
+
+func newCommitIndex() *commitIndex {
+	return &commitIndex{
+		Heads:   make(map[string]git.Hash),
+		Commits: make(map[git.Hash]*CommitExcerpt),
+	}
+}
+
+// add index a commit, if it mentions a bug
+func (idx *commitIndex) add(commit repository.Commit) {
+	mentions := commitMentions(commit.Message)
+	if len(mentions) == 0 {
+		return
+	}
+
+	subject := commit.Message
+	if i := strings.IndexByte(subject, '\n'); i >= 0 {
+		subject = subject[:i]
+	}
+
+	idx.Commits[commit.Hash] = &CommitExcerpt{
+		Hash:     commit.Hash,
+		Author:   commit.Author,
+		UnixTime: commit.Time.Unix(),
+		Subject:  strings.TrimSpace(subject),
+		Files:    commit.Files,
+		Mentions: mentions,
+	}
+}
+
+// commitMentions return the lowercase hexadecimal words of a commit message
+// long enough to be the id of a bug
+func commitMentions(message string) []string {
+	words := strings.FieldsFunc(message, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var result []string
+	seen := make(map[string]bool)
+
+	for _, word := range words {
+		if len(word) < minMentionLength || len(word) > entity.IdLengthSHA256 || !isHexWord(word) {
+			continue
+		}
+		word = strings.ToLower(word)
+		if !seen[word] {
+			seen[word] = true
+			result = append(result, word)
+		}
+	}
+
+	return result
+}
+
+func isHexWord(s string) bool {
+	for _, r := range s {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+			return false
+		}
+	}
+	return true
+}
+
+// updateCommitIndex index the commits added to the local branches since the
+// last update. The commitIndexMutex must be held.
+func (c *RepoCache) updateCommitIndex() error {
+	if c.commitIndex == nil {
+		err := c.loadCommitIndex()
+		if err != nil {
+			// the index is rebuilt from the history
+			c.commitIndex = newCommitIndex()
+		}
+	}
+
+	heads, err := c.repo.ResolveRefs(branchRefPattern)
+	if err != nil {
+		return err
+	}
+
+	var newHeads []git.Hash
+	known := make(map[git.Hash]bool, len(c.commitIndex.Heads))
+	for _, hash := range c.commitIndex.Heads {
+		known[hash] = true
+	}
+	for _, hash := range heads {
+		if !known[hash] {
+			newHeads = append(newHeads, hash)
+		}
+	}
+
+	if len(newHeads) == 0 && len(heads) == len(c.commitIndex.Heads) {
+		return nil
+	}
+
+	excluded := make([]git.Hash, 0, len(known))
+	for hash := range known {
+		excluded = append(excluded, hash)
+	}
+
+	commits, err := c.repo.ReadReachableCommits(newHeads, excluded)
+	if err != nil {
+		// a former head can be gone, like with a deleted branch
+		commits, err = c.repo.ReadReachableCommits(newHeads, nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, commit := range commits {
+		c.commitIndex.add(commit)
+	}
+	c.commitIndex.Heads = heads
+
+	return c.writeCommitIndex()
+}
+
+// linkCommits update the commit index and resolve the mentions of its commits
+// into the given bugs. The commitIndexMutex must be held.
+func (c *RepoCache) linkCommits(bugIds []entity.Id) error {
+	err := c.updateCommitIndex()
+	if err != nil {
+		return err
+	}
+
+	// the bugs can change between two calls, so the mentions are resolved
+	// each time
+	resolved := make(map[string]entity.Id)
+	links := make(map[entity.Id][]*CommitExcerpt)
+
+	for _, commit := range c.commitIndex.Commits {
+		for _, mention := range commit.Mentions {
+			id, ok := resolved[mention]
+			if !ok {
+				id = resolveMention(bugIds, mention)
+				resolved[mention] = id
+			}
+			if id != "" {
+				links[id] = append(links[id], commit)
+			}
+		}
+	}
+
+	for _, commits := range links {
+		sort.Slice(commits, func(i, j int) bool {
+			if commits[i].UnixTime != commits[j].UnixTime {
+				return commits[i].UnixTime > commits[j].UnixTime
+			}
+			return commits[i].Hash < commits[j].Hash
+		})
+	}
+
+	c.commitLinks = links
+	return nil
+}
+
+// resolveMention return the only bug whose id start with a mention, or an
+// empty id
+func resolveMention(bugIds []entity.Id, mention string) entity.Id {
+	var result entity.Id
+	for _, id := range bugIds {
+		if id.HasPrefix(mention) {
+			if result != "" {
+				return ""
+			}
+			result = id
+		}
+	}
+	return result
+}
+
+// CommitsReferencing return the commits of the local branches mentioning a
+// bug in their message, the newest first
+func (c *RepoCache) CommitsReferencing(id entity.Id) ([]*CommitExcerpt, error) {
+	// the bugs are listed first, as the muBug is taken before the
+	// commitIndexMutex by the filters
+	bugIds := c.AllBugsIds()
+
+	c.commitIndexMutex.Lock()
+	defer c.commitIndexMutex.Unlock()
+
+	err := c.linkCommits(bugIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.commitLinks[id], nil
+}
+
+// prepareCommitLinks link the commits to the bugs before matching a query
+// with a touching filter, as the filters can't fail
+func (c *RepoCache) prepareCommitLinks(query *Query) {
+	if query == nil || !query.touching {
+		return
+	}
+
+	bugIds := c.AllBugsIds()
+
+	c.commitIndexMutex.Lock()
+	defer c.commitIndexMutex.Unlock()
+
+	err := c.linkCommits(bugIds)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "warning: the commits can't be linked to the bugs: %v\n", err)
+	}
+}
+
+// commitsTouch tell if a commit mentioning a bug changed the given path, as
+// linked by the last query with a touching filter
+func (c *RepoCache) commitsTouch(id entity.Id, p string) bool {
+	c.commitIndexMutex.Lock()
+	defer c.commitIndexMutex.Unlock()
+
+	for _, commit := range c.commitLinks[id] {
+		if commit.Touches(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// load will try to read from the disk the commit index
+func (c *RepoCache) loadCommitIndex() error {
+	f, err := os.Open(commitIndexFilePath(c.repo))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	decoder := gob.NewDecoder(f)
+
+	aux := struct {
+		Version uint
+		Index   *commitIndex
+	}{}
+
+	err = decoder.Decode(&aux)
+	if err != nil {
+		return err
+	}
+
+	if aux.Version != formatVersion {
+		return fmt.Errorf("unexpected commit index version %d", aux.Version)
+	}
+
+	c.commitIndex = aux.Index
+	return nil
+}
+
+// write will serialize on disk the commit index
+func (c *RepoCache) writeCommitIndex() error {
+	if c.readOnly {
+		return nil
+	}
+
+	var data bytes.Buffer
+
+	aux := struct {
+		Version uint
+		Index   *commitIndex
+	}{
+		Version: formatVersion,
+		Index:   c.commitIndex,
+	}
+
+	encoder := gob.NewEncoder(&data)
+
+	err := encoder.Encode(aux)
+	if err != nil {
+		return err
+	}
+
+	return writeCacheFile(commitIndexFilePath(c.repo), data.Bytes())
+}
+
+func commitIndexFilePath(repo repository.Repo) string {
+	return path.Join(repo.GetPath(), "git-bug", commitIndexFile)
+}
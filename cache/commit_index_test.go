@@ -0,0 +1,130 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+// commitsRepo is a repository with a single branch of commits
+type commitsRepo struct {
+	repository.ClockedRepo
+	// the commits of the branch, the newest first
+	commits []repository.Commit
+	// the number of reads of the history
+	reads int
+}
+
+func (r *commitsRepo) ResolveRefs(refspec string) (map[string]git.Hash, error) {
+	if refspec != branchRefPattern {
+		return r.ClockedRepo.ResolveRefs(refspec)
+	}
+	return map[string]git.Hash{"refs/heads/master": r.commits[0].Hash}, nil
+}
+
+func (r *commitsRepo) ReadReachableCommits(heads []git.Hash, excluded []git.Hash) ([]repository.Commit, error) {
+	r.reads++
+
+	var result []repository.Commit
+	for _, commit := range r.commits {
+		for _, hash := range excluded {
+			if commit.Hash == hash {
+				return result, nil
+			}
+		}
+		result = append(result, commit)
+	}
+	return result, nil
+}
+
+func (r *commitsRepo) commit(hash string, message string, files ...string) {
+	r.commits = append([]repository.Commit{{
+		Hash:    git.Hash(hash),
+		Author:  "René Descartes <rene@descartes.fr>",
+		Time:    time.Unix(int64(1500000000+len(r.commits)), 0),
+		Message: message,
+		Files:   files,
+	}}, r.commits...)
+}
+
+func TestCommitIndex(t *testing.T) {
+	repo := &commitsRepo{ClockedRepo: repository.CreateTestRepo(false)}
+	defer repository.CleanupTestRepos(t, repo.ClockedRepo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(iden))
+
+	bug1, _, err := cache.NewBug("Parser crash", "it crashes")
+	require.NoError(t, err)
+	bug2, _, err := cache.NewBug("Outdated documentation", "")
+	require.NoError(t, err)
+
+	repo.commit("1111111", "Fix the lexer\n\nFixes: "+bug1.Id().Human(), "parser/lex.go")
+	repo.commit("2222222", "Document "+bug1.Id().String()+" and "+bug2.Id().Human(), "docs/parser.md")
+	repo.commit("3333333", "Bump the version to deadbeef00", "version.go")
+
+	commits, err := cache.CommitsReferencing(bug1.Id())
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	assert.Equal(t, git.Hash("2222222"), commits[0].Hash)
+	assert.Equal(t, "Fix the lexer", commits[1].Subject)
+	assert.Equal(t, []string{"parser/lex.go"}, commits[1].Files)
+	assert.Equal(t, "René Descartes <rene@descartes.fr>", commits[1].Author)
+
+	commits, err = cache.CommitsReferencing(bug2.Id())
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	assert.Equal(t, "2222222", commits[0].ShortHash())
+
+	// unchanged branch, the history is not read again
+	assert.Equal(t, 1, repo.reads)
+
+	queryIds := func(query string) []entity.Id {
+		q, err := ParseQuery(query + " sort:creation-asc")
+		require.NoError(t, err)
+		return cache.QueryBugs(q)
+	}
+
+	assert.Equal(t, []entity.Id{bug1.Id()}, queryIds("touching:parser"))
+	assert.Equal(t, []entity.Id{bug1.Id()}, queryIds("touching:parser/lex.go"))
+	assert.Equal(t, []entity.Id{bug1.Id(), bug2.Id()}, queryIds("touching:docs"))
+	assert.Equal(t, []entity.Id{bug2.Id()}, queryIds("-touching:parser"))
+	assert.Empty(t, queryIds("touching:pars"))
+	assert.Empty(t, queryIds("touching:version.go"))
+
+	// only the new commits are read
+	repo.commit("4444444", "Check the tokens, see "+bug2.Id().Human(), "parser/parse.go")
+
+	assert.Equal(t, []entity.Id{bug1.Id(), bug2.Id()}, queryIds("touching:parser"))
+	assert.Equal(t, 2, repo.reads)
+	commits, err = cache.CommitsReferencing(bug2.Id())
+	require.NoError(t, err)
+	assert.Len(t, commits, 2)
+
+	// the index is kept on disk
+	require.NoError(t, cache.Close())
+	cache, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	commits, err = cache.CommitsReferencing(bug2.Id())
+	require.NoError(t, err)
+	assert.Len(t, commits, 2)
+	assert.Equal(t, 2, repo.reads)
+}
+
+func TestCommitMentions(t *testing.T) {
+	assert.Equal(t, []string{"4a2b6c1", "deadbeefcafe"},
+		commitMentions("Fix 4A2B6C1, see deadbeefcafe and 4a2b6c1\n\nabc123 is too short, 4a2b6cz isn't hexadecimal"))
+	assert.Empty(t, commitMentions("Fix the parser"))
+}
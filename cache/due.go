@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/araddon/dateparse"
+)
+
+var dueDurationRegexp = regexp.MustCompile(`^\+(\d+)([hdwmy])$`)
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// ParseDueDate parse a due date, relative to now. It accept:
+// - a duration from now, as "+" followed by a number and a unit: h (hours),
+//   d (days), w (weeks), m (months) or y (years), like "+2w"
+// - "today" or "tomorrow"
+// - a day of the week, optionally preceded by "next", like "next friday",
+//   meaning the first such day after today
+// - "next week", "next month" or "next year"
+// - a date, like "2019-12-31" or "2019-12-31 10:00"
+//
+// Except for the durations in hours and the dates with a time, the due date
+// is the end of the day, so that a bug due today is not overdue before
+// tomorrow.
+func ParseDueDate(str string, now time.Time) (time.Time, error) {
+	query := strings.ToLower(strings.Join(strings.Fields(str), " "))
+
+	if matches := dueDurationRegexp.FindStringSubmatch(query); matches != nil {
+		n, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		switch matches[2] {
+		case "h":
+			return now.Add(time.Duration(n) * time.Hour), nil
+		case "d":
+			return endOfDay(now.AddDate(0, 0, n)), nil
+		case "w":
+			return endOfDay(now.AddDate(0, 0, 7*n)), nil
+		case "m":
+			return endOfDay(now.AddDate(0, n, 0)), nil
+		case "y":
+			return endOfDay(now.AddDate(n, 0, 0)), nil
+		}
+	}
+
+	switch query {
+	case "today":
+		return endOfDay(now), nil
+	case "tomorrow":
+		return endOfDay(now.AddDate(0, 0, 1)), nil
+	case "next week":
+		return endOfDay(now.AddDate(0, 0, 7)), nil
+	case "next month":
+		return endOfDay(now.AddDate(0, 1, 0)), nil
+	case "next year":
+		return endOfDay(now.AddDate(1, 0, 0)), nil
+	}
+
+	if day, ok := weekdays[strings.TrimPrefix(query, "next ")]; ok {
+		days := int(day - now.Weekday())
+		if days <= 0 {
+			days += 7
+		}
+		return endOfDay(now.AddDate(0, 0, days)), nil
+	}
+
+	t, err := dateparse.ParseIn(str, now.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("can't parse the due date \"%s\"", str)
+	}
+
+	// a date without time
+	if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 {
+		return endOfDay(t), nil
+	}
+
+	return t, nil
+}
+
+// endOfDay return the last second of the day of the given time
+func endOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 23, 59, 59, 0, t.Location())
+}
@@ -0,0 +1,59 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+)
+
+func TestParseDueDate(t *testing.T) {
+	// a wednesday
+	now := time.Date(2020, 1, 15, 10, 30, 0, 0, time.UTC)
+	endOf := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 23, 59, 59, 0, time.UTC)
+	}
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{"+36h", time.Date(2020, 1, 16, 22, 30, 0, 0, time.UTC)},
+		{"+2d", endOf(2020, 1, 17)},
+		{"+2w", endOf(2020, 1, 29)},
+		{"+1m", endOf(2020, 2, 15)},
+		{"+1y", endOf(2021, 1, 15)},
+		{"today", endOf(2020, 1, 15)},
+		{"Tomorrow", endOf(2020, 1, 16)},
+		{"next week", endOf(2020, 1, 22)},
+		{"friday", endOf(2020, 1, 17)},
+		{"next friday", endOf(2020, 1, 17)},
+		{"wednesday", endOf(2020, 1, 22)},
+		{"monday", endOf(2020, 1, 20)},
+		{"2020-03-01", endOf(2020, 3, 1)},
+		{"2020-03-01 10:00", time.Date(2020, 3, 1, 10, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		due, err := ParseDueDate(test.input, now)
+		require.NoError(t, err, test.input)
+		assert.True(t, test.expected.Equal(due), "%s: expected %v, got %v", test.input, test.expected, due)
+	}
+
+	for _, input := range []string{"", "+2", "2w", "next", "someday"} {
+		_, err := ParseDueDate(input, now)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestOverdue(t *testing.T) {
+	now := time.Now()
+
+	assert.False(t, (&BugExcerpt{Status: bug.OpenStatus}).Overdue(now))
+	assert.True(t, (&BugExcerpt{Status: bug.OpenStatus, DueUnixTime: now.Unix() - 1}).Overdue(now))
+	assert.False(t, (&BugExcerpt{Status: bug.OpenStatus, DueUnixTime: now.Unix() + 1}).Overdue(now))
+	assert.False(t, (&BugExcerpt{Status: bug.ClosedStatus, DueUnixTime: now.Unix() - 1}).Overdue(now))
+}
@@ -1,7 +1,9 @@
 package cache
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/MichaelMure/git-bug/bug"
 )
@@ -28,12 +30,12 @@ func AuthorFilter(query string) Filter {
 
 		// Normal identity
 		if excerpt.AuthorId != "" {
-			author, ok := repoCache.identitiesExcerpts[excerpt.AuthorId]
+			author, ok := repoCache.identityExcerpt(excerpt.AuthorId)
 			if !ok {
 				panic("missing identity in the cache")
 			}
 
-			return author.Match(query)
+			return repoCache.matchIdentity(author, query)
 		}
 
 		// Legacy identity support
@@ -45,8 +47,15 @@ func AuthorFilter(query string) Filter {
 // LabelFilter return a Filter that match a label
 func LabelFilter(label string) Filter {
 	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		// a renamed label match both its old and new name
+		var labels *bug.LabelStore
+		if repoCache != nil {
+			labels = repoCache.labels
+		}
+
+		expected := labels.Resolve(bug.Label(label))
 		for _, l := range excerpt.Labels {
-			if string(l) == label {
+			if labels.Resolve(l) == expected {
 				return true
 			}
 		}
@@ -60,12 +69,12 @@ func ActorFilter(query string) Filter {
 		query = strings.ToLower(query)
 
 		for _, id := range excerpt.Actors {
-			identityExcerpt, ok := repoCache.identitiesExcerpts[id]
+			identityExcerpt, ok := repoCache.identityExcerpt(id)
 			if !ok {
 				panic("missing identity in the cache")
 			}
 
-			if identityExcerpt.Match(query) {
+			if repoCache.matchIdentity(identityExcerpt, query) {
 				return true
 			}
 		}
@@ -79,12 +88,46 @@ func ParticipantFilter(query string) Filter {
 		query = strings.ToLower(query)
 
 		for _, id := range excerpt.Participants {
-			identityExcerpt, ok := repoCache.identitiesExcerpts[id]
+			identityExcerpt, ok := repoCache.identityExcerpt(id)
+			if !ok {
+				panic("missing identity in the cache")
+			}
+
+			if repoCache.matchIdentity(identityExcerpt, query) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// AssigneeFilter return a Filter that match a bug assignee. The special query
+// "me" match the user identity.
+func AssigneeFilter(query string) Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		if query == "me" {
+			user, err := repoCache.GetUserIdentity()
+			if err != nil {
+				return false
+			}
+
+			for _, id := range excerpt.Assignees {
+				if id == user.Id() {
+					return true
+				}
+			}
+			return false
+		}
+
+		query = strings.ToLower(query)
+
+		for _, id := range excerpt.Assignees {
+			identityExcerpt, ok := repoCache.identityExcerpt(id)
 			if !ok {
 				panic("missing identity in the cache")
 			}
 
-			if identityExcerpt.Match(query) {
+			if repoCache.matchIdentity(identityExcerpt, query) {
 				return true
 			}
 		}
@@ -102,6 +145,83 @@ func TitleFilter(query string) Filter {
 	}
 }
 
+// FullTextFilter return a Filter that match if the title or the comments
+// contain all the words of the given query
+func FullTextFilter(query string) Filter {
+	words := tokenize(query)
+
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return repoCache.fullTextIndex.match(excerpt.Id, words)
+	}
+}
+
+// PriorityFilter return a Filter that match a bug priority, one of
+// bug.Priorities
+func PriorityFilter(query string) (Filter, error) {
+	level := bug.PriorityLevel(query)
+	if level < 0 {
+		return nil, fmt.Errorf("unknown priority %s, expected one of %s", query, strings.Join(bug.Priorities, ", "))
+	}
+
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return excerpt.Priority == level
+	}, nil
+}
+
+// MilestoneFilter return a Filter that match a bug milestone
+func MilestoneFilter(query string) Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return excerpt.Milestone != "" && strings.EqualFold(excerpt.Milestone, query)
+	}
+}
+
+// ScopeFilter return a Filter that match the bugs of a scope or of one of its
+// sub-directories
+func ScopeFilter(query string) Filter {
+	scope := bug.CleanScope(query)
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return excerpt.Scope != "" && bug.ScopeContains(scope, excerpt.Scope)
+	}
+}
+
+// TouchingFilter return a Filter that match the bugs mentioned by a commit of
+// the local branches changing a file or a directory, relative to the root of
+// the repository
+func TouchingFilter(query string) Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return repoCache.commitsTouch(excerpt.Id, query)
+	}
+}
+
+// CreatedAfterFilter return a Filter that match the bugs created after a time
+func CreatedAfterFilter(t time.Time) Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return excerpt.CreateUnixTime >= t.Unix()
+	}
+}
+
+// CreatedBeforeFilter return a Filter that match the bugs created before a time
+func CreatedBeforeFilter(t time.Time) Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return excerpt.CreateUnixTime < t.Unix()
+	}
+}
+
+// EditedAfterFilter return a Filter that match the bugs edited after a time
+func EditedAfterFilter(t time.Time) Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return excerpt.EditUnixTime >= t.Unix()
+	}
+}
+
+// ClosedAfterFilter return a Filter that match the closed bugs that got
+// closed after a time
+func ClosedAfterFilter(t time.Time) Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return excerpt.CloseUnixTime != 0 && excerpt.CloseUnixTime >= t.Unix()
+	}
+}
+
 // NoLabelFilter return a Filter that match the absence of labels
 func NoLabelFilter() Filter {
 	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
@@ -109,15 +229,110 @@ func NoLabelFilter() Filter {
 	}
 }
 
+// NoAssigneeFilter return a Filter that match the absence of assignees
+func NoAssigneeFilter() Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return len(excerpt.Assignees) == 0
+	}
+}
+
+// NoMilestoneFilter return a Filter that match the absence of milestone
+func NoMilestoneFilter() Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return excerpt.Milestone == ""
+	}
+}
+
+// NoScopeFilter return a Filter that match the absence of scope
+func NoScopeFilter() Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return excerpt.Scope == ""
+	}
+}
+
+// NoPriorityFilter return a Filter that match the absence of priority
+func NoPriorityFilter() Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return excerpt.Priority == 0
+	}
+}
+
+// NotFilter return a Filter that match when the given Filter doesn't
+func NotFilter(filter Filter) Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return !filter(repoCache, excerpt)
+	}
+}
+
+// AndFilter return a Filter that match when all the given Filters match
+func AndFilter(filters ...Filter) Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		for _, f := range filters {
+			if !f(repoCache, excerpt) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// OrFilter return a Filter that match when any of the given Filters match
+func OrFilter(filters ...Filter) Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		for _, f := range filters {
+			if f(repoCache, excerpt) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// matchIdentity match a query with an identity, either as recorded or as
+// rewritten by the mailmap
+func (c *RepoCache) matchIdentity(i *IdentityExcerpt, query string) bool {
+	return i.Match(query) || i.Canonical(c.mailmap).Match(query)
+}
+
 // Filters is a collection of Filter that implement a complex filter
 type Filters struct {
 	Status      []Filter
 	Author      []Filter
 	Actor       []Filter
 	Participant []Filter
+	Assignee    []Filter
 	Label       []Filter
 	Title       []Filter
+	Priority    []Filter
+	Milestone   []Filter
+	Scope       []Filter
+	Touching    []Filter
+	FullText    []Filter
+	Time        []Filter
 	NoFilters   []Filter
+	// combination of filters with boolean operators
+	Expressions []Filter
+
+	// the words of the FullText filters, to only match the bugs having them
+	// in the full-text index
+	fullTextWords []string
+	// a TouchingFilter is used, the commits have to be linked to the bugs
+	// first
+	touching bool
+}
+
+// AddFullText add a FullTextFilter, the bugs being then looked up in the
+// full-text index instead of all being matched
+func (f *Filters) AddFullText(query string) {
+	f.FullText = append(f.FullText, FullTextFilter(query))
+	f.fullTextWords = append(f.fullTextWords, tokenize(query)...)
+}
+
+// AddTouching add a TouchingFilter, the commits being then linked to the bugs
+// before matching them
+func (f *Filters) AddTouching(query string) {
+	f.Touching = append(f.Touching, TouchingFilter(query))
+	f.touching = true
 }
 
 // Match check if a bug match the set of filters
@@ -138,10 +353,30 @@ func (f *Filters) Match(repoCache *RepoCache, excerpt *BugExcerpt) bool {
 		return false
 	}
 
+	if match := f.orMatch(f.Assignee, repoCache, excerpt); !match {
+		return false
+	}
+
 	if match := f.andMatch(f.Label, repoCache, excerpt); !match {
 		return false
 	}
 
+	if match := f.orMatch(f.Priority, repoCache, excerpt); !match {
+		return false
+	}
+
+	if match := f.orMatch(f.Milestone, repoCache, excerpt); !match {
+		return false
+	}
+
+	if match := f.orMatch(f.Scope, repoCache, excerpt); !match {
+		return false
+	}
+
+	if match := f.orMatch(f.Touching, repoCache, excerpt); !match {
+		return false
+	}
+
 	if match := f.andMatch(f.NoFilters, repoCache, excerpt); !match {
 		return false
 	}
@@ -150,6 +385,18 @@ func (f *Filters) Match(repoCache *RepoCache, excerpt *BugExcerpt) bool {
 		return false
 	}
 
+	if match := f.andMatch(f.FullText, repoCache, excerpt); !match {
+		return false
+	}
+
+	if match := f.andMatch(f.Time, repoCache, excerpt); !match {
+		return false
+	}
+
+	if match := f.andMatch(f.Expressions, repoCache, excerpt); !match {
+		return false
+	}
+
 	return true
 }
 
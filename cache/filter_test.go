@@ -1,9 +1,15 @@
 package cache
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
 )
 
 func TestTitleFilter(t *testing.T) {
@@ -32,3 +38,257 @@ func TestTitleFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestFullTextFilter(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(iden))
+
+	bug1, _, err := cache.NewBug("Crash on startup", "it crashes")
+	require.NoError(t, err)
+	_, err = bug1.AddComment("Null pointer in the parser")
+	require.NoError(t, err)
+
+	bug2, _, err := cache.NewBug("Typo", "a typo in the parser")
+	require.NoError(t, err)
+
+	tests := []struct {
+		query string
+		match []entity.Id
+	}{
+		{query: "crash", match: []entity.Id{bug1.Id()}},
+		{query: "PARSER", match: []entity.Id{bug1.Id(), bug2.Id()}},
+		{query: "null pointer", match: []entity.Id{bug1.Id()}},
+		{query: "null typo", match: nil},
+		{query: "pars", match: nil},
+	}
+
+	for _, tt := range tests {
+		filter := FullTextFilter(tt.query)
+		var matched []entity.Id
+		for _, id := range []entity.Id{bug1.Id(), bug2.Id()} {
+			excerpt, err := cache.ResolveBugExcerpt(id)
+			require.NoError(t, err)
+			if filter(cache, excerpt) {
+				matched = append(matched, id)
+			}
+		}
+		assert.Equal(t, tt.match, matched, tt.query)
+	}
+
+	// the same, through the index
+	for _, tt := range tests {
+		query, err := ParseQuery(fmt.Sprintf("fulltext:%q", tt.query))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, tt.match, cache.QueryBugs(query), tt.query)
+	}
+
+	query, err := ParseQuery("fulltext:parser title:typo")
+	require.NoError(t, err)
+	assert.Equal(t, []entity.Id{bug2.Id()}, cache.QueryBugs(query))
+
+	// the index survive a reload
+	require.NoError(t, cache.Close())
+	require.NoError(t, cache.load())
+	excerpt, err := cache.ResolveBugExcerpt(bug1.Id())
+	require.NoError(t, err)
+	assert.True(t, FullTextFilter("pointer")(cache, excerpt))
+}
+
+func TestTimeFilters(t *testing.T) {
+	limit := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := limit.Add(-time.Hour).Unix()
+	after := limit.Add(time.Hour).Unix()
+
+	open := &BugExcerpt{CreateUnixTime: before, EditUnixTime: after}
+	closed := &BugExcerpt{CreateUnixTime: after, EditUnixTime: after, CloseUnixTime: after}
+
+	assert.False(t, CreatedAfterFilter(limit)(nil, open))
+	assert.True(t, CreatedAfterFilter(limit)(nil, closed))
+	assert.True(t, CreatedBeforeFilter(limit)(nil, open))
+	assert.False(t, CreatedBeforeFilter(limit)(nil, closed))
+	assert.True(t, EditedAfterFilter(limit)(nil, open))
+	assert.False(t, ClosedAfterFilter(limit)(nil, open))
+	assert.True(t, ClosedAfterFilter(limit)(nil, closed))
+}
+
+func TestAssigneeFilter(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(rene))
+
+	blaise, err := cache.NewIdentity("Blaise Pascal", "blaise@pascal.fr")
+	require.NoError(t, err)
+
+	bug1, _, err := cache.NewBug("first", "message")
+	require.NoError(t, err)
+	_, err = bug1.ChangeAssignees([]*IdentityCache{rene, blaise}, nil)
+	require.NoError(t, err)
+
+	bug2, _, err := cache.NewBug("second", "message")
+	require.NoError(t, err)
+	_, err = bug2.ChangeAssignees([]*IdentityCache{blaise}, nil)
+	require.NoError(t, err)
+
+	bug3, _, err := cache.NewBug("third", "message")
+	require.NoError(t, err)
+
+	tests := []struct {
+		query string
+		match []entity.Id
+	}{
+		{query: "assignee:me", match: []entity.Id{bug1.Id()}},
+		{query: "assignee:pascal", match: []entity.Id{bug1.Id(), bug2.Id()}},
+		{query: "no:assignee", match: []entity.Id{bug3.Id()}},
+	}
+
+	for _, tt := range tests {
+		query, err := ParseQuery(tt.query)
+		require.NoError(t, err)
+		var matched []entity.Id
+		for _, id := range []entity.Id{bug1.Id(), bug2.Id(), bug3.Id()} {
+			excerpt, err := cache.ResolveBugExcerpt(id)
+			require.NoError(t, err)
+			if query.Match(cache, excerpt) {
+				matched = append(matched, id)
+			}
+		}
+		assert.Equal(t, tt.match, matched, tt.query)
+	}
+
+	// unassigning
+	_, err = bug1.ChangeAssignees(nil, []*IdentityCache{rene})
+	require.NoError(t, err)
+	excerpt, err := cache.ResolveBugExcerpt(bug1.Id())
+	require.NoError(t, err)
+	assert.False(t, AssigneeFilter("me")(cache, excerpt))
+}
+
+func TestTriageFilters(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(rene))
+
+	bug1, _, err := cache.NewBug("first", "message")
+	require.NoError(t, err)
+	_, _, err = bug1.ChangeLabels([]string{"priority:low", "priority:high", "milestone:v1.0"}, nil)
+	require.NoError(t, err)
+
+	bug2, _, err := cache.NewBug("second", "message")
+	require.NoError(t, err)
+	_, _, err = bug2.ChangeLabels([]string{"priority:medium"}, nil)
+	require.NoError(t, err)
+
+	bug3, _, err := cache.NewBug("third", "message")
+	require.NoError(t, err)
+
+	excerpt, err := cache.ResolveBugExcerpt(bug1.Id())
+	require.NoError(t, err)
+	assert.Equal(t, 3, excerpt.Priority)
+	assert.Equal(t, "v1.0", excerpt.Milestone)
+
+	tests := []struct {
+		query string
+		match []entity.Id
+	}{
+		{query: "priority:high", match: []entity.Id{bug1.Id()}},
+		{query: "priority:high priority:Medium", match: []entity.Id{bug1.Id(), bug2.Id()}},
+		{query: "no:priority", match: []entity.Id{bug3.Id()}},
+		{query: "priority:none", match: []entity.Id{bug3.Id()}},
+		{query: "milestone:V1.0", match: []entity.Id{bug1.Id()}},
+		{query: "no:milestone", match: []entity.Id{bug2.Id(), bug3.Id()}},
+	}
+
+	for _, tt := range tests {
+		query, err := ParseQuery(tt.query)
+		require.NoError(t, err)
+		var matched []entity.Id
+		for _, id := range []entity.Id{bug1.Id(), bug2.Id(), bug3.Id()} {
+			excerpt, err := cache.ResolveBugExcerpt(id)
+			require.NoError(t, err)
+			if query.Match(cache, excerpt) {
+				matched = append(matched, id)
+			}
+		}
+		assert.Equal(t, tt.match, matched, tt.query)
+	}
+}
+
+func TestScopeFilters(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(rene))
+
+	bug1, _, err := cache.NewBug("first", "message")
+	require.NoError(t, err)
+	_, _, err = bug1.ChangeLabels([]string{"scope:/services/api/"}, nil)
+	require.NoError(t, err)
+
+	bug2, _, err := cache.NewBug("second", "message")
+	require.NoError(t, err)
+	_, _, err = bug2.ChangeLabels([]string{"scope:services/web"}, nil)
+	require.NoError(t, err)
+
+	bug3, _, err := cache.NewBug("third", "message")
+	require.NoError(t, err)
+
+	excerpt, err := cache.ResolveBugExcerpt(bug1.Id())
+	require.NoError(t, err)
+	assert.Equal(t, "services/api", excerpt.Scope)
+
+	tests := []struct {
+		query string
+		match []entity.Id
+	}{
+		{query: "scope:services/api", match: []entity.Id{bug1.Id()}},
+		{query: "scope:services/", match: []entity.Id{bug1.Id(), bug2.Id()}},
+		{query: "scope:services/api/v2", match: nil},
+		{query: "scope:serv", match: nil},
+		{query: "scope:services/api scope:services/web", match: []entity.Id{bug1.Id(), bug2.Id()}},
+		{query: "no:scope", match: []entity.Id{bug3.Id()}},
+	}
+
+	for _, tt := range tests {
+		query, err := ParseQuery(tt.query)
+		require.NoError(t, err)
+		var matched []entity.Id
+		for _, id := range []entity.Id{bug1.Id(), bug2.Id(), bug3.Id()} {
+			excerpt, err := cache.ResolveBugExcerpt(id)
+			require.NoError(t, err)
+			if query.Match(cache, excerpt) {
+				matched = append(matched, id)
+			}
+		}
+		assert.Equal(t, tt.match, matched, tt.query)
+	}
+
+	// no:scope is an explicit scope, not one of the "no" filters
+	query, err := ParseQuery("no:scope")
+	require.NoError(t, err)
+	assert.Len(t, query.Scope, 1)
+	assert.Len(t, query.NoFilters, 0)
+}
@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"os"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// FsckKind is the kind of entity checked by Fsck
+type FsckKind string
+
+const (
+	FsckBug      FsckKind = "bug"
+	FsckIdentity FsckKind = "identity"
+)
+
+// FsckIssue is an entity failing the integrity check
+type FsckIssue struct {
+	Kind   FsckKind
+	Id     entity.Id
+	Errors []error
+	// true if the entity has been moved aside
+	Quarantined bool
+}
+
+// Fsck check the integrity of all the local identities and bugs. As the
+// cache can't be built from corrupted data, this work directly on the
+// repository, but still lock it like a RepoCache would.
+//
+// If quarantine is true, the corrupted entities are moved out of the way
+// in refs/quarantine/fsck/ and the cache is invalidated to be rebuilt
+// without them.
+func Fsck(repo repository.ClockedRepo, quarantine bool) ([]FsckIssue, error) {
+	c := &RepoCache{repo: repo}
+
+	err := c.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer c.unlock()
+
+	var issues []FsckIssue
+
+	identityIds, err := identity.ListLocalIds(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range identityIds {
+		errs := identity.Fsck(repo, id)
+		if len(errs) > 0 {
+			issues = append(issues, FsckIssue{Kind: FsckIdentity, Id: id, Errors: errs})
+		}
+	}
+
+	bugIds, err := bug.ListLocalIds(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range bugIds {
+		errs := bug.Fsck(repo, id)
+		if len(errs) > 0 {
+			issues = append(issues, FsckIssue{Kind: FsckBug, Id: id, Errors: errs})
+		}
+	}
+
+	if !quarantine || len(issues) == 0 {
+		return issues, nil
+	}
+
+	for i, issue := range issues {
+		switch issue.Kind {
+		case FsckIdentity:
+			err = identity.QuarantineLocal(repo, issue.Id)
+		case FsckBug:
+			err = bug.QuarantineLocal(repo, issue.Id)
+		}
+		if err != nil {
+			return issues, err
+		}
+		issues[i].Quarantined = true
+	}
+
+	// the cache will be rebuilt on the next use
+	for _, path := range []string{
+		bugCacheFilePath(repo),
+		identityCacheFilePath(repo),
+		fullTextIndexFilePath(repo),
+	} {
+		err := os.Remove(path)
+		if err != nil && !os.IsNotExist(err) {
+			return issues, err
+		}
+	}
+
+	return issues, nil
+}
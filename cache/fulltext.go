@@ -0,0 +1,204 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/gob"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const fullTextIndexFile = "fulltext-index"
+
+// fullTextIndex is an inverted index of the words found in the title and
+// comments of the bugs, allowing to search them without reading each bug.
+type fullTextIndex struct {
+	// the filters read the index outside of the lock of the cache
+	mu sync.RWMutex
+
+	// word --> bugs containing this word
+	Words map[string]map[entity.Id]bool
+	// bug --> words it contains, to be able to update the index
+	Bugs map[entity.Id][]string
+}
+
+func newFullTextIndex() *fullTextIndex {
+	return &fullTextIndex{
+		Words: make(map[string]map[entity.Id]bool),
+		Bugs:  make(map[entity.Id][]string),
+	}
+}
+
+// update (re)index a bug
+func (idx *fullTextIndex) update(id entity.Id, snap *bug.Snapshot) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(id)
+
+	texts := make([]string, 0, len(snap.Comments)+1)
+	texts = append(texts, snap.Title)
+	for _, comment := range snap.Comments {
+		texts = append(texts, comment.Message)
+	}
+
+	words := tokenize(strings.Join(texts, " "))
+
+	for _, word := range words {
+		bugs, ok := idx.Words[word]
+		if !ok {
+			bugs = make(map[entity.Id]bool)
+			idx.Words[word] = bugs
+		}
+		bugs[id] = true
+	}
+
+	idx.Bugs[id] = words
+}
+
+// remove drop a bug from the index
+func (idx *fullTextIndex) remove(id entity.Id) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(id)
+}
+
+func (idx *fullTextIndex) removeLocked(id entity.Id) {
+	for _, word := range idx.Bugs[id] {
+		delete(idx.Words[word], id)
+		if len(idx.Words[word]) == 0 {
+			delete(idx.Words, word)
+		}
+	}
+	delete(idx.Bugs, id)
+}
+
+// match tell if a bug contains all the given words
+func (idx *fullTextIndex) match(id entity.Id, words []string) bool {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	return idx.matchLocked(id, words)
+}
+
+func (idx *fullTextIndex) matchLocked(id entity.Id, words []string) bool {
+	for _, word := range words {
+		if !idx.Words[word][id] {
+			return false
+		}
+	}
+	return true
+}
+
+// lookup return the bugs containing all the given words, going only through
+// the bugs of the rarest one
+func (idx *fullTextIndex) lookup(words []string) []entity.Id {
+	if len(words) == 0 {
+		return nil
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	rarest := words[0]
+	for _, word := range words[1:] {
+		if len(idx.Words[word]) < len(idx.Words[rarest]) {
+			rarest = word
+		}
+	}
+
+	var result []entity.Id
+	for id := range idx.Words[rarest] {
+		if idx.matchLocked(id, words) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// tokenize split a text into a set of lowercase words
+func tokenize(text string) []string {
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	seen := make(map[string]struct{}, len(fields))
+	result := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		word := strings.ToLower(field)
+		if _, ok := seen[word]; ok {
+			continue
+		}
+		seen[word] = struct{}{}
+		result = append(result, word)
+	}
+
+	return result
+}
+
+// load will try to read from the disk the full-text index
+func (c *RepoCache) loadFullTextIndex() (uint, error) {
+	f, err := os.Open(fullTextIndexFilePath(c.repo))
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	decoder := gob.NewDecoder(f)
+
+	aux := struct {
+		Version uint
+		Index   *fullTextIndex
+	}{}
+
+	err = decoder.Decode(&aux)
+	if err != nil {
+		return 0, err
+	}
+
+	err = checkFormatVersion(aux.Version)
+	if err != nil {
+		return 0, err
+	}
+
+	c.fullTextIndex = aux.Index
+	return aux.Version, nil
+}
+
+// write will serialize on disk the full-text index. The muBug must be held.
+func (c *RepoCache) writeFullTextIndex() error {
+	if c.readOnly {
+		return nil
+	}
+
+	var data bytes.Buffer
+
+	aux := struct {
+		Version uint
+		Index   *fullTextIndex
+	}{
+		Version: formatVersion,
+		Index:   c.fullTextIndex,
+	}
+
+	encoder := gob.NewEncoder(&data)
+
+	err := encoder.Encode(aux)
+	if err != nil {
+		return err
+	}
+
+	return writeCacheFile(fullTextIndexFilePath(c.repo), data.Bytes())
+}
+
+func fullTextIndexFilePath(repo repository.Repo) string {
+	return path.Join(repo.GetPath(), "git-bug", fullTextIndexFile)
+}
@@ -0,0 +1,174 @@
+package cache
+
+import (
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+const remotesRefPrefix = "refs/remotes/"
+
+// GcOptions select the maintenance tasks done by Gc
+type GcOptions struct {
+	// if not zero, squash the commits of the bugs older than this time
+	CompactBefore time.Time
+	// if not zero, replace the operations of the bugs older than this time
+	// with a snapshot of the state they lead to, done before the compaction
+	CollapseBefore time.Time
+	// remove the refs of the remotes not configured anymore
+	Prune bool
+	// pack the git objects and prune the unreachable ones
+	Repack bool
+}
+
+// GcResult is the outcome of Gc
+type GcResult struct {
+	// number of bugs compacted and commits removed
+	CompactedBugs  int
+	RemovedCommits int
+	// number of bugs collapsed and operations archived
+	CollapsedBugs      int
+	ArchivedOperations int
+	// bugs not compacted because they exist on a remote
+	SharedBugs []entity.Id
+	// refs removed as their remote doesn't exist anymore
+	PrunedRefs []string
+}
+
+// Gc do the maintenance of the git-bug data in the repository, to keep its
+// size reasonable over time.
+//
+// The collapse and the compaction rewrite the history of the bugs, so only
+// the bugs that don't exist on any remote are changed. The remotes are
+// reached to know their bugs, and nothing is rewritten if one of them can't
+// be.
+func (c *RepoCache) Gc(opts GcOptions) (GcResult, error) {
+	var result GcResult
+
+	if err := c.writable(); err != nil {
+		return result, err
+	}
+
+	remoteRefs, err := c.repo.ListRefs(remotesRefPrefix)
+	if err != nil {
+		return result, err
+	}
+
+	var author *IdentityCache
+	if !opts.CollapseBefore.IsZero() {
+		author, err = c.GetUserIdentity()
+		if err != nil {
+			return result, err
+		}
+	}
+
+	if !opts.CompactBefore.IsZero() || !opts.CollapseBefore.IsZero() {
+		// the pushes don't create the refs of the remotes, the remotes
+		// themselves are asked
+		shared, err := bug.PublishedBugs(c.repo)
+		if err != nil {
+			return result, err
+		}
+
+		for _, id := range c.AllBugsIds() {
+			if shared[id] {
+				result.SharedBugs = append(result.SharedBugs, id)
+				continue
+			}
+
+			b, err := c.ResolveBug(id)
+			if err != nil {
+				return result, err
+			}
+			if b.bug.NeedCommit() {
+				continue
+			}
+
+			changed := false
+
+			if !opts.CollapseBefore.IsZero() {
+				archived, err := b.bug.CollapseHistory(c.repo, author.Identity, opts.CollapseBefore)
+				if err != nil {
+					return result, err
+				}
+
+				if archived > 0 {
+					result.CollapsedBugs++
+					result.ArchivedOperations += archived
+					changed = true
+				}
+			}
+
+			if !opts.CompactBefore.IsZero() {
+				removed, err := b.bug.Compact(c.repo, opts.CompactBefore)
+				if err != nil {
+					return result, err
+				}
+
+				if removed > 0 {
+					result.CompactedBugs++
+					result.RemovedCommits += removed
+					changed = true
+				}
+			}
+
+			// the last commit changed
+			if changed {
+				err = c.bugUpdated(b)
+				if err != nil {
+					return result, err
+				}
+			}
+		}
+	}
+
+	if opts.Prune {
+		remotes, err := c.repo.GetRemotes()
+		if err != nil {
+			return result, err
+		}
+
+		for _, ref := range remoteRefs {
+			remote, kind, _, ok := splitRemoteRef(ref)
+			if !ok || kind != "bugs" && kind != "identities" {
+				continue
+			}
+			if _, ok := remotes[remote]; ok {
+				continue
+			}
+
+			err = c.repo.RemoveRef(ref)
+			if err != nil {
+				return result, err
+			}
+			result.PrunedRefs = append(result.PrunedRefs, ref)
+		}
+	}
+
+	if opts.Repack {
+		err = c.repo.Repack()
+		if err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// splitRemoteRef split a ref like refs/remotes/<remote>/<kind>/<id>, the
+// remote name possibly containing slashes
+func splitRemoteRef(ref string) (remote string, kind string, id string, ok bool) {
+	if !strings.HasPrefix(ref, remotesRefPrefix) {
+		return "", "", "", false
+	}
+
+	split := strings.Split(strings.TrimPrefix(ref, remotesRefPrefix), "/")
+	if len(split) < 3 {
+		return "", "", "", false
+	}
+
+	n := len(split)
+	return strings.Join(split[:n-2], "/"), split[n-2], split[n-1], true
+}
@@ -0,0 +1,149 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestGc(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(rene))
+
+	local, _, err := cache.NewBug("local", "message")
+	require.NoError(t, err)
+	shared, _, err := cache.NewBug("shared", "message")
+	require.NoError(t, err)
+
+	for _, b := range []*BugCache{local, shared} {
+		for _, message := range []string{"one", "two", "three"} {
+			_, err = b.AddComment(message)
+			require.NoError(t, err)
+			require.NoError(t, b.Commit())
+		}
+	}
+
+	// pretend that a bug has been pushed to a remote that doesn't exist anymore
+	remoteRef := "refs/remotes/gone/bugs/" + shared.Id().String()
+	require.NoError(t, repo.CopyRef("refs/bugs/"+shared.Id().String(), remoteRef))
+
+	result, err := cache.Gc(GcOptions{
+		CompactBefore: time.Now().Add(time.Hour),
+		Prune:         true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.CompactedBugs)
+	assert.Equal(t, 2, result.RemovedCommits)
+	assert.Len(t, result.SharedBugs, 1)
+	assert.Equal(t, []string{remoteRef}, result.PrunedRefs)
+
+	assert.Len(t, local.Snapshot().Comments, 4)
+}
+
+func TestGcPushed(t *testing.T) {
+	repo, _, remote := repository.SetupReposAndRemote(t)
+	defer repository.CleanupTestRepos(t, repo, remote)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(rene))
+
+	local, _, err := cache.NewBug("local", "message")
+	require.NoError(t, err)
+	pushed, _, err := cache.NewBug("pushed", "message")
+	require.NoError(t, err)
+
+	for _, b := range []*BugCache{local, pushed} {
+		for _, message := range []string{"one", "two", "three"} {
+			_, err = b.AddComment(message)
+			require.NoError(t, err)
+			require.NoError(t, b.Commit())
+		}
+	}
+
+	// pushed without any ref of the remote created locally
+	_, err = repo.PushRefs("origin", "refs/bugs/"+pushed.Id().String())
+	require.NoError(t, err)
+	tracked, err := repo.ListRefs("refs/remotes/")
+	require.NoError(t, err)
+	require.Empty(t, tracked)
+
+	result, err := cache.Gc(GcOptions{CompactBefore: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.CompactedBugs)
+	assert.Equal(t, []entity.Id{pushed.Id()}, result.SharedBugs)
+
+	// nothing is rewritten when a remote can't be reached
+	require.NoError(t, repo.GitConfig().StoreString("remote.origin.url", "file:///nonexistent"))
+	_, err = cache.Gc(GcOptions{CompactBefore: time.Now().Add(time.Hour)})
+	assert.Error(t, err)
+}
+
+func TestGcCollapse(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(rene))
+
+	b, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	old := time.Now().Add(-48 * time.Hour).Unix()
+	for _, message := range []string{"one", "two", "three"} {
+		_, err = b.AddCommentRaw(rene, old, message, nil, map[string]string{"origin": message})
+		require.NoError(t, err)
+		require.NoError(t, b.Commit())
+	}
+
+	result, err := cache.Gc(GcOptions{
+		CollapseBefore: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.CollapsedBugs)
+	assert.Equal(t, 3, result.ArchivedOperations)
+
+	assert.Len(t, b.Snapshot().Comments, 4)
+	assert.Equal(t, "two", b.Snapshot().Comments[2].Message)
+
+	// the imported operations are still found
+	_, err = b.ResolveOperationWithMetadata("origin", "two")
+	require.NoError(t, err)
+}
+
+func TestSplitRemoteRef(t *testing.T) {
+	remote, kind, id, ok := splitRemoteRef("refs/remotes/origin/bugs/1234")
+	assert.True(t, ok)
+	assert.Equal(t, "origin", remote)
+	assert.Equal(t, "bugs", kind)
+	assert.Equal(t, "1234", id)
+
+	remote, _, _, ok = splitRemoteRef("refs/remotes/a/b/identities/1234")
+	assert.True(t, ok)
+	assert.Equal(t, "a/b", remote)
+
+	_, _, _, ok = splitRemoteRef("refs/remotes/origin/master")
+	assert.False(t, ok)
+}
@@ -0,0 +1,225 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const hooksDir = "hooks"
+
+// Hook is the name of a script run on an event of the bugs, found in
+// .git/git-bug/hooks/
+type Hook string
+
+const (
+	// HookPreAdd is run before a bug is created, and can reject it
+	HookPreAdd Hook = "pre-add"
+	// HookPostAdd is run after a bug is created
+	HookPostAdd Hook = "post-add"
+	// HookPreStatus is run before the status of a bug is changed, and can
+	// reject the change
+	HookPreStatus Hook = "pre-status"
+	// HookPostComment is run after a comment is added to a bug
+	HookPostComment Hook = "post-comment"
+	// HookPostImport is run after bugs are imported with a bridge or from a file
+	HookPostImport Hook = "post-import"
+	// HookPostOperation is run after any operation is added to a bug,
+	// including its creation and the comments
+	HookPostOperation Hook = "post-op"
+)
+
+func (h Hook) isPre() bool {
+	return strings.HasPrefix(string(h), "pre-")
+}
+
+// ImportSummary is the data given to the post-import hook
+type ImportSummary struct {
+	// the name of the bridge or the imported file
+	Source string `json:"source"`
+	// the new bugs
+	Bugs []entity.Id `json:"bugs,omitempty"`
+}
+
+// ErrHookRejected is returned when a pre- hook exit with a non-zero status,
+// to cancel the action
+type ErrHookRejected struct {
+	Hook Hook
+	Err  error
+}
+
+func (e ErrHookRejected) Error() string {
+	return fmt.Sprintf("rejected by the %s hook: %v", e.Hook, e.Err)
+}
+
+// HookHandler is run in the process on the post- hooks, after their script.
+// Its failure is only reported, as for the scripts. The slow work, like the
+// network requests, should go to RunHookInBackground.
+type HookHandler func(repo *RepoCache, hook Hook, id entity.Id, data interface{}) error
+
+var hookHandlers []HookHandler
+
+// RegisterHookHandler add a handler run on the post- hooks of all the caches
+func RegisterHookHandler(handler HookHandler) {
+	hookHandlers = append(hookHandlers, handler)
+}
+
+func hookPath(repo repository.Repo, hook Hook) string {
+	return path.Join(repo.GetPath(), "git-bug", hooksDir, string(hook))
+}
+
+// DisablePreHooks stop running the pre- hooks for the life of this RepoCache,
+// to bypass the custom validations.
+func (c *RepoCache) DisablePreHooks() {
+	c.noPreHooks = true
+}
+
+// SetImporting tell if the changes made are imported. During an import, the
+// hook handlers are not run for each imported change, but once with the
+// post-import hook. The hook scripts are still run.
+func (c *RepoCache) SetImporting(importing bool) {
+	c.importing = importing
+}
+
+// RunHook run a hook if the corresponding script exist and is executable,
+// with the data serialized as JSON on its standard input. The id of the bug,
+// if any, is given in the GIT_BUG_ID environment variable. The registered
+// hook handlers are then run for the post- hooks. For the operations added to
+// a BugCache, they are run once the operations are committed.
+//
+// A failing pre- hook return an ErrHookRejected. The failure of the other
+// hooks is only reported, as the action is already done. The pre- hooks are
+// not run at all if disabled with DisablePreHooks.
+func (c *RepoCache) RunHook(hook Hook, id entity.Id, data interface{}) error {
+	if hook.isPre() && c.noPreHooks {
+		return nil
+	}
+
+	err := c.runHookScript(hook, id, data)
+	if err != nil || hook.isPre() {
+		return err
+	}
+
+	c.runHookHandlers(hook, id, data)
+
+	return nil
+}
+
+// runHookHandlers run the registered hook handlers, except for the changes
+// of an import
+func (c *RepoCache) runHookHandlers(hook Hook, id entity.Id, data interface{}) {
+	if c.importing && hook != HookPostImport {
+		return
+	}
+
+	for _, handler := range hookHandlers {
+		err := handler(c, hook, id, data)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "warning: the %s hook failed: %v\n", hook, err)
+		}
+	}
+}
+
+// the maximum number of works queued by the hook handlers
+const maxBackgroundHooks = 100
+
+type backgroundHook struct {
+	hook Hook
+	work func() error
+}
+
+// RunHookInBackground queue the slow work of a hook handler, like a network
+// request, to be run after the work queued before, without blocking the
+// change. Its failure is only reported. It fail if too much work is queued
+// already. Close wait for the queued work.
+func (c *RepoCache) RunHookInBackground(hook Hook, work func() error) error {
+	c.hookMutex.Lock()
+	defer c.hookMutex.Unlock()
+
+	if len(c.hookQueue) >= maxBackgroundHooks {
+		return fmt.Errorf("too many %s hooks running in the background", hook)
+	}
+
+	c.hookQueue = append(c.hookQueue, backgroundHook{hook: hook, work: work})
+	if !c.hookRunning {
+		c.hookRunning = true
+		c.hookWait.Add(1)
+		go c.runBackgroundHooks()
+	}
+
+	return nil
+}
+
+func (c *RepoCache) runBackgroundHooks() {
+	defer c.hookWait.Done()
+
+	c.hookMutex.Lock()
+	for len(c.hookQueue) > 0 {
+		// the running work stay in the queue, to count in its size
+		next := c.hookQueue[0]
+		c.hookMutex.Unlock()
+
+		err := next.work()
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "warning: the %s hook failed: %v\n", next.hook, err)
+		}
+
+		c.hookMutex.Lock()
+		c.hookQueue = c.hookQueue[1:]
+	}
+	c.hookRunning = false
+	c.hookMutex.Unlock()
+}
+
+// WaitHooks wait for the work queued by the hook handlers
+func (c *RepoCache) WaitHooks() {
+	c.hookWait.Wait()
+}
+
+func (c *RepoCache) runHookScript(hook Hook, id entity.Id, data interface{}) error {
+	script := hookPath(c.repo, hook)
+
+	info, err := os.Stat(script)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.IsDir() || info.Mode()&0111 == 0 {
+		return nil
+	}
+
+	input, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(script)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(),
+		"GIT_BUG_HOOK="+string(hook),
+		"GIT_BUG_ID="+id.String(),
+	)
+
+	err = cmd.Run()
+	if err == nil {
+		return nil
+	}
+
+	if hook.isPre() {
+		return ErrHookRejected{Hook: hook, Err: err}
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "warning: the %s hook failed: %v\n", hook, err)
+	return nil
+}
@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func writeHook(t *testing.T, repo repository.Repo, hook Hook, script string) {
+	p := hookPath(repo, hook)
+	require.NoError(t, os.MkdirAll(path.Dir(p), 0755))
+	require.NoError(t, ioutil.WriteFile(p, []byte("#!/bin/sh\n"+script), 0755))
+}
+
+func TestHooks(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(rene))
+
+	output := path.Join(repo.GetPath(), "hook-output")
+
+	writeHook(t, repo, HookPreAdd, "grep -q forbidden && exit 1\nexit 0\n")
+	writeHook(t, repo, HookPostComment, "cat > "+output+"\necho $GIT_BUG_ID >> "+output+"\n")
+	opsOutput := path.Join(repo.GetPath(), "hook-ops")
+	writeHook(t, repo, HookPostOperation, "echo $GIT_BUG_HOOK >> "+opsOutput+"\n")
+
+	_, _, err = cache.NewBug("title", "forbidden message")
+	require.IsType(t, ErrHookRejected{}, err)
+	assert.Equal(t, HookPreAdd, err.(ErrHookRejected).Hook)
+	assert.Len(t, cache.AllBugsIds(), 0)
+
+	b, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	_, err = b.AddComment("a comment")
+	require.NoError(t, err)
+
+	data, err := ioutil.ReadFile(output)
+	require.NoError(t, err)
+
+	var op struct {
+		Message string `json:"message"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	require.NoError(t, decoder.Decode(&op))
+	assert.Equal(t, "a comment", op.Message)
+	assert.Contains(t, string(data), b.Id().String())
+
+	// the creation and the comment
+	ops, err := ioutil.ReadFile(opsOutput)
+	require.NoError(t, err)
+	assert.Equal(t, "post-op\npost-op\n", string(ops))
+}
+
+func TestRunHookInBackground(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	// the work is run in order, without blocking
+	release := make(chan struct{})
+	var done []int
+	for i := 0; i < maxBackgroundHooks; i++ {
+		i := i
+		err := cache.RunHookInBackground(HookPostOperation, func() error {
+			<-release
+			done = append(done, i)
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	// the queue is full until the work is done
+	err = cache.RunHookInBackground(HookPostOperation, func() error { return nil })
+	require.Error(t, err)
+
+	close(release)
+	cache.WaitHooks()
+	require.Len(t, done, maxBackgroundHooks)
+	for i, d := range done {
+		require.Equal(t, i, d)
+	}
+}
@@ -26,18 +26,46 @@ func (i *IdentityCache) AddVersion(version *identity.Version) error {
 	return i.notifyUpdated()
 }
 
+// Mutate add a new version of the identity, see identity.Identity.Mutate
+func (i *IdentityCache) Mutate(f func(orig identity.Mutator) identity.Mutator) error {
+	if err := i.Identity.Mutate(f); err != nil {
+		return err
+	}
+	return i.notifyUpdated()
+}
+
 func (i *IdentityCache) Commit() error {
+	if err := i.repoCache.writable(); err != nil {
+		return err
+	}
+
 	err := i.Identity.Commit(i.repoCache.repo)
 	if err != nil {
 		return err
 	}
-	return i.notifyUpdated()
+
+	err = i.notifyUpdated()
+	if err != nil {
+		return err
+	}
+	return i.repoCache.identityCommitted(i.Id())
 }
 
 func (i *IdentityCache) CommitAsNeeded() error {
+	if err := i.repoCache.writable(); err != nil {
+		return err
+	}
+
+	committing := i.Identity.NeedCommit()
+
 	err := i.Identity.CommitAsNeeded(i.repoCache.repo)
 	if err != nil {
 		return err
 	}
-	return i.notifyUpdated()
+
+	err = i.notifyUpdated()
+	if err != nil || !committing {
+		return err
+	}
+	return i.repoCache.identityCommitted(i.Id())
 }
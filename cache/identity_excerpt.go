@@ -21,6 +21,7 @@ type IdentityExcerpt struct {
 	Id entity.Id
 
 	Name              string
+	Email             string
 	Login             string
 	ImmutableMetadata map[string]string
 }
@@ -29,11 +30,20 @@ func NewIdentityExcerpt(i *identity.Identity) *IdentityExcerpt {
 	return &IdentityExcerpt{
 		Id:                i.Id(),
 		Name:              i.Name(),
+		Email:             i.Email(),
 		Login:             i.Login(),
 		ImmutableMetadata: i.ImmutableMetadata(),
 	}
 }
 
+// Canonical return a copy of the excerpt with the name and email rewritten
+// according to the mailmap
+func (i *IdentityExcerpt) Canonical(mailmap *identity.Mailmap) *IdentityExcerpt {
+	result := *i
+	result.Name, result.Email = mailmap.Map(i.Name, i.Email)
+	return &result
+}
+
 // DisplayName return a non-empty string to display, representing the
 // identity, based on the non-empty values.
 func (i *IdentityExcerpt) DisplayName() string {
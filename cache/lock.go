@@ -0,0 +1,240 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/util/process"
+)
+
+// ErrReadOnly is returned when changing a repository opened read-only
+var ErrReadOnly = errors.New("the repository is opened read-only")
+
+// ErrLocked is returned when the repository is already used by another
+// process
+type ErrLocked struct {
+	Pid int
+}
+
+func (e ErrLocked) Error() string {
+	if e.Pid == 0 {
+		return "the repository you want to access is already locked by another process"
+	}
+	return fmt.Sprintf("the repository you want to access is already locked by the process pid %d", e.Pid)
+}
+
+// returned by tryLockFile when the system or the filesystem don't support
+// the advisory locks
+var errNoFileLock = errors.New("advisory file locks are not supported")
+
+// how long an empty lock file is considered held without advisory lock: its
+// owner write its pid right after creating it, unless it crashed meanwhile
+const emptyLockTimeout = 10 * time.Second
+
+// lock take the lock of the repository, held until the cache is closed.
+//
+// The lock file hold the pid of its owner, and is locked with an advisory
+// lock where supported. This lock is released by the system when the process
+// exit, so a lock file left behind by a crash is simply taken over. Without
+// advisory lock, the lock file is taken over if its process is not running
+// anymore, or if it stays empty for emptyLockTimeout.
+func (c *RepoCache) lock() error {
+	lockPath := repoLockFilePath(c.repo)
+
+	for {
+		created := true
+		f, err := os.OpenFile(lockPath, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
+		if os.IsExist(err) {
+			created = false
+			f, err = os.OpenFile(lockPath, os.O_RDWR, 0644)
+			if os.IsNotExist(err) {
+				// removed by its owner meanwhile
+				continue
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		locked, err := tryLockFile(f)
+		if err == errNoFileLock {
+			locked, err = created, nil
+			if !created {
+				locked, err = lockOwnerIsGone(f, time.Now())
+			}
+		}
+		if err == nil && !locked {
+			pid, _ := readLockPid(f)
+			err = ErrLocked{Pid: pid}
+		}
+		if err != nil {
+			_ = f.Close()
+			return err
+		}
+
+		// the previous owner remove the file before releasing the lock: if it
+		// did after we opened it, another process might have created and locked
+		// a new file in the meantime
+		current, err := os.Stat(lockPath)
+		if os.IsNotExist(err) {
+			_ = f.Close()
+			continue
+		}
+		if err != nil {
+			_ = f.Close()
+			return err
+		}
+		opened, err := f.Stat()
+		if err != nil {
+			_ = f.Close()
+			return err
+		}
+		if !os.SameFile(current, opened) {
+			_ = f.Close()
+			continue
+		}
+
+		err = writeLockPid(f)
+		if err != nil {
+			_ = f.Close()
+			return err
+		}
+
+		c.lockFile = f
+		return nil
+	}
+}
+
+// unlock release the lock of the repository, if held
+func (c *RepoCache) unlock() error {
+	if c.lockFile == nil {
+		return nil
+	}
+
+	lockPath := repoLockFilePath(c.repo)
+
+	// removed before being released, see lock()
+	err := os.Remove(lockPath)
+	closeErr := c.lockFile.Close()
+	c.lockFile = nil
+	if err != nil {
+		// some systems can't remove an opened file
+		err = os.Remove(lockPath)
+	}
+
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
+
+// writable return ErrReadOnly if the cache can't change the repository
+func (c *RepoCache) writable() error {
+	if c.readOnly {
+		return ErrReadOnly
+	}
+	return nil
+}
+
+// lockOwnerIsGone tell if the process owning a lock file created by another
+// process is not running anymore, for the systems without advisory locks
+func lockOwnerIsGone(f *os.File, now time.Time) (bool, error) {
+	pid, err := readLockPid(f)
+	if err != nil {
+		return false, err
+	}
+
+	if pid == 0 {
+		// its pid is not written yet
+		info, err := f.Stat()
+		if err != nil {
+			return false, err
+		}
+		if now.Sub(info.ModTime()) < emptyLockTimeout {
+			return false, nil
+		}
+		_, _ = fmt.Fprintln(os.Stderr, "An empty lock file is present for a while, taking it over.")
+		return true, nil
+	}
+
+	if pid != os.Getpid() && !process.IsRunning(pid) {
+		_, _ = fmt.Fprintln(os.Stderr, "A lock file is present but the corresponding process is not, taking it over.")
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// readLockPid read the pid written in a lock file, 0 if there is none
+func readLockPid(f *os.File) (int, error) {
+	_, err := f.Seek(0, io.SeekStart)
+	if err != nil {
+		return 0, err
+	}
+
+	buf, err := ioutil.ReadAll(io.LimitReader(f, 10))
+	if err != nil {
+		return 0, err
+	}
+	if len(buf) == 10 {
+		return 0, fmt.Errorf("the lock file should be < 10 bytes")
+	}
+
+	content := strings.TrimSpace(string(buf))
+	if content == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(content)
+}
+
+func writeLockPid(f *os.File) error {
+	err := f.Truncate(0)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.WriteAt([]byte(strconv.Itoa(os.Getpid())), 0)
+	if err != nil {
+		return err
+	}
+
+	return f.Sync()
+}
+
+// writeCacheFile replace a cache file atomically, so that a read-only cache
+// reading it concurrently never see a partial file
+func writeCacheFile(filePath string, data []byte) error {
+	f, err := ioutil.TempFile(path.Dir(filePath), path.Base(filePath)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(data)
+	if err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return err
+	}
+
+	err = f.Close()
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return err
+	}
+
+	err = os.Rename(f.Name(), filePath)
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package cache
+
+import (
+	"os"
+)
+
+// tryLockFile take an exclusive advisory lock on a file without waiting,
+// and tell if it succeeded
+func tryLockFile(f *os.File) (bool, error) {
+	return false, errNoFileLock
+}
@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"io/ioutil"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestLock(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(iden))
+	_, _, err = cache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	_, err = NewRepoCache(repo)
+	require.Equal(t, ErrLocked{Pid: os.Getpid()}, err)
+
+	// the repository can still be read
+	readOnly, err := NewReadOnlyRepoCache(repo)
+	require.NoError(t, err)
+	require.Len(t, readOnly.AllBugsIds(), 1)
+
+	b, err := readOnly.ResolveBug(readOnly.AllBugsIds()[0])
+	require.NoError(t, err)
+	_, err = b.SetTitle("edited")
+	require.Equal(t, ErrReadOnly, err)
+	require.Equal(t, ErrReadOnly, b.Commit())
+	_, _, err = readOnly.NewBug("title", "message")
+	require.Equal(t, ErrReadOnly, err)
+	require.NoError(t, readOnly.Close())
+
+	// closing the read-only cache doesn't release the lock
+	_, err = NewRepoCache(repo)
+	require.IsType(t, ErrLocked{}, err)
+
+	require.NoError(t, cache.Close())
+	_, err = os.Stat(repoLockFilePath(repo))
+	require.True(t, os.IsNotExist(err))
+
+	cache, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	require.NoError(t, cache.Close())
+}
+
+func TestStaleLock(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	require.NoError(t, cache.Close())
+
+	// left behind by a crashed process
+	err = ioutil.WriteFile(repoLockFilePath(repo), []byte("999999999"), 0644)
+	require.NoError(t, err)
+
+	cache, err = NewRepoCache(repo)
+	require.NoError(t, err)
+
+	pid, err := readLockPid(cache.lockFile)
+	require.NoError(t, err)
+	require.Equal(t, os.Getpid(), pid)
+
+	require.NoError(t, cache.Close())
+}
+
+func TestEmptyLock(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	// just created by another process, without its pid yet
+	lockPath := repoLockFilePath(repo)
+	require.NoError(t, ioutil.WriteFile(lockPath, nil, 0644))
+
+	f, err := os.OpenFile(lockPath, os.O_RDWR, 0644)
+	require.NoError(t, err)
+	defer f.Close()
+
+	now := time.Now()
+	gone, err := lockOwnerIsGone(f, now)
+	require.NoError(t, err)
+	require.False(t, gone)
+
+	// left behind by a process crashing before writing its pid
+	gone, err = lockOwnerIsGone(f, now.Add(emptyLockTimeout+time.Second))
+	require.NoError(t, err)
+	require.True(t, gone)
+
+	// a running process
+	require.NoError(t, ioutil.WriteFile(lockPath, []byte(strconv.Itoa(os.Getppid())), 0644))
+	gone, err = lockOwnerIsGone(f, now.Add(emptyLockTimeout+time.Second))
+	require.NoError(t, err)
+	require.False(t, gone)
+}
@@ -0,0 +1,25 @@
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package cache
+
+import (
+	"os"
+	"syscall"
+)
+
+// tryLockFile take an exclusive advisory lock on a file without waiting,
+// and tell if it succeeded
+func tryLockFile(f *os.File) (bool, error) {
+	err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	switch err {
+	case nil:
+		return true, nil
+	case syscall.EWOULDBLOCK:
+		return false, nil
+	case syscall.ENOLCK, syscall.EOPNOTSUPP, syscall.EINVAL:
+		// some network filesystems don't have locks
+		return false, errNoFileLock
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,99 @@
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"strconv"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const maxLoadedBugsConfigKey = "git-bug.cache.max-loaded-bugs"
+
+// the number of bugs kept in memory if not configured
+const defaultMaxLoadedBugs = 1000
+
+// lruIds order ids from the most to the least recently used
+type lruIds struct {
+	order    *list.List
+	elements map[entity.Id]*list.Element
+}
+
+func newLruIds() *lruIds {
+	return &lruIds{
+		order:    list.New(),
+		elements: make(map[entity.Id]*list.Element),
+	}
+}
+
+// touch mark an id as the most recently used
+func (l *lruIds) touch(id entity.Id) {
+	if e, ok := l.elements[id]; ok {
+		l.order.MoveToFront(e)
+		return
+	}
+	l.elements[id] = l.order.PushFront(id)
+}
+
+// remove forget an id
+func (l *lruIds) remove(id entity.Id) {
+	if e, ok := l.elements[id]; ok {
+		l.order.Remove(e)
+		delete(l.elements, id)
+	}
+}
+
+// oldest return the ids from the least to the most recently used
+func (l *lruIds) oldest() []entity.Id {
+	result := make([]entity.Id, 0, l.order.Len())
+	for e := l.order.Back(); e != nil; e = e.Prev() {
+		result = append(result, e.Value.(entity.Id))
+	}
+	return result
+}
+
+// readMaxLoadedBugs read the number of bugs kept in memory configured for
+// the repository, or globally
+func readMaxLoadedBugs(repo repository.RepoCommon) (int, error) {
+	val, err := repository.ReadConfigAnyScope(repo, maxLoadedBugsConfigKey)
+	if err != nil {
+		return 0, err
+	}
+	if val == "" {
+		return defaultMaxLoadedBugs, nil
+	}
+
+	n, err := strconv.Atoi(val)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid %s %s", maxLoadedBugsConfigKey, val)
+	}
+	return n, nil
+}
+
+// bugLoaded mark a bug as the most recently used, and unload the least
+// recently used ones above the limit. The bugs with changes not committed
+// yet are kept. The muBug must be held.
+func (c *RepoCache) bugLoaded(id entity.Id) {
+	c.loadedBugs.touch(id)
+
+	if c.maxLoadedBugs == 0 || len(c.bugs) <= c.maxLoadedBugs {
+		return
+	}
+
+	for _, old := range c.loadedBugs.oldest() {
+		if len(c.bugs) <= c.maxLoadedBugs {
+			return
+		}
+		b, ok := c.bugs[old]
+		if !ok {
+			c.loadedBugs.remove(old)
+			continue
+		}
+		if old == id || b.NeedCommit() {
+			continue
+		}
+		delete(c.bugs, old)
+		c.loadedBugs.remove(old)
+	}
+}
@@ -0,0 +1,116 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/MichaelMure/git-bug/bug"
+)
+
+// errOutdatedCache is returned when loading a cache of a previous format
+// version that can't be upgraded in place
+type errOutdatedCache struct {
+	version uint
+}
+
+func (e errOutdatedCache) Error() string {
+	return fmt.Sprintf("outdated cache format version %v", e.version)
+}
+
+// migration upgrade in place the cache of a format version to the next one,
+// when the new data can be derived from the previous one without reading all
+// the bugs again
+type migration struct {
+	// upgrade the bug excerpts, nil if their format didn't change
+	bugs func(c *RepoCache) error
+	// upgrade the identity excerpts, nil if their format didn't change
+	identities func(c *RepoCache) error
+}
+
+// the migrations, by the format version they upgrade. A cache of a version
+// without one is rebuilt.
+var migrations = map[uint]migration{
+	6: {bugs: addBugHeads},
+	7: {bugs: addBugTriage},
+	8: {bugs: addBugScope},
+}
+
+// checkFormatVersion check that a cache file of the given format version can
+// be used, directly or after an upgrade
+func checkFormatVersion(version uint) error {
+	if version > formatVersion {
+		return ErrInvalidCacheFormat{
+			message: fmt.Sprintf("unknown cache format version %v", version),
+		}
+	}
+
+	for v := version; v < formatVersion; v++ {
+		if _, ok := migrations[v]; !ok {
+			return errOutdatedCache{version: version}
+		}
+	}
+
+	return nil
+}
+
+// upgrade the loaded cache from a previous format version, and write it
+func (c *RepoCache) upgrade(version uint) error {
+	_, _ = fmt.Fprintf(os.Stderr, "Upgrading the cache from version %d to %d... ", version, formatVersion)
+
+	for v := version; v < formatVersion; v++ {
+		m := migrations[v]
+
+		if m.bugs != nil {
+			err := m.bugs(c)
+			if err != nil {
+				return err
+			}
+		}
+
+		if m.identities != nil {
+			err := m.identities(c)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, _ = fmt.Fprintln(os.Stderr, "Done.")
+
+	return c.write()
+}
+
+// 6 -> 7: the excerpts were kept up to date by the cache, so the last commit
+// of each bug is the current head of its ref
+func addBugHeads(c *RepoCache) error {
+	heads, err := bug.ListLocalHeads(c.repo)
+	if err != nil {
+		return err
+	}
+
+	for id, excerpt := range c.bugExcerpts {
+		excerpt.Head = heads[id]
+	}
+
+	return nil
+}
+
+// 7 -> 8: the priority and the milestone are given by the labels, already in
+// the excerpts
+func addBugTriage(c *RepoCache) error {
+	for _, excerpt := range c.bugExcerpts {
+		excerpt.Priority = bug.LabelsPriority(excerpt.Labels)
+		excerpt.Milestone = bug.LabelsMilestone(excerpt.Labels)
+	}
+
+	return nil
+}
+
+// 8 -> 9: the scope is given by the labels, already in the excerpts
+func addBugScope(c *RepoCache) error {
+	for _, excerpt := range c.bugExcerpts {
+		excerpt.Scope = bug.LabelsScope(excerpt.Labels)
+	}
+
+	return nil
+}
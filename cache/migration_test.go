@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"encoding/gob"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// rewriteCacheFile decode a cache file in aux, change it and encode it back
+func rewriteCacheFile(t *testing.T, path string, aux interface{}, change func()) {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	require.NoError(t, gob.NewDecoder(f).Decode(aux))
+	require.NoError(t, f.Close())
+
+	change()
+
+	f, err = os.Create(path)
+	require.NoError(t, err)
+	require.NoError(t, gob.NewEncoder(f).Encode(aux))
+	require.NoError(t, f.Close())
+}
+
+// setCacheVersion rewrite the cache files as the given format version, with
+// bug excerpts of the format 6
+func setCacheVersion(t *testing.T, repo repository.Repo, version uint, title string) {
+	bugs := struct {
+		Version  uint
+		Excerpts map[entity.Id]*BugExcerpt
+	}{}
+	rewriteCacheFile(t, bugCacheFilePath(repo), &bugs, func() {
+		bugs.Version = version
+		for _, excerpt := range bugs.Excerpts {
+			excerpt.Head = ""
+			// only kept if the cache is not rebuilt
+			excerpt.Title = title
+		}
+	})
+
+	identities := struct {
+		Version  uint
+		Excerpts map[entity.Id]*IdentityExcerpt
+	}{}
+	rewriteCacheFile(t, identityCacheFilePath(repo), &identities, func() {
+		identities.Version = version
+	})
+
+	index := struct {
+		Version uint
+		Index   *fullTextIndex
+	}{}
+	rewriteCacheFile(t, fullTextIndexFilePath(repo), &index, func() {
+		index.Version = version
+	})
+}
+
+func TestCacheUpgrade(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(iden))
+
+	b, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+	_, _, err = b.ChangeLabels([]string{"priority:medium", "milestone:v1.0", "scope:services/api"}, nil)
+	require.NoError(t, err)
+	head := cache.bugExcerpts[b.Id()].Head
+
+	require.NoError(t, cache.Close())
+
+	// upgraded in place
+	setCacheVersion(t, repo, 6, "upgraded")
+
+	cache, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	require.Equal(t, "upgraded", cache.bugExcerpts[b.Id()].Title)
+	require.Equal(t, head, cache.bugExcerpts[b.Id()].Head)
+	require.Equal(t, 2, cache.bugExcerpts[b.Id()].Priority)
+	require.Equal(t, "v1.0", cache.bugExcerpts[b.Id()].Milestone)
+	require.Equal(t, "services/api", cache.bugExcerpts[b.Id()].Scope)
+	require.NoError(t, cache.Close())
+
+	// and written back
+	require.NoError(t, cache.load())
+	require.Equal(t, head, cache.bugExcerpts[b.Id()].Head)
+
+	// too old, rebuilt
+	setCacheVersion(t, repo, 5, "rebuilt")
+
+	cache, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	require.Equal(t, "title", cache.bugExcerpts[b.Id()].Title)
+	require.Equal(t, head, cache.bugExcerpts[b.Id()].Head)
+	require.NoError(t, cache.Close())
+
+	// unknown, refused
+	setCacheVersion(t, repo, formatVersion+1, "unknown")
+
+	_, err = NewRepoCache(repo)
+	require.IsType(t, ErrInvalidCacheFormat{}, err)
+}
@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// the due date of a milestone is configured with
+// git-bug.milestone.<name>.due
+const (
+	milestoneConfigPrefix = "git-bug.milestone."
+	milestoneDueSuffix    = ".due"
+)
+
+// MilestoneDate is the due date of a milestone
+type MilestoneDate struct {
+	Name string
+	Due  time.Time
+}
+
+// MilestoneDates read the due dates of the milestones configured with
+// git-bug.milestone.<name>.due, like "2020-03-01", sorted by date
+func (c *RepoCache) MilestoneDates() ([]MilestoneDate, error) {
+	entries, err := c.repo.LocalConfig().ReadAll(milestoneConfigPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []MilestoneDate
+
+	for key, value := range entries {
+		if !strings.HasPrefix(key, milestoneConfigPrefix) || !strings.HasSuffix(key, milestoneDueSuffix) {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(key, milestoneConfigPrefix), milestoneDueSuffix)
+		if name == "" {
+			continue
+		}
+
+		due, err := ParseDueDate(value, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", key, err)
+		}
+
+		result = append(result, MilestoneDate{Name: name, Due: due})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].Due.Equal(result[j].Due) {
+			return result[i].Due.Before(result[j].Due)
+		}
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
@@ -0,0 +1,37 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestMilestoneDates(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	dates, err := cache.MilestoneDates()
+	require.NoError(t, err)
+	assert.Empty(t, dates)
+
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.milestone.v2.0.due", "2020-06-01"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.milestone.v1.0.due", "2020-03-01"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.milestone.v1.0.owner", "rene"))
+
+	dates, err = cache.MilestoneDates()
+	require.NoError(t, err)
+	require.Len(t, dates, 2)
+	assert.Equal(t, "v1.0", dates[0].Name)
+	assert.Equal(t, "2020-03-01", dates[0].Due.Format("2006-01-02"))
+	assert.Equal(t, "v2.0", dates[1].Name)
+
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.milestone.v3.0.due", "someday"))
+	_, err = cache.MilestoneDates()
+	assert.Error(t, err)
+}
@@ -2,6 +2,7 @@ package cache
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/MichaelMure/git-bug/repository"
 )
@@ -21,6 +22,10 @@ func NewMultiRepoCache() MultiRepoCache {
 
 // RegisterRepository register a named repository. Use this for multi-repo setup
 func (c *MultiRepoCache) RegisterRepository(ref string, repo repository.ClockedRepo) error {
+	if _, ok := c.repos[ref]; ok {
+		return fmt.Errorf("repository %s already registered", ref)
+	}
+
 	r, err := NewRepoCache(repo)
 	if err != nil {
 		return err
@@ -30,6 +35,17 @@ func (c *MultiRepoCache) RegisterRepository(ref string, repo repository.ClockedR
 	return nil
 }
 
+// RegisterRepoCache register an already opened repository, named, or unnamed
+// with an empty ref. It's closed with the others.
+func (c *MultiRepoCache) RegisterRepoCache(ref string, r *RepoCache) error {
+	if _, ok := c.repos[ref]; ok {
+		return fmt.Errorf("repository %s already registered", ref)
+	}
+
+	c.repos[ref] = r
+	return nil
+}
+
 // RegisterDefaultRepository register a unnamed repository. Use this for mono-repo setup
 func (c *MultiRepoCache) RegisterDefaultRepository(repo repository.ClockedRepo) error {
 	r, err := NewRepoCache(repo)
@@ -41,8 +57,13 @@ func (c *MultiRepoCache) RegisterDefaultRepository(repo repository.ClockedRepo)
 	return nil
 }
 
-// DefaultRepo retrieve the default repository
+// DefaultRepo retrieve the default repository: the unnamed one, or the only
+// one registered
 func (c *MultiRepoCache) DefaultRepo() (*RepoCache, error) {
+	if r, ok := c.repos[""]; ok {
+		return r, nil
+	}
+
 	if len(c.repos) != 1 {
 		return nil, fmt.Errorf("repository is not unique")
 	}
@@ -58,11 +79,23 @@ func (c *MultiRepoCache) DefaultRepo() (*RepoCache, error) {
 func (c *MultiRepoCache) ResolveRepo(ref string) (*RepoCache, error) {
 	r, ok := c.repos[ref]
 	if !ok {
-		return nil, fmt.Errorf("unknown repo")
+		return nil, fmt.Errorf("unknown repository %s", ref)
 	}
 	return r, nil
 }
 
+// RepoRefs return the references of the named repositories, sorted
+func (c *MultiRepoCache) RepoRefs() []string {
+	refs := make([]string, 0, len(c.repos))
+	for ref := range c.repos {
+		if ref != "" {
+			refs = append(refs, ref)
+		}
+	}
+	sort.Strings(refs)
+	return refs
+}
+
 // Close will do anything that is needed to close the cache properly
 func (c *MultiRepoCache) Close() error {
 	for _, cachedRepo := range c.repos {
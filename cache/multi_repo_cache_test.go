@@ -0,0 +1,45 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestMultiRepoCache(t *testing.T) {
+	repoA := repository.CreateTestRepo(false)
+	repoB := repository.CreateTestRepo(false)
+	repoC := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repoA, repoB, repoC)
+
+	mrc := NewMultiRepoCache()
+	defer mrc.Close()
+
+	// the only one is the default
+	require.NoError(t, mrc.RegisterRepository("b", repoB))
+	b, err := mrc.DefaultRepo()
+	require.NoError(t, err)
+
+	require.NoError(t, mrc.RegisterRepository("c", repoC))
+	_, err = mrc.DefaultRepo()
+	require.Error(t, err)
+
+	// the unnamed one is the default
+	require.NoError(t, mrc.RegisterDefaultRepository(repoA))
+	a, err := mrc.DefaultRepo()
+	require.NoError(t, err)
+	require.NotEqual(t, b, a)
+
+	require.Error(t, mrc.RegisterRepository("b", repoA))
+
+	require.Equal(t, []string{"b", "c"}, mrc.RepoRefs())
+
+	resolved, err := mrc.ResolveRepo("b")
+	require.NoError(t, err)
+	require.Equal(t, b, resolved)
+
+	_, err = mrc.ResolveRepo("d")
+	require.Error(t, err)
+}
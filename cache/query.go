@@ -2,120 +2,295 @@ package cache
 
 import (
 	"fmt"
+	"math"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 	"unicode"
+
+	"github.com/araddon/dateparse"
 )
 
 type Query struct {
 	Filters
-	OrderBy
-	OrderDirection
+	// the bugs are sorted with the first key, then the next ones for the
+	// bugs equal with the previous keys
+	Sorting []SortKey
 }
 
 // Return an identity query with default sorting (creation-desc)
 func NewQuery() *Query {
 	return &Query{
-		OrderBy:        OrderByCreation,
-		OrderDirection: OrderDescending,
+		Sorting: []SortKey{{OrderByCreation, OrderDescending}},
 	}
 }
 
 // ParseQuery parse a query DSL
 //
-// Ex: "status:open author:descartes sort:edit-asc"
+// Ex: "status:open (label:crash OR label:data-loss) -author:bot sort:edit-asc"
 //
 // Supported filter qualifiers and syntax are described in docs/queries.md
 func ParseQuery(query string) (*Query, error) {
-	fields := splitQuery(query)
+	return ParseQueryWithSorting(query, nil)
+}
 
-	result := &Query{
-		OrderBy:        OrderByCreation,
-		OrderDirection: OrderDescending,
+// ParseQueryWithSorting parse a query DSL like ParseQuery, the given sorting
+// being used when the query doesn't have one.
+func ParseQueryWithSorting(query string, sorting []SortKey) (*Query, error) {
+	root, err := parseQueryExpression(splitQuery(query))
+	if err != nil {
+		return nil, err
 	}
 
-	sortingDone := false
-
-	for _, field := range fields {
-		split := strings.Split(field, ":")
-		if len(split) != 2 {
-			return nil, fmt.Errorf("can't parse \"%s\"", field)
-		}
-
-		qualifierName := split[0]
-		qualifierQuery := removeQuote(split[1])
-
-		switch qualifierName {
-		case "status", "state":
-			f, err := StatusFilter(qualifierQuery)
-			if err != nil {
-				return nil, err
-			}
-			result.Status = append(result.Status, f)
-
-		case "author":
-			f := AuthorFilter(qualifierQuery)
-			result.Author = append(result.Author, f)
-
-		case "actor":
-			f := ActorFilter(qualifierQuery)
-			result.Actor = append(result.Actor, f)
+	result := NewQuery()
+	if len(sorting) > 0 {
+		result.Sorting = sorting
+	}
 
-		case "participant":
-			f := ParticipantFilter(qualifierQuery)
-			result.Participant = append(result.Participant, f)
+	// the commits are linked to the bugs before matching them
+	result.touching = root.hasQualifier("touching")
 
-		case "label":
-			f := LabelFilter(qualifierQuery)
-			result.Label = append(result.Label, f)
+	sortingDone := false
 
-		case "title":
-			f := TitleFilter(qualifierQuery)
-			result.Title = append(result.Title, f)
+	for _, node := range root.topLevel() {
+		term, ok := node.(*queryTerm)
 
-		case "no":
-			err := result.parseNoFilter(qualifierQuery)
+		// negations and groups are compiled into a single filter
+		if !ok {
+			f, err := node.compile()
 			if err != nil {
 				return nil, err
 			}
+			result.Expressions = append(result.Expressions, f)
+			continue
+		}
 
-		case "sort":
+		if term.name == "sort" {
 			if sortingDone {
 				return nil, fmt.Errorf("multiple sorting")
 			}
 
-			err := result.parseSorting(qualifierQuery)
+			sorting, err := ParseSortKeys(term.value)
 			if err != nil {
 				return nil, err
 			}
+			result.Sorting = sorting
 
 			sortingDone = true
+			continue
+		}
 
-		default:
-			return nil, fmt.Errorf("unknow qualifier name %s", qualifierName)
+		err := result.addTerm(term.name, term.value)
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	return result, nil
 }
 
+// addTerm add the filter of a qualifier in the matching category
+func (q *Query) addTerm(qualifierName string, qualifierQuery string) error {
+	f, err := termFilter(qualifierName, qualifierQuery)
+	if err != nil {
+		return err
+	}
+
+	switch qualifierName {
+	case "status", "state":
+		q.Status = append(q.Status, f)
+	case "author":
+		q.Author = append(q.Author, f)
+	case "actor":
+		q.Actor = append(q.Actor, f)
+	case "participant":
+		q.Participant = append(q.Participant, f)
+	case "assignee":
+		q.Assignee = append(q.Assignee, f)
+	case "label":
+		q.Label = append(q.Label, f)
+	case "title":
+		q.Title = append(q.Title, f)
+	case "priority":
+		q.Priority = append(q.Priority, f)
+	case "milestone":
+		q.Milestone = append(q.Milestone, f)
+	case "scope":
+		q.Scope = append(q.Scope, f)
+	case "touching":
+		q.Touching = append(q.Touching, f)
+	case "fulltext":
+		q.AddFullText(qualifierQuery)
+	case "created-after", "created-before", "edited-after", "closed-after":
+		q.Time = append(q.Time, f)
+	case "no":
+		// an explicit absence of scope replace the default one of the commands
+		if qualifierQuery == "scope" {
+			q.Scope = append(q.Scope, f)
+			break
+		}
+		q.NoFilters = append(q.NoFilters, f)
+	default:
+		panic("unhandled qualifier")
+	}
+
+	return nil
+}
+
+// termFilter return the Filter matching a qualifier
+func termFilter(qualifierName string, qualifierQuery string) (Filter, error) {
+	switch qualifierName {
+	case "status", "state":
+		return StatusFilter(qualifierQuery)
+
+	case "author":
+		return AuthorFilter(qualifierQuery), nil
+
+	case "actor":
+		return ActorFilter(qualifierQuery), nil
+
+	case "participant":
+		return ParticipantFilter(qualifierQuery), nil
+
+	case "assignee":
+		return AssigneeFilter(qualifierQuery), nil
+
+	case "label":
+		return LabelFilter(qualifierQuery), nil
+
+	case "title":
+		return TitleFilter(qualifierQuery), nil
+
+	case "priority":
+		return PriorityFilter(qualifierQuery)
+
+	case "milestone":
+		return MilestoneFilter(qualifierQuery), nil
+
+	case "scope":
+		return ScopeFilter(qualifierQuery), nil
+
+	case "touching":
+		return TouchingFilter(qualifierQuery), nil
+
+	case "fulltext":
+		return FullTextFilter(qualifierQuery), nil
+
+	case "created-after", "created-before", "edited-after", "closed-after":
+		t, err := parseTimeQuery(qualifierQuery, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		return timeFilters[qualifierName](t), nil
+
+	case "no":
+		return noFilter(qualifierQuery)
+
+	case "sort":
+		return nil, fmt.Errorf("sort can't be negated or used in a group")
+
+	default:
+		return nil, fmt.Errorf("unknow qualifier name %s", qualifierName)
+	}
+}
+
+var timeFilters = map[string]func(t time.Time) Filter{
+	"created-after":  CreatedAfterFilter,
+	"created-before": CreatedBeforeFilter,
+	"edited-after":   EditedAfterFilter,
+	"closed-after":   ClosedAfterFilter,
+}
+
+// ParseTime parse either a date (ex: 2019-12-31) or a duration relative to
+// now (ex: 36h, 2d, 3w, 1m, 1y), as accepted in the time filters of a query
+func ParseTime(str string) (time.Time, error) {
+	return parseTimeQuery(str, time.Now())
+}
+
+var relativeTimeRegexp = regexp.MustCompile(`^(\d+)([hdwmy])$`)
+
+// the longest hours of each unit of a relative time
+var relativeTimeHours = map[string]int64{
+	"h": 1,
+	"d": 24,
+	"w": 7 * 24,
+	"m": 31 * 24,
+	"y": 366 * 24,
+}
+
+// a relative time must fit in a time.Duration
+const maxRelativeTimeHours = math.MaxInt64 / int64(time.Hour)
+
+// parseTimeQuery parse either a date (ex: 2019-12-31) or a duration relative
+// to now (ex: 36h, 2d, 3w, 1m, 1y)
+func parseTimeQuery(query string, now time.Time) (time.Time, error) {
+	if matches := relativeTimeRegexp.FindStringSubmatch(query); matches != nil {
+		n, err := strconv.Atoi(matches[1])
+		if err != nil || int64(n) > maxRelativeTimeHours/relativeTimeHours[matches[2]] {
+			return time.Time{}, fmt.Errorf("the duration \"%s\" is too long", query)
+		}
+
+		switch matches[2] {
+		case "h":
+			return now.Add(-time.Duration(n) * time.Hour), nil
+		case "d":
+			return now.AddDate(0, 0, -n), nil
+		case "w":
+			return now.AddDate(0, 0, -7*n), nil
+		case "m":
+			return now.AddDate(0, -n, 0), nil
+		case "y":
+			return now.AddDate(-n, 0, 0), nil
+		}
+	}
+
+	t, err := dateparse.ParseLocal(query)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("can't parse the time \"%s\"", query)
+	}
+
+	return t, nil
+}
+
+// splitQuery split a query into tokens: the terms, the operators and the
+// parenthesis. Spaces and parenthesis in quotes don't split the terms.
 func splitQuery(query string) []string {
+	var result []string
+	var current strings.Builder
+
+	flush := func() {
+		if current.Len() > 0 {
+			result = append(result, current.String())
+			current.Reset()
+		}
+	}
+
 	lastQuote := rune(0)
-	f := func(c rune) bool {
+
+	for _, c := range query {
 		switch {
 		case c == lastQuote:
 			lastQuote = rune(0)
-			return false
+			current.WriteRune(c)
 		case lastQuote != rune(0):
-			return false
+			current.WriteRune(c)
 		case unicode.In(c, unicode.Quotation_Mark):
 			lastQuote = c
-			return false
+			current.WriteRune(c)
+		case c == '(' || c == ')':
+			flush()
+			result = append(result, string(c))
+		case unicode.IsSpace(c):
+			flush()
 		default:
-			return unicode.IsSpace(c)
+			current.WriteRune(c)
 		}
 	}
 
-	return strings.FieldsFunc(query, f)
+	flush()
+
+	return result
 }
 
 func removeQuote(field string) string {
@@ -127,46 +302,19 @@ func removeQuote(field string) string {
 	return field
 }
 
-func (q *Query) parseNoFilter(query string) error {
+func noFilter(query string) (Filter, error) {
 	switch query {
 	case "label":
-		q.NoFilters = append(q.NoFilters, NoLabelFilter())
+		return NoLabelFilter(), nil
+	case "assignee":
+		return NoAssigneeFilter(), nil
+	case "milestone":
+		return NoMilestoneFilter(), nil
+	case "scope":
+		return NoScopeFilter(), nil
+	case "priority":
+		return NoPriorityFilter(), nil
 	default:
-		return fmt.Errorf("unknown \"no\" filter %s", query)
+		return nil, fmt.Errorf("unknown \"no\" filter %s", query)
 	}
-
-	return nil
-}
-
-func (q *Query) parseSorting(query string) error {
-	switch query {
-	// default ASC
-	case "id-desc":
-		q.OrderBy = OrderById
-		q.OrderDirection = OrderDescending
-	case "id", "id-asc":
-		q.OrderBy = OrderById
-		q.OrderDirection = OrderAscending
-
-	// default DESC
-	case "creation", "creation-desc":
-		q.OrderBy = OrderByCreation
-		q.OrderDirection = OrderDescending
-	case "creation-asc":
-		q.OrderBy = OrderByCreation
-		q.OrderDirection = OrderAscending
-
-	// default DESC
-	case "edit", "edit-desc":
-		q.OrderBy = OrderByEdit
-		q.OrderDirection = OrderDescending
-	case "edit-asc":
-		q.OrderBy = OrderByEdit
-		q.OrderDirection = OrderAscending
-
-	default:
-		return fmt.Errorf("unknow sorting %s", query)
-	}
-
-	return nil
 }
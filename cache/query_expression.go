@@ -0,0 +1,267 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+)
+
+// queryNode is a node in the parsed tree of a query with boolean operators
+type queryNode interface {
+	// compile return the Filter equivalent to the node
+	compile() (Filter, error)
+	// topLevel return the nodes implicitly ANDed at the root of the query
+	topLevel() []queryNode
+	// hasQualifier tell if a qualifier is used in the node or below
+	hasQualifier(name string) bool
+}
+
+// queryTerm is a single "qualifier:value"
+type queryTerm struct {
+	name  string
+	value string
+}
+
+func (t *queryTerm) compile() (Filter, error) {
+	return termFilter(t.name, t.value)
+}
+
+func (t *queryTerm) topLevel() []queryNode {
+	return []queryNode{t}
+}
+
+func (t *queryTerm) hasQualifier(name string) bool {
+	return t.name == name
+}
+
+type queryNot struct {
+	node queryNode
+}
+
+func (n *queryNot) compile() (Filter, error) {
+	f, err := n.node.compile()
+	if err != nil {
+		return nil, err
+	}
+	return NotFilter(f), nil
+}
+
+func (n *queryNot) topLevel() []queryNode {
+	return []queryNode{n}
+}
+
+func (n *queryNot) hasQualifier(name string) bool {
+	return n.node.hasQualifier(name)
+}
+
+type queryAnd []queryNode
+
+func (a queryAnd) compile() (Filter, error) {
+	filters := make([]Filter, len(a))
+	for i, node := range a {
+		f, err := node.compile()
+		if err != nil {
+			return nil, err
+		}
+		filters[i] = f
+	}
+	return AndFilter(filters...), nil
+}
+
+func (a queryAnd) topLevel() []queryNode {
+	return a
+}
+
+func (a queryAnd) hasQualifier(name string) bool {
+	for _, node := range a {
+		if node.hasQualifier(name) {
+			return true
+		}
+	}
+	return false
+}
+
+type queryOr []queryNode
+
+func (o queryOr) compile() (Filter, error) {
+	filters := make([]Filter, len(o))
+	for i, node := range o {
+		f, err := node.compile()
+		if err != nil {
+			return nil, err
+		}
+		filters[i] = f
+	}
+	return OrFilter(filters...), nil
+}
+
+func (o queryOr) topLevel() []queryNode {
+	return []queryNode{o}
+}
+
+func (o queryOr) hasQualifier(name string) bool {
+	for _, node := range o {
+		if node.hasQualifier(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// queryParser is a recursive descent parser for this grammar:
+//   or   := and ("OR" and)*
+//   and  := not (["AND"] not)*
+//   not  := ("NOT" | "-") not | "(" or ")" | term
+//   term := qualifier ":" value | word
+type queryParser struct {
+	tokens []string
+	pos    int
+}
+
+func parseQueryExpression(tokens []string) (queryNode, error) {
+	p := &queryParser{tokens: tokens}
+
+	if len(tokens) == 0 {
+		return queryAnd{}, nil
+	}
+
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected \"%s\"", p.tokens[p.pos])
+	}
+
+	return node, nil
+}
+
+func (p *queryParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+// missingTerm return the error for a term missing at the current position,
+// pointing at the token around it
+func (p *queryParser) missingTerm() error {
+	if p.pos > 0 {
+		return fmt.Errorf("missing term after \"%s\"", p.tokens[p.pos-1])
+	}
+	return fmt.Errorf("missing term before \"%s\"", p.peek())
+}
+
+func (p *queryParser) parseOr() (queryNode, error) {
+	var nodes queryOr
+
+	for {
+		node, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+
+		if p.peek() != "OR" {
+			break
+		}
+		p.pos++
+	}
+
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return nodes, nil
+}
+
+func (p *queryParser) parseAnd() (queryNode, error) {
+	var nodes queryAnd
+
+	for {
+		switch p.peek() {
+		case "", ")", "OR":
+			if len(nodes) == 0 {
+				return nil, p.missingTerm()
+			}
+			return nodes, nil
+		case "AND":
+			if len(nodes) == 0 {
+				return nil, p.missingTerm()
+			}
+			p.pos++
+		}
+
+		node, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+}
+
+func (p *queryParser) parseNot() (queryNode, error) {
+	token := p.peek()
+
+	switch {
+	case token == "", token == "OR", token == "AND":
+		return nil, p.missingTerm()
+
+	case token == "NOT":
+		p.pos++
+		node, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &queryNot{node: node}, nil
+
+	case token == "(":
+		p.pos++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return node, nil
+
+	case token == ")":
+		return nil, fmt.Errorf("unexpected \")\"")
+
+	case strings.HasPrefix(token, "-") && len(token) > 1:
+		// "-term" or "-(group)" once split
+		p.tokens[p.pos] = token[1:]
+		node, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &queryNot{node: node}, nil
+
+	case token == "-":
+		p.pos++
+		node, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &queryNot{node: node}, nil
+	}
+
+	p.pos++
+
+	// the value itself can contain a ':', for example in a time
+	split := strings.SplitN(token, ":", 2)
+
+	// a bare word or a quoted sentence is searched in the text of the bugs
+	if len(split) != 2 || strings.HasPrefix(token, "\"") {
+		return &queryTerm{
+			name:  "fulltext",
+			value: removeQuote(token),
+		}, nil
+	}
+
+	return &queryTerm{
+		name:  split[0],
+		value: removeQuote(split[1]),
+	}, nil
+}
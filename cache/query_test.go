@@ -1,6 +1,18 @@
 package cache
 
-import "testing"
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/misc/random_bugs"
+	"github.com/MichaelMure/git-bug/repository"
+)
 
 func TestQueryParse(t *testing.T) {
 
@@ -8,7 +20,9 @@ func TestQueryParse(t *testing.T) {
 		input string
 		ok    bool
 	}{
-		{"gibberish", false},
+		{"gibberish", true},
+		{`"null pointer"`, true},
+		{"crash OR panic", true},
 
 		{"status:", false},
 
@@ -21,6 +35,18 @@ func TestQueryParse(t *testing.T) {
 
 		{"actor:bernhard", true},
 		{"participant:leonhard", true},
+		{"assignee:me", true},
+		{"no:assignee", true},
+		{"no:milestone", true},
+		{"no:priority", true},
+		{"no:unknown", false},
+
+		{"priority:high", true},
+		{"priority:urgent", false},
+		{"milestone:v1.0", true},
+		{"scope:services/api", true},
+		{"no:scope", true},
+		{"touching:parser/lex.go", true},
 
 		{"label:hello", true},
 		{`label:"Good first issue"`, true},
@@ -28,8 +54,34 @@ func TestQueryParse(t *testing.T) {
 		{"title:titleOne", true},
 		{`title:"Bug titleTwo"`, true},
 
+		{"fulltext:crash", true},
+		{`fulltext:"null pointer"`, true},
+		{`"null:pointer"`, true},
+
+		{"created-after:2019-12-31", true},
+		{`created-before:"2019-12-31 10:00:00"`, true},
+		{"edited-after:2w", true},
+		{"closed-after:36h", true},
+		{"closed-after:yesterday", false},
+
+		{"status:open (label:crash OR label:data-loss) -author:bot", true},
+		{"NOT label:wontfix", true},
+		{"-(label:a label:b)", true},
+		{"label:a AND label:b", true},
+		{"(label:a", false},
+		{"label:a)", false},
+		{"label:a OR", false},
+		{"OR label:a", false},
+		{"-sort:edit", false},
+		{"(status:open sort:edit)", false},
+
 		{"sort:edit", true},
 		{"sort:unknown", false},
+		{"sort:status,edit-desc", true},
+		{"sort:comments-asc,participants", true},
+		{"sort:title-desc", true},
+		{"sort:priority,due,milestone", true},
+		{"sort:status,unknown", false},
 	}
 
 	for _, test := range tests {
@@ -39,3 +91,190 @@ func TestQueryParse(t *testing.T) {
 		}
 	}
 }
+
+func TestQueryParseFullText(t *testing.T) {
+	query, err := ParseQuery(`status:open crash "null pointer" label:bug`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"crash", "null", "pointer"}, query.fullTextWords)
+	assert.Len(t, query.Status, 1)
+	assert.Len(t, query.Label, 1)
+
+	// in a group, the words are matched as a filter
+	query, err = ParseQuery("crash OR panic")
+	require.NoError(t, err)
+	assert.Empty(t, query.fullTextWords)
+	assert.Len(t, query.Expressions, 1)
+}
+
+func TestQueryParseError(t *testing.T) {
+	var tests = []struct {
+		input string
+		err   string
+	}{
+		{"a OR", `missing term after "OR"`},
+		{"OR a", `missing term before "OR"`},
+		{"a AND", `missing term after "AND"`},
+		{"a AND OR b", `missing term after "AND"`},
+		{"a NOT", `missing term after "NOT"`},
+		{"()", `missing term after "("`},
+		{"(a", "missing closing parenthesis"},
+		{"a)", `unexpected ")"`},
+	}
+
+	for _, test := range tests {
+		_, err := ParseQuery(test.input)
+		require.Error(t, err, test.input)
+		assert.Equal(t, test.err, err.Error(), test.input)
+	}
+}
+
+func TestParseTimeQuery(t *testing.T) {
+	now := time.Date(2020, 3, 15, 12, 0, 0, 0, time.Local)
+
+	var tests = []struct {
+		input    string
+		expected time.Time
+	}{
+		{"36h", time.Date(2020, 3, 14, 0, 0, 0, 0, time.Local)},
+		{"2d", time.Date(2020, 3, 13, 12, 0, 0, 0, time.Local)},
+		{"2w", time.Date(2020, 3, 1, 12, 0, 0, 0, time.Local)},
+		{"1m", time.Date(2020, 2, 15, 12, 0, 0, 0, time.Local)},
+		{"1y", time.Date(2019, 3, 15, 12, 0, 0, 0, time.Local)},
+		{"2019-12-31", time.Date(2019, 12, 31, 0, 0, 0, 0, time.Local)},
+		{"2019-12-31T10:30:00", time.Date(2019, 12, 31, 10, 30, 0, 0, time.Local)},
+	}
+
+	for _, test := range tests {
+		result, err := parseTimeQuery(test.input, now)
+		require.NoError(t, err, test.input)
+		assert.True(t, test.expected.Equal(result), "%s: expected %v, got %v", test.input, test.expected, result)
+	}
+
+	_, err := parseTimeQuery("2x", now)
+	assert.Error(t, err)
+
+	// the durations overflowing a time.Duration are rejected
+	for _, input := range []string{"99999999999h", "99999999999w", "2562048h", "10000000000000000000000y"} {
+		_, err = parseTimeQuery(input, now)
+		assert.EqualError(t, err, `the duration "`+input+`" is too long`)
+	}
+
+	result, err := parseTimeQuery("2562047h", now)
+	require.NoError(t, err)
+	assert.True(t, result.Before(now))
+
+	_, err = ParseQuery("created-after:99999999999w")
+	assert.Error(t, err)
+}
+
+func TestQuerySorting(t *testing.T) {
+	bugs := []*BugExcerpt{
+		{Id: "1", Status: bug.ClosedStatus, LenComments: 3, EditLamportTime: 1, Priority: 3},
+		{Id: "2", Status: bug.OpenStatus, LenComments: 1, EditLamportTime: 2, Priority: 1, DueUnixTime: 200, Milestone: "v2"},
+		{Id: "3", Status: bug.OpenStatus, LenComments: 5, EditLamportTime: 3, Priority: 3, DueUnixTime: 100, Milestone: "V1"},
+		{Id: "4", Status: bug.ClosedStatus, LenComments: 3, EditLamportTime: 4, DueUnixTime: 300, Milestone: "v1"},
+	}
+
+	var tests = []struct {
+		sort     string
+		expected []entity.Id
+	}{
+		{"comments", []entity.Id{"3", "1", "4", "2"}},
+		{"status,comments", []entity.Id{"3", "2", "1", "4"}},
+		{"status-desc,comments-asc,edit", []entity.Id{"4", "1", "2", "3"}},
+		{"priority", []entity.Id{"1", "3", "2", "4"}},
+		{"priority-asc", []entity.Id{"4", "2", "1", "3"}},
+		{"due", []entity.Id{"3", "2", "4", "1"}},
+		{"milestone", []entity.Id{"3", "4", "2", "1"}},
+	}
+
+	for _, test := range tests {
+		keys, err := ParseSortKeys(test.sort)
+		require.NoError(t, err)
+
+		sorted := append([]*BugExcerpt(nil), bugs...)
+		sort.Stable(newBugsMultiSorter(sorted, keys))
+
+		ids := make([]entity.Id, len(sorted))
+		for i, b := range sorted {
+			ids[i] = b.Id
+		}
+		assert.Equal(t, test.expected, ids, test.sort)
+	}
+}
+
+func TestParseQueryWithSorting(t *testing.T) {
+	sorting := []SortKey{{OrderByEdit, OrderAscending}}
+
+	query, err := ParseQueryWithSorting("status:open", sorting)
+	require.NoError(t, err)
+	assert.Equal(t, sorting, query.Sorting)
+
+	// the sorting of the query takes precedence
+	query, err = ParseQueryWithSorting("status:open sort:comments-desc", sorting)
+	require.NoError(t, err)
+	assert.Equal(t, []SortKey{{OrderByComments, OrderDescending}}, query.Sorting)
+}
+
+func TestQueryBooleanOperators(t *testing.T) {
+	openCrash := &BugExcerpt{Status: bug.OpenStatus, Labels: []bug.Label{"crash"}, Title: "a"}
+	openDataLoss := &BugExcerpt{Status: bug.OpenStatus, Labels: []bug.Label{"data-loss"}, Title: "b"}
+	openOther := &BugExcerpt{Status: bug.OpenStatus, Title: "c"}
+	closedCrash := &BugExcerpt{Status: bug.ClosedStatus, Labels: []bug.Label{"crash"}, Title: "d"}
+
+	all := []*BugExcerpt{openCrash, openDataLoss, openOther, closedCrash}
+
+	var tests = []struct {
+		query    string
+		expected []*BugExcerpt
+	}{
+		{"status:open (label:crash OR label:data-loss)", []*BugExcerpt{openCrash, openDataLoss}},
+		{"label:crash OR status:closed", []*BugExcerpt{openCrash, closedCrash}},
+		{"-label:crash", []*BugExcerpt{openDataLoss, openOther}},
+		{"NOT (status:open label:crash)", []*BugExcerpt{openDataLoss, openOther, closedCrash}},
+		{"status:open -title:a -title:b", []*BugExcerpt{openOther}},
+		{"label:crash AND status:closed", []*BugExcerpt{closedCrash}},
+	}
+
+	for _, test := range tests {
+		query, err := ParseQuery(test.query)
+		require.NoError(t, err, test.query)
+
+		var matched []*BugExcerpt
+		for _, excerpt := range all {
+			if query.Match(nil, excerpt) {
+				matched = append(matched, excerpt)
+			}
+		}
+		assert.Equal(t, test.expected, matched, test.query)
+	}
+}
+
+func BenchmarkQueryBugs(b *testing.B) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(b, repo)
+
+	random_bugs.FillRepoWithSeed(repo, 50, 42)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(b, err)
+
+	var queries []*Query
+	for _, str := range []string{
+		"status:open sort:edit-desc",
+		"status:open (label:bug OR label:critical) sort:creation",
+		"-label:bug no:assignee",
+		"title:the sort:title",
+	} {
+		query, err := ParseQuery(str)
+		require.NoError(b, err, str)
+		queries = append(queries, query)
+	}
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		for _, query := range queries {
+			cache.QueryBugs(query)
+		}
+	}
+}
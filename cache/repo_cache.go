@@ -4,12 +4,11 @@ import (
 	"bytes"
 	"encoding/gob"
 	"fmt"
-	"io"
-	"io/ioutil"
+	"net/http"
 	"os"
 	"path"
 	"sort"
-	"strconv"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -19,7 +18,6 @@ import (
 	"github.com/MichaelMure/git-bug/identity"
 	"github.com/MichaelMure/git-bug/repository"
 	"github.com/MichaelMure/git-bug/util/git"
-	"github.com/MichaelMure/git-bug/util/process"
 )
 
 const bugCacheFile = "bug-cache"
@@ -27,7 +25,17 @@ const identityCacheFile = "identity-cache"
 
 // 1: original format
 // 2: added cache for identities with a reference in the bug cache
-const formatVersion = 2
+// 3: added the email in the identity cache
+// 4: added the closing time in the bug cache
+// 5: added the assignees in the bug cache
+// 6: added the due date in the bug cache
+// 7: added the last commit of the bugs in the bug cache
+// 8: added the priority and the milestone in the bug cache
+// 9: added the scope in the bug cache
+//
+// When the new format can be derived from the previous one, add a migration
+// in migration.go to spare the users a rebuild of the cache.
+const formatVersion = 9
 
 type ErrInvalidCacheFormat struct {
 	message string
@@ -42,7 +50,8 @@ var _ repository.RepoCommon = &RepoCache{}
 // RepoCache is a cache for a Repository. This cache has multiple functions:
 //
 // 1. After being loaded, a Bug is kept in memory in the cache, allowing for fast
-// 		access later.
+// 		access later. Above a configurable number of bugs, the least recently
+// 		used ones without pending changes are unloaded.
 // 2. The cache maintain in memory and on disk a pre-digested excerpt for each bug,
 // 		allowing for fast querying the whole set of bugs without having to load
 //		them individually.
@@ -52,15 +61,37 @@ var _ repository.RepoCommon = &RepoCache{}
 //
 // The cache also protect the on-disk data by locking the git repository for its
 // own usage, by writing a lock file. Of course, normal git operations are not
-// affected, only git-bug related one.
+// affected, only git-bug related one. A read-only cache can be opened without
+// the lock, see NewReadOnlyRepoCache.
 type RepoCache struct {
 	// the underlying repo
 	repo repository.ClockedRepo
 
+	// protect the bugs, their excerpts and the full text index, as the
+	// cache is used concurrently by the requests of the web UI
+	muBug sync.RWMutex
+	// protect the identities, their excerpts and the user identity. It's
+	// taken after muBug when both are needed.
+	muIdentity sync.RWMutex
+
 	// excerpt of bugs data for all bugs
 	bugExcerpts map[entity.Id]*BugExcerpt
 	// bug loaded in memory
 	bugs map[entity.Id]*BugCache
+	// the loaded bugs, by last use
+	loadedBugs *lruIds
+	// the number of bugs kept in memory, 0 for no limit
+	maxLoadedBugs int
+
+	// index of the words of the bugs
+	fullTextIndex *fullTextIndex
+
+	// the commits mentioning bugs, read when first needed
+	commitIndex *commitIndex
+	// the commits of the commit index, by bug mentioned
+	commitLinks map[entity.Id][]*CommitExcerpt
+	// protect the commit index and the links
+	commitIndexMutex sync.Mutex
 
 	// excerpt of identities data for all identities
 	identitiesExcerpts map[entity.Id]*IdentityExcerpt
@@ -69,34 +100,143 @@ type RepoCache struct {
 
 	// the user identity's id, if known
 	userIdentityId entity.Id
+
+	// canonical names and emails of the identities
+	mailmap *identity.Mailmap
+
+	// repository level definitions of the labels
+	labels *bug.LabelStore
+
+	// the avatar provider and the client fetching the avatars, made once
+	// from the config
+	avatarProvider   identity.AvatarProvider
+	avatarClient     *http.Client
+	avatarConfigOnce sync.Once
+	// the avatars being fetched, by file, for the concurrent requests of
+	// an avatar to share the fetch
+	avatarFetches     map[string]*avatarFetch
+	avatarFetchesLock sync.Mutex
+
+	// don't run the pre- hooks
+	noPreHooks bool
+	// the changes are imported, see SetImporting
+	importing bool
+	// the work of the hook handlers run in the background, see
+	// RunHookInBackground
+	hookMutex   sync.Mutex
+	hookQueue   []backgroundHook
+	hookRunning bool
+	hookWait    sync.WaitGroup
+
+	// the remote the local changes are pushed to in the background, if any
+	autoPushRemote string
+	// the bugs shared with the auto-push remote, nil for all
+	autoPushQuery *Query
+	// protect the push queue file and the state of the background push
+	pushMutex sync.Mutex
+	// the refs queued while a push was running
+	pushRequeued   map[queuedPush]bool
+	pushRunning    bool
+	pushAgain      bool
+	pushErr        error
+	pushWait       sync.WaitGroup
+	pushBatchDepth int
+
+	// the lock file, held while the cache is open
+	lockFile *os.File
+	// opened without the lock, see NewReadOnlyRepoCache
+	readOnly bool
 }
 
 func NewRepoCache(r repository.ClockedRepo) (*RepoCache, error) {
+	return newRepoCache(r, false)
+}
+
+// NewReadOnlyRepoCache open a cache without locking the repository, to read
+// it while another process use it. The cache files are read but never
+// written, and the changes are refused with ErrReadOnly.
+func NewReadOnlyRepoCache(r repository.ClockedRepo) (*RepoCache, error) {
+	return newRepoCache(r, true)
+}
+
+func newRepoCache(r repository.ClockedRepo, readOnly bool) (*RepoCache, error) {
 	c := &RepoCache{
-		repo:       r,
-		bugs:       make(map[entity.Id]*BugCache),
-		identities: make(map[entity.Id]*IdentityCache),
+		repo:         r,
+		bugs:         make(map[entity.Id]*BugCache),
+		loadedBugs:   newLruIds(),
+		identities:   make(map[entity.Id]*IdentityCache),
+		pushRequeued: make(map[queuedPush]bool),
+		readOnly:     readOnly,
+	}
+
+	if !readOnly {
+		err := c.lock()
+		if err != nil {
+			return &RepoCache{}, err
+		}
+	}
+
+	err := c.init()
+	if err != nil {
+		_ = c.unlock()
+		return nil, err
 	}
 
-	err := c.lock()
+	return c, nil
+}
+
+// init load the cache from the disk, or build it if needed
+func (c *RepoCache) init() error {
+	var err error
+
+	c.mailmap, err = identity.ReadMailmap(c.repo)
 	if err != nil {
-		return &RepoCache{}, err
+		return err
+	}
+
+	c.labels, err = bug.ReadLabelStore(c.repo)
+	if err != nil {
+		return err
+	}
+
+	c.maxLoadedBugs, err = readMaxLoadedBugs(c.repo)
+	if err != nil {
+		return err
+	}
+
+	if !c.readOnly {
+		c.autoPushRemote, err = readAutoPushRemote(c.repo)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.autoPushRemote != "" {
+		c.autoPushQuery, err = readShareQuery(c.repo, c.autoPushRemote)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = c.load()
 	if err == nil {
-		return c, nil
+		// only the bugs changed since the cache was written are read again
+		_, err = c.refreshBugCache()
+		return err
 	}
-	if _, ok := err.(ErrInvalidCacheFormat); ok {
-		return nil, err
+	switch err := err.(type) {
+	case ErrInvalidCacheFormat:
+		return err
+	case errOutdatedCache:
+		_, _ = fmt.Fprintf(os.Stderr, "The cache of format version %d can't be upgraded to the version %d, it has to be rebuilt.\n", err.version, formatVersion)
 	}
 
 	err = c.buildCache()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return c, c.write()
+	return c.write()
 }
 
 // LocalConfig give access to the repository scoped configuration
@@ -134,55 +274,107 @@ func (c *RepoCache) GetUserEmail() (string, error) {
 	return c.repo.GetUserEmail()
 }
 
-func (c *RepoCache) lock() error {
-	lockPath := repoLockFilePath(c.repo)
+// ReadData read a file stored in the repo, like the files attached to a comment
+func (c *RepoCache) ReadData(hash git.Hash) ([]byte, error) {
+	return c.repo.ReadData(hash)
+}
 
-	err := repoIsAvailable(c.repo)
-	if err != nil {
-		return err
-	}
+// StoreData store a file in the repo, to be attached to a comment
+func (c *RepoCache) StoreData(data []byte) (git.Hash, error) {
+	return c.repo.StoreData(data)
+}
 
-	f, err := os.Create(lockPath)
-	if err != nil {
-		return err
-	}
+// BeginBatch start delaying the ref updates of the bugs and the identities
+// until the matching EndBatch, like during an import
+func (c *RepoCache) BeginBatch() {
+	c.repo.BeginBatch()
 
-	pid := fmt.Sprintf("%d", os.Getpid())
-	_, err = f.WriteString(pid)
-	if err != nil {
-		return err
+	c.pushMutex.Lock()
+	c.pushBatchDepth++
+	c.pushMutex.Unlock()
+}
+
+// EndBatch write the ref updates delayed since BeginBatch at once, and pack
+// what was written meanwhile. The refs queued for the auto-push meanwhile
+// are then pushed at once.
+func (c *RepoCache) EndBatch() error {
+	err := c.repo.EndBatch()
+
+	c.pushMutex.Lock()
+	defer c.pushMutex.Unlock()
+
+	c.pushBatchDepth--
+	if c.pushBatchDepth == 0 && len(c.pushRequeued) > 0 {
+		c.startPushLocked()
 	}
 
-	return f.Close()
+	return err
 }
 
 func (c *RepoCache) Close() error {
+	// let the hook handlers and the background push finish
+	c.WaitHooks()
+
+	if err := c.waitPush(); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "warning: the auto-push failed, the changes are queued until \"git bug sync --flush\": %v\n", err)
+	}
+
+	c.muBug.Lock()
+	c.muIdentity.Lock()
 	c.identities = make(map[entity.Id]*IdentityCache)
 	c.identitiesExcerpts = nil
 	c.bugs = make(map[entity.Id]*BugCache)
+	c.loadedBugs = newLruIds()
 	c.bugExcerpts = nil
+	c.fullTextIndex = nil
+	c.muIdentity.Unlock()
+	c.muBug.Unlock()
 
-	lockPath := repoLockFilePath(c.repo)
-	return os.Remove(lockPath)
+	c.commitIndexMutex.Lock()
+	c.commitIndex = nil
+	c.commitLinks = nil
+	c.commitIndexMutex.Unlock()
+
+	return c.unlock()
 }
 
 // bugUpdated is a callback to trigger when the excerpt of a bug changed,
 // that is each time a bug is updated
-func (c *RepoCache) bugUpdated(id entity.Id) error {
-	b, ok := c.bugs[id]
-	if !ok {
-		panic("missing bug in the cache")
+func (c *RepoCache) bugUpdated(b *BugCache) error {
+	if err := c.writable(); err != nil {
+		return err
 	}
 
+	id := b.Id()
+
+	c.muBug.Lock()
+	defer c.muBug.Unlock()
+
+	// a bug still used after being unloaded is loaded again
+	c.bugs[id] = b
+	c.bugLoaded(id)
+
 	c.bugExcerpts[id] = NewBugExcerpt(b.bug, b.Snapshot())
+	c.fullTextIndex.update(id, b.Snapshot())
 
-	// we only need to write the bug cache
-	return c.writeBugCache()
+	// we only need to write the bug cache and the index
+	err := c.writeBugCache()
+	if err != nil {
+		return err
+	}
+	return c.writeFullTextIndex()
 }
 
 // identityUpdated is a callback to trigger when the excerpt of an identity
 // changed, that is each time an identity is updated
 func (c *RepoCache) identityUpdated(id entity.Id) error {
+	if err := c.writable(); err != nil {
+		return err
+	}
+
+	c.muIdentity.Lock()
+	defer c.muIdentity.Unlock()
+
 	i, ok := c.identities[id]
 	if !ok {
 		panic("missing identity in the cache")
@@ -196,18 +388,33 @@ func (c *RepoCache) identityUpdated(id entity.Id) error {
 
 // load will try to read from the disk all the cache files
 func (c *RepoCache) load() error {
-	err := c.loadBugCache()
+	bugVersion, err := c.loadBugCache()
+	if err != nil {
+		return err
+	}
+	indexVersion, err := c.loadFullTextIndex()
 	if err != nil {
 		return err
 	}
-	return c.loadIdentityCache()
+	identityVersion, err := c.loadIdentityCache()
+	if err != nil {
+		return err
+	}
+
+	if bugVersion != indexVersion || bugVersion != identityVersion {
+		return fmt.Errorf("inconsistent cache format versions")
+	}
+	if bugVersion < formatVersion {
+		return c.upgrade(bugVersion)
+	}
+	return nil
 }
 
 // load will try to read from the disk the bug cache file
-func (c *RepoCache) loadBugCache() error {
+func (c *RepoCache) loadBugCache() (uint, error) {
 	f, err := os.Open(bugCacheFilePath(c.repo))
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	decoder := gob.NewDecoder(f)
@@ -219,24 +426,23 @@ func (c *RepoCache) loadBugCache() error {
 
 	err = decoder.Decode(&aux)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	if aux.Version != 2 {
-		return ErrInvalidCacheFormat{
-			message: fmt.Sprintf("unknown cache format version %v", aux.Version),
-		}
+	err = checkFormatVersion(aux.Version)
+	if err != nil {
+		return 0, err
 	}
 
 	c.bugExcerpts = aux.Excerpts
-	return nil
+	return aux.Version, nil
 }
 
 // load will try to read from the disk the identity cache file
-func (c *RepoCache) loadIdentityCache() error {
+func (c *RepoCache) loadIdentityCache() (uint, error) {
 	f, err := os.Open(identityCacheFilePath(c.repo))
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	decoder := gob.NewDecoder(f)
@@ -248,30 +454,42 @@ func (c *RepoCache) loadIdentityCache() error {
 
 	err = decoder.Decode(&aux)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	if aux.Version != 2 {
-		return ErrInvalidCacheFormat{
-			message: fmt.Sprintf("unknown cache format version %v", aux.Version),
-		}
+	err = checkFormatVersion(aux.Version)
+	if err != nil {
+		return 0, err
 	}
 
 	c.identitiesExcerpts = aux.Excerpts
-	return nil
+	return aux.Version, nil
 }
 
-// write will serialize on disk all the cache files
+// write will serialize on disk all the cache files. The muBug and muIdentity
+// must be held.
 func (c *RepoCache) write() error {
+	if c.readOnly {
+		return nil
+	}
+
 	err := c.writeBugCache()
 	if err != nil {
 		return err
 	}
+	err = c.writeFullTextIndex()
+	if err != nil {
+		return err
+	}
 	return c.writeIdentityCache()
 }
 
-// write will serialize on disk the bug cache file
+// write will serialize on disk the bug cache file. The muBug must be held.
 func (c *RepoCache) writeBugCache() error {
+	if c.readOnly {
+		return nil
+	}
+
 	var data bytes.Buffer
 
 	aux := struct {
@@ -289,21 +507,16 @@ func (c *RepoCache) writeBugCache() error {
 		return err
 	}
 
-	f, err := os.Create(bugCacheFilePath(c.repo))
-	if err != nil {
-		return err
-	}
-
-	_, err = f.Write(data.Bytes())
-	if err != nil {
-		return err
-	}
-
-	return f.Close()
+	return writeCacheFile(bugCacheFilePath(c.repo), data.Bytes())
 }
 
-// write will serialize on disk the identity cache file
+// write will serialize on disk the identity cache file. The muIdentity must
+// be held.
 func (c *RepoCache) writeIdentityCache() error {
+	if c.readOnly {
+		return nil
+	}
+
 	var data bytes.Buffer
 
 	aux := struct {
@@ -321,17 +534,7 @@ func (c *RepoCache) writeIdentityCache() error {
 		return err
 	}
 
-	f, err := os.Create(identityCacheFilePath(c.repo))
-	if err != nil {
-		return err
-	}
-
-	_, err = f.Write(data.Bytes())
-	if err != nil {
-		return err
-	}
-
-	return f.Close()
+	return writeCacheFile(identityCacheFilePath(c.repo), data.Bytes())
 }
 
 func bugCacheFilePath(repo repository.Repo) string {
@@ -362,6 +565,7 @@ func (c *RepoCache) buildCache() error {
 	_, _ = fmt.Fprintf(os.Stderr, "Building bug cache... ")
 
 	c.bugExcerpts = make(map[entity.Id]*BugExcerpt)
+	c.fullTextIndex = newFullTextIndex()
 
 	allBugs := bug.ReadAllLocalBugs(c.repo)
 
@@ -372,16 +576,68 @@ func (c *RepoCache) buildCache() error {
 
 		snap := b.Bug.Compile()
 		c.bugExcerpts[b.Bug.Id()] = NewBugExcerpt(b.Bug, &snap)
+		c.fullTextIndex.update(b.Bug.Id(), &snap)
 	}
 
 	_, _ = fmt.Fprintln(os.Stderr, "Done.")
 	return nil
 }
 
+// refreshBugCache update the excerpts of the bugs changed outside of the
+// cache, by a git fetch of the bug refs for example, by comparing the last
+// commit of each bug with the one of its excerpt. It return the ids of the
+// changed bugs. The muBug must be held.
+func (c *RepoCache) refreshBugCache() ([]entity.Id, error) {
+	heads, err := bug.ListLocalHeads(c.repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []entity.Id
+	for id, head := range heads {
+		excerpt, ok := c.bugExcerpts[id]
+		if !ok || excerpt.Head != head {
+			changed = append(changed, id)
+		}
+	}
+
+	for b := range bug.ReadLocalBugs(c.repo, changed) {
+		if b.Err != nil {
+			return nil, b.Err
+		}
+
+		snap := b.Bug.Compile()
+		c.bugExcerpts[b.Bug.Id()] = NewBugExcerpt(b.Bug, &snap)
+		c.fullTextIndex.update(b.Bug.Id(), &snap)
+	}
+
+	for id := range c.bugExcerpts {
+		if _, ok := heads[id]; !ok {
+			delete(c.bugExcerpts, id)
+			c.fullTextIndex.remove(id)
+			changed = append(changed, id)
+		}
+	}
+
+	if len(changed) == 0 {
+		return nil, nil
+	}
+
+	err = c.writeBugCache()
+	if err != nil {
+		return nil, err
+	}
+	return changed, c.writeFullTextIndex()
+}
+
 // ResolveBug retrieve a bug matching the exact given id
 func (c *RepoCache) ResolveBug(id entity.Id) (*BugCache, error) {
+	c.muBug.Lock()
+	defer c.muBug.Unlock()
+
 	cached, ok := c.bugs[id]
 	if ok {
+		c.bugLoaded(id)
 		return cached, nil
 	}
 
@@ -391,13 +647,19 @@ func (c *RepoCache) ResolveBug(id entity.Id) (*BugCache, error) {
 	}
 
 	cached = NewBugCache(c, b)
+	// compiled under the lock, as the loaded bug can be read concurrently
+	cached.Snapshot()
 	c.bugs[id] = cached
+	c.bugLoaded(id)
 
 	return cached, nil
 }
 
 // ResolveBugExcerpt retrieve a BugExcerpt matching the exact given id
 func (c *RepoCache) ResolveBugExcerpt(id entity.Id) (*BugExcerpt, error) {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
 	e, ok := c.bugExcerpts[id]
 	if !ok {
 		return nil, bug.ErrBugNotExist
@@ -412,11 +674,13 @@ func (c *RepoCache) ResolveBugPrefix(prefix string) (*BugCache, error) {
 	// preallocate but empty
 	matching := make([]entity.Id, 0, 5)
 
+	c.muBug.RLock()
 	for id := range c.bugExcerpts {
 		if id.HasPrefix(prefix) {
 			matching = append(matching, id)
 		}
 	}
+	c.muBug.RUnlock()
 
 	if len(matching) > 1 {
 		return nil, bug.NewErrMultipleMatchBug(matching)
@@ -436,11 +700,13 @@ func (c *RepoCache) ResolveBugCreateMetadata(key string, value string) (*BugCach
 	// preallocate but empty
 	matching := make([]entity.Id, 0, 5)
 
+	c.muBug.RLock()
 	for id, excerpt := range c.bugExcerpts {
 		if excerpt.CreateMetadata[key] == value {
 			matching = append(matching, id)
 		}
 	}
+	c.muBug.RUnlock()
 
 	if len(matching) > 1 {
 		return nil, bug.NewErrMultipleMatchBug(matching)
@@ -459,32 +725,7 @@ func (c *RepoCache) QueryBugs(query *Query) []entity.Id {
 		return c.AllBugsIds()
 	}
 
-	var filtered []*BugExcerpt
-
-	for _, excerpt := range c.bugExcerpts {
-		if query.Match(c, excerpt) {
-			filtered = append(filtered, excerpt)
-		}
-	}
-
-	var sorter sort.Interface
-
-	switch query.OrderBy {
-	case OrderById:
-		sorter = BugsById(filtered)
-	case OrderByCreation:
-		sorter = BugsByCreationTime(filtered)
-	case OrderByEdit:
-		sorter = BugsByEditTime(filtered)
-	default:
-		panic("missing sort type")
-	}
-
-	if query.OrderDirection == OrderDescending {
-		sorter = sort.Reverse(sorter)
-	}
-
-	sort.Sort(sorter)
+	filtered := c.QueryBugExcerpts(query)
 
 	result := make([]entity.Id, len(filtered))
 
@@ -495,8 +736,24 @@ func (c *RepoCache) QueryBugs(query *Query) []entity.Id {
 	return result
 }
 
+// QueryBugExcerpts return the excerpts of the bugs matching a query, sorted,
+// for the callers needing them all without resolving them one by one. They
+// are shared with the cache, and must not be changed.
+func (c *RepoCache) QueryBugExcerpts(query *Query) []*BugExcerpt {
+	filtered := c.matchingExcerpts(query)
+
+	if query != nil {
+		sort.Sort(newBugsMultiSorter(filtered, query.Sorting))
+	}
+
+	return filtered
+}
+
 // AllBugsIds return all known bug ids
 func (c *RepoCache) AllBugsIds() []entity.Id {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
 	result := make([]entity.Id, len(c.bugExcerpts))
 
 	i := 0
@@ -508,19 +765,22 @@ func (c *RepoCache) AllBugsIds() []entity.Id {
 	return result
 }
 
-// ValidLabels list valid labels
-//
-// Note: in the future, a proper label policy could be implemented where valid
-// labels are defined in a configuration file. Until that, the default behavior
-// is to return the list of labels already used.
+// ValidLabels list valid labels: the labels defined in the repository and
+// the ones already used, with the renamed labels under their current name.
 func (c *RepoCache) ValidLabels() []bug.Label {
 	set := map[bug.Label]interface{}{}
 
+	c.muBug.RLock()
 	for _, excerpt := range c.bugExcerpts {
 		for _, l := range excerpt.Labels {
-			set[l] = nil
+			set[c.labels.Resolve(l)] = nil
 		}
 	}
+	c.muBug.RUnlock()
+
+	for _, def := range c.labels.Definitions() {
+		set[def.Name] = nil
+	}
 
 	result := make([]bug.Label, len(set))
 
@@ -538,6 +798,70 @@ func (c *RepoCache) ValidLabels() []bug.Label {
 	return result
 }
 
+// LabelUsage return the number of bugs having a label, the renamed labels
+// being counted under their current name
+func (c *RepoCache) LabelUsage(label bug.Label) int {
+	label = c.labels.Resolve(label)
+
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
+	count := 0
+	for _, excerpt := range c.bugExcerpts {
+		for _, l := range excerpt.Labels {
+			if c.labels.Resolve(l) == label {
+				count++
+				break
+			}
+		}
+	}
+
+	return count
+}
+
+// LabelStore give access to the label definitions of the repository, to
+// resolve renamed labels and get their color and description
+func (c *RepoCache) LabelStore() *bug.LabelStore {
+	return c.labels
+}
+
+// SetLabelColor define the color of a label
+func (c *RepoCache) SetLabelColor(label bug.Label, color bug.LabelColor) error {
+	if err := c.writable(); err != nil {
+		return err
+	}
+
+	return c.labels.SetColor(c.repo, label, color)
+}
+
+// SetLabelDescription define the description of a label
+func (c *RepoCache) SetLabelDescription(label bug.Label, description string) error {
+	if err := c.writable(); err != nil {
+		return err
+	}
+
+	return c.labels.SetDescription(c.repo, label, description)
+}
+
+// RenameLabel rename a label. The bugs are not modified, the old name become
+// an alias of the new one.
+func (c *RepoCache) RenameLabel(old bug.Label, new bug.Label) error {
+	if err := c.writable(); err != nil {
+		return err
+	}
+
+	return c.labels.Rename(c.repo, old, new)
+}
+
+// RemoveLabelDefinition remove the color and description of a label
+func (c *RepoCache) RemoveLabelDefinition(label bug.Label) error {
+	if err := c.writable(); err != nil {
+		return err
+	}
+
+	return c.labels.Remove(c.repo, label)
+}
+
 // NewBug create a new bug
 // The new bug is written in the repository (commit)
 func (c *RepoCache) NewBug(title string, message string) (*BugCache, *bug.CreateOperation, error) {
@@ -559,6 +883,10 @@ func (c *RepoCache) NewBugWithFiles(title string, message string, files []git.Ha
 // well as metadata for the Create operation.
 // The new bug is written in the repository (commit)
 func (c *RepoCache) NewBugRaw(author *IdentityCache, unixTime int64, title string, message string, files []git.Hash, metadata map[string]string) (*BugCache, *bug.CreateOperation, error) {
+	if err := c.writable(); err != nil {
+		return nil, nil, err
+	}
+
 	b, op, err := bug.CreateWithFiles(author.Identity, unixTime, title, message, files)
 	if err != nil {
 		return nil, nil, err
@@ -568,20 +896,43 @@ func (c *RepoCache) NewBugRaw(author *IdentityCache, unixTime int64, title strin
 		op.SetMetadata(key, value)
 	}
 
+	// give a chance to the pre-add hook to reject the bug
+	err = c.RunHook(HookPreAdd, "", op)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	err = b.Commit(c.repo)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	if _, has := c.bugs[b.Id()]; has {
+	c.muBug.RLock()
+	_, has := c.bugs[b.Id()]
+	c.muBug.RUnlock()
+	if has {
 		return nil, nil, fmt.Errorf("bug %s already exist in the cache", b.Id())
 	}
 
 	cached := NewBugCache(c, b)
-	c.bugs[b.Id()] = cached
 
-	// force the write of the excerpt
-	err = c.bugUpdated(b.Id())
+	// register the bug and force the write of the excerpt
+	err = c.bugUpdated(cached)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = c.bugCommitted(b.Id())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = c.RunHook(HookPostAdd, b.Id(), op)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = c.RunHook(HookPostOperation, b.Id(), op)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -589,6 +940,37 @@ func (c *RepoCache) NewBugRaw(author *IdentityCache, unixTime int64, title strin
 	return cached, op, nil
 }
 
+// RemoveBug delete a bug locally. The bug is not merged back by a later pull.
+func (c *RepoCache) RemoveBug(id entity.Id) error {
+	if err := c.writable(); err != nil {
+		return err
+	}
+
+	err := bug.Remove(c.repo, id)
+	if err != nil {
+		return err
+	}
+
+	c.muBug.Lock()
+	defer c.muBug.Unlock()
+
+	delete(c.bugs, id)
+	c.loadedBugs.remove(id)
+	delete(c.bugExcerpts, id)
+	c.fullTextIndex.remove(id)
+
+	err = c.writeBugCache()
+	if err != nil {
+		return err
+	}
+	return c.writeFullTextIndex()
+}
+
+// RemoveBugRemote delete a bug on a remote
+func (c *RepoCache) RemoveBugRemote(remote string, id entity.Id) (string, error) {
+	return bug.RemoveRemote(c.repo, remote, id)
+}
+
 // Fetch retrieve updates from a remote
 // This does not change the local bugs or identities state
 func (c *RepoCache) Fetch(remote string) (string, error) {
@@ -606,9 +988,18 @@ func (c *RepoCache) Fetch(remote string) (string, error) {
 }
 
 // MergeAll will merge all the available remote bug and identities
+// The remote bugs not matching the share query of the remote are left out.
 func (c *RepoCache) MergeAll(remote string) <-chan entity.MergeResult {
 	out := make(chan entity.MergeResult)
 
+	if err := c.writable(); err != nil {
+		go func() {
+			out <- entity.NewMergeError(err, "")
+			close(out)
+		}()
+		return out
+	}
+
 	// Intercept merge results to update the cache properly
 	go func() {
 		defer close(out)
@@ -624,10 +1015,19 @@ func (c *RepoCache) MergeAll(remote string) <-chan entity.MergeResult {
 			switch result.Status {
 			case entity.MergeStatusNew, entity.MergeStatusUpdated:
 				i := result.Entity.(*identity.Identity)
+				c.muIdentity.Lock()
 				c.identitiesExcerpts[result.Id] = NewIdentityExcerpt(i)
+				c.muIdentity.Unlock()
 			}
 		}
 
+		// the remote bugs are read with the identities merged above
+		err := c.filterFetchedBugs(remote)
+		if err != nil {
+			out <- entity.NewMergeError(err, "")
+			return
+		}
+
 		results = bug.MergeAll(c.repo, remote)
 		for result := range results {
 			out <- result
@@ -640,11 +1040,18 @@ func (c *RepoCache) MergeAll(remote string) <-chan entity.MergeResult {
 			case entity.MergeStatusNew, entity.MergeStatusUpdated:
 				b := result.Entity.(*bug.Bug)
 				snap := b.Compile()
+				c.muBug.Lock()
 				c.bugExcerpts[result.Id] = NewBugExcerpt(b, &snap)
+				c.fullTextIndex.update(result.Id, &snap)
+				c.muBug.Unlock()
 			}
 		}
 
-		err := c.write()
+		c.muBug.Lock()
+		c.muIdentity.Lock()
+		err = c.write()
+		c.muIdentity.Unlock()
+		c.muBug.Unlock()
 
 		// No easy way out here ..
 		if err != nil {
@@ -656,7 +1063,21 @@ func (c *RepoCache) MergeAll(remote string) <-chan entity.MergeResult {
 }
 
 // Push update a remote with the local changes
+// With a share query for the remote, only the matching bugs and the identities
+// they reference are pushed.
 func (c *RepoCache) Push(remote string) (string, error) {
+	query, err := readShareQuery(c.repo, remote)
+	if err != nil {
+		return "", err
+	}
+	if query != nil {
+		stdout, err := c.pushShared(remote, query)
+		if err != nil {
+			return stdout, err
+		}
+		return stdout, c.clearPushQueue(remote)
+	}
+
 	stdout1, err := identity.Push(c.repo, remote)
 	if err != nil {
 		return stdout1, err
@@ -667,12 +1088,22 @@ func (c *RepoCache) Push(remote string) (string, error) {
 		return stdout2, err
 	}
 
+	// everything queued for the auto-push is pushed as well
+	err = c.clearPushQueue(remote)
+	if err != nil {
+		return stdout1 + stdout2, err
+	}
+
 	return stdout1 + stdout2, nil
 }
 
 // Pull will do a Fetch + MergeAll
 // This function will return an error if a merge fail
 func (c *RepoCache) Pull(remote string) error {
+	if err := c.writable(); err != nil {
+		return err
+	}
+
 	_, err := c.Fetch(remote)
 	if err != nil {
 		return err
@@ -694,68 +1125,11 @@ func repoLockFilePath(repo repository.Repo) string {
 	return path.Join(repo.GetPath(), "git-bug", lockfile)
 }
 
-// repoIsAvailable check is the given repository is locked by a Cache.
-// Note: this is a smart function that will cleanup the lock file if the
-// corresponding process is not there anymore.
-// If no error is returned, the repo is free to edit.
-func repoIsAvailable(repo repository.Repo) error {
-	lockPath := repoLockFilePath(repo)
-
-	// Todo: this leave way for a racey access to the repo between the test
-	// if the file exist and the actual write. It's probably not a problem in
-	// practice because using a repository will be done from user interaction
-	// or in a context where a single instance of git-bug is already guaranteed
-	// (say, a server with the web UI running). But still, that might be nice to
-	// have a mutex or something to guard that.
-
-	// Todo: this will fail if somehow the filesystem is shared with another
-	// computer. Should add a configuration that prevent the cleaning of the
-	// lock file
-
-	f, err := os.Open(lockPath)
-
-	if err != nil && !os.IsNotExist(err) {
-		return err
-	}
-
-	if err == nil {
-		// lock file already exist
-		buf, err := ioutil.ReadAll(io.LimitReader(f, 10))
-		if err != nil {
-			return err
-		}
-		if len(buf) == 10 {
-			return fmt.Errorf("the lock file should be < 10 bytes")
-		}
-
-		pid, err := strconv.Atoi(string(buf))
-		if err != nil {
-			return err
-		}
-
-		if process.IsRunning(pid) {
-			return fmt.Errorf("the repository you want to access is already locked by the process pid %d", pid)
-		}
-
-		// The lock file is just laying there after a crash, clean it
-
-		fmt.Println("A lock file is present but the corresponding process is not, removing it.")
-		err = f.Close()
-		if err != nil {
-			return err
-		}
-
-		err = os.Remove(lockPath)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
 // ResolveIdentity retrieve an identity matching the exact given id
 func (c *RepoCache) ResolveIdentity(id entity.Id) (*IdentityCache, error) {
+	c.muIdentity.Lock()
+	defer c.muIdentity.Unlock()
+
 	cached, ok := c.identities[id]
 	if ok {
 		return cached, nil
@@ -774,7 +1148,7 @@ func (c *RepoCache) ResolveIdentity(id entity.Id) (*IdentityCache, error) {
 
 // ResolveIdentityExcerpt retrieve a IdentityExcerpt matching the exact given id
 func (c *RepoCache) ResolveIdentityExcerpt(id entity.Id) (*IdentityExcerpt, error) {
-	e, ok := c.identitiesExcerpts[id]
+	e, ok := c.identityExcerpt(id)
 	if !ok {
 		return nil, identity.ErrIdentityNotExist
 	}
@@ -782,17 +1156,38 @@ func (c *RepoCache) ResolveIdentityExcerpt(id entity.Id) (*IdentityExcerpt, erro
 	return e, nil
 }
 
+func (c *RepoCache) identityExcerpt(id entity.Id) (*IdentityExcerpt, bool) {
+	c.muIdentity.RLock()
+	defer c.muIdentity.RUnlock()
+
+	e, ok := c.identitiesExcerpts[id]
+	return e, ok
+}
+
+// ResolveCanonicalIdentityExcerpt retrieve a IdentityExcerpt matching the exact
+// given id, with the name and email rewritten according to the mailmap
+func (c *RepoCache) ResolveCanonicalIdentityExcerpt(id entity.Id) (*IdentityExcerpt, error) {
+	e, err := c.ResolveIdentityExcerpt(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.Canonical(c.mailmap), nil
+}
+
 // ResolveIdentityPrefix retrieve an Identity matching an id prefix.
 // It fails if multiple identities match.
 func (c *RepoCache) ResolveIdentityPrefix(prefix string) (*IdentityCache, error) {
 	// preallocate but empty
 	matching := make([]entity.Id, 0, 5)
 
+	c.muIdentity.RLock()
 	for id := range c.identitiesExcerpts {
 		if id.HasPrefix(prefix) {
 			matching = append(matching, id)
 		}
 	}
+	c.muIdentity.RUnlock()
 
 	if len(matching) > 1 {
 		return nil, identity.NewErrMultipleMatch(matching)
@@ -811,11 +1206,13 @@ func (c *RepoCache) ResolveIdentityImmutableMetadata(key string, value string) (
 	// preallocate but empty
 	matching := make([]entity.Id, 0, 5)
 
+	c.muIdentity.RLock()
 	for id, i := range c.identitiesExcerpts {
 		if i.ImmutableMetadata[key] == value {
 			matching = append(matching, id)
 		}
 	}
+	c.muIdentity.RUnlock()
 
 	if len(matching) > 1 {
 		return nil, identity.NewErrMultipleMatch(matching)
@@ -830,6 +1227,9 @@ func (c *RepoCache) ResolveIdentityImmutableMetadata(key string, value string) (
 
 // AllIdentityIds return all known identity ids
 func (c *RepoCache) AllIdentityIds() []entity.Id {
+	c.muIdentity.RLock()
+	defer c.muIdentity.RUnlock()
+
 	result := make([]entity.Id, len(c.identitiesExcerpts))
 
 	i := 0
@@ -842,11 +1242,18 @@ func (c *RepoCache) AllIdentityIds() []entity.Id {
 }
 
 func (c *RepoCache) SetUserIdentity(i *IdentityCache) error {
+	if err := c.writable(); err != nil {
+		return err
+	}
+
 	err := identity.SetUserIdentity(c.repo, i.Identity)
 	if err != nil {
 		return err
 	}
 
+	c.muIdentity.Lock()
+	defer c.muIdentity.Unlock()
+
 	// Make sure that everything is fine
 	if _, ok := c.identities[i.Id()]; !ok {
 		panic("SetUserIdentity while the identity is not from the cache, something is wrong")
@@ -858,6 +1265,9 @@ func (c *RepoCache) SetUserIdentity(i *IdentityCache) error {
 }
 
 func (c *RepoCache) GetUserIdentity() (*IdentityCache, error) {
+	c.muIdentity.Lock()
+	defer c.muIdentity.Unlock()
+
 	if c.userIdentityId != "" {
 		i, ok := c.identities[c.userIdentityId]
 		if ok {
@@ -890,6 +1300,10 @@ func (c *RepoCache) NewIdentityFull(name string, email string, login string, ava
 }
 
 func (c *RepoCache) NewIdentityRaw(name string, email string, login string, avatarUrl string, metadata map[string]string) (*IdentityCache, error) {
+	if err := c.writable(); err != nil {
+		return nil, err
+	}
+
 	i := identity.NewIdentityFull(name, email, login, avatarUrl)
 
 	for key, value := range metadata {
@@ -901,12 +1315,15 @@ func (c *RepoCache) NewIdentityRaw(name string, email string, login string, avat
 		return nil, err
 	}
 
+	c.muIdentity.Lock()
 	if _, has := c.identities[i.Id()]; has {
+		c.muIdentity.Unlock()
 		return nil, fmt.Errorf("identity %s already exist in the cache", i.Id())
 	}
 
 	cached := NewIdentityCache(c, i)
 	c.identities[i.Id()] = cached
+	c.muIdentity.Unlock()
 
 	// force the write of the excerpt
 	err = c.identityUpdated(i.Id())
@@ -914,5 +1331,10 @@ func (c *RepoCache) NewIdentityRaw(name string, email string, login string, avat
 		return nil, err
 	}
 
+	err = c.identityCommitted(i.Id())
+	if err != nil {
+		return nil, err
+	}
+
 	return cached, nil
 }
@@ -2,9 +2,12 @@ package cache
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/misc/random_bugs"
 	"github.com/MichaelMure/git-bug/repository"
 )
 
@@ -102,6 +105,61 @@ func TestCache(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCacheRefresh(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(iden))
+
+	bug1, _, err := cache.NewBug("title1", "message")
+	require.NoError(t, err)
+	bug2, _, err := cache.NewBug("title2", "message")
+	require.NoError(t, err)
+	bug3, _, err := cache.NewBug("title3", "message")
+	require.NoError(t, err)
+
+	head3 := cache.bugExcerpts[bug3.Id()].Head
+	require.NotEmpty(t, head3)
+
+	require.NoError(t, cache.Close())
+
+	// change the bugs without the cache, like a git fetch would
+	b, err := bug.ReadLocalBug(repo, bug1.Id())
+	require.NoError(t, err)
+	_, err = bug.SetTitle(b, iden.Identity, time.Now().Unix(), "edited")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit(repo))
+
+	require.NoError(t, bug.Remove(repo, bug2.Id()))
+
+	b, _, err = bug.Create(iden.Identity, time.Now().Unix(), "new", "message")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit(repo))
+
+	cache, err = NewRepoCache(repo)
+	require.NoError(t, err)
+
+	require.Len(t, cache.bugExcerpts, 3)
+	require.Equal(t, "edited", cache.bugExcerpts[bug1.Id()].Title)
+	require.NotContains(t, cache.bugExcerpts, bug2.Id())
+	require.Equal(t, "new", cache.bugExcerpts[b.Id()].Title)
+	require.Equal(t, head3, cache.bugExcerpts[bug3.Id()].Head)
+
+	// the full-text index follow
+	results, err := cache.Search("edited")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, bug1.Id(), results[0].Id)
+	results, err = cache.Search("title2")
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
+
 func TestPushPull(t *testing.T) {
 	repoA, repoB, remote := repository.SetupReposAndRemote(t)
 	defer repository.CleanupTestRepos(t, repoA, repoB, remote)
@@ -156,3 +214,67 @@ func TestPushPull(t *testing.T) {
 
 	require.Len(t, cacheA.AllBugsIds(), 2)
 }
+
+func TestCacheMaxLoadedBugs(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	require.NoError(t, repo.LocalConfig().StoreString(maxLoadedBugsConfigKey, "2"))
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	require.Equal(t, 2, cache.maxLoadedBugs)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(iden))
+
+	bug1, _, err := cache.NewBug("title1", "message")
+	require.NoError(t, err)
+	bug2, _, err := cache.NewBug("title2", "message")
+	require.NoError(t, err)
+
+	// a bug with pending changes is kept
+	_, err = bug1.SetTitleRaw(iden, time.Now().Unix(), "edited", nil)
+	require.NoError(t, err)
+	require.True(t, bug1.NeedCommit())
+
+	bug3, _, err := cache.NewBug("title3", "message")
+	require.NoError(t, err)
+	require.Len(t, cache.bugs, 2)
+	require.Contains(t, cache.bugs, bug1.Id())
+	require.Contains(t, cache.bugs, bug3.Id())
+
+	// loaded again when needed
+	resolved, err := cache.ResolveBug(bug2.Id())
+	require.NoError(t, err)
+	require.Equal(t, "title2", resolved.Snapshot().Title)
+	require.Len(t, cache.bugs, 2)
+	require.Contains(t, cache.bugs, bug1.Id())
+	require.NotContains(t, cache.bugs, bug3.Id())
+
+	// once committed, it's unloaded like the others
+	require.NoError(t, bug1.Commit())
+	_, err = cache.ResolveBug(bug3.Id())
+	require.NoError(t, err)
+	require.NotContains(t, cache.bugs, bug2.Id())
+	_, err = cache.ResolveBug(bug2.Id())
+	require.NoError(t, err)
+	require.Len(t, cache.bugs, 2)
+	require.NotContains(t, cache.bugs, bug1.Id())
+}
+
+func BenchmarkBuildCache(b *testing.B) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(b, repo)
+
+	random_bugs.FillRepoWithSeed(repo, 50, 42)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(b, err)
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		require.NoError(b, cache.buildCache())
+	}
+}
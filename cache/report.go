@@ -0,0 +1,240 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ReportInterval is the duration of the periods of a report
+type ReportInterval int
+
+const (
+	_ ReportInterval = iota
+	ReportDaily
+	ReportWeekly
+	ReportMonthly
+)
+
+func (i ReportInterval) String() string {
+	switch i {
+	case ReportDaily:
+		return "day"
+	case ReportWeekly:
+		return "week"
+	case ReportMonthly:
+		return "month"
+	default:
+		return "unknown interval"
+	}
+}
+
+func ReportIntervalFromString(str string) (ReportInterval, error) {
+	switch str {
+	case "day":
+		return ReportDaily, nil
+	case "week":
+		return ReportWeekly, nil
+	case "month":
+		return ReportMonthly, nil
+	default:
+		return 0, fmt.Errorf("unknown interval %s", str)
+	}
+}
+
+// start return the beginning of the period containing t
+func (i ReportInterval) start(t time.Time) time.Time {
+	year, month, day := t.Date()
+
+	switch i {
+	case ReportWeekly:
+		return weekStart(t)
+	case ReportMonthly:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	}
+}
+
+func (i ReportInterval) next(t time.Time) time.Time {
+	switch i {
+	case ReportWeekly:
+		return t.AddDate(0, 0, 7)
+	case ReportMonthly:
+		return t.AddDate(0, 1, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
+
+// ReportGroupBy select how the bugs are split in the series of a report
+type ReportGroupBy int
+
+const (
+	_ ReportGroupBy = iota
+	ReportGroupByNone
+	ReportGroupByLabel
+	ReportGroupByAuthor
+)
+
+func (g ReportGroupBy) String() string {
+	switch g {
+	case ReportGroupByNone:
+		return "none"
+	case ReportGroupByLabel:
+		return "label"
+	case ReportGroupByAuthor:
+		return "author"
+	default:
+		return "unknown group"
+	}
+}
+
+func ReportGroupByFromString(str string) (ReportGroupBy, error) {
+	switch str {
+	case "none":
+		return ReportGroupByNone, nil
+	case "label":
+		return ReportGroupByLabel, nil
+	case "author":
+		return ReportGroupByAuthor, nil
+	default:
+		return 0, fmt.Errorf("unknown group %s", str)
+	}
+}
+
+// ReportOptions configure the generation of a report
+type ReportOptions struct {
+	// the bugs to include, all of them if nil
+	Query    *Query
+	Since    time.Time
+	Until    time.Time
+	Interval ReportInterval
+	GroupBy  ReportGroupBy
+}
+
+// Report is the activity and burndown data of a set of bugs over time
+type Report struct {
+	// the beginning of each period
+	Periods []time.Time
+	// the series, sorted by name
+	Series []ReportSeries
+}
+
+// ReportSeries is the data of a group of bugs over the periods of a report
+type ReportSeries struct {
+	// the label or author of the group, empty for the bugs without label
+	// or if the bugs are not grouped
+	Name   string
+	Points []ReportPoint
+}
+
+// ReportPoint is the activity of a group of bugs during a period
+type ReportPoint struct {
+	// bugs opened during the period
+	Opened int
+	// bugs closed during the period
+	Closed int
+	// bugs open at the end of the period
+	Open int
+}
+
+// Report compute the number of bugs opened and closed during each period, and
+// the number of bugs still open at the end of each one.
+//
+// As the bug excerpts only keep the last closing time, a bug reopened then
+// closed again is considered open until its last closing.
+func (c *RepoCache) Report(opts ReportOptions) (*Report, error) {
+	ids := c.QueryBugs(opts.Query)
+
+	excerpts := make([]*BugExcerpt, len(ids))
+	c.muBug.RLock()
+	for i, id := range ids {
+		excerpts[i] = c.bugExcerpts[id]
+	}
+	c.muBug.RUnlock()
+
+	var groups func(*BugExcerpt) []string
+
+	switch opts.GroupBy {
+	case ReportGroupByNone:
+		groups = func(*BugExcerpt) []string { return []string{""} }
+	case ReportGroupByLabel:
+		groups = func(excerpt *BugExcerpt) []string {
+			labels := c.labels.ResolveAll(excerpt.Labels)
+			if len(labels) == 0 {
+				return []string{""}
+			}
+			result := make([]string, len(labels))
+			for i, label := range labels {
+				result[i] = label.String()
+			}
+			return result
+		}
+	case ReportGroupByAuthor:
+		groups = func(excerpt *BugExcerpt) []string {
+			return []string{c.excerptAuthorName(excerpt)}
+		}
+	default:
+		return nil, fmt.Errorf("unknown group %d", opts.GroupBy)
+	}
+
+	return computeReport(excerpts, opts, groups)
+}
+
+func computeReport(excerpts []*BugExcerpt, opts ReportOptions, groups func(*BugExcerpt) []string) (*Report, error) {
+	if opts.Until.Before(opts.Since) {
+		return nil, fmt.Errorf("the end of the report is before its beginning")
+	}
+
+	report := &Report{}
+
+	for p := opts.Interval.start(opts.Since); !p.After(opts.Until); p = opts.Interval.next(p) {
+		report.Periods = append(report.Periods, p)
+	}
+
+	series := make(map[string][]ReportPoint)
+
+	for _, excerpt := range excerpts {
+		created := time.Unix(excerpt.CreateUnixTime, 0)
+		var closed time.Time
+		if excerpt.CloseUnixTime != 0 {
+			closed = time.Unix(excerpt.CloseUnixTime, 0)
+		}
+
+		for _, group := range groups(excerpt) {
+			points, ok := series[group]
+			if !ok {
+				points = make([]ReportPoint, len(report.Periods))
+				series[group] = points
+			}
+
+			for i, start := range report.Periods {
+				end := opts.Interval.next(start)
+
+				if !created.Before(start) && created.Before(end) {
+					points[i].Opened++
+				}
+				if !closed.IsZero() && !closed.Before(start) && closed.Before(end) {
+					points[i].Closed++
+				}
+				if created.Before(end) && (closed.IsZero() || !closed.Before(end)) {
+					points[i].Open++
+				}
+			}
+		}
+	}
+
+	for name, points := range series {
+		report.Series = append(report.Series, ReportSeries{
+			Name:   name,
+			Points: points,
+		})
+	}
+
+	sort.Slice(report.Series, func(i, j int) bool {
+		return report.Series[i].Name < report.Series[j].Name
+	})
+
+	return report, nil
+}
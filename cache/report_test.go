@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeReport(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2020, 1, d, 12, 0, 0, 0, time.Local)
+	}
+
+	bugs := []*BugExcerpt{
+		{CreateUnixTime: day(1).Unix(), Title: "a"},
+		{CreateUnixTime: day(1).Unix(), CloseUnixTime: day(2).Unix(), Title: "b"},
+		{CreateUnixTime: day(2).Unix(), CloseUnixTime: day(3).Unix(), Title: "a"},
+	}
+
+	// group by title to test the grouping
+	groups := func(excerpt *BugExcerpt) []string {
+		return []string{excerpt.Title}
+	}
+
+	report, err := computeReport(bugs, ReportOptions{
+		Since:    day(1),
+		Until:    day(3),
+		Interval: ReportDaily,
+	}, groups)
+	require.NoError(t, err)
+
+	require.Len(t, report.Periods, 3)
+	assert.Equal(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.Local), report.Periods[0])
+
+	assert.Equal(t, []ReportSeries{
+		{Name: "a", Points: []ReportPoint{
+			{Opened: 1, Open: 1},
+			{Opened: 1, Open: 2},
+			{Closed: 1, Open: 1},
+		}},
+		{Name: "b", Points: []ReportPoint{
+			{Opened: 1, Open: 1},
+			{Closed: 1, Open: 0},
+			{},
+		}},
+	}, report.Series)
+
+	_, err = computeReport(bugs, ReportOptions{
+		Since:    day(3),
+		Until:    day(1),
+		Interval: ReportDaily,
+	}, groups)
+	assert.Error(t, err)
+}
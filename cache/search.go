@@ -0,0 +1,176 @@
+package cache
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// a word found in the title weight as much as this number of words found in
+// the comments
+const searchTitleWeight = 3
+
+// the number of excerpts given for each bug, and their length in characters
+const (
+	searchMaxMatches = 3
+	searchExcerptLen = 200
+	// the characters kept before the first word found
+	searchExcerptContext = 60
+)
+
+// SearchResult is a bug found by a full-text search, with the excerpts of its
+// texts containing the words searched
+type SearchResult struct {
+	Id entity.Id
+	// the relevance of the bug, higher first
+	Score   int
+	Matches []SearchMatch
+}
+
+// SearchMatch is an excerpt of the title or of a comment containing the
+// words searched
+type SearchMatch struct {
+	// the comment, or -1 for the title
+	Comment int
+	Excerpt []TextPart
+}
+
+// TextPart is a piece of an excerpt, highlighted if it's a word searched
+type TextPart struct {
+	Text        string
+	Highlighted bool
+}
+
+// Search find the bugs containing all the words of a query in their title or
+// comments, the most relevant first: the ones where they appear the most,
+// preferably in the title.
+func (c *RepoCache) Search(query string) ([]SearchResult, error) {
+	words := tokenize(query)
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	wordSet := make(map[string]bool, len(words))
+	for _, word := range words {
+		wordSet[word] = true
+	}
+
+	var result []SearchResult
+
+	for _, id := range c.fullTextIndex.lookup(words) {
+		b, err := c.ResolveBug(id)
+		if err != nil {
+			return nil, err
+		}
+		snap := b.Snapshot()
+
+		found := SearchResult{Id: id}
+
+		texts := make([]string, 0, len(snap.Comments)+1)
+		texts = append(texts, snap.Title)
+		for _, comment := range snap.Comments {
+			texts = append(texts, comment.Message)
+		}
+
+		for i, text := range texts {
+			excerpt, hits := highlight(text, wordSet)
+			if hits == 0 {
+				continue
+			}
+
+			if i == 0 {
+				found.Score += searchTitleWeight * hits
+			} else {
+				found.Score += hits
+			}
+
+			if len(found.Matches) < searchMaxMatches {
+				found.Matches = append(found.Matches, SearchMatch{
+					Comment: i - 1,
+					Excerpt: excerpt,
+				})
+			}
+		}
+
+		result = append(result, found)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+		return result[i].Id < result[j].Id
+	})
+
+	return result, nil
+}
+
+// highlight find the given words in a text, and return an excerpt around the
+// first one with the words highlighted, and the number of words found
+func highlight(text string, words map[string]bool) ([]TextPart, int) {
+	runes := []rune(text)
+
+	// the [start, end) of the words found
+	var found [][2]int
+	start := -1
+	for i := 0; i <= len(runes); i++ {
+		inWord := i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]))
+		switch {
+		case inWord && start < 0:
+			start = i
+		case !inWord && start >= 0:
+			if words[strings.ToLower(string(runes[start:i]))] {
+				found = append(found, [2]int{start, i})
+			}
+			start = -1
+		}
+	}
+
+	if len(found) == 0 {
+		return nil, 0
+	}
+
+	from, to := 0, len(runes)
+	if len(runes) > searchExcerptLen {
+		from = found[0][0] - searchExcerptContext
+		if from < 0 {
+			from = 0
+		}
+		to = from + searchExcerptLen
+		if to > len(runes) {
+			to = len(runes)
+			from = to - searchExcerptLen
+		}
+	}
+
+	var parts []TextPart
+	add := func(text string, highlighted bool) {
+		if text != "" {
+			parts = append(parts, TextPart{Text: text, Highlighted: highlighted})
+		}
+	}
+
+	prefix, suffix := "", ""
+	if from > 0 {
+		prefix = "…"
+	}
+	if to < len(runes) {
+		suffix = "…"
+	}
+
+	pos := from
+	for _, f := range found {
+		if f[0] < from || f[1] > to {
+			continue
+		}
+		add(prefix+string(runes[pos:f[0]]), false)
+		prefix = ""
+		add(string(runes[f[0]:f[1]]), true)
+		pos = f[1]
+	}
+	add(prefix+string(runes[pos:to])+suffix, false)
+
+	return parts, len(found)
+}
@@ -0,0 +1,74 @@
+package cache
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestSearch(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(iden))
+
+	bug1, _, err := cache.NewBug("Typo", "a typo in the parser")
+	require.NoError(t, err)
+
+	bug2, _, err := cache.NewBug("Parser crash", "it crashes")
+	require.NoError(t, err)
+	_, err = bug2.AddComment("Null pointer in the Parser, again")
+	require.NoError(t, err)
+
+	results, err := cache.Search("parser")
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	// found in the title first
+	assert.Equal(t, bug2.Id(), results[0].Id)
+	assert.Equal(t, 4, results[0].Score)
+	assert.Equal(t, []SearchMatch{
+		{Comment: -1, Excerpt: []TextPart{{"Parser", true}, {" crash", false}}},
+		{Comment: 1, Excerpt: []TextPart{{"Null pointer in the ", false}, {"Parser", true}, {", again", false}}},
+	}, results[0].Matches)
+	assert.Equal(t, bug1.Id(), results[1].Id)
+
+	results, err = cache.Search("null parser")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, bug2.Id(), results[0].Id)
+
+	results, err = cache.Search("nothing")
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	results, err = cache.Search("  ")
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestHighlight(t *testing.T) {
+	words := map[string]bool{"needle": true}
+
+	text := strings.Repeat("hay ", 100) + "Needle " + strings.Repeat("hay ", 100)
+	parts, hits := highlight(text, words)
+	require.Equal(t, 1, hits)
+	require.Len(t, parts, 3)
+	assert.True(t, strings.HasPrefix(parts[0].Text, "…"))
+	assert.Equal(t, TextPart{"Needle", true}, parts[1])
+	assert.True(t, strings.HasSuffix(parts[2].Text, "…"))
+	assert.Equal(t, searchExcerptLen+2, len([]rune(parts[0].Text+parts[1].Text+parts[2].Text)))
+
+	// a part of a word is not found
+	_, hits = highlight("needles", words)
+	assert.Equal(t, 0, hits)
+}
@@ -0,0 +1,131 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// the query selecting the bugs shared with a remote, like
+// git-bug.remote.origin.share "label:public"
+const shareConfigKeyPattern = "git-bug.remote.%s.share"
+
+// readShareQuery read the query selecting the bugs pushed to and pulled from
+// a remote, or nil if all the bugs are shared with it
+func readShareQuery(repo repository.RepoCommon, remote string) (*Query, error) {
+	key := fmt.Sprintf(shareConfigKeyPattern, remote)
+
+	val, err := repo.LocalConfig().ReadString(key)
+	if err == repository.ErrNoConfigEntry {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(val) == "" {
+		return nil, nil
+	}
+
+	query, err := ParseQuery(val)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", key, err)
+	}
+
+	return query, nil
+}
+
+// sharedIdentities return the identities a remote need to read a bug
+func (c *RepoCache) sharedIdentities(excerpt *BugExcerpt) []entity.Id {
+	ids := []entity.Id{excerpt.AuthorId}
+	ids = append(ids, excerpt.Actors...)
+	ids = append(ids, excerpt.Participants...)
+	ids = append(ids, excerpt.Assignees...)
+
+	var result []entity.Id
+	for _, id := range ids {
+		// a legacy author has no identity
+		if _, ok := c.identityExcerpt(id); ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// sharedRefs return the refs of the bugs matching the share query of a
+// remote, along with the refs of the identities they reference
+func (c *RepoCache) sharedRefs(query *Query) []string {
+	identities := make(map[entity.Id]bool)
+	var bugRefs []string
+
+	for _, excerpt := range c.matchingExcerpts(query) {
+		bugRefs = append(bugRefs, bug.RefName(excerpt.Id))
+
+		for _, id := range c.sharedIdentities(excerpt) {
+			identities[id] = true
+		}
+	}
+
+	var refs []string
+	for id := range identities {
+		refs = append(refs, identity.RefName(id))
+	}
+	sort.Strings(refs)
+	sort.Strings(bugRefs)
+
+	// the identities first, like a full push
+	return append(refs, bugRefs...)
+}
+
+// pushShared push to a remote the bugs matching its share query, and the
+// identities they need
+func (c *RepoCache) pushShared(remote string, query *Query) (string, error) {
+	refs := c.sharedRefs(query)
+	if len(refs) == 0 {
+		// without refspec, git would push the current branch
+		return "Everything up-to-date", nil
+	}
+
+	return c.repo.PushRefs(remote, refs...)
+}
+
+// filterFetchedBugs remove the bugs fetched from a remote that don't match its
+// share query, so that they are not merged. A bug failing to be read is left
+// for the merge to report it.
+func (c *RepoCache) filterFetchedBugs(remote string) error {
+	query, err := readShareQuery(c.repo, remote)
+	if err != nil || query == nil {
+		return err
+	}
+
+	refs, err := c.repo.ListRefs(bug.RemoteRefName(remote, ""))
+	if err != nil {
+		return err
+	}
+
+	for _, ref := range refs {
+		id := entity.Id(ref[strings.LastIndex(ref, "/")+1:])
+
+		b, err := bug.ReadRemoteBug(c.repo, remote, id.String())
+		if err != nil {
+			continue
+		}
+
+		snap := b.Compile()
+		if query.Match(c, NewBugExcerpt(b, &snap)) {
+			continue
+		}
+
+		err = c.repo.RemoveRef(ref)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestShareQuery(t *testing.T) {
+	repoA, repoB, remote := repository.SetupReposAndRemote(t)
+	defer repository.CleanupTestRepos(t, repoA, repoB, remote)
+
+	cacheA, err := NewRepoCache(repoA)
+	require.NoError(t, err)
+
+	rene, err := cacheA.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cacheA.SetUserIdentity(rene))
+
+	public, _, err := cacheA.NewBug("public", "message")
+	require.NoError(t, err)
+	_, _, err = public.ChangeLabels([]string{"public"}, nil)
+	require.NoError(t, err)
+	require.NoError(t, public.Commit())
+	private, _, err := cacheA.NewBug("private", "message")
+	require.NoError(t, err)
+
+	// everything is pushed without share query
+	_, err = cacheA.Push("origin")
+	require.NoError(t, err)
+
+	// only the public bug is pulled
+	require.NoError(t, repoB.LocalConfig().StoreString(fmt.Sprintf(shareConfigKeyPattern, "origin"), "label:public"))
+
+	cacheB, err := NewRepoCache(repoB)
+	require.NoError(t, err)
+	require.NoError(t, cacheB.Pull("origin"))
+	assert.ElementsMatch(t, []interface{}{public.Id()}, cacheB.AllBugsIds())
+
+	// only the public bugs are pushed
+	require.NoError(t, repoA.LocalConfig().StoreString(fmt.Sprintf(shareConfigKeyPattern, "origin"), "label:public"))
+
+	other, _, err := cacheA.NewBug("other private", "message")
+	require.NoError(t, err)
+
+	_, err = cacheA.Push("origin")
+	require.NoError(t, err)
+
+	exist, err := remote.RefExist(bug.RefName(other.Id()))
+	require.NoError(t, err)
+	assert.False(t, exist)
+	exist, err = remote.RefExist(bug.RefName(private.Id()))
+	require.NoError(t, err)
+	assert.True(t, exist)
+
+	// nothing to push
+	require.NoError(t, repoA.LocalConfig().StoreString(fmt.Sprintf(shareConfigKeyPattern, "origin"), "label:none"))
+	_, err = cacheA.Push("origin")
+	require.NoError(t, err)
+}
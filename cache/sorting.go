@@ -1,5 +1,11 @@
 package cache
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
 type OrderBy int
 
 const (
@@ -7,6 +13,13 @@ const (
 	OrderById
 	OrderByCreation
 	OrderByEdit
+	OrderByStatus
+	OrderByComments
+	OrderByParticipants
+	OrderByTitle
+	OrderByPriority
+	OrderByDueDate
+	OrderByMilestone
 )
 
 type OrderDirection int
@@ -16,3 +29,141 @@ const (
 	OrderAscending
 	OrderDescending
 )
+
+// SortKey is one of the criteria used to order the bugs
+type SortKey struct {
+	OrderBy
+	OrderDirection
+}
+
+// name of the sort keys and their default direction
+var sortKeys = map[string]SortKey{
+	"id":           {OrderById, OrderAscending},
+	"creation":     {OrderByCreation, OrderDescending},
+	"edit":         {OrderByEdit, OrderDescending},
+	"status":       {OrderByStatus, OrderAscending},
+	"comments":     {OrderByComments, OrderDescending},
+	"participants": {OrderByParticipants, OrderDescending},
+	"title":        {OrderByTitle, OrderAscending},
+	"priority":     {OrderByPriority, OrderDescending},
+	"due":          {OrderByDueDate, OrderAscending},
+	"milestone":    {OrderByMilestone, OrderAscending},
+}
+
+// ParseSortKey parse a sort key with an optional direction, for example
+// "edit", "edit-asc" or "comments-desc". Without direction, the default one
+// of the key is used.
+func ParseSortKey(str string) (SortKey, error) {
+	name := str
+	direction := OrderDirection(0)
+
+	switch {
+	case strings.HasSuffix(str, "-asc"):
+		name = strings.TrimSuffix(str, "-asc")
+		direction = OrderAscending
+	case strings.HasSuffix(str, "-desc"):
+		name = strings.TrimSuffix(str, "-desc")
+		direction = OrderDescending
+	}
+
+	key, ok := sortKeys[name]
+	if !ok {
+		return SortKey{}, fmt.Errorf("unknow sorting %s", str)
+	}
+
+	if direction != 0 {
+		key.OrderDirection = direction
+	}
+
+	return key, nil
+}
+
+// ParseSortKeys parse a comma separated list of sort keys, for example
+// "status,edit-desc"
+func ParseSortKeys(str string) ([]SortKey, error) {
+	var result []SortKey
+
+	for _, s := range strings.Split(str, ",") {
+		key, err := ParseSortKey(strings.TrimSpace(s))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, key)
+	}
+
+	return result, nil
+}
+
+func (k SortKey) sorter(bugs []*BugExcerpt) sort.Interface {
+	var sorter sort.Interface
+
+	switch k.OrderBy {
+	case OrderById:
+		sorter = BugsById(bugs)
+	case OrderByCreation:
+		sorter = BugsByCreationTime(bugs)
+	case OrderByEdit:
+		sorter = BugsByEditTime(bugs)
+	case OrderByStatus:
+		sorter = BugsByStatus(bugs)
+	case OrderByComments:
+		sorter = BugsByLenComments(bugs)
+	case OrderByParticipants:
+		sorter = BugsByLenParticipants(bugs)
+	case OrderByTitle:
+		sorter = BugsByTitle(bugs)
+	case OrderByPriority:
+		sorter = BugsByPriority(bugs)
+	case OrderByDueDate:
+		sorter = BugsByDueDate(bugs)
+	case OrderByMilestone:
+		sorter = BugsByMilestone(bugs)
+	default:
+		panic("missing sort type")
+	}
+
+	if k.OrderDirection == OrderDescending {
+		sorter = sort.Reverse(sorter)
+	}
+
+	return sorter
+}
+
+// bugsMultiSorter sort bugs with a list of keys, each key being used only
+// when the previous ones consider two bugs equal
+type bugsMultiSorter struct {
+	bugs    []*BugExcerpt
+	sorters []sort.Interface
+}
+
+func newBugsMultiSorter(bugs []*BugExcerpt, keys []SortKey) bugsMultiSorter {
+	sorters := make([]sort.Interface, len(keys))
+	for i, key := range keys {
+		sorters[i] = key.sorter(bugs)
+	}
+
+	return bugsMultiSorter{
+		bugs:    bugs,
+		sorters: sorters,
+	}
+}
+
+func (s bugsMultiSorter) Len() int {
+	return len(s.bugs)
+}
+
+func (s bugsMultiSorter) Less(i, j int) bool {
+	for _, sorter := range s.sorters {
+		switch {
+		case sorter.Less(i, j):
+			return true
+		case sorter.Less(j, i):
+			return false
+		}
+	}
+	return false
+}
+
+func (s bugsMultiSorter) Swap(i, j int) {
+	s.bugs[i], s.bugs[j] = s.bugs[j], s.bugs[i]
+}
@@ -0,0 +1,148 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// Stats is an aggregated view of a set of bugs
+type Stats struct {
+	Total      int
+	ByStatus   map[bug.Status]int
+	ByLabel    map[bug.Label]int
+	ByAuthor   map[string]int
+	ByAssignee map[string]int
+
+	// number of closed bugs, and mean duration between their creation and
+	// their closing
+	Closed          int
+	MeanTimeToClose time.Duration
+
+	// opened and closed bugs for each week, without gap, from the oldest bug
+	// to the last activity
+	Weeks []WeekStats
+}
+
+// WeekStats count the bugs opened and closed during a week
+type WeekStats struct {
+	// midnight on the monday of the week
+	Start  time.Time
+	Opened int
+	Closed int
+}
+
+// Stats compute the statistics of the bugs matching the query, or of all the
+// bugs if the query is nil
+func (c *RepoCache) Stats(query *Query) *Stats {
+	ids := c.QueryBugs(query)
+
+	excerpts := make([]*BugExcerpt, len(ids))
+	c.muBug.RLock()
+	for i, id := range ids {
+		excerpts[i] = c.bugExcerpts[id]
+	}
+	c.muBug.RUnlock()
+
+	return computeStats(excerpts, c.labels, c.excerptAuthorName, c.identityName)
+}
+
+// excerptAuthorName return the canonical display name of the author of a bug
+func (c *RepoCache) excerptAuthorName(excerpt *BugExcerpt) string {
+	if excerpt.AuthorId == "" {
+		return excerpt.LegacyAuthor.DisplayName()
+	}
+
+	author, err := c.ResolveCanonicalIdentityExcerpt(excerpt.AuthorId)
+	if err != nil {
+		return "<missing author data>"
+	}
+
+	return author.DisplayName()
+}
+
+// identityName return the canonical display name of an identity
+func (c *RepoCache) identityName(id entity.Id) string {
+	i, err := c.ResolveCanonicalIdentityExcerpt(id)
+	if err != nil {
+		return "<missing identity data>"
+	}
+
+	return i.DisplayName()
+}
+
+func computeStats(excerpts []*BugExcerpt, labels *bug.LabelStore, authorName func(*BugExcerpt) string, identityName func(entity.Id) string) *Stats {
+	stats := &Stats{
+		Total:      len(excerpts),
+		ByStatus:   make(map[bug.Status]int),
+		ByLabel:    make(map[bug.Label]int),
+		ByAuthor:   make(map[string]int),
+		ByAssignee: make(map[string]int),
+	}
+
+	if len(excerpts) == 0 {
+		return stats
+	}
+
+	var totalTimeToClose time.Duration
+	opened := make(map[time.Time]int)
+	closed := make(map[time.Time]int)
+	first, last := time.Unix(excerpts[0].CreateUnixTime, 0), time.Time{}
+
+	for _, excerpt := range excerpts {
+		stats.ByStatus[excerpt.Status]++
+		for _, label := range labels.ResolveAll(excerpt.Labels) {
+			stats.ByLabel[label]++
+		}
+		stats.ByAuthor[authorName(excerpt)]++
+		for _, id := range excerpt.Assignees {
+			stats.ByAssignee[identityName(id)]++
+		}
+
+		createTime := time.Unix(excerpt.CreateUnixTime, 0)
+		opened[weekStart(createTime)]++
+		if createTime.Before(first) {
+			first = createTime
+		}
+		if createTime.After(last) {
+			last = createTime
+		}
+
+		if excerpt.Status == bug.ClosedStatus && excerpt.CloseUnixTime != 0 {
+			closeTime := time.Unix(excerpt.CloseUnixTime, 0)
+			closed[weekStart(closeTime)]++
+			if closeTime.After(last) {
+				last = closeTime
+			}
+
+			stats.Closed++
+			totalTimeToClose += closeTime.Sub(createTime)
+		}
+	}
+
+	if stats.Closed > 0 {
+		stats.MeanTimeToClose = totalTimeToClose / time.Duration(stats.Closed)
+	}
+
+	for week := weekStart(first); !week.After(last); week = week.AddDate(0, 0, 7) {
+		stats.Weeks = append(stats.Weeks, WeekStats{
+			Start:  week,
+			Opened: opened[week],
+			Closed: closed[week],
+		})
+	}
+
+	return stats
+}
+
+// weekStart return midnight on the monday of the week of the given time
+func weekStart(t time.Time) time.Time {
+	year, month, day := t.Date()
+	midnight := time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+
+	// time.Weekday start on sunday
+	offset := (int(midnight.Weekday()) + 6) % 7
+
+	return midnight.AddDate(0, 0, -offset)
+}
@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+func TestComputeStats(t *testing.T) {
+	// 2020-01-01 is a wednesday
+	day := func(d int) int64 {
+		return time.Date(2020, 1, d, 12, 0, 0, 0, time.Local).Unix()
+	}
+
+	bugs := []*BugExcerpt{
+		{Status: bug.OpenStatus, CreateUnixTime: day(1), Labels: []bug.Label{"bug"}, Assignees: []entity.Id{"blaise"}},
+		{Status: bug.ClosedStatus, CreateUnixTime: day(2), CloseUnixTime: day(4), Labels: []bug.Label{"bug", "ui"}},
+		{Status: bug.ClosedStatus, CreateUnixTime: day(8), CloseUnixTime: day(16)},
+	}
+
+	authorName := func(*BugExcerpt) string { return "René" }
+	identityName := func(id entity.Id) string { return string(id) }
+
+	stats := computeStats(bugs, nil, authorName, identityName)
+
+	assert.Equal(t, 3, stats.Total)
+	assert.Equal(t, map[bug.Status]int{bug.OpenStatus: 1, bug.ClosedStatus: 2}, stats.ByStatus)
+	assert.Equal(t, map[bug.Label]int{"bug": 2, "ui": 1}, stats.ByLabel)
+	assert.Equal(t, map[string]int{"René": 3}, stats.ByAuthor)
+	assert.Equal(t, map[string]int{"blaise": 1}, stats.ByAssignee)
+	assert.Equal(t, 2, stats.Closed)
+	assert.Equal(t, 5*24*time.Hour, stats.MeanTimeToClose)
+
+	assert.Equal(t, []WeekStats{
+		{Start: time.Date(2019, 12, 30, 0, 0, 0, 0, time.Local), Opened: 2, Closed: 1},
+		{Start: time.Date(2020, 1, 6, 0, 0, 0, 0, time.Local), Opened: 1, Closed: 0},
+		{Start: time.Date(2020, 1, 13, 0, 0, 0, 0, time.Local), Opened: 0, Closed: 1},
+	}, stats.Weeks)
+
+	empty := computeStats(nil, nil, authorName, identityName)
+	assert.Equal(t, 0, empty.Total)
+	assert.Empty(t, empty.Weeks)
+}
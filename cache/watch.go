@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+// DefaultWatchInterval is the interval between two checks of the bug refs
+// suggested to Watch
+const DefaultWatchInterval = 2 * time.Second
+
+// Refresh update the cache with the bugs changed outside of it, like by a
+// git fetch in another process, and return their ids. The changed bugs
+// loaded in memory are unloaded to be read again when resolved, except the
+// ones with changes not committed yet.
+func (c *RepoCache) Refresh() ([]entity.Id, error) {
+	c.muBug.Lock()
+	defer c.muBug.Unlock()
+
+	changed, err := c.refreshBugCache()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range changed {
+		b, ok := c.bugs[id]
+		if !ok || b.NeedCommit() {
+			continue
+		}
+		delete(c.bugs, id)
+		c.loadedBugs.remove(id)
+	}
+
+	return changed, nil
+}
+
+// Watch check the bug refs every interval, and call changed when they differ
+// from the previous check, until the returned stop function is called.
+//
+// The refs are polled by another goroutine, from which changed is called:
+// as the cache is not safe for concurrent use, changed should have Refresh
+// called where the cache is not used meanwhile.
+func (c *RepoCache) Watch(interval time.Duration, changed func()) (stop func()) {
+	quit := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		// the changes made before are already in the cache, or are found by
+		// the first Refresh
+		previous, _ := bug.ListLocalHeads(c.repo)
+
+		for {
+			select {
+			case <-quit:
+				return
+			case <-ticker.C:
+			}
+
+			heads, err := bug.ListLocalHeads(c.repo)
+			if err != nil {
+				// the repository might be in the middle of a change, the
+				// next check will tell
+				continue
+			}
+
+			if !sameHeads(previous, heads) {
+				previous = heads
+				changed()
+			}
+		}
+	}()
+
+	return func() {
+		close(quit)
+		<-done
+	}
+}
+
+func sameHeads(a, b map[entity.Id]git.Hash) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for id, head := range a {
+		if b[id] != head {
+			return false
+		}
+	}
+	return true
+}
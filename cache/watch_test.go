@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestCacheWatch(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(iden))
+
+	bug1, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	changed := make(chan struct{}, 1)
+	stop := cache.Watch(10*time.Millisecond, func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	})
+	defer stop()
+
+	// changed by another process
+	b, err := bug.ReadLocalBug(repo, bug1.Id())
+	require.NoError(t, err)
+	_, err = bug.SetTitle(b, iden.Identity, time.Now().Unix(), "edited")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit(repo))
+
+	select {
+	case <-changed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the change was not noticed")
+	}
+
+	ids, err := cache.Refresh()
+	require.NoError(t, err)
+	require.Equal(t, []entity.Id{bug1.Id()}, ids)
+	require.Equal(t, "edited", cache.bugExcerpts[bug1.Id()].Title)
+
+	// read again
+	reloaded, err := cache.ResolveBug(bug1.Id())
+	require.NoError(t, err)
+	require.Equal(t, "edited", reloaded.Snapshot().Title)
+
+	// nothing more
+	ids, err = cache.Refresh()
+	require.NoError(t, err)
+	require.Empty(t, ids)
+}
@@ -3,6 +3,7 @@ package commands
 import (
 	"fmt"
 
+	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/input"
 	"github.com/MichaelMure/git-bug/util/interrupt"
@@ -13,6 +14,8 @@ var (
 	addTitle       string
 	addMessage     string
 	addMessageFile string
+	addNoVerify    bool
+	addScope       string
 )
 
 func runAddBug(cmd *cobra.Command, args []string) error {
@@ -25,6 +28,10 @@ func runAddBug(cmd *cobra.Command, args []string) error {
 	defer backend.Close()
 	interrupt.RegisterCleaner(backend.Close)
 
+	if addNoVerify {
+		backend.DisablePreHooks()
+	}
+
 	if addMessageFile != "" && addMessage == "" {
 		addTitle, addMessage, err = input.BugCreateFileInput(addMessageFile)
 		if err != nil {
@@ -44,19 +51,58 @@ func runAddBug(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if !addNoVerify {
+		err = input.ValidateBug(backend, addTitle, addMessage)
+		if err != nil {
+			return fmt.Errorf("%v (use --no-verify to bypass)", err)
+		}
+	}
+
+	scope, err := flagScope(cmd, backend, addScope)
+	if err != nil {
+		return err
+	}
+
 	b, _, err := backend.NewBug(addTitle, addMessage)
 	if err != nil {
 		return err
 	}
 
+	if scope != "" {
+		_, _, err = b.ChangeLabels([]string{bug.ScopeLabelPrefix + scope}, nil)
+		if err != nil {
+			return err
+		}
+		err = b.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
 	fmt.Printf("%s created\n", b.Id().Human())
 
 	return nil
 }
 
 var addCmd = &cobra.Command{
-	Use:     "add",
-	Short:   "Create a new bug.",
+	Use:   "add",
+	Short: "Create a new bug.",
+	Long: `Create a new bug.
+
+Without a title and a message, the editor is opened to write them. If git-bug.add.template is configured, the editor is pre-filled with the content of this file.
+
+Before creating the bug, the title and message are checked against the rules configured in the repository:
+- git-bug.add.title-max-length: the maximum length of the title
+- git-bug.add.required-sections: a comma separated list of lines that must be present in the message, each followed by some content
+
+The pre-add hook is then run, if any. Both can be bypassed with --no-verify.
+
+In a monorepo with the directories of the components configured with "git bug config set scopes", the bug is scoped to the component of the current directory with a "scope:<directory>" label, unless another scope is given with --scope.`,
+	Example: `git bug add -t "crash on startup" -m "the program crash when started"
+git config git-bug.add.template .github/bug_template.txt
+git config git-bug.add.required-sections "Steps to reproduce:,Expected behavior:"
+git bug add --scope services/api -t "timeout on login"
+`,
 	PreRunE: loadRepo,
 	RunE:    runAddBug,
 }
@@ -75,4 +121,10 @@ func init() {
 	addCmd.Flags().StringVarP(&addMessageFile, "file", "F", "",
 		"Take the message from the given file. Use - to read the message from the standard input",
 	)
+	addCmd.Flags().BoolVar(&addNoVerify, "no-verify", false,
+		"Don't check the title and message, and bypass the pre-add hook",
+	)
+	addCmd.Flags().StringVarP(&addScope, "scope", "", "",
+		"Scope the bug to the directory of a component in a monorepo. Default to the scope of the current directory, an empty value creating a bug without scope",
+	)
 }
@@ -0,0 +1,236 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const aliasConfigPrefix = "git-bug.alias."
+
+// defaultAliases are available without configuration, and can be redefined
+// in the git config
+var defaultAliases = map[string]string{
+	"mine": "ls status:open assignee:me",
+}
+
+// expandAliases replace the command of the given arguments by its definition,
+// if it's an alias defined in the git config, like git do:
+//
+//	git config git-bug.alias.triage "ls status:open no:label sort:edit-desc"
+//
+// An alias starting with "!" is a shell command, run with the remaining
+// arguments. As git-bug then doesn't have anything else to do, the process
+// exit with the status of the command.
+//
+// An alias can't replace an existing command, and can refer to another alias.
+// Some aliases are defined by default, like "mine".
+func expandAliases(args []string) ([]string, error) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") || isCommand(args[0]) {
+		return args, nil
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return args, nil
+	}
+	r, err := repository.OpenRepo(cwd, bug.Witnesser)
+	if err != nil {
+		// not in a repository, let the command fail by itself
+		return args, nil
+	}
+
+	seen := make(map[string]bool)
+
+	for len(args) > 0 && !isCommand(args[0]) {
+		name := args[0]
+
+		value, err := readAlias(r, name)
+		if err != nil {
+			return nil, err
+		}
+		if value == "" {
+			// not an alias either, leave it to cobra to report
+			return args, nil
+		}
+
+		if seen[name] {
+			return nil, fmt.Errorf("alias loop detected: %s", name)
+		}
+		seen[name] = true
+
+		if strings.HasPrefix(value, "!") {
+			os.Exit(runShellAlias(strings.TrimPrefix(value, "!"), args[1:]))
+		}
+
+		expanded, err := splitAliasArgs(value)
+		if err != nil {
+			return nil, fmt.Errorf("bad alias %s: %v", name, err)
+		}
+		if len(expanded) == 0 {
+			return nil, fmt.Errorf("empty alias %s", name)
+		}
+
+		args = append(expanded, args[1:]...)
+	}
+
+	return args, nil
+}
+
+// isCommand tell if the name is a command or a command alias of git-bug
+func isCommand(name string) bool {
+	if name == "help" {
+		return true
+	}
+	for _, cmd := range RootCmd.Commands() {
+		if cmd.Name() == name || cmd.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// readAlias read the definition of an alias, from the git config or else the
+// default aliases. An empty string is returned if the alias doesn't exist.
+func readAlias(r repository.RepoCommon, name string) (string, error) {
+	// git store the variable names in lower case
+	val, err := repository.ReadConfigAnyScope(r, aliasConfigPrefix+strings.ToLower(name))
+	if err != nil {
+		return "", err
+	}
+	if val == "" {
+		val = defaultAliases[name]
+	}
+	return strings.TrimSpace(val), nil
+}
+
+// readQueryAliases read the aliases listing bugs with a query, like "mine",
+// which are the saved queries of git-bug. The query of each alias is returned
+// by name.
+func readQueryAliases(r repository.RepoCommon) (map[string]string, error) {
+	result := make(map[string]string)
+
+	for name, value := range defaultAliases {
+		if query, ok := aliasQuery(value); ok {
+			result[name] = query
+		}
+	}
+
+	// the repository config override the global one
+	for _, config := range []repository.Config{r.GlobalConfig(), r.LocalConfig()} {
+		values, err := config.ReadAll(aliasConfigPrefix)
+		if err != nil {
+			return nil, err
+		}
+
+		for key, value := range values {
+			name := strings.TrimPrefix(key, aliasConfigPrefix)
+			if query, ok := aliasQuery(value); ok {
+				result[name] = query
+			} else {
+				delete(result, name)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// aliasQuery return the query of an alias defined as "ls <query>", without
+// any flag
+func aliasQuery(value string) (string, bool) {
+	args, err := splitAliasArgs(strings.TrimSpace(value))
+	if err != nil || len(args) == 0 || args[0] != "ls" {
+		return "", false
+	}
+
+	for _, arg := range args[1:] {
+		if strings.HasPrefix(arg, "-") {
+			return "", false
+		}
+	}
+
+	// as ls do with its arguments
+	return strings.Join(args[1:], " "), true
+}
+
+// splitAliasArgs split the definition of an alias into arguments, the same
+// way a shell would for the simple cases: on spaces, except within single or
+// double quotes, a backslash escaping the next character.
+func splitAliasArgs(s string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	inArg := false
+	var quote rune
+	escaped := false
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote")
+	}
+	if escaped {
+		return nil, fmt.Errorf("trailing backslash")
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
+
+// runShellAlias run a shell alias with the given arguments, and return its
+// exit status
+func runShellAlias(command string, args []string) int {
+	// as git do, the arguments are appended to the command
+	cmd := exec.Command("sh", append([]string{"-c", command + ` "$@"`, command}, args...)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	err := cmd.Run()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.ExitStatus() > 0 {
+			return status.ExitStatus()
+		}
+		return 1
+	}
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
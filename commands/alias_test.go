@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestSplitAliasArgs(t *testing.T) {
+	args, err := splitAliasArgs(`ls  status:open "author:René Descartes" 'a\b' c\ d`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ls", "status:open", "author:René Descartes", `a\b`, "c d"}, args)
+
+	args, err = splitAliasArgs(`  `)
+	require.NoError(t, err)
+	assert.Empty(t, args)
+
+	_, err = splitAliasArgs(`ls "status:open`)
+	assert.Error(t, err)
+}
+
+func TestReadQueryAliases(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+
+	require.NoError(t, repo.GlobalConfig().StoreString(aliasConfigPrefix+"triage", "ls no:label sort:edit"))
+	require.NoError(t, repo.GlobalConfig().StoreString(aliasConfigPrefix+"crashes", "ls label:crash"))
+	require.NoError(t, repo.LocalConfig().StoreString(aliasConfigPrefix+"crashes", "ls label:crash status:open"))
+	require.NoError(t, repo.LocalConfig().StoreString(aliasConfigPrefix+"mine", "ls --status open"))
+	require.NoError(t, repo.LocalConfig().StoreString(aliasConfigPrefix+"hello", "!echo hello"))
+
+	aliases, err := readQueryAliases(repo)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"triage":  "no:label sort:edit",
+		"crashes": "label:crash status:open",
+	}, aliases)
+}
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/interchange"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	applyAllowOverride bool
+)
+
+func runApply(cmd *cobra.Command, args []string) error {
+	if len(args) > 1 {
+		return fmt.Errorf("only one file can be applied at a time")
+	}
+
+	var input io.Reader = os.Stdin
+
+	if len(args) == 1 && args[0] != "-" {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		input = f
+	}
+
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	encoder := json.NewEncoder(os.Stdout)
+
+	return interchange.Apply(backend, input, applyAllowOverride, func(result interchange.ApplyResult) {
+		_ = encoder.Encode(result)
+	})
+}
+
+var applyCmd = &cobra.Command{
+	Use:   "apply [<file>]",
+	Short: "Apply a stream of operations on the bugs.",
+	Long: `Apply a stream of operations on the bugs, to let external tools and migrations drive git-bug without running a command for each change.
+
+The operations are read from the file, or the standard input without a file or with -, as one JSON object per line. They are applied in order, and the processing stops at the first invalid operation. The operations already applied are kept.
+
+For each operation applied, a JSON object is written on the standard output, with the line of the operation and the id of the bug.
+
+The format of the operations is described in doc/interchange.md. By default, the operations are done by the user identity at the current time. With --allow-override, they can set their author and time.`,
+	Example: `echo '{"op":"comment","bug":"5e29397","message":"fixed in v1.2"}' | git bug apply -
+git bug apply --allow-override migration.ndjson
+`,
+	PreRunE: loadRepo,
+	RunE:    runApply,
+}
+
+func init() {
+	RootCmd.AddCommand(applyCmd)
+
+	applyCmd.Flags().SortFlags = false
+
+	applyCmd.Flags().BoolVar(&applyAllowOverride, "allow-override", false,
+		"Allow the operations to set their author and time")
+}
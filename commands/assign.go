@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runAssign(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	b, args, err := _select.ResolveBug(backend, args)
+	if err != nil {
+		return err
+	}
+
+	identities, err := resolveIdentities(backend, args)
+	if err != nil {
+		return err
+	}
+
+	op, err := b.ChangeAssignees(identities, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range op.Added {
+		fmt.Printf("assigned %s\n", i.DisplayName())
+	}
+
+	return b.Commit()
+}
+
+// resolveIdentities resolve a list of identity id prefixes, "me" being the
+// user identity
+func resolveIdentities(backend *cache.RepoCache, args []string) ([]*cache.IdentityCache, error) {
+	if len(args) == 0 {
+		return nil, errors.New("you must provide at least one identity")
+	}
+
+	result := make([]*cache.IdentityCache, len(args))
+
+	for i, arg := range args {
+		var err error
+		if arg == "me" {
+			result[i], err = backend.GetUserIdentity()
+		} else {
+			result[i], err = backend.ResolveIdentityPrefix(arg)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", arg, err)
+		}
+	}
+
+	return result, nil
+}
+
+var assignCmd = &cobra.Command{
+	Use:   "assign [<id>] <user>[...]",
+	Short: "Assign identities to a bug.",
+	Long: `Assign identities to a bug.
+
+The identities are given by a prefix of their id, as listed by "git bug user ls", or "me" for your own identity.`,
+	Example: `Assign a bug to yourself:
+git bug assign 2f15 me
+
+Assign the selected bug to two identities:
+git bug assign a3d9 7c01
+`,
+	PreRunE: loadRepo,
+	RunE:    runAssign,
+}
+
+func init() {
+	RootCmd.AddCommand(assignCmd)
+}
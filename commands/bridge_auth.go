@@ -32,9 +32,9 @@ func printToken(token *core.Token) {
 	targetFmt := text.LeftPadMaxLine(token.Target, 10, 0)
 
 	fmt.Printf("%s %s %s %s\n",
-		colors.Cyan(token.ID().Human()),
-		colors.Yellow(targetFmt),
-		colors.Magenta("token"),
+		colors.Id(token.ID().Human()),
+		colors.Action(targetFmt),
+		colors.Kind("token"),
 		token.Value,
 	)
 }
@@ -1,17 +1,17 @@
 package commands
 
 import (
-	"bufio"
 	"fmt"
 	"os"
 	"strings"
 
-	"github.com/mattn/go-isatty"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/MichaelMure/git-bug/bridge"
 	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/input"
+	"github.com/MichaelMure/git-bug/util/term"
 )
 
 var (
@@ -33,15 +33,18 @@ func runBridgeTokenAdd(cmd *cobra.Command, args []string) error {
 		value = args[0]
 	} else {
 		// Read from Stdin
-		if isatty.IsTerminal(os.Stdin.Fd()) {
+		if term.IsTerminal(os.Stdin) {
+			err := input.RequireInteractive("prompt for the token", "give it as argument or on the standard input")
+			if err != nil {
+				return err
+			}
 			fmt.Println("Enter the token:")
 		}
-		reader := bufio.NewReader(os.Stdin)
-		raw, err := reader.ReadString('\n')
+		raw, err := term.ReadPassword()
 		if err != nil {
 			return fmt.Errorf("reading from stdin: %v", err)
 		}
-		value = strings.TrimSuffix(raw, "\n")
+		value = raw
 	}
 
 	token := core.NewToken(value, bridgeAuthAddTokenTarget)
@@ -12,6 +12,7 @@ import (
 	"github.com/MichaelMure/git-bug/bridge"
 	"github.com/MichaelMure/git-bug/bridge/core"
 	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/input"
 	"github.com/MichaelMure/git-bug/repository"
 	"github.com/MichaelMure/git-bug/util/interrupt"
 )
@@ -68,6 +69,11 @@ func runBridgeConfigure(cmd *cobra.Command, args []string) error {
 }
 
 func promptTarget() (string, error) {
+	err := input.RequireInteractive("prompt for the bridge target", "use --target")
+	if err != nil {
+		return "", err
+	}
+
 	targets := bridge.Targets()
 
 	for {
@@ -95,6 +101,11 @@ func promptTarget() (string, error) {
 }
 
 func promptName(repo repository.RepoCommon) (string, error) {
+	err := input.RequireInteractive("prompt for the bridge name", "use --name")
+	if err != nil {
+		return "", err
+	}
+
 	defaultExist := core.BridgeExist(repo, defaultName)
 
 	for {
@@ -133,7 +144,8 @@ var bridgeConfigureCmd = &cobra.Command{
 	Short: "Configure a new bridge.",
 	Long: `	Configure a new bridge by passing flags or/and using interactive terminal prompts. You can avoid all the terminal prompts by passing all the necessary flags to configure your bridge.
 	Repository configuration can be made by passing either the --url flag or the --project and --owner flags. If the three flags are provided git-bug will use --project and --owner flags.
-	Token configuration can be directly passed with the --token flag or in the terminal prompt. If you don't already have one you can use the interactive procedure to generate one.`,
+	Token configuration can be directly passed with the --token flag or in the terminal prompt. If you don't already have one you can use the interactive procedure to generate one.
+	With --credential-helper, the token is kept by the git credential helpers instead of the git-bug token store: a token passed with --token or --token-stdin is stored with "git credential approve", otherwise it's read with "git credential fill", like for a git push.`,
 	Example: `# Interactive example
 [1]: github
 [2]: launchpad-preview
@@ -183,7 +195,14 @@ git bug bridge configure \
     --name=default \
     --target=github \
     --url=https://github.com/michaelmure/git-bug \
-    --token=$(TOKEN)`,
+    --token=$(TOKEN)
+
+# With the token known by the git credential helpers
+git bug bridge configure \
+    --name=default \
+    --target=github \
+    --url=https://github.com/michaelmure/git-bug \
+    --credential-helper`,
 	PreRunE: loadRepo,
 	RunE:    runBridgeConfigure,
 }
@@ -198,6 +217,7 @@ func init() {
 	bridgeConfigureCmd.Flags().StringVarP(&bridgeParams.Token, "token", "T", "", "The authentication token for the API")
 	bridgeConfigureCmd.Flags().StringVarP(&bridgeParams.TokenId, "token-id", "i", "", "The authentication token identifier for the API")
 	bridgeConfigureCmd.Flags().BoolVar(&bridgeParams.TokenStdin, "token-stdin", false, "Will read the token from stdin and ignore --token")
+	bridgeConfigureCmd.Flags().BoolVar(&bridgeParams.CredentialHelper, "credential-helper", false, "Keep the token in the git credential helpers instead of the git-bug token store")
 	bridgeConfigureCmd.Flags().StringVarP(&bridgeParams.Project, "project", "p", "", "The name of the target repository")
 	bridgeConfigureCmd.Flags().SortFlags = false
 }
@@ -75,6 +75,9 @@ func runBridgePull(cmd *cobra.Command, args []string) error {
 		return nil
 	})
 
+	backend.SetImporting(true)
+	defer backend.SetImporting(false)
+
 	var events <-chan core.ImportResult
 	switch {
 	case bridgePullNoResume:
@@ -95,6 +98,7 @@ func runBridgePull(cmd *cobra.Command, args []string) error {
 
 	importedIssues := 0
 	importedIdentities := 0
+	summary := cache.ImportSummary{Source: b.Name}
 	for result := range events {
 		if result.Event != core.ImportEventNothing {
 			fmt.Println(result.String())
@@ -103,6 +107,7 @@ func runBridgePull(cmd *cobra.Command, args []string) error {
 		switch result.Event {
 		case core.ImportEventBug:
 			importedIssues++
+			summary.Bugs = append(summary.Bugs, result.ID)
 		case core.ImportEventIdentity:
 			importedIdentities++
 		}
@@ -113,7 +118,7 @@ func runBridgePull(cmd *cobra.Command, args []string) error {
 	// send done signal
 	close(done)
 
-	return nil
+	return backend.RunHook(cache.HookPostImport, "", summary)
 }
 
 func parseSince(since string) (time.Time, error) {
@@ -135,6 +140,7 @@ var bridgePullCmd = &cobra.Command{
 
 func init() {
 	bridgeCmd.AddCommand(bridgePullCmd)
+	addProfileFlags(bridgePullCmd)
 	bridgePullCmd.Flags().BoolVarP(&bridgePullNoResume, "no-resume", "n", false, "force importing all bugs")
 	bridgePullCmd.Flags().StringVarP(&bridgePullImportSince, "since", "s", "", "import only bugs updated after the given date (ex: \"200h\" or \"june 2 2019\")")
 }
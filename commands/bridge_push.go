@@ -97,4 +97,5 @@ var bridgePushCmd = &cobra.Command{
 
 func init() {
 	bridgeCmd.AddCommand(bridgePushCmd)
+	addProfileFlags(bridgePushCmd)
 }
@@ -13,7 +13,17 @@ import (
 	"github.com/MichaelMure/git-bug/util/interrupt"
 )
 
+var (
+	commentOutputFormat   string
+	commentOutputTemplate string
+)
+
 func runComment(cmd *cobra.Command, args []string) error {
+	tmpl, err := parseOutputTemplate(commentOutputFormat, commentOutputTemplate)
+	if err != nil {
+		return err
+	}
+
 	backend, err := cache.NewRepoCache(repo)
 	if err != nil {
 		return err
@@ -28,6 +38,16 @@ func runComment(cmd *cobra.Command, args []string) error {
 
 	snap := b.Snapshot()
 
+	if tmpl != nil {
+		for _, comment := range snap.Comments {
+			err = executeOutputTemplate(tmpl, comment)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	commentsTextOutput(snap.Comments)
 
 	return nil
@@ -39,8 +59,8 @@ func commentsTextOutput(comments []bug.Comment) {
 			fmt.Println()
 		}
 
-		fmt.Printf("Author: %s\n", colors.Magenta(comment.Author.DisplayName()))
-		fmt.Printf("Id: %s\n", colors.Cyan(comment.Id().Human()))
+		fmt.Printf("Author: %s\n", colors.Author(comment.Author.DisplayName()))
+		fmt.Printf("Id: %s\n", colors.Id(comment.Id().Human()))
 		fmt.Printf("Date: %s\n\n", comment.FormatTime())
 		fmt.Println(text.LeftPadLines(comment.Message, 4))
 	}
@@ -57,4 +77,6 @@ func init() {
 	RootCmd.AddCommand(commentCmd)
 
 	commentCmd.Flags().SortFlags = false
+
+	addOutputFormatFlags(commentCmd, &commentOutputFormat, &commentOutputTemplate)
 }
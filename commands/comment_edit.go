@@ -0,0 +1,148 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/input"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	commentEditMessageFile string
+	commentEditMessage     string
+)
+
+func runCommentEdit(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	b, comment, err := resolveBugComment(backend, args)
+	if err != nil {
+		return err
+	}
+
+	if commentEditMessageFile != "" && commentEditMessage == "" {
+		commentEditMessage, err = input.BugCommentFileInput(commentEditMessageFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	if commentEditMessageFile == "" && commentEditMessage == "" {
+		commentEditMessage, err = input.BugCommentEditorInput(backend, comment.Message)
+		if err == input.ErrEmptyMessage {
+			fmt.Println("Empty message, aborting.")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if commentEditMessage == comment.Message {
+		fmt.Println("No change, aborting.")
+		return nil
+	}
+
+	_, err = b.EditComment(comment.Id(), commentEditMessage)
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
+
+// resolveBugComment find the bug and the comment selected by the command line
+// arguments. The comment is the last argument, as an index or an id can't be
+// told apart from a bug prefix, and the bug the argument before it if any, or
+// the selected bug.
+func resolveBugComment(backend *cache.RepoCache, args []string) (*cache.BugCache, *bug.Comment, error) {
+	if len(args) == 0 {
+		return nil, nil, errors.New("you must provide a comment index or id")
+	}
+
+	selector := args[len(args)-1]
+
+	b, args, err := _select.ResolveBug(backend, args[:len(args)-1])
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(args) > 0 {
+		return nil, nil, errors.New("only one comment can be selected at a time")
+	}
+
+	comment, err := resolveComment(b.Snapshot(), selector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return b, comment, nil
+}
+
+// resolveComment find a comment either by its index (as displayed by "show")
+// or by an id prefix.
+func resolveComment(snap *bug.Snapshot, selector string) (*bug.Comment, error) {
+	if index, err := strconv.Atoi(selector); err == nil {
+		if index < 0 || index >= len(snap.Comments) {
+			return nil, fmt.Errorf("no comment with index %d", index)
+		}
+		return &snap.Comments[index], nil
+	}
+
+	var found *bug.Comment
+	for i, comment := range snap.Comments {
+		if comment.Id().HasPrefix(selector) {
+			if found != nil {
+				return nil, fmt.Errorf("multiple comments matching %s", selector)
+			}
+			found = &snap.Comments[i]
+		}
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("no comment matching %s", selector)
+	}
+
+	return found, nil
+}
+
+var commentEditCmd = &cobra.Command{
+	Use:   "edit [<id>] <comment>",
+	Short: "Edit a comment of a bug.",
+	Long: `Edit a comment of a bug.
+
+The comment is selected either by its index, as displayed by "git bug show" (0 being the description of the bug), or by a prefix of its id, as displayed by "git bug comment".`,
+	Example: `Edit the description of the selected bug in the default editor:
+git bug comment edit 0
+
+Replace the message of a comment of the bug 2f15:
+git bug comment edit 2f15 8d3a1c2 -m "new message"
+`,
+	PreRunE: loadRepo,
+	RunE:    runCommentEdit,
+}
+
+func init() {
+	commentCmd.AddCommand(commentEditCmd)
+
+	commentEditCmd.Flags().SortFlags = false
+
+	commentEditCmd.Flags().StringVarP(&commentEditMessageFile, "file", "F", "",
+		"Take the message from the given file. Use - to read the message from the standard input",
+	)
+
+	commentEditCmd.Flags().StringVarP(&commentEditMessage, "message", "m", "",
+		"Provide the new message from the command line",
+	)
+}
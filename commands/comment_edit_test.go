@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestCommentEditRmByIndex(t *testing.T) {
+	testRepo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, testRepo)
+
+	repo = testRepo
+	defer func() { repo = nil }()
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	iden, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(iden))
+
+	b, _, err := backend.NewBug("Parser crash", "it crashes")
+	require.NoError(t, err)
+	_, err = b.AddComment("on every file")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+	require.NoError(t, _select.Select(backend, b.Id()))
+	require.NoError(t, backend.Close())
+
+	// a comment of the selected bug
+	commentEditMessage = "only on the big files"
+	defer func() { commentEditMessage = "" }()
+	require.NoError(t, runCommentEdit(nil, []string{"1"}))
+
+	// a comment of the given bug
+	require.NoError(t, runCommentRm(nil, []string{b.Id().Human(), "0"}))
+
+	err = runCommentRm(nil, []string{"2"})
+	assert.EqualError(t, err, "no comment with index 2")
+	err = runCommentRm(nil, nil)
+	assert.EqualError(t, err, "you must provide a comment index or id")
+
+	backend, err = cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	b, err = backend.ResolveBug(b.Id())
+	require.NoError(t, err)
+	comments := b.Snapshot().Comments
+	require.Len(t, comments, 2)
+	assert.Equal(t, "", comments[0].Message)
+	assert.Equal(t, "only on the big files", comments[1].Message)
+}
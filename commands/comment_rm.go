@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runCommentRm(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	b, comment, err := resolveBugComment(backend, args)
+	if err != nil {
+		return err
+	}
+
+	// the comment is redacted with an empty edition, as the operations
+	// can't be removed from the history
+	_, err = b.EditComment(comment.Id(), "")
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
+
+var commentRmCmd = &cobra.Command{
+	Use:   "rm [<id>] <comment>",
+	Short: "Remove the content of a comment of a bug.",
+	Long: `Remove the content of a comment of a bug.
+
+The comment is selected either by its index, as displayed by "git bug show" (0 being the description of the bug), or by a prefix of its id, as displayed by "git bug comment".
+
+The comment is redacted by an edition with an empty message: it stays in the timeline, and its previous versions are still part of the history of the bug.`,
+	PreRunE: loadRepo,
+	RunE:    runCommentRm,
+}
+
+func init() {
+	commentCmd.AddCommand(commentRmCmd)
+
+	commentRmCmd.Flags().SortFlags = false
+}
@@ -0,0 +1,276 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+// The kinds of values completed dynamically, by querying the cache at
+// completion time with the hidden "_complete" command.
+const (
+	completeBug     = "bug"
+	completeLabel   = "label"
+	completeUser    = "user"
+	completeBridge  = "bridge"
+	completeSetting = "setting"
+)
+
+// argsCompletions return the kind of values to complete for the arguments of
+// the commands. It's a function so that the commands are all initialized when
+// it's called.
+func argsCompletions() map[*cobra.Command]string {
+	return map[*cobra.Command]string{
+		assignCmd:      completeUser,
+		unassignCmd:    completeUser,
+		commentCmd:     completeBug,
+		commentAddCmd:  completeBug,
+		commentEditCmd: completeBug,
+		commentRmCmd:   completeBug,
+		dueCmd:         completeBug,
+		labelCmd:       completeBug,
+		labelAddCmd:    completeLabel,
+		labelEditCmd:   completeLabel,
+		labelRenameCmd: completeLabel,
+		labelRmCmd:     completeLabel,
+		rmCmd:          completeBug,
+		selectCmd:      completeBug,
+		showCmd:        completeBug,
+		statusCmd:      completeBug,
+		closeCmd:       completeBug,
+		openCmd:        completeBug,
+		titleCmd:       completeBug,
+		titleEditCmd:   completeBug,
+		userCmd:        completeUser,
+		userAdoptCmd:   completeUser,
+		bridgePullCmd:  completeBridge,
+		bridgePushCmd:  completeBridge,
+		bridgeRmCmd:    completeBridge,
+		configGetCmd:   completeSetting,
+		configSetCmd:   completeSetting,
+		configUnsetCmd: completeSetting,
+		listBugIDCmd:   completeBug,
+	}
+}
+
+// flagCompletion is a flag with dynamically completed values
+type flagCompletion struct {
+	cmd  *cobra.Command
+	flag string
+	kind string
+}
+
+func flagCompletions() []flagCompletion {
+	return []flagCompletion{
+		{lsCmd, "author", completeUser},
+		{lsCmd, "participant", completeUser},
+		{lsCmd, "actor", completeUser},
+		{lsCmd, "assignee", completeUser},
+		{lsCmd, "label", completeLabel},
+	}
+}
+
+func runComplete(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("a kind of value to complete is required")
+	}
+
+	backend, err := loadBackendForReading()
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	// one value per line, optionally followed by a tab and a description
+	switch args[0] {
+	case completeBug:
+		for _, id := range backend.AllBugsIds() {
+			excerpt, err := backend.ResolveBugExcerpt(id)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%s\t%s\n", id.Human(), excerpt.Title)
+		}
+
+	case completeLabel:
+		for _, label := range backend.ValidLabels() {
+			fmt.Printf("%s\t%s\n", label, backend.LabelStore().Description(label))
+		}
+
+	case completeUser:
+		for _, id := range backend.AllIdentityIds() {
+			excerpt, err := backend.ResolveIdentityExcerpt(id)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%s\t%s\n", id.Human(), excerpt.DisplayName())
+		}
+
+	case completeBridge:
+		bridges, err := core.ConfiguredBridges(backend)
+		if err != nil {
+			return err
+		}
+		for _, bridge := range bridges {
+			fmt.Println(bridge)
+		}
+
+	case completeSetting:
+		for _, s := range settings() {
+			fmt.Printf("%s\t%s\n", s.name, s.description)
+		}
+
+	default:
+		return fmt.Errorf("unknown kind of value %s", args[0])
+	}
+
+	return nil
+}
+
+var completeCmd = &cobra.Command{
+	Use:     "_complete <kind>",
+	Short:   "List the values to complete in the shell completion.",
+	Hidden:  true,
+	PreRunE: loadRepo,
+	RunE:    runComplete,
+}
+
+func init() {
+	RootCmd.AddCommand(completeCmd)
+}
+
+// bashCommandName return the name of a command in the generated bash
+// completion, as found in $last_command
+func bashCommandName(cmd *cobra.Command) string {
+	name := strings.Replace(cmd.CommandPath(), " ", "_", -1)
+	return strings.Replace(name, ":", "__", -1)
+}
+
+// GenBashCompletion generate the bash completion, with the ids, labels, users
+// and bridges completed dynamically
+func GenBashCompletion(w io.Writer) error {
+	var buf bytes.Buffer
+
+	buf.WriteString(RootCmd.BashCompletionFunction)
+	buf.WriteString(`
+__git-bug_complete()
+{
+    local IFS=$'\n'
+    local values
+    values=$(git-bug _complete "$1" 2>/dev/null | cut -f1)
+    COMPREPLY=( $(compgen -W "${values}" -- "$cur") )
+}
+`)
+
+	kinds := []string{completeBug, completeLabel, completeUser, completeBridge}
+	for _, kind := range kinds {
+		fmt.Fprintf(&buf, "\n__git-bug_complete_%s()\n{\n    __git-bug_complete %s\n}\n", kind, kind)
+	}
+
+	// group the commands by kind to have a stable output
+	byKind := make(map[string][]string)
+	for cmd, kind := range argsCompletions() {
+		byKind[kind] = append(byKind[kind], bashCommandName(cmd))
+	}
+
+	buf.WriteString("\n__git-bug_custom_func()\n{\n    case ${last_command} in\n")
+	for _, kind := range kinds {
+		names := byKind[kind]
+		if len(names) == 0 {
+			continue
+		}
+		sort.Strings(names)
+		fmt.Fprintf(&buf, "        %s)\n            __git-bug_complete %s\n            return\n            ;;\n",
+			strings.Join(names, " | "), kind)
+	}
+	buf.WriteString("    esac\n}\n")
+
+	for _, fc := range flagCompletions() {
+		err := fc.cmd.MarkFlagCustom(fc.flag, "__git-bug_complete_"+fc.kind)
+		if err != nil {
+			return err
+		}
+	}
+
+	original := RootCmd.BashCompletionFunction
+	RootCmd.BashCompletionFunction = buf.String()
+	defer func() { RootCmd.BashCompletionFunction = original }()
+
+	return RootCmd.GenBashCompletion(w)
+}
+
+// GenBashCompletionFile generate the bash completion in a file
+func GenBashCompletionFile(filename string) error {
+	return genCompletionFile(filename, GenBashCompletion)
+}
+
+// zshCompletionPlaceholder is a fake word used to mark the arguments to
+// complete dynamically, replaced once the zsh completion is generated
+func zshCompletionPlaceholder(kind string) string {
+	return "__git-bug_complete_" + kind
+}
+
+// GenZshCompletion generate the zsh completion, with the ids, labels, users
+// and bridges arguments completed dynamically
+func GenZshCompletion(w io.Writer) error {
+	for cmd, kind := range argsCompletions() {
+		err := cmd.MarkZshCompPositionalArgumentWords(1, zshCompletionPlaceholder(kind))
+		if err != nil {
+			return err
+		}
+	}
+
+	var buf bytes.Buffer
+	err := RootCmd.GenZshCompletion(&buf)
+	if err != nil {
+		return err
+	}
+
+	script := buf.String()
+
+	for _, kind := range []string{completeBug, completeLabel, completeUser, completeBridge} {
+		script = strings.Replace(script,
+			fmt.Sprintf(`'1: :(%q)'`, zshCompletionPlaceholder(kind)),
+			fmt.Sprintf(`'*: :{__git-bug_complete %s}'`, kind), -1)
+	}
+
+	script += `
+function __git-bug_complete {
+  local -a values
+  values=(${(f)"$(git-bug _complete $1 2>/dev/null | cut -f1)"})
+  compadd -a values
+}
+`
+
+	_, err = io.WriteString(w, script)
+	return err
+}
+
+// GenZshCompletionFile generate the zsh completion in a file
+func GenZshCompletionFile(filename string) error {
+	return genCompletionFile(filename, GenZshCompletion)
+}
+
+func genCompletionFile(filename string, gen func(io.Writer) error) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	err = gen(f)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
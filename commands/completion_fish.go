@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const fishCompletionHeader = `# fish completion for git-bug
+
+# __git-bug_words print the words of the command line after git-bug,
+# without the flags
+function __git-bug_words
+    set -l words (commandline -opc)
+    set -e words[1]
+    # "git bug" instead of "git-bug"
+    if test "$words[1]" = bug
+        set -e words[1]
+    end
+    for word in $words
+        switch $word
+            case '-*'
+            case '*'
+                echo $word
+        end
+    end
+end
+
+# __git-bug_using <path> -- <subcommands>: true if the command line is
+# exactly the given command path, followed by anything but a subcommand
+function __git-bug_using
+    set -l words (__git-bug_words)
+    set -l i (contains -i -- -- $argv)
+    set -l path
+    if test $i -gt 1
+        set path $argv[1..(math $i - 1)]
+    end
+    set -l subcommands
+    if test $i -lt (count $argv)
+        set subcommands $argv[(math $i + 1)..-1]
+    end
+
+    if test (count $words) -lt (count $path)
+        return 1
+    end
+    for j in (seq (count $path))
+        if test "$words[$j]" != "$path[$j]"
+            return 1
+        end
+    end
+    set -l next (math (count $path) + 1)
+    if test (count $words) -ge $next; and contains -- $words[$next] $subcommands
+        return 1
+    end
+    return 0
+end
+
+# __git-bug_exact <path>: true if the command line is exactly the given
+# command path, to complete its subcommands
+function __git-bug_exact
+    set -l words (__git-bug_words)
+    test "$words" = "$argv"
+end
+
+function __git-bug_complete
+    git-bug _complete $argv[1] 2>/dev/null
+end
+
+complete -c git-bug -f
+`
+
+// GenFishCompletion generate the fish completion, with the ids, labels, users
+// and bridges completed dynamically
+func GenFishCompletion(w io.Writer) error {
+	var buf bytes.Buffer
+
+	buf.WriteString(fishCompletionHeader)
+
+	args := argsCompletions()
+	flags := make(map[*cobra.Command]map[string]string)
+	for _, fc := range flagCompletions() {
+		if flags[fc.cmd] == nil {
+			flags[fc.cmd] = make(map[string]string)
+		}
+		flags[fc.cmd][fc.flag] = fc.kind
+	}
+
+	var walk func(cmd *cobra.Command, path []string)
+	walk = func(cmd *cobra.Command, path []string) {
+		var subcommands []string
+		for _, sub := range cmd.Commands() {
+			if sub.IsAvailableCommand() {
+				subcommands = append(subcommands, sub.Name())
+			}
+		}
+		sort.Strings(subcommands)
+
+		pathStr := strings.Join(path, " ")
+		using := fmt.Sprintf("__git-bug_using %s -- %s", pathStr, strings.Join(subcommands, " "))
+
+		fmt.Fprintf(&buf, "\n# %s\n", cmd.CommandPath())
+
+		for _, sub := range cmd.Commands() {
+			if !sub.IsAvailableCommand() {
+				continue
+			}
+			fmt.Fprintf(&buf, "complete -c git-bug -n %s -a %s -d %s\n",
+				fishQuote("__git-bug_exact "+pathStr), sub.Name(), fishQuote(sub.Short))
+		}
+
+		if kind, ok := args[cmd]; ok {
+			fmt.Fprintf(&buf, "complete -c git-bug -n %s -a %s\n",
+				fishQuote(using), fishQuote("(__git-bug_complete "+kind+")"))
+		}
+
+		cmd.NonInheritedFlags().VisitAll(func(flag *pflag.Flag) {
+			if flag.Hidden {
+				return
+			}
+			fmt.Fprintf(&buf, "complete -c git-bug -n %s -l %s", fishQuote(using), flag.Name)
+			if flag.Shorthand != "" {
+				fmt.Fprintf(&buf, " -s %s", flag.Shorthand)
+			}
+			if flag.NoOptDefVal == "" {
+				buf.WriteString(" -r")
+			}
+			if kind, ok := flags[cmd][flag.Name]; ok {
+				fmt.Fprintf(&buf, " -a %s", fishQuote("(__git-bug_complete "+kind+")"))
+			}
+			fmt.Fprintf(&buf, " -d %s\n", fishQuote(flag.Usage))
+		})
+
+		for _, sub := range cmd.Commands() {
+			if sub.IsAvailableCommand() {
+				walk(sub, append(path[:len(path):len(path)], sub.Name()))
+			}
+		}
+	}
+
+	walk(RootCmd, nil)
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// GenFishCompletionFile generate the fish completion in a file
+func GenFishCompletionFile(filename string) error {
+	return genCompletionFile(filename, GenFishCompletion)
+}
+
+func fishQuote(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	return "'" + strings.Replace(s, "'", `\'`, -1) + "'"
+}
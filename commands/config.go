@@ -0,0 +1,412 @@
+package commands
+
+import (
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/termui"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+const settingConfigPrefix = "git-bug."
+
+// setting is a configuration of git-bug, stored in the git config under the
+// git-bug namespace
+type setting struct {
+	// the name of the setting, without the git-bug prefix, like "ls.sort"
+	name        string
+	description string
+	// the setting is only meaningful for a repository, not in the global config
+	localOnly bool
+	// validate check a new value, nil if anything goes
+	validate func(value string) error
+}
+
+func (s setting) key() string {
+	return settingConfigPrefix + s.name
+}
+
+// settings return the settings managed by the config command. It's a function
+// so that the validations can use the repository loaded for the command.
+func settings() []setting {
+	return []setting{
+		{
+			name:        "ls.sort",
+			description: "the sorting of ls when the query doesn't have one, like \"edit-desc\"",
+			validate: func(value string) error {
+				_, err := cache.ParseSortKeys(value)
+				return err
+			},
+		},
+		{
+			name:        "ls.query",
+			description: "the query of ls when none is given, like \"status:open\"",
+			validate: func(value string) error {
+				_, err := cache.ParseQuery(value)
+				return err
+			},
+		},
+		{
+			name:        "webui.port",
+			description: "the port of the web UI when --port is not given",
+			validate:    validatePort,
+		},
+		{
+			name:        "webui.listen",
+			description: "the address the web UI listen to when --listen and --port are not given: [host]:port, unix:<path> or systemd",
+			validate:    validateWebUIListen,
+		},
+		{
+			name:        "webui.url",
+			description: "the address of the web UI, to copy the url of the bugs from the terminal UI",
+			validate:    validateURL,
+		},
+		{
+			name:        "webui.open",
+			description: "open the web UI in the default browser",
+			localOnly:   true,
+			validate:    validateBool,
+		},
+		{
+			name:        "webui.auth",
+			description: "how the users of the web UI are authenticated: none, local, header or oidc",
+			validate:    validateWebUIAuth,
+		},
+		{
+			name:        "webui.auth-header",
+			description: "the header holding the login or email of the user with the header authentication",
+		},
+		{
+			name:        "webui.cors-origins",
+			description: "the comma-separated origins of the sites allowed to call the API of the web UI, or *",
+			validate:    validateCORSOrigins,
+		},
+		{
+			name:        "webui.cors-methods",
+			description: "the comma-separated methods allowed to the other sites calling the API of the web UI",
+		},
+		{
+			name:        "webui.cors-headers",
+			description: "the comma-separated headers allowed to the other sites calling the API of the web UI",
+		},
+		{
+			name:        "webui.rate-limit",
+			description: "the requests per second to the API of the web UI allowed to each token or IP address, 0 for no limit",
+			validate:    validateRateLimit,
+		},
+		{
+			name:        "webui.rate-burst",
+			description: "the requests to the API of the web UI allowed at once above the rate limit",
+			validate:    validatePositiveInt,
+		},
+		{
+			name:        "webui.max-query-complexity",
+			description: "the highest cost of a GraphQL query to the web UI, 0 for no limit",
+			validate:    validatePositiveInt,
+		},
+		{
+			name:        "webui.max-query-depth",
+			description: "the deepest nesting of a GraphQL query to the web UI, 0 for no limit",
+			validate:    validatePositiveInt,
+		},
+		{
+			name:        "webui.allowed-queries",
+			description: "the path of a JSON file of the only GraphQL queries accepted by the web UI, by their SHA-256 hash",
+			validate:    validateFile,
+		},
+		{
+			name:        "webui.oidc.issuer",
+			description: "the url of the OpenID Connect provider with the oidc authentication",
+			validate:    validateURL,
+		},
+		{
+			name:        "webui.oidc.client-id",
+			description: "the id of the web UI registered with the OpenID Connect provider",
+		},
+		{
+			name:        "webui.oidc.client-secret",
+			description: "the secret of the web UI registered with the OpenID Connect provider",
+			localOnly:   true,
+		},
+		{
+			name:        "color.ui",
+			description: "when to use colors: auto, always or never",
+			validate: func(value string) error {
+				_, err := colors.ParseMode(value)
+				return err
+			},
+		},
+		{
+			name:        "color.theme",
+			description: "the color theme: dark, light, solarized, or the path of a theme file",
+			validate:    loadTheme,
+		},
+		{
+			name:        "termui.preset",
+			description: "the preset of key bindings of the terminal UI: vim or emacs",
+			validate:    termui.LoadKeyPreset,
+		},
+		{
+			name:        "termui.keys",
+			description: "the path of a key bindings file for the terminal UI",
+			validate:    loadKeysFile,
+		},
+		{
+			name:        "default-bridge",
+			description: "the bridge used by bridge pull and push when several are configured",
+			localOnly:   true,
+			validate:    validateBridge,
+		},
+		{
+			name:        "sync.remotes",
+			description: "the comma separated remotes of sync when none is given",
+			localOnly:   true,
+			validate:    validateRemotes,
+		},
+		{
+			name:        "autoPush",
+			description: "the remote the bugs and identities are pushed to in the background after each local change",
+			localOnly:   true,
+			validate:    validateRemote,
+		},
+		{
+			name:        "add.template",
+			description: "the path of a file pre-filling the message of a new bug",
+			validate:    validateFile,
+		},
+		{
+			name:        "add.title-max-length",
+			description: "the maximum length of the title of a new bug",
+			validate:    validatePositiveInt,
+		},
+		{
+			name:        "add.required-sections",
+			description: "the comma separated sections required in the message of a new bug",
+		},
+		{
+			name:        "scopes",
+			description: "the comma separated directories of the components of a monorepo, giving the scope of the bugs listed and created in them",
+			localOnly:   true,
+			validate:    validateScopes,
+		},
+		{
+			name:        "hook.trailers",
+			description: "the comma separated trailers of the commit messages closing the bugs",
+			validate:    validateHookTrailers,
+		},
+		{
+			name:        "notify.smtp",
+			description: "the SMTP server sending the notifications, as host:port",
+			validate:    validateHostPort,
+		},
+		{
+			name:        "notify.smtp-username",
+			description: "the user name to authenticate with the SMTP server",
+		},
+		{
+			name:        "notify.smtp-password",
+			description: "the password to authenticate with the SMTP server",
+			localOnly:   true,
+		},
+		{
+			name:        "notify.from",
+			description: "the sender of the notifications, like \"git-bug <bugs@example.com>\"",
+			validate:    validateAddress,
+		},
+		{
+			name:        "notify.digest-interval",
+			description: "the interval between two digests of the notifications, like 24h",
+			validate:    validateDuration,
+		},
+		{
+			name:        "avatar.provider",
+			description: "the avatar service used for the identities: none, gravatar or libravatar",
+			validate: func(value string) error {
+				_, err := identity.AvatarProviderFromString(value)
+				return err
+			},
+		},
+		{
+			name:        "avatar.private-hosts",
+			description: "fetch the avatars from the loopback, private and link-local addresses, like from a provider of the local network",
+			validate:    validateBool,
+		},
+		{
+			name:        "verify.policy",
+			description: "what to do with a pulled bug failing the verification: warn, quarantine or reject",
+			localOnly:   true,
+			validate: func(value string) error {
+				_, err := bug.VerifyPolicyFromString(value)
+				return err
+			},
+		},
+		{
+			name:        "encoding",
+			description: "how the new operations are stored: json, or cbor to store them smaller but unreadable by the versions of git-bug before it",
+			localOnly:   true,
+			validate: func(value string) error {
+				_, err := bug.EncodingFromString(value)
+				return err
+			},
+		},
+		{
+			name:        "mailmap.file",
+			description: "the path of a mailmap file used for the identities",
+			validate:    validateFile,
+		},
+		{
+			name:        "cache.max-loaded-bugs",
+			description: "the number of bugs kept in memory by the termui and the web UI, 0 for no limit",
+			validate:    validatePositiveInt,
+		},
+	}
+}
+
+// findSetting return the setting with the given name
+func findSetting(name string) (setting, error) {
+	for _, s := range settings() {
+		if s.name == name {
+			return s, nil
+		}
+	}
+	return setting{}, fmt.Errorf("unknown setting %s, see \"git bug config list --all\"", name)
+}
+
+// settingConfig return the config of the repository or the global config
+func settingConfig(global bool) repository.Config {
+	if global {
+		return repo.GlobalConfig()
+	}
+	return repo.LocalConfig()
+}
+
+func validatePort(value string) error {
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return fmt.Errorf("invalid port %s", value)
+	}
+	return nil
+}
+
+func validateURL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid url %s", value)
+	}
+	return nil
+}
+
+func validateHostPort(value string) error {
+	_, port, err := net.SplitHostPort(value)
+	if err != nil {
+		return err
+	}
+	return validatePort(port)
+}
+
+func validateAddress(value string) error {
+	_, err := mail.ParseAddress(value)
+	return err
+}
+
+func validateDuration(value string) error {
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return fmt.Errorf("invalid duration %s", value)
+	}
+	return nil
+}
+
+func validateBool(value string) error {
+	_, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("invalid boolean %s", value)
+	}
+	return nil
+}
+
+func validatePositiveInt(value string) error {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return fmt.Errorf("invalid positive number %s", value)
+	}
+	return nil
+}
+
+func validateFile(value string) error {
+	if strings.HasPrefix(value, "~/") {
+		value = filepath.Join(os.Getenv("HOME"), value[2:])
+	}
+	_, err := os.Stat(value)
+	return err
+}
+
+func validateBridge(value string) error {
+	bridges, err := core.ConfiguredBridges(repo)
+	if err != nil {
+		return err
+	}
+	if !containsString(bridges, value) {
+		return fmt.Errorf("no bridge named %s", value)
+	}
+	return nil
+}
+
+func validateRemote(value string) error {
+	remotes, err := repo.GetRemotes()
+	if err != nil {
+		return err
+	}
+	if _, ok := remotes[value]; !ok {
+		return fmt.Errorf("no remote named %s", value)
+	}
+	return nil
+}
+
+func validateRemotes(value string) error {
+	remotes, err := repo.GetRemotes()
+	if err != nil {
+		return err
+	}
+	for _, remote := range strings.FieldsFunc(value, isSyncRemoteSeparator) {
+		if _, ok := remotes[remote]; !ok {
+			return fmt.Errorf("no remote named %s", remote)
+		}
+	}
+	return nil
+}
+
+func validateScopes(value string) error {
+	if len(splitScopes(value)) == 0 {
+		return fmt.Errorf("no directory in %s", value)
+	}
+	return nil
+}
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Get and set the configuration of git-bug.",
+	Long: `Get and set the configuration of git-bug, validating the values before storing them in the git config.
+
+The settings are stored in the config of the repository, or in the global config with --global. When reading a setting, the config of the repository takes precedence.`,
+}
+
+func init() {
+	RootCmd.AddCommand(configCmd)
+
+	configCmd.Flags().SortFlags = false
+}
@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func runConfigGet(cmd *cobra.Command, args []string) error {
+	s, err := findSetting(args[0])
+	if err != nil {
+		return err
+	}
+
+	value, err := repository.ReadConfigAnyScope(repo, s.key())
+	if err != nil {
+		return err
+	}
+	if value == "" {
+		return fmt.Errorf("%s is not set", s.name)
+	}
+
+	fmt.Println(value)
+
+	return nil
+}
+
+var configGetCmd = &cobra.Command{
+	Use:     "get <name>",
+	Short:   "Display the value of a setting.",
+	PreRunE: loadRepo,
+	RunE:    runConfigGet,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	configCmd.AddCommand(configGetCmd)
+
+	configGetCmd.Flags().SortFlags = false
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+var (
+	configListAll bool
+)
+
+func runConfigList(cmd *cobra.Command, args []string) error {
+	for _, s := range settings() {
+		value, err := repository.ReadConfigAnyScope(repo, s.key())
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case value != "":
+			fmt.Printf("%s=%s\n", s.name, value)
+		case configListAll:
+			fmt.Printf("%s %s\n", s.name, colors.Description(s.description))
+		}
+	}
+
+	return nil
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the settings of git-bug.",
+	Long: `List the settings that are set, with their value.
+
+With --all, the settings not set are listed as well, with a description.`,
+	PreRunE: loadRepo,
+	RunE:    runConfigList,
+}
+
+func init() {
+	configCmd.AddCommand(configListCmd)
+
+	configListCmd.Flags().SortFlags = false
+
+	configListCmd.Flags().BoolVarP(&configListAll, "all", "a", false,
+		"Also list the settings not set, with a description")
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	configSetGlobal bool
+)
+
+func runConfigSet(cmd *cobra.Command, args []string) error {
+	s, err := findSetting(args[0])
+	if err != nil {
+		return err
+	}
+	value := args[1]
+
+	if configSetGlobal && s.localOnly {
+		return fmt.Errorf("%s can only be set in the config of the repository", s.name)
+	}
+
+	if s.validate != nil {
+		err = s.validate(value)
+		if err != nil {
+			return errors.Wrap(err, s.name)
+		}
+	}
+
+	return settingConfig(configSetGlobal).StoreString(s.key(), value)
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <name> <value>",
+	Short: "Change the value of a setting.",
+	Example: `Sort the bugs listed by last edition:
+git bug config set ls.sort edit-desc
+
+Use the same port for the web UI in every repository:
+git bug config set --global webui.port 8080
+`,
+	PreRunE: loadRepo,
+	RunE:    runConfigSet,
+	Args:    cobra.ExactArgs(2),
+}
+
+func init() {
+	configCmd.AddCommand(configSetCmd)
+
+	configSetCmd.Flags().SortFlags = false
+
+	configSetCmd.Flags().BoolVar(&configSetGlobal, "global", false,
+		"Store the setting in the global config instead of the one of the repository")
+}
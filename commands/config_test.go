@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettingsValidation(t *testing.T) {
+	var tests = []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"ls.sort", "edit-desc,comments", true},
+		{"ls.sort", "nope", false},
+		{"ls.query", "status:open label:bug", true},
+		{"ls.query", "(status:open", false},
+		{"webui.port", "8080", true},
+		{"webui.port", "0", false},
+		{"webui.port", "http", false},
+		{"webui.listen", "0.0.0.0:8080", true},
+		{"webui.listen", "unix:/run/git-bug.sock", true},
+		{"webui.listen", "systemd", true},
+		{"webui.listen", "8080", false},
+		{"webui.url", "https://bugs.example.com", true},
+		{"webui.url", "bugs.example.com", false},
+		{"webui.open", "true", true},
+		{"webui.open", "maybe", false},
+		{"webui.auth", "local", true},
+		{"webui.auth", "oidc", true},
+		{"webui.auth", "password", false},
+		{"webui.cors-origins", "https://dashboard.example.com, http://localhost:3000", true},
+		{"webui.cors-origins", "*", true},
+		{"webui.cors-origins", "dashboard.example.com", false},
+		{"webui.rate-limit", "0.5", true},
+		{"webui.rate-limit", "-1", false},
+		{"webui.rate-burst", "20", true},
+		{"webui.max-query-depth", "0", true},
+		{"webui.max-query-depth", "deep", false},
+		{"color.ui", "always", true},
+		{"color.ui", "sometimes", false},
+		{"termui.preset", "vim", true},
+		{"termui.preset", "nano", false},
+		{"add.title-max-length", "80", true},
+		{"add.title-max-length", "-1", false},
+		{"scopes", "services/api, services/web", true},
+		{"scopes", "/", false},
+		{"hook.trailers", "Closes-bug, Resolves", true},
+		{"hook.trailers", "Closed by", false},
+		{"notify.smtp", "smtp.example.com:587", true},
+		{"notify.smtp", "smtp.example.com", false},
+		{"notify.from", "git-bug <bugs@example.com>", true},
+		{"notify.from", "bugs", false},
+		{"notify.digest-interval", "12h", true},
+		{"notify.digest-interval", "daily", false},
+		{"avatar.provider", "libravatar", true},
+		{"avatar.private-hosts", "true", true},
+		{"avatar.private-hosts", "internal", false},
+		{"verify.policy", "ignore", false},
+		{"encoding", "cbor", true},
+		{"encoding", "xml", false},
+		{"cache.max-loaded-bugs", "0", true},
+		{"cache.max-loaded-bugs", "many", false},
+	}
+
+	for _, test := range tests {
+		s, err := findSetting(test.name)
+		require.NoError(t, err)
+
+		err = s.validate(test.value)
+		if test.valid {
+			assert.NoError(t, err, "%s=%s", test.name, test.value)
+		} else {
+			assert.Error(t, err, "%s=%s", test.name, test.value)
+		}
+	}
+
+	_, err := findSetting("unknown")
+	assert.Error(t, err)
+}
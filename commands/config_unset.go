@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+var (
+	configUnsetGlobal bool
+)
+
+func runConfigUnset(cmd *cobra.Command, args []string) error {
+	s, err := findSetting(args[0])
+	if err != nil {
+		return err
+	}
+
+	config := settingConfig(configUnsetGlobal)
+
+	_, err = config.ReadString(s.key())
+	if err == repository.ErrNoConfigEntry {
+		return fmt.Errorf("%s is not set", s.name)
+	}
+	if err != nil && err != repository.ErrMultipleConfigEntry {
+		return err
+	}
+
+	return config.RemoveAll(s.key())
+}
+
+var configUnsetCmd = &cobra.Command{
+	Use:     "unset <name>",
+	Short:   "Remove a setting, to get back to the default behavior.",
+	PreRunE: loadRepo,
+	RunE:    runConfigUnset,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	configCmd.AddCommand(configUnsetCmd)
+
+	configUnsetCmd.Flags().SortFlags = false
+
+	configUnsetCmd.Flags().BoolVar(&configUnsetGlobal, "global", false,
+		"Remove the setting from the global config instead of the one of the repository")
+}
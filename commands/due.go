@@ -0,0 +1,114 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	dueClear bool
+)
+
+func runDue(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	b, args, err := _select.ResolveBug(backend, args)
+	if err != nil {
+		return err
+	}
+
+	if dueClear {
+		if len(args) > 0 {
+			return errors.New("a due date can't be given with --clear")
+		}
+
+		_, err = b.ClearDueDate()
+		if err != nil {
+			return err
+		}
+
+		return b.Commit()
+	}
+
+	// without a date, display the current one
+	if len(args) == 0 {
+		snap := b.Snapshot()
+		if !snap.DueDate.IsZero() {
+			fmt.Println(formatDueDate(snap.DueDate, snap.Status == bug.OpenStatus))
+		}
+		return nil
+	}
+
+	due, err := cache.ParseDueDate(strings.Join(args, " "), time.Now())
+	if err != nil {
+		return err
+	}
+
+	_, err = b.SetDueDate(due)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("due %s\n", due.Format("Mon Jan 2 15:04:05 2006"))
+
+	return b.Commit()
+}
+
+// formatDueDate format a due date, marked if it's overdue
+func formatDueDate(due time.Time, open bool) string {
+	str := due.Format("Mon Jan 2 15:04:05 2006")
+	if open && due.Before(time.Now()) {
+		str += " " + colors.Error("(overdue)")
+	}
+	return str
+}
+
+var dueCmd = &cobra.Command{
+	Use:   "due [<id>] [<date>]",
+	Short: "Display or change the due date of a bug.",
+	Long: `Display or change the due date of a bug.
+
+The date can be:
+- a duration from now, as "+" followed by a number and a unit: h (hours), d (days), w (weeks), m (months) or y (years)
+- "today" or "tomorrow"
+- a day of the week, optionally preceded by "next", meaning the first such day after today
+- "next week", "next month" or "next year"
+- a date, with an optional time
+
+Unless a time is given, the bug is due at the end of the day. An open bug past its due date is marked as overdue in "git bug ls".`,
+	Example: `Set the due date of a bug in two weeks:
+git bug due 2f15 +2w
+
+Set the due date of the selected bug:
+git bug due next friday
+
+Remove the due date:
+git bug due 2f15 --clear
+`,
+	PreRunE: loadRepo,
+	RunE:    runDue,
+}
+
+func init() {
+	RootCmd.AddCommand(dueCmd)
+
+	dueCmd.Flags().SortFlags = false
+
+	dueCmd.Flags().BoolVar(&dueClear, "clear", false,
+		"Remove the due date")
+}
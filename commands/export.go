@@ -0,0 +1,261 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/interchange"
+	"github.com/MichaelMure/git-bug/util/git"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+const (
+	exportFormatMarkdown = "markdown"
+	exportFormatHtml     = "html"
+	exportFormatCsv      = "csv"
+	exportFormatJson     = "json"
+	exportFormatOrg      = "org"
+	exportFormatIcs      = "ics"
+	exportFormatSite     = "site"
+)
+
+var (
+	exportFormat string
+	exportQuery  string
+	exportOut    string
+)
+
+func runExport(cmd *cobra.Command, args []string) error {
+	switch exportFormat {
+	case exportFormatMarkdown, exportFormatHtml, exportFormatSite:
+		if exportOut == "" {
+			return fmt.Errorf("the %s format require an output directory with --out", exportFormat)
+		}
+	case exportFormatCsv, exportFormatJson, exportFormatOrg, exportFormatIcs:
+	default:
+		return fmt.Errorf("unknown export format %s", exportFormat)
+	}
+
+	backend, err := loadBackendForReading()
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	query := cache.NewQuery()
+	if exportQuery != "" {
+		query, err = cache.ParseQuery(exportQuery)
+		if err != nil {
+			return err
+		}
+	}
+
+	ids := backend.QueryBugs(query)
+	bugs := make([]interchange.Bug, len(ids))
+
+	for i, id := range ids {
+		b, err := backend.ResolveBug(id)
+		if err != nil {
+			return err
+		}
+		bugs[i] = interchange.FromSnapshot(b.Snapshot(), backend.LabelStore())
+	}
+
+	if exportOut != "" {
+		err = os.MkdirAll(exportOut, 0755)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch exportFormat {
+	case exportFormatMarkdown:
+		return exportFiles(bugs, ".md", interchange.WriteMarkdown, interchange.WriteMarkdownIndex)
+	case exportFormatHtml:
+		return exportFiles(bugs, ".html", interchange.WriteHTML, interchange.WriteHTMLIndex)
+	case exportFormatCsv:
+		return exportSingleFile(bugs, "bugs.csv", interchange.WriteCSV)
+	case exportFormatJson:
+		return exportSingleFile(bugs, "bugs.json", interchange.WriteJSON)
+	case exportFormatOrg:
+		return exportSingleFile(bugs, "bugs.org", interchange.WriteOrg)
+	case exportFormatIcs:
+		milestones, err := backend.MilestoneDates()
+		if err != nil {
+			return err
+		}
+		return exportSingleFile(bugs, "bugs.ics", func(w io.Writer, bugs []interchange.Bug) error {
+			return interchange.WriteICS(w, bugs, milestones)
+		})
+	case exportFormatSite:
+		return exportSite(backend, bugs)
+	}
+
+	return nil
+}
+
+// exportFiles write one file per bug, and an index listing them
+func exportFiles(bugs []interchange.Bug, ext string,
+	writeBug func(io.Writer, interchange.Bug) error,
+	writeIndex func(io.Writer, []interchange.Bug) error) error {
+
+	for _, b := range bugs {
+		err := writeExportFile(filepath.Join(exportOut, b.HumanId()+ext), func(w io.Writer) error {
+			return writeBug(w, b)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err := writeExportFile(filepath.Join(exportOut, "index"+ext), func(w io.Writer) error {
+		return writeIndex(w, bugs)
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%d bugs exported to %s\n", len(bugs), exportOut)
+	return nil
+}
+
+// exportSingleFile write all the bugs in a single file, or on the standard
+// output if no directory is given
+func exportSingleFile(bugs []interchange.Bug, name string, write func(io.Writer, []interchange.Bug) error) error {
+	if exportOut == "" {
+		return write(os.Stdout, bugs)
+	}
+
+	return writeExportFile(filepath.Join(exportOut, name), func(w io.Writer) error {
+		return write(w, bugs)
+	})
+}
+
+// exportSite write a static site browsing the bugs: the lists of the open and
+// closed bugs, a page per bug, and the files referenced by their messages
+func exportSite(backend *cache.RepoCache, bugs []interchange.Bug) error {
+	bugsDir := filepath.Join(exportOut, interchange.SiteBugsDir)
+	filesDir := filepath.Join(exportOut, interchange.SiteFilesDir)
+
+	for _, dir := range []string{bugsDir, filesDir} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+
+	files := 0
+
+	for _, b := range bugs {
+		err := writeExportFile(filepath.Join(bugsDir, b.HumanId()+".html"), func(w io.Writer) error {
+			return interchange.WriteSiteBug(w, b)
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, hash := range interchange.SiteFiles(b) {
+			data, err := backend.ReadData(git.Hash(hash))
+			if err != nil {
+				// a file of another repository, or not pulled
+				fmt.Printf("missing file %s of bug %s\n", hash, b.HumanId())
+				continue
+			}
+			err = ioutil.WriteFile(filepath.Join(filesDir, hash), data, 0644)
+			if err != nil {
+				return err
+			}
+			files++
+		}
+	}
+
+	for _, closed := range []bool{false, true} {
+		name := "index.html"
+		if closed {
+			name = "closed.html"
+		}
+
+		err := writeExportFile(filepath.Join(exportOut, name), func(w io.Writer) error {
+			return interchange.WriteSiteIndex(w, "Bugs", bugs, closed)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// serve the files as they are on GitHub Pages
+	err := ioutil.WriteFile(filepath.Join(exportOut, ".nojekyll"), nil, 0644)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%d bugs and %d files exported to %s\n", len(bugs), files, exportOut)
+	return nil
+}
+
+func writeExportFile(path string, write func(io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = write(f)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the bugs to Markdown, HTML, CSV, JSON, Org, iCalendar or a static site.",
+	Long: `Export the bugs and their comments to standalone files, to share them with people who don't use git-bug.
+
+The markdown and html formats write one file per bug and an index in the --out directory. The csv, json, org and ics formats write a single file in the --out directory, or on the standard output. See doc/interchange.md for the description of the csv and json formats.
+
+The org format writes an Org mode headline per bug, to follow them in the agenda of Emacs: TODO or DONE depending on the status, the labels as tags, the due date as a deadline, and the id and author as properties.
+
+The ics format writes an iCalendar of the deadlines, to import in a calendar application: a task per bug with a due date, and an all-day event per milestone with a date, configured with "git config git-bug.milestone.<name>.due <date>". The web UI serves the same calendar at /calendar.ics, to subscribe to it.
+
+The site format writes a static site browsing the bugs in the --out directory, to publish the tracker without running the web UI, for example with GitHub Pages: the lists of the open and closed bugs, a page per bug with its rendered comments, and the files attached to them.`,
+	Example: `Export the open bugs as HTML:
+git bug export --format html --query "status:open" --out bugs/
+
+Publish the tracker as a static site:
+git bug export --format site --out public/
+
+Export all the bugs as JSON:
+git bug export --format json > bugs.json
+
+Export the open bugs to a file of the Emacs agenda:
+git bug export --format org --query "status:open" > ~/org/bugs.org
+
+Export the deadlines of the open bugs and the milestones as an iCalendar:
+git config git-bug.milestone.v1.0.due 2020-03-01
+git bug export --format ics --query "status:open" > bugs.ics
+`,
+	PreRunE: loadRepo,
+	RunE:    runExport,
+}
+
+func init() {
+	RootCmd.AddCommand(exportCmd)
+	addProfileFlags(exportCmd)
+
+	exportCmd.Flags().SortFlags = false
+
+	exportCmd.Flags().StringVarP(&exportFormat, "format", "f", exportFormatMarkdown,
+		"Select the export format. Valid values are [markdown,html,csv,json,org,ics,site]")
+	exportCmd.Flags().StringVarP(&exportQuery, "query", "q", "",
+		"Export only the bugs matching the query")
+	exportCmd.Flags().StringVarP(&exportOut, "out", "o", "",
+		"Directory to write the files to")
+}
@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+var (
+	fsckQuarantine bool
+)
+
+func runFsck(cmd *cobra.Command, args []string) error {
+	issues, err := cache.Fsck(repo, fsckQuarantine)
+
+	for _, issue := range issues {
+		fmt.Printf("%s %s\n", colors.Error(issue.Kind), colors.Id(issue.Id.Human()))
+		for _, err := range issue.Errors {
+			fmt.Printf("  %s\n", err)
+		}
+		if issue.Quarantined {
+			fmt.Printf("  moved to refs/quarantine/fsck/\n")
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(issues) > 0 {
+		return fmt.Errorf("%d corrupted entities found", len(issues))
+	}
+
+	fmt.Println("no problem found")
+
+	return nil
+}
+
+var fsckCmd = &cobra.Command{
+	Use:   "fsck",
+	Short: "Check the integrity of the bugs and identities.",
+	Long: `Check the integrity of the bugs and identities stored in the repository.
+
+Each bug and identity is read from git, and checked for:
+- the structure of the git data and the ids derived from it
+- the validity of the operations or versions, and the ordering of their clocks
+- the signatures of the operations, for the authors having declared keys
+- the references to unknown identities, operations or files
+
+With --quarantine, the corrupted entities are moved to refs/quarantine/fsck/, out of the way of the other commands but still available for inspection.`,
+	Example: `git bug fsck
+git bug fsck --quarantine
+`,
+	PreRunE: loadRepo,
+	RunE:    runFsck,
+}
+
+func init() {
+	RootCmd.AddCommand(fsckCmd)
+	addProfileFlags(fsckCmd)
+
+	fsckCmd.Flags().SortFlags = false
+
+	fsckCmd.Flags().BoolVar(&fsckQuarantine, "quarantine", false,
+		"Move the corrupted bugs and identities to refs/quarantine/fsck/")
+}
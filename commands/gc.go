@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	gcCompactOlderThan  string
+	gcCollapseOlderThan string
+	gcNoPrune           bool
+	gcNoRepack          bool
+)
+
+func runGc(cmd *cobra.Command, args []string) error {
+	opts := cache.GcOptions{
+		Prune:  !gcNoPrune,
+		Repack: !gcNoRepack,
+	}
+
+	if gcCompactOlderThan != "" {
+		var err error
+		opts.CompactBefore, err = cache.ParseTime(gcCompactOlderThan)
+		if err != nil {
+			return err
+		}
+	}
+
+	if gcCollapseOlderThan != "" {
+		var err error
+		opts.CollapseBefore, err = cache.ParseTime(gcCollapseOlderThan)
+		if err != nil {
+			return err
+		}
+	}
+
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	result, err := backend.Gc(opts)
+	if err != nil {
+		return err
+	}
+
+	if !opts.CollapseBefore.IsZero() {
+		fmt.Printf("%d bugs collapsed, %d operations archived\n", result.CollapsedBugs, result.ArchivedOperations)
+	}
+	if !opts.CompactBefore.IsZero() {
+		fmt.Printf("%d bugs compacted, %d commits removed\n", result.CompactedBugs, result.RemovedCommits)
+	}
+	if len(result.SharedBugs) > 0 {
+		fmt.Printf("%d bugs not rewritten as they exist on a remote\n", len(result.SharedBugs))
+	}
+
+	for _, ref := range result.PrunedRefs {
+		fmt.Printf("pruned %s\n", ref)
+	}
+
+	return nil
+}
+
+var gcCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Do the maintenance of the bugs data.",
+	Long: `Do the maintenance of the bugs data, to keep the size of the repository reasonable for long-lived trackers.
+
+By default, the refs of the bugs and identities fetched from remotes that are not configured anymore are removed, and the git objects are packed with "git gc".
+
+With --compact, the successive commits of a bug whose operations are all older than the given time are squashed into a single commit. The operations are kept untouched.
+
+With --collapse, the operations of a bug older than the given time are replaced with a single operation holding the state they lead to, so that reading bugs with thousands of operations stays fast. The comments and their editions are kept, but the other events, like the label changes, are only kept in the archive ref refs/archive/bugs/<id>/<commit> along with the original history. The new operation is authored by your identity.
+
+As these rewrite the history of the bug, only the bugs that don't exist on a remote yet are changed, typically right after a large import. The remotes are reached to list their bugs, and nothing is rewritten if one of them can't be reached.`,
+	Example: `Compact the history imported more than a week ago:
+git bug gc --compact 1w
+
+Replace the history imported more than a month ago with a snapshot:
+git bug gc --collapse 1m
+`,
+	PreRunE: loadRepo,
+	RunE:    runGc,
+}
+
+func init() {
+	RootCmd.AddCommand(gcCmd)
+	addProfileFlags(gcCmd)
+
+	gcCmd.Flags().SortFlags = false
+
+	gcCmd.Flags().StringVar(&gcCompactOlderThan, "compact", "",
+		"Squash the commits of the operations older than the given date or duration (ex: \"2019-12-31\" or \"30d\")")
+	gcCmd.Flags().StringVar(&gcCollapseOlderThan, "collapse", "",
+		"Replace the operations older than the given date or duration (ex: \"2019-12-31\" or \"30d\") with a snapshot, archiving them")
+	gcCmd.Flags().BoolVar(&gcNoPrune, "no-prune", false,
+		"Don't remove the refs of the remotes not configured anymore")
+	gcCmd.Flags().BoolVar(&gcNoRepack, "no-repack", false,
+		"Don't pack the git objects")
+}
@@ -0,0 +1,213 @@
+package commands
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	grepIgnoreCase       bool
+	grepFixedStrings     bool
+	grepFilesWithMatches bool
+	grepAfterContext     int
+	grepBeforeContext    int
+	grepContext          int
+	grepQuery            string
+)
+
+func runGrep(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("a single pattern is required")
+	}
+
+	pattern := args[0]
+	if grepFixedStrings {
+		pattern = regexp.QuoteMeta(pattern)
+	}
+	if grepIgnoreCase {
+		pattern = "(?i)" + pattern
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+
+	before, after := grepBeforeContext, grepAfterContext
+	if cmd.Flags().Changed("context") {
+		if !cmd.Flags().Changed("before-context") {
+			before = grepContext
+		}
+		if !cmd.Flags().Changed("after-context") {
+			after = grepContext
+		}
+	}
+
+	backend, err := loadBackendForReading()
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	query := cache.NewQuery()
+	if grepQuery != "" {
+		query, err = cache.ParseQuery(grepQuery)
+		if err != nil {
+			return err
+		}
+	}
+
+	// as in git grep, "--" separate the groups of lines that are not
+	// contiguous, only when printing context lines
+	withContext := before > 0 || after > 0
+	printed := false
+
+	for _, id := range backend.QueryBugs(query) {
+		b, err := backend.ResolveBug(id)
+		if err != nil {
+			return err
+		}
+
+		snap := b.Snapshot()
+		humanId := id.Human()
+
+		if grepFilesWithMatches {
+			matched := re.MatchString(snap.Title)
+			for _, comment := range snap.Comments {
+				lines := strings.Split(comment.Message, "\n")
+				matched = matched || len(grepGroups(lines, re, 0, 0)) > 0
+			}
+			if matched {
+				fmt.Println(colors.File(humanId))
+			}
+			continue
+		}
+
+		if re.MatchString(snap.Title) {
+			if printed && withContext {
+				fmt.Println(colors.Separator("--"))
+			}
+			grepPrintLine(humanId, "title", ":", snap.Title, re)
+			printed = true
+		}
+
+		for i, comment := range snap.Comments {
+			lines := strings.Split(comment.Message, "\n")
+
+			for _, group := range grepGroups(lines, re, before, after) {
+				if printed && withContext {
+					fmt.Println(colors.Separator("--"))
+				}
+				for j := group.start; j < group.end; j++ {
+					sep := "-"
+					if re.MatchString(lines[j]) {
+						sep = ":"
+					}
+					grepPrintLine(humanId, fmt.Sprint(i), sep, lines[j], re)
+				}
+				printed = true
+			}
+		}
+	}
+
+	return nil
+}
+
+// grepGroup is a range of lines [start, end) to print, with the matching
+// lines and their context
+type grepGroup struct {
+	start, end int
+}
+
+// grepGroups return the ranges of lines to print for the lines matching the
+// regex, merging the overlapping or adjacent contexts
+func grepGroups(lines []string, re *regexp.Regexp, before, after int) []grepGroup {
+	var groups []grepGroup
+
+	for i, line := range lines {
+		if !re.MatchString(line) {
+			continue
+		}
+
+		start := i - before
+		if start < 0 {
+			start = 0
+		}
+		end := i + after + 1
+		if end > len(lines) {
+			end = len(lines)
+		}
+
+		if len(groups) > 0 && start <= groups[len(groups)-1].end {
+			groups[len(groups)-1].end = end
+			continue
+		}
+
+		groups = append(groups, grepGroup{start: start, end: end})
+	}
+
+	return groups
+}
+
+func grepPrintLine(id string, location string, sep string, line string, re *regexp.Regexp) {
+	if sep == ":" {
+		line = re.ReplaceAllStringFunc(line, func(match string) string {
+			return colors.Match(match)
+		})
+	}
+
+	fmt.Printf("%s%s%s%s%s\n",
+		colors.File(id), colors.Separator(sep),
+		colors.Location(location), colors.Separator(sep),
+		line,
+	)
+}
+
+var grepCmd = &cobra.Command{
+	Use:   "grep <pattern>",
+	Short: "Search the titles and comments of the bugs with a regular expression.",
+	Long: `Search the titles and comments of the bugs with a regular expression, in the syntax of Go (https://golang.org/s/re2syntax).
+
+Each matching line is printed with the id of the bug and the index of the comment, 0 being the description of the bug, or "title" for a match in the title. As in "git grep", the context lines are printed with a "-" separator instead of ":", and with context lines, the groups of lines that are not contiguous are separated by "--".`,
+	Example: `Search for a panic in the open bugs:
+git bug grep -i "panic:" --query "status:open"
+
+Show the lines around the matches:
+git bug grep -C 2 "segfault|segmentation fault"
+
+List the bugs mentioning a function:
+git bug grep -l -F "ResolveBug("
+`,
+	PreRunE: loadRepo,
+	RunE:    runGrep,
+}
+
+func init() {
+	RootCmd.AddCommand(grepCmd)
+	addProfileFlags(grepCmd)
+
+	grepCmd.Flags().SortFlags = false
+
+	grepCmd.Flags().BoolVarP(&grepIgnoreCase, "ignore-case", "i", false,
+		"Ignore the case when matching")
+	grepCmd.Flags().BoolVarP(&grepFixedStrings, "fixed-strings", "F", false,
+		"Interpret the pattern as a fixed string, not a regular expression")
+	grepCmd.Flags().BoolVarP(&grepFilesWithMatches, "files-with-matches", "l", false,
+		"Only print the ids of the matching bugs")
+	grepCmd.Flags().IntVarP(&grepAfterContext, "after-context", "A", 0,
+		"Print <num> lines of context after the matching lines")
+	grepCmd.Flags().IntVarP(&grepBeforeContext, "before-context", "B", 0,
+		"Print <num> lines of context before the matching lines")
+	grepCmd.Flags().IntVarP(&grepContext, "context", "C", 0,
+		"Print <num> lines of context before and after the matching lines")
+	grepCmd.Flags().StringVarP(&grepQuery, "query", "q", "",
+		"Search only in the bugs matching the query")
+}
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// captureStdout return what f printed on the standard output, without colors
+func captureStdout(t *testing.T, f func() error) (string, error) {
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+
+	stdout, noColor := os.Stdout, color.NoColor
+	os.Stdout, color.NoColor = w, true
+
+	output := make(chan string)
+	go func() {
+		var buf bytes.Buffer
+		_, _ = io.Copy(&buf, r)
+		output <- buf.String()
+	}()
+
+	err = f()
+
+	os.Stdout, color.NoColor = stdout, noColor
+	require.NoError(t, w.Close())
+
+	return <-output, err
+}
+
+func TestGrep(t *testing.T) {
+	testRepo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, testRepo)
+
+	repo = testRepo
+	defer func() { repo = nil }()
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	iden, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(iden))
+
+	b, _, err := backend.NewBug("Parser crash", "it crashes\nwith a panic\non every file")
+	require.NoError(t, err)
+	_, _, err = backend.NewBug("Slow startup", "it takes a while")
+	require.NoError(t, err)
+	require.NoError(t, backend.Close())
+
+	defer func() {
+		grepIgnoreCase = false
+		grepFixedStrings = false
+		grepFilesWithMatches = false
+	}()
+
+	id := b.Id().Human()
+
+	// a match in the title and in a comment
+	output, err := captureStdout(t, func() error {
+		return runGrep(grepCmd, []string{"crash"})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, id+":title:Parser crash\n"+id+":0:it crashes\n", output)
+
+	grepIgnoreCase = true
+	output, err = captureStdout(t, func() error {
+		return runGrep(grepCmd, []string{"PANIC"})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, id+":0:with a panic\n", output)
+
+	grepFilesWithMatches = true
+	output, err = captureStdout(t, func() error {
+		return runGrep(grepCmd, []string{"every"})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, id+"\n", output)
+	grepFilesWithMatches = false
+
+	// no match
+	output, err = captureStdout(t, func() error {
+		return runGrep(grepCmd, []string{"segfault"})
+	})
+	require.NoError(t, err)
+	assert.Empty(t, output)
+
+	// an invalid regexp, fine as a fixed string
+	_, err = captureStdout(t, func() error {
+		return runGrep(grepCmd, []string{"crash("})
+	})
+	assert.EqualError(t, err, "error parsing regexp: missing closing ): `(?i)crash(`")
+
+	grepFixedStrings = true
+	output, err = captureStdout(t, func() error {
+		return runGrep(grepCmd, []string{"crash("})
+	})
+	require.NoError(t, err)
+	assert.Empty(t, output)
+}
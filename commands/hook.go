@@ -0,0 +1,27 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var hookCmd = &cobra.Command{
+	Use:   "hook",
+	Short: "Close the bugs from the trailers of the commit messages.",
+	Long: `Close the bugs referenced by the trailers of the commit messages, like:
+
+    Fix the crash of the parser on empty files
+
+    Closes-bug: 4a2b6c1
+
+The trailers are the lines of the last paragraph of the message. Their keys are read from git-bug.hook.trailers, "Closes-bug, Fixes-bug, Fixes" by default, and their values are the ids of the bugs, of at least 7 characters and separated by commas or spaces. The values not matching a bug, like the hash of a commit, are ignored.
+
+Each bug is closed by your identity, with the hash of the commit recorded in the metadata of the status change, so that a commit closes a bug only once.
+
+"git bug hook install" installs the git hooks closing the bugs after each commit and merge.`,
+}
+
+func init() {
+	RootCmd.AddCommand(hookCmd)
+
+	hookCmd.Flags().SortFlags = false
+}
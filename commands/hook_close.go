@@ -0,0 +1,222 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+const (
+	hookTrailersConfigKey = "git-bug.hook.trailers"
+
+	// the metadata of the status changes made from a commit, with its hash
+	commitMetadataKey = "git-commit"
+
+	// the shortest value of a trailer taken as the id of a bug
+	minTrailerIdLength = 7
+)
+
+var defaultHookTrailers = []string{"Closes-bug", "Fixes-bug", "Fixes"}
+
+func runHookClose(cmd *cobra.Command, args []string) error {
+	revisions := "HEAD"
+	if len(args) == 1 {
+		revisions = args[0]
+	}
+
+	trailers, err := readHookTrailers()
+	if err != nil {
+		return err
+	}
+
+	commits, err := repo.ReadCommits(revisions)
+	if err != nil {
+		return err
+	}
+
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	// from the oldest, as a later commit could reopen the bug some day
+	for i := len(commits) - 1; i >= 0; i-- {
+		commit := commits[i]
+
+		for _, id := range commitTrailerIds(commit.Message, trailers) {
+			b, err := backend.ResolveBugPrefix(id)
+			if err == bug.ErrBugNotExist {
+				continue
+			}
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "%s: %v\n", id, err)
+				continue
+			}
+
+			err = closeFromCommit(backend, b, commit.Hash.String())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// closeFromCommit close a bug, unless already closed or closed by the same
+// commit before
+func closeFromCommit(backend *cache.RepoCache, b *cache.BugCache, hash string) error {
+	short := hash
+	if len(short) > 7 {
+		short = short[:7]
+	}
+
+	_, err := b.ResolveOperationWithMetadata(commitMetadataKey, hash)
+	if err == nil {
+		return nil
+	}
+	if err != cache.ErrNoMatchingOp {
+		return err
+	}
+
+	if b.Snapshot().Status == bug.ClosedStatus {
+		fmt.Printf("%s already closed, referenced by %s\n", colors.Id(b.Id().Human()), short)
+		return nil
+	}
+
+	author, err := backend.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	_, err = b.CloseRaw(author, time.Now().Unix(), map[string]string{commitMetadataKey: hash})
+	if err != nil {
+		return err
+	}
+
+	err = b.Commit()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s closed by %s\n", colors.Id(b.Id().Human()), short)
+	return nil
+}
+
+func readHookTrailers() ([]string, error) {
+	value, err := repository.ReadConfigAnyScope(repo, hookTrailersConfigKey)
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return defaultHookTrailers, nil
+	}
+	return splitHookTrailers(value), nil
+}
+
+func splitHookTrailers(value string) []string {
+	var result []string
+	for _, trailer := range strings.Split(value, ",") {
+		if trailer = strings.TrimSpace(trailer); trailer != "" {
+			result = append(result, trailer)
+		}
+	}
+	return result
+}
+
+func validateHookTrailers(value string) error {
+	trailers := splitHookTrailers(value)
+	if len(trailers) == 0 {
+		return fmt.Errorf("no trailer")
+	}
+	for _, trailer := range trailers {
+		if strings.IndexFunc(trailer, unicode.IsSpace) >= 0 || strings.Contains(trailer, ":") {
+			return fmt.Errorf("invalid trailer %s", trailer)
+		}
+	}
+	return nil
+}
+
+// commitTrailerIds return the values looking like bug ids of the trailers
+// with the given keys, in the last paragraph of a commit message
+func commitTrailerIds(message string, keys []string) []string {
+	message = strings.Replace(message, "\r\n", "\n", -1)
+	paragraphs := strings.Split(strings.TrimSpace(message), "\n\n")
+
+	// the subject is never a trailer
+	if len(paragraphs) < 2 {
+		return nil
+	}
+
+	var result []string
+
+	for _, line := range strings.Split(strings.TrimSpace(paragraphs[len(paragraphs)-1]), "\n") {
+		i := strings.Index(line, ":")
+		if i <= 0 || !containsFold(keys, strings.TrimSpace(line[:i])) {
+			continue
+		}
+
+		values := strings.FieldsFunc(line[i+1:], func(r rune) bool {
+			return r == ',' || unicode.IsSpace(r)
+		})
+		for _, value := range values {
+			value = strings.TrimPrefix(value, "#")
+			if len(value) >= minTrailerIdLength && isHex(value) {
+				result = append(result, strings.ToLower(value))
+			}
+		}
+	}
+
+	return result
+}
+
+func containsFold(values []string, s string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func isHex(s string) bool {
+	for _, r := range s {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+			return false
+		}
+	}
+	return true
+}
+
+var hookCloseCmd = &cobra.Command{
+	Use:   "close [<revisions>]",
+	Short: "Close the bugs referenced by the trailers of commits.",
+	Long: `Close the bugs referenced by the trailers of the commit messages of a revision, HEAD by default, or of a range like ORIG_HEAD..HEAD.
+
+This is what the hooks installed by "git bug hook install" run.`,
+	Example: `Close the bugs referenced by the last commit:
+git bug hook close
+
+Close the bugs referenced by the commits of a branch:
+git bug hook close main..feature
+`,
+	Args:    cobra.MaximumNArgs(1),
+	PreRunE: loadRepoEnsureUser,
+	RunE:    runHookClose,
+}
+
+func init() {
+	hookCmd.AddCommand(hookCloseCmd)
+}
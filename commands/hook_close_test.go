@@ -0,0 +1,29 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommitTrailerIds(t *testing.T) {
+	var tests = []struct {
+		message  string
+		expected []string
+	}{
+		{"Fix the parser\n\nCloses-bug: 4a2b6c1", []string{"4a2b6c1"}},
+		{"Fix the parser\n\nSome details.\n\ncloses-bug: #4A2B6C1, 9f8e7d6c\nSigned-off-by: René <rene@descartes.fr>", []string{"4a2b6c1", "9f8e7d6c"}},
+		{"Fix the parser\n\nFixes: 1234abcd (\"Rewrite the parser\")", []string{"1234abcd"}},
+		// only the last paragraph
+		{"Fix the parser\n\nCloses-bug: 4a2b6c1\n\nSigned-off-by: René <rene@descartes.fr>", nil},
+		// never the subject
+		{"Closes-bug: 4a2b6c1", nil},
+		// too short, or not an id
+		{"Fix the parser\n\nFixes: 4a2 and the rest", nil},
+		{"Fix the parser\n\nReviewed-by: 4a2b6c1", nil},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, commitTrailerIds(test.message, defaultHookTrailers), test.message)
+	}
+}
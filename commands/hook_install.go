@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// the first line of the hooks written by git-bug, to recognize them
+const hookScriptMarker = "# installed by git bug hook install"
+
+// the revisions of the new commits, by git hook
+var gitHookRevisions = []struct {
+	hook      string
+	revisions string
+}{
+	{"post-commit", "HEAD"},
+	{"post-merge", "ORIG_HEAD..HEAD"},
+}
+
+var (
+	hookInstallForce bool
+)
+
+func runHookInstall(cmd *cobra.Command, args []string) error {
+	dir, err := repo.GetHooksPath()
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+
+	for _, h := range gitHookRevisions {
+		p := filepath.Join(dir, h.hook)
+
+		existing, err := ioutil.ReadFile(p)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err == nil && !strings.Contains(string(existing), hookScriptMarker) && !hookInstallForce {
+			return fmt.Errorf("%s already exists, use --force to replace it", p)
+		}
+
+		script := fmt.Sprintf("#!/bin/sh\n%s\ngit bug hook close %s\n", hookScriptMarker, h.revisions)
+
+		err = ioutil.WriteFile(p, []byte(script), 0755)
+		if err != nil {
+			return err
+		}
+		// WriteFile doesn't change the mode of an existing file
+		err = os.Chmod(p, 0755)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("installed %s\n", p)
+	}
+
+	return nil
+}
+
+var hookInstallCmd = &cobra.Command{
+	Use:   "install",
+	Short: "Install the git hooks closing the bugs after each commit and merge.",
+	Long: `Install the post-commit and post-merge git hooks, closing the bugs referenced by the trailers of the new commits.
+
+An existing hook not installed by git-bug is kept, unless --force is given.`,
+	PreRunE: loadRepo,
+	RunE:    runHookInstall,
+}
+
+func init() {
+	hookCmd.AddCommand(hookInstallCmd)
+
+	hookInstallCmd.Flags().SortFlags = false
+
+	hookInstallCmd.Flags().BoolVarP(&hookInstallForce, "force", "f", false,
+		"Replace the existing hooks")
+}
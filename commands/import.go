@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/interchange"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	importFormat string
+)
+
+func runImport(cmd *cobra.Command, args []string) error {
+	if len(args) > 1 {
+		return fmt.Errorf("only one file can be imported at a time")
+	}
+
+	var input io.Reader = os.Stdin
+	format := importFormat
+
+	if len(args) == 1 && args[0] != "-" {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		input = f
+
+		if format == "" {
+			format = strings.TrimPrefix(filepath.Ext(args[0]), ".")
+		}
+	}
+
+	var bugs []interchange.Bug
+	var err error
+
+	switch format {
+	case exportFormatJson, "":
+		bugs, err = interchange.ReadJSON(input)
+	case exportFormatCsv:
+		bugs, err = interchange.ReadCSV(input)
+	default:
+		return fmt.Errorf("unknown import format %s", format)
+	}
+	if err != nil {
+		return err
+	}
+
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	backend.SetImporting(true)
+	defer backend.SetImporting(false)
+
+	results, err := interchange.Import(backend, bugs)
+
+	summary := cache.ImportSummary{Source: "-"}
+	if len(args) == 1 {
+		summary.Source = args[0]
+	}
+
+	for _, result := range results {
+		if result.Skipped {
+			fmt.Printf("%s %s already imported\n", colors.Id(result.Id.Human()), result.SourceId)
+			continue
+		}
+		fmt.Printf("%s %s imported\n", colors.Id(result.Id.Human()), result.SourceId)
+		summary.Bugs = append(summary.Bugs, result.Id)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return backend.RunHook(cache.HookPostImport, "", summary)
+}
+
+var importCmd = &cobra.Command{
+	Use:   "import [<file>]",
+	Short: "Import bugs from a JSON or CSV file.",
+	Long: `Import bugs from a JSON or CSV file, to migrate them from another tracker.
+
+The file must follow the interchange format described in doc/interchange.md, which is also the format written by "git bug export". Without a file, the bugs are read from the standard input.
+
+The authors are matched with the existing identities by name and email, or created as needed. A bug with an id already imported is skipped, so the same file can be imported again after being updated.`,
+	Example: `git bug import bugs.csv
+other-tracker-dump | git bug import --format json`,
+	PreRunE: loadRepo,
+	RunE:    runImport,
+}
+
+func init() {
+	RootCmd.AddCommand(importCmd)
+	addProfileFlags(importCmd)
+
+	importCmd.Flags().SortFlags = false
+
+	importCmd.Flags().StringVarP(&importFormat, "format", "f", "",
+		"Select the import format, by default from the file extension or json. Valid values are [json,csv]")
+}
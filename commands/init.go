@@ -0,0 +1,212 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+var (
+	initRemove bool
+)
+
+func runInit(cmd *cobra.Command, args []string) error {
+	remotes := args
+
+	if len(remotes) == 0 {
+		all, err := repo.GetRemotes()
+		if err != nil {
+			return err
+		}
+		if len(all) == 0 {
+			return fmt.Errorf("no remote configured")
+		}
+		for remote := range all {
+			remotes = append(remotes, remote)
+		}
+		sort.Strings(remotes)
+	}
+
+	config := remotesConfig(repo)
+
+	for _, remote := range remotes {
+		if initRemove {
+			removed, err := removeRefSpecs(config, remote)
+			if err != nil {
+				return err
+			}
+			for _, refSpec := range removed {
+				fmt.Printf("%s: removed %s\n", remote, refSpec)
+			}
+			continue
+		}
+
+		added, err := installRefSpecs(config, remote)
+		if err != nil {
+			return err
+		}
+		if len(added) == 0 {
+			fmt.Printf("%s: already configured\n", remote)
+		}
+		for _, refSpec := range added {
+			fmt.Printf("%s: added %s\n", remote, refSpec)
+		}
+	}
+
+	return nil
+}
+
+// remotesConfig return the config read by git for the remotes, which is not
+// the one of git-bug if it is stored elsewhere
+func remotesConfig(repo repository.RepoCommon) repository.Config {
+	if gitRepo, ok := repo.(repository.GitConfigRepo); ok {
+		return gitRepo.GitConfig()
+	}
+	return repo.LocalConfig()
+}
+
+// refSpecs return the git config keys and values of the refspecs fetching and
+// pushing the bugs and identities with a remote
+func refSpecs(remote string) (fetchKey string, fetch []string, pushKey string, push []string) {
+	return fmt.Sprintf("remote.%s.fetch", remote),
+		[]string{identity.FetchRefSpec(remote), bug.FetchRefSpec(remote)},
+		fmt.Sprintf("remote.%s.push", remote),
+		[]string{identity.PushRefSpec(), bug.PushRefSpec()}
+}
+
+// installRefSpecs add the missing refspecs of a remote, and return them
+func installRefSpecs(config repository.Config, remote string) ([]string, error) {
+	fetchKey, fetch, pushKey, push := refSpecs(remote)
+
+	var added []string
+
+	addMissing := func(key string, values []string) error {
+		existing, err := config.ReadAllValues(key)
+		if err != nil {
+			return err
+		}
+
+		// once a push refspec is configured, git push doesn't push the current
+		// branch by default anymore
+		if key == pushKey && len(existing) == 0 {
+			values = append([]string{"HEAD"}, values...)
+		}
+
+		for _, value := range values {
+			if containsString(existing, value) {
+				continue
+			}
+			err := config.AddString(key, value)
+			if err != nil {
+				return err
+			}
+			added = append(added, fmt.Sprintf("%s %s", key, value))
+		}
+		return nil
+	}
+
+	err := addMissing(fetchKey, fetch)
+	if err != nil {
+		return nil, err
+	}
+
+	err = addMissing(pushKey, push)
+	if err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
+// removeRefSpecs remove the refspecs of a remote, and return them
+func removeRefSpecs(config repository.Config, remote string) ([]string, error) {
+	fetchKey, fetch, pushKey, push := refSpecs(remote)
+
+	var removed []string
+
+	removeExisting := func(key string, values []string) error {
+		existing, err := config.ReadAllValues(key)
+		if err != nil {
+			return err
+		}
+
+		for _, value := range values {
+			if !containsString(existing, value) {
+				continue
+			}
+			err := config.RemoveValue(key, value)
+			if err != nil {
+				return err
+			}
+			removed = append(removed, fmt.Sprintf("%s %s", key, value))
+		}
+		return nil
+	}
+
+	err := removeExisting(fetchKey, fetch)
+	if err != nil {
+		return nil, err
+	}
+
+	err = removeExisting(pushKey, push)
+	if err != nil {
+		return nil, err
+	}
+
+	// the HEAD refspec added with the others, if nothing else is pushed
+	remaining, err := config.ReadAllValues(pushKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(remaining) == 1 && remaining[0] == "HEAD" && len(removed) > 0 {
+		err = removeExisting(pushKey, remaining)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return removed, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+var initCmd = &cobra.Command{
+	Use:   "init [<remote>...]",
+	Short: "Configure git remotes to fetch and push the bugs.",
+	Long: `Configure git remotes to fetch and push the bugs and identities with plain "git fetch" and "git push".
+
+Without argument, all the remotes are configured.
+
+The fetched bugs still need to be merged with the local ones, with "git bug pull --no-fetch", or a "git bug pull" later on.
+
+When a remote doesn't have any push refspec yet, the HEAD refspec is added along the ones of git-bug, so that "git push" keeps pushing the current branch.`,
+	Example: `Configure all the remotes:
+git bug init
+
+Undo the configuration of origin:
+git bug init origin --remove
+`,
+	PreRunE: loadRepo,
+	RunE:    runInit,
+}
+
+func init() {
+	RootCmd.AddCommand(initCmd)
+
+	initCmd.Flags().SortFlags = false
+
+	initCmd.Flags().BoolVar(&initRemove, "remove", false,
+		"Remove the refspecs instead of adding them")
+}
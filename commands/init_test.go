@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestInstallRefSpecs(t *testing.T) {
+	config := repository.NewMockRepoForTest().LocalConfig()
+
+	added, err := installRefSpecs(config, "origin")
+	require.NoError(t, err)
+	assert.Len(t, added, 5)
+
+	push, err := config.ReadAllValues("remote.origin.push")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"HEAD", "refs/identities/*", "refs/bugs/*"}, push)
+
+	// installing again doesn't add anything
+	added, err = installRefSpecs(config, "origin")
+	require.NoError(t, err)
+	assert.Empty(t, added)
+
+	removed, err := removeRefSpecs(config, "origin")
+	require.NoError(t, err)
+	assert.Len(t, removed, 5)
+
+	fetch, err := config.ReadAllValues("remote.origin.fetch")
+	require.NoError(t, err)
+	assert.Empty(t, fetch)
+	push, err = config.ReadAllValues("remote.origin.push")
+	require.NoError(t, err)
+	assert.Empty(t, push)
+}
+
+func TestInstallRefSpecsExistingPush(t *testing.T) {
+	config := repository.NewMockRepoForTest().LocalConfig()
+	require.NoError(t, config.AddString("remote.origin.push", "refs/heads/master"))
+
+	_, err := installRefSpecs(config, "origin")
+	require.NoError(t, err)
+
+	push, err := config.ReadAllValues("remote.origin.push")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"refs/heads/master", "refs/identities/*", "refs/bugs/*"}, push)
+
+	_, err = removeRefSpecs(config, "origin")
+	require.NoError(t, err)
+
+	push, err = config.ReadAllValues("remote.origin.push")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"refs/heads/master"}, push)
+}
+
+func TestInitReplacedConfig(t *testing.T) {
+	testRepo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, testRepo)
+
+	require.NoError(t, testRepo.GitConfig().StoreString("remote.origin.url", "https://example.com/repo.git"))
+	testRepo.SetConfig(repository.NewMemConfig(), repository.NewMemConfig())
+
+	repo = testRepo
+	defer func() { repo = nil }()
+
+	require.NoError(t, runInit(nil, nil))
+
+	// the refspecs are read by git, not by git-bug
+	fetch, err := testRepo.GitConfig().ReadAllValues("remote.origin.fetch")
+	require.NoError(t, err)
+	assert.Contains(t, fetch, bug.FetchRefSpec("origin"))
+
+	fetch, err = testRepo.LocalConfig().ReadAllValues("remote.origin.fetch")
+	require.NoError(t, err)
+	assert.Empty(t, fetch)
+
+	initRemove = true
+	defer func() { initRemove = false }()
+	require.NoError(t, runInit(nil, nil))
+
+	fetch, err = testRepo.GitConfig().ReadAllValues("remote.origin.fetch")
+	require.NoError(t, err)
+	assert.NotContains(t, fetch, bug.FetchRefSpec("origin"))
+}
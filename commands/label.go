@@ -24,7 +24,7 @@ func runLabel(cmd *cobra.Command, args []string) error {
 
 	snap := b.Snapshot()
 
-	for _, l := range snap.Labels {
+	for _, l := range backend.LabelStore().ResolveAll(snap.Labels) {
 		fmt.Println(l)
 	}
 
@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	labelEditColor       string
+	labelEditDescription string
+)
+
+func runLabelEdit(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("only one label can be edited at a time")
+	}
+
+	colorChanged := cmd.Flags().Changed("color")
+	descriptionChanged := cmd.Flags().Changed("description")
+
+	if !colorChanged && !descriptionChanged {
+		return fmt.Errorf("nothing to edit, use --color and/or --description")
+	}
+
+	label := bug.Label(args[0])
+	if err := label.Validate(); err != nil {
+		return err
+	}
+
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	if colorChanged {
+		color, err := bug.ParseLabelColor(labelEditColor)
+		if err != nil {
+			return err
+		}
+		err = backend.SetLabelColor(label, color)
+		if err != nil {
+			return err
+		}
+	}
+
+	if descriptionChanged {
+		err = backend.SetLabelDescription(label, labelEditDescription)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var labelEditCmd = &cobra.Command{
+	Use:   "edit <label>",
+	Short: "Edit the color or description of a label.",
+	Long: `Edit the color or description of a label.
+
+The label definitions are stored in the repository configuration and are not shared with the bugs.`,
+	Example: `git bug label edit bug --color "#d73a4a" --description "Something isn't working"`,
+	PreRunE: loadRepo,
+	RunE:    runLabelEdit,
+}
+
+func init() {
+	labelCmd.AddCommand(labelEditCmd)
+
+	labelEditCmd.Flags().SortFlags = false
+
+	labelEditCmd.Flags().StringVarP(&labelEditColor, "color", "c", "",
+		"Set the color of the label, as #rrggbb")
+	labelEditCmd.Flags().StringVarP(&labelEditDescription, "description", "d", "",
+		"Set the description of the label")
+}
@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runLabelRename(cmd *cobra.Command, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("a label and its new name are required")
+	}
+
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	return backend.RenameLabel(bug.Label(args[0]), bug.Label(args[1]))
+}
+
+var labelRenameCmd = &cobra.Command{
+	Use:   "rename <label> <new name>",
+	Short: "Rename a label.",
+	Long: `Rename a label.
+
+The existing bugs are not modified. Instead, the old name is recorded as an alias of the new one, so that the bugs having the old label are displayed and queried with the new name.`,
+	PreRunE: loadRepo,
+	RunE:    runLabelRename,
+}
+
+func init() {
+	labelCmd.AddCommand(labelRenameCmd)
+}
@@ -5,11 +5,16 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/commands/select"
 	"github.com/MichaelMure/git-bug/util/interrupt"
 )
 
+var (
+	labelRmDefinition bool
+)
+
 func runLabelRm(cmd *cobra.Command, args []string) error {
 	backend, err := cache.NewRepoCache(repo)
 	if err != nil {
@@ -18,6 +23,16 @@ func runLabelRm(cmd *cobra.Command, args []string) error {
 	defer backend.Close()
 	interrupt.RegisterCleaner(backend.Close)
 
+	if labelRmDefinition {
+		for _, label := range args {
+			err = backend.RemoveLabelDefinition(bug.Label(label))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	b, args, err := _select.ResolveBug(backend, args)
 	if err != nil {
 		return err
@@ -37,12 +52,20 @@ func runLabelRm(cmd *cobra.Command, args []string) error {
 }
 
 var labelRmCmd = &cobra.Command{
-	Use:     "rm [<id>] <label>[...]",
-	Short:   "Remove a label from a bug.",
+	Use:   "rm [<id>] <label>[...]",
+	Short: "Remove a label from a bug.",
+	Long: `Remove a label from a bug.
+
+With --definition, the color and description of the labels are removed from the repository instead.`,
 	PreRunE: loadRepo,
 	RunE:    runLabelRm,
 }
 
 func init() {
 	labelCmd.AddCommand(labelRmCmd)
+
+	labelRmCmd.Flags().SortFlags = false
+
+	labelRmCmd.Flags().BoolVar(&labelRmDefinition, "definition", false,
+		"Remove the definition (color, description) of the labels instead of removing them from a bug")
 }
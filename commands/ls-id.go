@@ -5,13 +5,12 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/util/interrupt"
 )
 
 func runLsID(cmd *cobra.Command, args []string) error {
 
-	backend, err := cache.NewRepoCache(repo)
+	backend, err := loadBackendForReading()
 	if err != nil {
 		return err
 	}
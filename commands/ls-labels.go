@@ -3,13 +3,13 @@ package commands
 import (
 	"fmt"
 
-	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/colors"
 	"github.com/MichaelMure/git-bug/util/interrupt"
 	"github.com/spf13/cobra"
 )
 
 func runLsLabel(cmd *cobra.Command, args []string) error {
-	backend, err := cache.NewRepoCache(repo)
+	backend, err := loadBackendForReading()
 	if err != nil {
 		return err
 	}
@@ -17,9 +17,16 @@ func runLsLabel(cmd *cobra.Command, args []string) error {
 	interrupt.RegisterCleaner(backend.Close)
 
 	labels := backend.ValidLabels()
+	store := backend.LabelStore()
 
 	for _, l := range labels {
-		fmt.Println(l)
+		lc256 := store.Color(l).Term256()
+		fmt.Printf("%s %s", colors.Term256(int(lc256), "◼"), l)
+
+		if description := store.Description(l); description != "" {
+			fmt.Printf(" %s", colors.Description(description))
+		}
+		fmt.Println()
 	}
 
 	return nil
@@ -30,7 +37,7 @@ var lsLabelCmd = &cobra.Command{
 	Short: "List valid labels.",
 	Long: `List valid labels.
 
-Note: in the future, a proper label policy could be implemented where valid labels are defined in a configuration file. Until that, the default behavior is to return the list of labels already used.`,
+The valid labels are the ones defined with "git bug label edit" and the ones already used. Renamed labels are listed under their new name.`,
 	PreRunE: loadRepo,
 	RunE:    runLsLabel,
 }
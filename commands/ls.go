@@ -3,11 +3,14 @@ package commands
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	text "github.com/MichaelMure/go-term-text"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
 	"github.com/MichaelMure/git-bug/util/colors"
 	"github.com/MichaelMure/git-bug/util/interrupt"
 )
@@ -16,43 +19,108 @@ var (
 	lsStatusQuery      []string
 	lsAuthorQuery      []string
 	lsParticipantQuery []string
+	lsAssigneeQuery    []string
 	lsLabelQuery       []string
 	lsTitleQuery       []string
+	lsPriorityQuery    []string
+	lsMilestoneQuery   []string
+	lsFullTextQuery    []string
+	lsTouchingQuery    []string
 	lsActorQuery       []string
 	lsNoQuery          []string
+	lsScope            string
 	lsSortBy           string
 	lsSortDirection    string
+	lsOutputFormat     string
+	lsOutputTemplate   string
 )
 
+const (
+	// the sorting used when none is given
+	lsSortConfigKey = "git-bug.ls.sort"
+	// the query used when none is given
+	lsQueryConfigKey = "git-bug.ls.query"
+)
+
+// lsTemplateData is the data available in the --template of ls
+type lsTemplateData struct {
+	*cache.BugExcerpt
+	// the display name of the author
+	Author string
+}
+
 func runLsBug(cmd *cobra.Command, args []string) error {
-	backend, err := cache.NewRepoCache(repo)
+	tmpl, err := parseOutputTemplate(lsOutputFormat, lsOutputTemplate)
+	if err != nil {
+		return err
+	}
+
+	backend, err := loadBackendForReading()
 	if err != nil {
 		return err
 	}
 	defer backend.Close()
 	interrupt.RegisterCleaner(backend.Close)
 
-	var query *cache.Query
-	if len(args) >= 1 {
-		query, err = cache.ParseQuery(strings.Join(args, " "))
+	defaultQuery, err := repository.ReadConfigAnyScope(backend, lsQueryConfigKey)
+	if err != nil {
+		return err
+	}
+	defaultSorting, err := lsConfiguredSorting(backend)
+	if err != nil {
+		return err
+	}
+
+	sortFlags := cmd.Flags().Changed("by") || cmd.Flags().Changed("direction")
 
+	var query *cache.Query
+	switch {
+	case len(args) >= 1:
+		query, err = cache.ParseQueryWithSorting(strings.Join(args, " "), defaultSorting)
 		if err != nil {
 			return err
 		}
-	} else {
+	case defaultQuery != "" && !sortFlags && !lsHasFilterFlags():
+		query, err = cache.ParseQueryWithSorting(defaultQuery, defaultSorting)
+		if err != nil {
+			return errors.Wrap(err, lsQueryConfigKey)
+		}
+	default:
 		query, err = lsQueryFromFlags()
 		if err != nil {
 			return err
 		}
+		if defaultSorting != nil && !sortFlags {
+			query.Sorting = defaultSorting
+		}
 	}
 
-	allIds := backend.QueryBugs(query)
+	// the bugs of the current component of a monorepo, unless the query
+	// has its own scope
+	scope, err := flagScope(cmd, backend, lsScope)
+	if err != nil {
+		return err
+	}
+	if scope != "" && len(query.Scope) == 0 {
+		query.Scope = append(query.Scope, cache.ScopeFilter(scope))
+	}
 
-	for _, id := range allIds {
-		b, err := backend.ResolveBugExcerpt(id)
+	// the paths are relative to the current directory, and apply to any query
+	for _, p := range lsTouchingQuery {
+		p, err := worktreePath(backend, p)
 		if err != nil {
 			return err
 		}
+		query.AddTouching(p)
+	}
+
+	now := time.Now()
+
+	// the bugs are streamed, not to sort them all before printing the first
+	// ones
+	it := backend.IterateBugs(query)
+	for it.Next() {
+		b := it.Value()
 
 		var name string
 		if b.AuthorId != "" {
@@ -66,12 +134,18 @@ func runLsBug(cmd *cobra.Command, args []string) error {
 			name = b.LegacyAuthor.DisplayName()
 		}
 
+		if tmpl != nil {
+			err = executeOutputTemplate(tmpl, lsTemplateData{BugExcerpt: b, Author: name})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
 		var labelsTxt strings.Builder
-		for _, l := range b.Labels {
-			lc256 := l.Color().Term256()
-			labelsTxt.WriteString(lc256.Escape())
-			labelsTxt.WriteString(" ◼")
-			labelsTxt.WriteString(lc256.Unescape())
+		for _, l := range backend.LabelStore().ResolveAll(b.Labels) {
+			lc256 := backend.LabelStore().Color(l).Term256()
+			labelsTxt.WriteString(colors.Term256(int(lc256), " ◼"))
 		}
 
 		// truncate + pad if needed
@@ -84,18 +158,51 @@ func runLsBug(cmd *cobra.Command, args []string) error {
 			comments = "    ∞ 💬"
 		}
 
-		fmt.Printf("%s %s\t%s\t%s\t%s\n",
-			colors.Cyan(b.Id.Human()),
-			colors.Yellow(b.Status),
+		var overdue string
+		if b.Overdue(now) {
+			overdue = " " + colors.Error("overdue")
+		}
+
+		fmt.Printf("%s %s\t%s\t%s\t%s%s\n",
+			colors.Id(b.Id.Human()),
+			colors.Status(b.Status),
 			titleFmt+labelsFmt,
-			colors.Magenta(authorFmt),
+			colors.Author(authorFmt),
 			comments,
+			overdue,
 		)
 	}
 
 	return nil
 }
 
+// lsConfiguredSorting read the sorting configured with git-bug.ls.sort, nil
+// if there is none
+func lsConfiguredSorting(repo repository.RepoCommon) ([]cache.SortKey, error) {
+	value, err := repository.ReadConfigAnyScope(repo, lsSortConfigKey)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	sorting, err := cache.ParseSortKeys(value)
+	if err != nil {
+		return nil, errors.Wrap(err, lsSortConfigKey)
+	}
+
+	return sorting, nil
+}
+
+// lsHasFilterFlags tell if a filter was given with the flags
+func lsHasFilterFlags() bool {
+	for _, filters := range [][]string{lsStatusQuery, lsAuthorQuery, lsParticipantQuery,
+		lsAssigneeQuery, lsLabelQuery, lsTitleQuery, lsPriorityQuery, lsMilestoneQuery, lsFullTextQuery, lsActorQuery, lsNoQuery} {
+		if len(filters) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // Transform the command flags into a query
 func lsQueryFromFlags() (*cache.Query, error) {
 	query := cache.NewQuery()
@@ -113,6 +220,23 @@ func lsQueryFromFlags() (*cache.Query, error) {
 		query.Title = append(query.Title, f)
 	}
 
+	for _, priority := range lsPriorityQuery {
+		f, err := cache.PriorityFilter(priority)
+		if err != nil {
+			return nil, err
+		}
+		query.Priority = append(query.Priority, f)
+	}
+
+	for _, milestone := range lsMilestoneQuery {
+		f := cache.MilestoneFilter(milestone)
+		query.Milestone = append(query.Milestone, f)
+	}
+
+	for _, words := range lsFullTextQuery {
+		query.AddFullText(words)
+	}
+
 	for _, author := range lsAuthorQuery {
 		f := cache.AuthorFilter(author)
 		query.Author = append(query.Author, f)
@@ -128,6 +252,11 @@ func lsQueryFromFlags() (*cache.Query, error) {
 		query.Participant = append(query.Participant, f)
 	}
 
+	for _, assignee := range lsAssigneeQuery {
+		f := cache.AssigneeFilter(assignee)
+		query.Assignee = append(query.Assignee, f)
+	}
+
 	for _, label := range lsLabelQuery {
 		f := cache.LabelFilter(label)
 		query.Label = append(query.Label, f)
@@ -137,31 +266,39 @@ func lsQueryFromFlags() (*cache.Query, error) {
 		switch no {
 		case "label":
 			query.NoFilters = append(query.NoFilters, cache.NoLabelFilter())
+		case "assignee":
+			query.NoFilters = append(query.NoFilters, cache.NoAssigneeFilter())
+		case "milestone":
+			query.NoFilters = append(query.NoFilters, cache.NoMilestoneFilter())
+		case "priority":
+			query.NoFilters = append(query.NoFilters, cache.NoPriorityFilter())
+		case "scope":
+			query.Scope = append(query.Scope, cache.NoScopeFilter())
 		default:
 			return nil, fmt.Errorf("unknown \"no\" filter %s", no)
 		}
 	}
 
-	switch lsSortBy {
-	case "id":
-		query.OrderBy = cache.OrderById
-	case "creation":
-		query.OrderBy = cache.OrderByCreation
-	case "edit":
-		query.OrderBy = cache.OrderByEdit
-	default:
-		return nil, fmt.Errorf("unknown sort flag %s", lsSortBy)
-	}
-
 	switch lsSortDirection {
-	case "asc":
-		query.OrderDirection = cache.OrderAscending
-	case "desc":
-		query.OrderDirection = cache.OrderDescending
+	case "asc", "desc":
 	default:
 		return nil, fmt.Errorf("unknown sort direction %s", lsSortDirection)
 	}
 
+	// the direction apply to the keys that don't have one
+	keys := strings.Split(lsSortBy, ",")
+	for i, key := range keys {
+		if !strings.HasSuffix(key, "-asc") && !strings.HasSuffix(key, "-desc") {
+			keys[i] = key + "-" + lsSortDirection
+		}
+	}
+
+	sorting, err := cache.ParseSortKeys(strings.Join(keys, ","))
+	if err != nil {
+		return nil, err
+	}
+	query.Sorting = sorting
+
 	return query, nil
 }
 
@@ -170,12 +307,30 @@ var lsCmd = &cobra.Command{
 	Short: "List bugs.",
 	Long: `Display a summary of each bugs.
 
-You can pass an additional query to filter and order the list. This query can be expressed either with a simple query language or with flags.`,
+You can pass an additional query to filter and order the list. This query can be expressed either with a simple query language or with flags.
+
+Without query, the one configured with "git bug config set ls.query" is used. The sorting configured with "git bug config set ls.sort" is used when the query doesn't have one.
+
+In a monorepo, the directories of the components can be configured with "git bug config set scopes". Only the bugs of the component of the current directory are then listed, unless the query has a scope: term or another scope is given with --scope. Use --scope "" or no:scope to list all the bugs.
+
+The bugs mentioned by their id in the messages of the commits of the local branches are linked to these commits. With --touching, or a touching: term, only the bugs linked to a commit changing the given file, or a file of the given directory, are listed. Unlike the other filter flags, --touching also applies to a query.`,
 	Example: `List open bugs sorted by last edition with a query:
 git bug ls status:open sort:edit-desc
 
 List closed bugs sorted by creation with flags:
 git bug ls --status closed --by creation
+
+List open bugs with the most commented first, then by last edition:
+git bug ls status:open sort:comments,edit-desc
+
+List the id and title of the open bugs with a template:
+git bug ls status:open --format template --template '{{.Id.Human}} {{.Title}}'
+
+List the bugs of a component of a monorepo, wherever the current directory:
+git bug ls --scope services/api status:open
+
+List the bugs mentioned by the commits changing the files of a directory:
+git bug ls --touching parser/
 `,
 	PreRunE: loadRepo,
 	RunE:    runLsBug,
@@ -183,6 +338,7 @@ git bug ls --status closed --by creation
 
 func init() {
 	RootCmd.AddCommand(lsCmd)
+	addProfileFlags(lsCmd)
 
 	lsCmd.Flags().SortFlags = false
 
@@ -192,16 +348,29 @@ func init() {
 		"Filter by author")
 	lsCmd.Flags().StringSliceVarP(&lsParticipantQuery, "participant", "p", nil,
 		"Filter by participant")
+	lsCmd.Flags().StringSliceVarP(&lsAssigneeQuery, "assignee", "", nil,
+		"Filter by assignee, \"me\" being your own identity")
 	lsCmd.Flags().StringSliceVarP(&lsActorQuery, "actor", "A", nil,
 		"Filter by actor")
 	lsCmd.Flags().StringSliceVarP(&lsLabelQuery, "label", "l", nil,
 		"Filter by label")
 	lsCmd.Flags().StringSliceVarP(&lsTitleQuery, "title", "t", nil,
 		"Filter by title")
+	lsCmd.Flags().StringSliceVarP(&lsPriorityQuery, "priority", "", nil,
+		"Filter by priority. Valid values are [none,low,medium,high]")
+	lsCmd.Flags().StringSliceVarP(&lsMilestoneQuery, "milestone", "", nil,
+		"Filter by milestone")
+	lsCmd.Flags().StringVarP(&lsScope, "scope", "", "",
+		"Filter by scope, the directory of a component in a monorepo, including its sub-directories. Default to the scope of the current directory, an empty value listing all the bugs")
+	lsCmd.Flags().StringSliceVarP(&lsFullTextQuery, "fulltext", "T", nil,
+		"Filter by words in the title or the comments")
+	lsCmd.Flags().StringSliceVarP(&lsTouchingQuery, "touching", "", nil,
+		"Filter by file or directory changed by a commit mentioning the bug, relative to the current directory")
 	lsCmd.Flags().StringSliceVarP(&lsNoQuery, "no", "n", nil,
-		"Filter by absence of something. Valid values are [label]")
+		"Filter by absence of something. Valid values are [label,assignee,milestone,priority,scope]")
 	lsCmd.Flags().StringVarP(&lsSortBy, "by", "b", "creation",
-		"Sort the results by a characteristic. Valid values are [id,creation,edit]")
+		"Sort the results by one or more comma separated characteristics, each optionally suffixed by -asc or -desc. Valid values are [id,creation,edit,status,comments,participants,title,priority,due,milestone]")
 	lsCmd.Flags().StringVarP(&lsSortDirection, "direction", "d", "asc",
-		"Select the sorting direction. Valid values are [asc,desc]")
+		"Select the sorting direction of the characteristics without explicit direction. Valid values are [asc,desc]")
+	addOutputFormatFlags(lsCmd, &lsOutputFormat, &lsOutputTemplate)
 }
@@ -0,0 +1,137 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/notify"
+)
+
+// the interval between two checks of the digests due and of the emails to
+// send again
+const notifyFlushInterval = time.Minute
+
+var (
+	notifyOnce bool
+)
+
+func runNotify(cmd *cobra.Command, args []string) error {
+	config, err := notify.ReadConfig(repo)
+	if err != nil {
+		return err
+	}
+	err = config.Validate()
+	if err != nil {
+		return err
+	}
+	if len(config.Recipients) == 0 {
+		return fmt.Errorf("no recipient, set git-bug.notify.user.<email>.mode")
+	}
+
+	// the cache is only read, without locking the repository, to run along
+	// the other commands
+	backend, err := cache.NewReadOnlyRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+
+	notifier, err := notify.NewNotifier(backend, config, notify.NewSMTPMailer(config))
+	if err != nil {
+		return err
+	}
+
+	err = notifier.Check(time.Now())
+	if notifyOnce {
+		return err
+	}
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+	}
+
+	// the notifier and the cache are used by the watch and the flushes, one
+	// at a time
+	var mu sync.Mutex
+
+	stop := backend.Watch(cache.DefaultWatchInterval, func() {
+		mu.Lock()
+		defer mu.Unlock()
+
+		_, err := backend.Refresh()
+		if err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, "Refreshing the cache:", err)
+			return
+		}
+		err = notifier.Check(time.Now())
+		if err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, err)
+		}
+	})
+	defer stop()
+
+	ticker := time.NewTicker(notifyFlushInterval)
+	defer ticker.Stop()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+
+	fmt.Printf("Sending the notifications to %d recipients through %s\n", len(config.Recipients), config.SMTP)
+
+	for {
+		select {
+		case <-quit:
+			return nil
+		case <-ticker.C:
+		}
+
+		mu.Lock()
+		err := notifier.Flush(time.Now())
+		mu.Unlock()
+		if err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, err)
+		}
+	}
+}
+
+var notifyCmd = &cobra.Command{
+	Use:   "notify",
+	Short: "Send the changes of the bugs by email.",
+	Long: `Watch the bugs and send the changes to the users by email, until interrupted.
+
+A user is told about the bugs they created, commented, changed or are assigned to, about the bugs matching their query, and when they are assigned or mentioned with @<login> in a message, but never about their own changes. The users are matched with the identities by email, and configured in the repository with:
+
+git config git-bug.notify.user.<email>.mode immediate|digest|none
+git config git-bug.notify.user.<email>.query <query>
+
+In immediate mode, an email is sent for each changed bug. In digest mode, the changes are sent together every git-bug.notify.digest-interval, 24h by default.
+
+The emails are sent through the SMTP server of git-bug.notify.smtp, as host:port, authenticating with git-bug.notify.smtp-username and git-bug.notify.smtp-password if set, from git-bug.notify.from. With git-bug.webui.url set, the emails link to the bugs in the web UI.
+
+The first run only records the existing changes. The changes already sent and the ones waiting for a digest are kept in .git/git-bug/notify-state, so that nothing is sent twice or lost across the runs.`,
+	Example: `git config git-bug.notify.smtp smtp.example.com:587
+git config git-bug.notify.from "git-bug <bugs@example.com>"
+git config git-bug.notify.user.rene@descartes.fr.mode digest
+git bug notify
+
+Send the changes since the last run, from a cron job:
+git bug notify --once
+`,
+	PreRunE: loadRepo,
+	RunE:    runNotify,
+}
+
+func init() {
+	RootCmd.AddCommand(notifyCmd)
+
+	notifyCmd.Flags().SortFlags = false
+
+	notifyCmd.Flags().BoolVar(&notifyOnce, "once", false,
+		"Check the bugs and send what is due once, instead of watching them")
+}
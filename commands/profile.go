@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+
+	"github.com/spf13/cobra"
+)
+
+// addProfileFlags add the hidden --cpuprofile and --memprofile flags to a
+// command, to measure where the time and the memory go when it runs
+func addProfileFlags(cmd *cobra.Command) {
+	var cpuProfile, memProfile string
+
+	cmd.Flags().StringVar(&cpuProfile, "cpuprofile", "",
+		"Write a CPU profile of the command to this file")
+	cmd.Flags().StringVar(&memProfile, "memprofile", "",
+		"Write a memory profile to this file when the command is done")
+	_ = cmd.Flags().MarkHidden("cpuprofile")
+	_ = cmd.Flags().MarkHidden("memprofile")
+
+	run := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if cpuProfile != "" {
+			f, err := os.Create(cpuProfile)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			err = pprof.StartCPUProfile(f)
+			if err != nil {
+				return err
+			}
+			defer pprof.StopCPUProfile()
+		}
+
+		err := run(cmd, args)
+
+		if memProfile != "" {
+			if errProfile := writeMemProfile(memProfile); errProfile != nil {
+				_, _ = fmt.Fprintln(os.Stderr, "Writing the memory profile:", errProfile)
+			}
+		}
+
+		return err
+	}
+}
+
+func writeMemProfile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	// get up-to-date statistics
+	runtime.GC()
+
+	return pprof.WriteHeapProfile(f)
+}
@@ -11,6 +11,10 @@ import (
 	"github.com/MichaelMure/git-bug/util/interrupt"
 )
 
+var (
+	pullNoFetch bool
+)
+
 func runPull(cmd *cobra.Command, args []string) error {
 	if len(args) > 1 {
 		return errors.New("Only pulling from one remote at a time is supported")
@@ -28,14 +32,16 @@ func runPull(cmd *cobra.Command, args []string) error {
 	defer backend.Close()
 	interrupt.RegisterCleaner(backend.Close)
 
-	fmt.Println("Fetching remote ...")
+	if !pullNoFetch {
+		fmt.Println("Fetching remote ...")
 
-	stdout, err := backend.Fetch(remote)
-	if err != nil {
-		return err
-	}
+		stdout, err := backend.Fetch(remote)
+		if err != nil {
+			return err
+		}
 
-	fmt.Println(stdout)
+		fmt.Println(stdout)
+	}
 
 	fmt.Println("Merging data ...")
 
@@ -44,7 +50,7 @@ func runPull(cmd *cobra.Command, args []string) error {
 			fmt.Println(result.Err)
 		}
 
-		if result.Status != entity.MergeStatusNothing {
+		if result.Status != entity.MergeStatusNothing || result.Warning != "" {
 			fmt.Printf("%s: %s\n", result.Id.Human(), result)
 		}
 	}
@@ -54,12 +60,35 @@ func runPull(cmd *cobra.Command, args []string) error {
 
 // showCmd defines the "push" subcommand.
 var pullCmd = &cobra.Command{
-	Use:     "pull [<remote>]",
-	Short:   "Pull bugs update from a git remote.",
+	Use:   "pull [<remote>]",
+	Short: "Pull bugs update from a git remote.",
+	Long: `Pull bugs update from a git remote.
+
+Before being merged, the fetched bugs are verified. In particular, operations
+claiming an author that declared signing keys must be stored in a commit signed
+by one of these keys.
+
+Available git config:
+  git-bug.verify.policy [warn|quarantine|reject]: what to do with a bug failing
+    the verification. "warn" (default) merge it anyway and report the problem,
+    "quarantine" doesn't merge it and keep a copy under refs/quarantine/<remote>/bugs/,
+    "reject" doesn't merge it.
+  git-bug.remote.<remote>.share <query>: only merge the bugs of the remote
+    matching the query.
+
+With --no-fetch, only the bugs already fetched, for example by "git fetch" on a
+remote configured with "git bug init", are merged.
+`,
 	PreRunE: loadRepo,
 	RunE:    runPull,
 }
 
 func init() {
 	RootCmd.AddCommand(pullCmd)
+	addProfileFlags(pullCmd)
+
+	pullCmd.Flags().SortFlags = false
+
+	pullCmd.Flags().BoolVar(&pullNoFetch, "no-fetch", false,
+		"Only merge the bugs and identities already fetched from the remote")
 }
@@ -38,12 +38,19 @@ func runPush(cmd *cobra.Command, args []string) error {
 
 // showCmd defines the "push" subcommand.
 var pushCmd = &cobra.Command{
-	Use:     "push [<remote>]",
-	Short:   "Push bugs update to a git remote.",
+	Use:   "push [<remote>]",
+	Short: "Push bugs update to a git remote.",
+	Long: `Push bugs update to a git remote.
+
+Available git config:
+  git-bug.remote.<remote>.share <query>: only push the bugs matching the query,
+    and the identities they reference.
+`,
 	PreRunE: loadRepo,
 	RunE:    runPush,
 }
 
 func init() {
 	RootCmd.AddCommand(pushCmd)
+	addProfileFlags(pushCmd)
 }
@@ -0,0 +1,225 @@
+package commands
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	text "github.com/MichaelMure/go-term-text"
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+const (
+	reportFormatCsv       = "csv"
+	reportFormatSparkline = "sparkline"
+)
+
+var (
+	reportSince        string
+	reportUntil        string
+	reportInterval     string
+	reportGroupBy      string
+	reportOutputFormat string
+)
+
+func runReport(cmd *cobra.Command, args []string) error {
+	opts := cache.ReportOptions{
+		Until: time.Now(),
+	}
+
+	var err error
+
+	opts.Since, err = cache.ParseTime(reportSince)
+	if err != nil {
+		return err
+	}
+
+	if reportUntil != "" {
+		opts.Until, err = cache.ParseTime(reportUntil)
+		if err != nil {
+			return err
+		}
+	}
+
+	opts.Interval, err = cache.ReportIntervalFromString(reportInterval)
+	if err != nil {
+		return err
+	}
+
+	opts.GroupBy, err = cache.ReportGroupByFromString(reportGroupBy)
+	if err != nil {
+		return err
+	}
+
+	backend, err := loadBackendForReading()
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	if len(args) >= 1 {
+		opts.Query, err = cache.ParseQuery(strings.Join(args, " "))
+		if err != nil {
+			return err
+		}
+	}
+
+	report, err := backend.Report(opts)
+	if err != nil {
+		return err
+	}
+
+	switch reportOutputFormat {
+	case outputFormatDefault:
+		reportDefaultFormatter(report, opts.GroupBy)
+		return nil
+	case reportFormatCsv:
+		return reportCsvFormatter(report, opts.GroupBy)
+	case reportFormatSparkline:
+		reportSparklineFormatter(report, opts.GroupBy)
+		return nil
+	default:
+		return fmt.Errorf("unknown output format %s", reportOutputFormat)
+	}
+}
+
+// reportSeriesName return the name to display for a series
+func reportSeriesName(series cache.ReportSeries, groupBy cache.ReportGroupBy) string {
+	switch {
+	case series.Name != "":
+		return series.Name
+	case groupBy == cache.ReportGroupByLabel:
+		return "<no label>"
+	default:
+		return "all"
+	}
+}
+
+func reportDefaultFormatter(report *cache.Report, groupBy cache.ReportGroupBy) {
+	for i, series := range report.Series {
+		if i > 0 {
+			fmt.Println()
+		}
+		if groupBy != cache.ReportGroupByNone {
+			fmt.Println(colors.Emphasis(reportSeriesName(series, groupBy)))
+		}
+
+		fmt.Printf("  %-10s %6s %6s %6s\n", "period", "opened", "closed", "open")
+		for j, point := range series.Points {
+			fmt.Printf("  %-10s %6d %6d %6d\n",
+				report.Periods[j].Format("2006-01-02"),
+				point.Opened,
+				point.Closed,
+				point.Open,
+			)
+		}
+	}
+}
+
+func reportCsvFormatter(report *cache.Report, groupBy cache.ReportGroupBy) error {
+	w := csv.NewWriter(os.Stdout)
+
+	records := [][]string{
+		{"period", "group", "opened", "closed", "open"},
+	}
+
+	for _, series := range report.Series {
+		for i, point := range series.Points {
+			records = append(records, []string{
+				report.Periods[i].Format("2006-01-02"),
+				series.Name,
+				strconv.Itoa(point.Opened),
+				strconv.Itoa(point.Closed),
+				strconv.Itoa(point.Open),
+			})
+		}
+	}
+
+	err := w.WriteAll(records)
+	if err != nil {
+		return err
+	}
+
+	return w.Error()
+}
+
+var sparklineTicks = []rune("▁▂▃▄▅▆▇█")
+
+// reportSparklineFormatter display the open bugs of each series as a
+// sparkline, followed by the count at the end of the last period
+func reportSparklineFormatter(report *cache.Report, groupBy cache.ReportGroupBy) {
+	for _, series := range report.Series {
+		max := 0
+		for _, point := range series.Points {
+			if point.Open > max {
+				max = point.Open
+			}
+		}
+
+		var line strings.Builder
+		for _, point := range series.Points {
+			tick := 0
+			if max > 0 {
+				tick = point.Open * (len(sparklineTicks) - 1) / max
+			}
+			line.WriteRune(sparklineTicks[tick])
+		}
+
+		last := 0
+		if len(series.Points) > 0 {
+			last = series.Points[len(series.Points)-1].Open
+		}
+
+		fmt.Printf("%s %s %d\n",
+			text.LeftPadMaxLine(reportSeriesName(series, groupBy), 20, 0),
+			line.String(),
+			last,
+		)
+	}
+}
+
+var reportCmd = &cobra.Command{
+	Use:   "report [<query>]",
+	Short: "Display the activity and burndown of the bugs over time.",
+	Long: `Display the activity and burndown of the bugs over time: for each period, the number of bugs opened, closed and still open at its end.
+
+You can pass an additional query to restrict the report to a subset of the bugs, with the same query language as "git bug ls".
+
+The times accepted by --since and --until are either a date (ex: 2019-12-31) or a duration relative to now (ex: 36h, 2d, 3w, 1m, 1y).`,
+	Example: `Weekly burndown of the last 3 months:
+git bug report
+
+Daily burndown of each label since the beginning of 2020, as CSV:
+git bug report --since 2020-01-01 --interval day --group-by label --format csv
+
+Sparkline of the open bugs of each author:
+git bug report --group-by author --format sparkline
+`,
+	PreRunE: loadRepo,
+	RunE:    runReport,
+}
+
+func init() {
+	RootCmd.AddCommand(reportCmd)
+
+	reportCmd.Flags().SortFlags = false
+
+	reportCmd.Flags().StringVar(&reportSince, "since", "3m",
+		"Beginning of the report")
+	reportCmd.Flags().StringVar(&reportUntil, "until", "",
+		"End of the report, now by default")
+	reportCmd.Flags().StringVarP(&reportInterval, "interval", "i", "week",
+		"Duration of each period. Valid values are [day,week,month]")
+	reportCmd.Flags().StringVarP(&reportGroupBy, "group-by", "g", "none",
+		"Split the bugs in groups. Valid values are [none,label,author]")
+	reportCmd.Flags().StringVar(&reportOutputFormat, "format", outputFormatDefault,
+		"Select the output format. Valid values are [default,csv,sparkline]")
+}
@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	rmRemotes []string
+)
+
+func runRm(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("you must provide the id of the bug to remove")
+	}
+
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	// no fallback on the selected bug, the bug to remove must be explicit
+	b, err := backend.ResolveBugPrefix(args[0])
+	if err != nil {
+		return err
+	}
+	id := b.Id()
+
+	// remove on the remotes first, so that it can be retried if it fail
+	for _, remote := range rmRemotes {
+		stdout, err := backend.RemoveBugRemote(remote, id)
+		if err != nil {
+			return err
+		}
+		fmt.Println(stdout)
+	}
+
+	err = backend.RemoveBug(id)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("removed bug %s\n", id.Human())
+
+	return nil
+}
+
+var rmCmd = &cobra.Command{
+	Use:   "rm <id>",
+	Short: "Remove a bug.",
+	Long: `Remove a bug from the local repository.
+
+A removal marker is kept under refs/removed/bugs/, so that the bug is not merged back when pulling from a remote still having it. Deleting this ref with "git update-ref -d" allow to pull the bug again.
+
+With --remote, the bug is also deleted on the given remotes. Other clones of the repository having the bug are not affected, and can push it again.`,
+	Example: `Remove a spam bug locally and on origin:
+git bug rm 2f15 --remote origin
+`,
+	PreRunE: loadRepo,
+	RunE:    runRm,
+}
+
+func init() {
+	RootCmd.AddCommand(rmCmd)
+
+	rmCmd.Flags().SortFlags = false
+
+	rmCmd.Flags().StringSliceVar(&rmRemotes, "remote", nil,
+		"Also delete the bug on this remote")
+}
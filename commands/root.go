@@ -4,12 +4,18 @@ package commands
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/input"
 	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/colors"
 )
 
 const rootCommandName = "git-bug"
@@ -17,6 +23,9 @@ const rootCommandName = "git-bug"
 // package scoped var to hold the repo after the PreRun execution
 var repo repository.ClockedRepo
 
+// set with the --non-interactive flag
+var rootNonInteractive bool
+
 // RootCmd represents the base command when called without any subcommands
 var RootCmd = &cobra.Command{
 	Use:   rootCommandName,
@@ -50,7 +59,23 @@ _git_bug() {
 `,
 }
 
+func init() {
+	RootCmd.PersistentFlags().BoolVar(&rootNonInteractive, "non-interactive", false,
+		"Never prompt the user, fail instead. Also the case when the standard input is not a terminal")
+
+	cobra.OnInitialize(func() {
+		input.SetNonInteractive(rootNonInteractive)
+	})
+}
+
 func Execute() {
+	args, err := expandAliases(os.Args[1:])
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	RootCmd.SetArgs(args)
+
 	if err := RootCmd.Execute(); err != nil {
 		os.Exit(1)
 	}
@@ -63,7 +88,7 @@ func loadRepo(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("unable to get the current working directory: %q", err)
 	}
 
-	repo, err = repository.NewGitRepo(cwd, bug.Witnesser)
+	repo, err = repository.OpenRepo(cwd, bug.Witnesser)
 	if err == repository.ErrNotARepo {
 		return fmt.Errorf("%s must be run from within a git repo", rootCommandName)
 	}
@@ -72,7 +97,65 @@ func loadRepo(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	return nil
+	return loadColorConfig(repo)
+}
+
+// loadColorConfig apply the color configuration of the repository:
+// - git-bug.color.ui, or color.ui as a fallback: auto, always or never
+// - git-bug.color.theme: the name of a builtin theme or the path of a theme file
+func loadColorConfig(repo repository.RepoCommon) error {
+	for _, key := range []string{"git-bug.color.ui", "color.ui"} {
+		val, err := repository.ReadConfigAnyScope(repo, key)
+		if err != nil {
+			return err
+		}
+		if val == "" {
+			continue
+		}
+		mode, err := colors.ParseMode(val)
+		if err != nil {
+			return errors.Wrap(err, key)
+		}
+		colors.SetMode(mode)
+		break
+	}
+
+	themePath, err := repository.ReadConfigAnyScope(repo, "git-bug.color.theme")
+	if err != nil || themePath == "" {
+		return err
+	}
+
+	return errors.Wrap(loadTheme(themePath), "git-bug.color.theme")
+}
+
+// loadTheme use a builtin theme by name, or else load a theme file, "~/"
+// being expanded to the home directory
+func loadTheme(path string) error {
+	if _, ok := colors.Themes[path]; ok {
+		return colors.UseTheme(path)
+	}
+
+	if strings.HasPrefix(path, "~/") {
+		path = filepath.Join(os.Getenv("HOME"), path[2:])
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return errors.Wrap(colors.LoadTheme(f), path)
+}
+
+// loadBackendForReading open the cache of the repository for a command that
+// only read it, read-only if another process like the termui has it open
+func loadBackendForReading() (*cache.RepoCache, error) {
+	backend, err := cache.NewRepoCache(repo)
+	if _, ok := err.(cache.ErrLocked); ok {
+		return cache.NewReadOnlyRepoCache(repo)
+	}
+	return backend, err
 }
 
 // loadRepoEnsureUser is the same as loadRepo, but also ensure that the user has configured
@@ -0,0 +1,128 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// the comma separated directories of the components of a monorepo
+const scopesConfigKey = "git-bug.scopes"
+
+// readScopes read the scopes configured with git-bug.scopes
+func readScopes(repo repository.RepoCommon) ([]string, error) {
+	val, err := repo.LocalConfig().ReadString(scopesConfigKey)
+	if err == repository.ErrNoConfigEntry {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return splitScopes(val), nil
+}
+
+// splitScopes split a list of scopes separated by commas or spaces
+func splitScopes(value string) []string {
+	var scopes []string
+	for _, field := range strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	}) {
+		if scope := bug.CleanScope(field); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// currentScope return the default scope of the commands: the deepest of the
+// configured scopes containing the current directory, or an empty string
+func currentScope(repo repository.RepoCommon) (string, error) {
+	scopes, err := readScopes(repo)
+	if err != nil || len(scopes) == 0 {
+		return "", err
+	}
+
+	dir, err := workingDirScope(repo)
+	if err != nil || dir == "" {
+		return "", err
+	}
+
+	var result string
+	for _, scope := range scopes {
+		if bug.ScopeContains(scope, dir) && len(scope) > len(result) {
+			result = scope
+		}
+	}
+
+	return result, nil
+}
+
+// workingDirScope return the current directory relative to the root of the
+// worktree, as a scope. It's empty outside of the worktree, like in a bare
+// repository.
+func workingDirScope(repo repository.RepoCommon) (string, error) {
+	gitDir, err := filepath.Abs(repo.GetPath())
+	if err != nil {
+		return "", err
+	}
+	if filepath.Base(gitDir) != ".git" {
+		return "", nil
+	}
+
+	root, err := filepath.EvalSymlinks(filepath.Dir(gitDir))
+	if err != nil {
+		return "", err
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	cwd, err = filepath.EvalSymlinks(cwd)
+	if err != nil {
+		return "", err
+	}
+
+	rel, err := filepath.Rel(root, cwd)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", nil
+	}
+
+	return bug.CleanScope(filepath.ToSlash(rel)), nil
+}
+
+// worktreePath return a path given relative to the current directory relative
+// to the root of the worktree instead, like the files of the commits
+func worktreePath(repo repository.RepoCommon, p string) (string, error) {
+	if filepath.IsAbs(p) {
+		return "", fmt.Errorf("%s: expected a path relative to the current directory", p)
+	}
+
+	dir, err := workingDirScope(repo)
+	if err != nil {
+		return "", err
+	}
+
+	result := filepath.ToSlash(filepath.Join(filepath.FromSlash(dir), p))
+	if result == ".." || strings.HasPrefix(result, "../") {
+		return "", fmt.Errorf("%s: outside of the repository", p)
+	}
+
+	return bug.CleanScope(result), nil
+}
+
+// flagScope return the scope given with the --scope flag of a command, or the
+// default one when the flag is not given
+func flagScope(cmd *cobra.Command, repo repository.RepoCommon, flag string) (string, error) {
+	if cmd.Flags().Changed("scope") {
+		return bug.CleanScope(flag), nil
+	}
+	return currentScope(repo)
+}
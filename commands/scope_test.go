@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestCurrentScope(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	root := filepath.Dir(repo.GetPath())
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "services", "api", "handlers"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "docs"), 0755))
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() { _ = os.Chdir(wd) }()
+
+	// without configured scopes
+	require.NoError(t, os.Chdir(filepath.Join(root, "services", "api")))
+	scope, err := currentScope(repo)
+	require.NoError(t, err)
+	assert.Equal(t, "", scope)
+
+	require.NoError(t, repo.LocalConfig().StoreString(scopesConfigKey, "services, services/api/"))
+
+	var tests = []struct {
+		dir   string
+		scope string
+	}{
+		{".", ""},
+		{"docs", ""},
+		{"services", "services"},
+		{"services/api", "services/api"},
+		{"services/api/handlers", "services/api"},
+	}
+
+	for _, test := range tests {
+		require.NoError(t, os.Chdir(filepath.Join(root, filepath.FromSlash(test.dir))))
+		scope, err := currentScope(repo)
+		require.NoError(t, err)
+		assert.Equal(t, test.scope, scope, test.dir)
+	}
+}
+
+func TestWorktreePath(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	root := filepath.Dir(repo.GetPath())
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "services", "api"), 0755))
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() { _ = os.Chdir(wd) }()
+
+	require.NoError(t, os.Chdir(filepath.Join(root, "services", "api")))
+
+	var tests = []struct {
+		path   string
+		result string
+	}{
+		{"handlers.go", "services/api/handlers.go"},
+		{"auth/", "services/api/auth"},
+		{".", "services/api"},
+		{"../web/main.go", "services/web/main.go"},
+		{"../..", ""},
+	}
+
+	for _, test := range tests {
+		result, err := worktreePath(repo, test.path)
+		require.NoError(t, err)
+		assert.Equal(t, test.result, result, test.path)
+	}
+
+	_, err = worktreePath(repo, "../../..")
+	assert.Error(t, err)
+	_, err = worktreePath(repo, filepath.Join(root, "README"))
+	assert.Error(t, err)
+}
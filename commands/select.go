@@ -3,16 +3,27 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"os"
 
+	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
 
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/input"
+	"github.com/MichaelMure/git-bug/termui"
 	"github.com/MichaelMure/git-bug/util/interrupt"
 )
 
+var (
+	selectInteractive bool
+)
+
 func runSelect(cmd *cobra.Command, args []string) error {
-	if len(args) == 0 {
+	interactive := selectInteractive ||
+		len(args) == 0 && input.IsInteractive() && isatty.IsTerminal(os.Stdout.Fd())
+
+	if len(args) == 0 && !interactive {
 		return errors.New("You must provide a bug id")
 	}
 
@@ -23,11 +34,27 @@ func runSelect(cmd *cobra.Command, args []string) error {
 	defer backend.Close()
 	interrupt.RegisterCleaner(backend.Close)
 
-	prefix := args[0]
+	var b *cache.BugCache
 
-	b, err := backend.ResolveBugPrefix(prefix)
-	if err != nil {
-		return err
+	if interactive {
+		err = input.RequireInteractive("choose a bug", "give an id instead")
+		if err != nil {
+			return err
+		}
+
+		id, err := termui.SelectBug(backend)
+		if err != nil {
+			return err
+		}
+		b, err = backend.ResolveBug(id)
+		if err != nil {
+			return err
+		}
+	} else {
+		b, err = backend.ResolveBugPrefix(args[0])
+		if err != nil {
+			return err
+		}
 	}
 
 	err = _select.Select(backend, b.Id())
@@ -41,11 +68,14 @@ func runSelect(cmd *cobra.Command, args []string) error {
 }
 
 var selectCmd = &cobra.Command{
-	Use:   "select <id>",
+	Use:   "select [<id>]",
 	Short: "Select a bug for implicit use in future commands.",
 	Example: `git bug select 2f15
 git bug comment
 git bug status
+
+Choose the bug with a fuzzy search on the ids, titles and labels:
+git bug select --interactive
 `,
 	Long: `Select a bug for implicit use in future commands.
 
@@ -54,6 +84,8 @@ This command allows you to omit any bug <id> argument, for example:
 instead of
   git bug show 2f153ca
 
+Without an id, or with --interactive, a fuzzy finder over the ids, titles and labels of the bugs let you choose the bug. The commands taking a bug <id> also fall back to it when running in a terminal, if no id is given and no bug is selected.
+
 The complementary command is "git bug deselect" performing the opposite operation.
 `,
 	PreRunE: loadRepo,
@@ -63,4 +95,7 @@ The complementary command is "git bug deselect" performing the opposite operatio
 func init() {
 	RootCmd.AddCommand(selectCmd)
 	selectCmd.Flags().SortFlags = false
+
+	selectCmd.Flags().BoolVarP(&selectInteractive, "interactive", "i", false,
+		"Choose the bug with an interactive fuzzy finder")
 }
@@ -7,12 +7,15 @@ import (
 	"os"
 	"path"
 
+	"github.com/mattn/go-isatty"
 	"github.com/pkg/errors"
 
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/input"
 	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/termui"
 )
 
 const selectFile = "select"
@@ -20,7 +23,8 @@ const selectFile = "select"
 var ErrNoValidId = errors.New("you must provide a bug id or use the \"select\" command first")
 
 // ResolveBug first try to resolve a bug using the first argument of the command
-// line. If it fails, it fallback to the select mechanism.
+// line. If it fails, it fallback to the select mechanism, and then to the
+// interactive fuzzy selector if running in a terminal.
 //
 // Returns:
 // - the bug if any
@@ -65,7 +69,20 @@ func ResolveBug(repo *cache.RepoCache, args []string) (*cache.BugCache, []string
 		return b, args, nil
 	}
 
-	// no selected bug and no valid first argument
+	// no selected bug and no valid first argument, let the user choose one
+	// if we are in a terminal
+	if input.IsInteractive() && isatty.IsTerminal(os.Stdout.Fd()) {
+		id, err := termui.SelectBug(repo)
+		if err != nil {
+			return nil, nil, err
+		}
+		b, err := repo.ResolveBug(id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return b, args, nil
+	}
+
 	return nil, nil, ErrNoValidId
 }
 
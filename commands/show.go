@@ -3,21 +3,50 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"os"
 	"strings"
+	"text/template"
+	"time"
 
-	"github.com/MichaelMure/git-bug/cache"
+	"github.com/dustin/go-humanize"
+
+	"github.com/MichaelMure/git-bug/bug"
 	_select "github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/interchange"
 	"github.com/MichaelMure/git-bug/util/colors"
 	"github.com/MichaelMure/git-bug/util/interrupt"
 	"github.com/spf13/cobra"
 )
 
 var (
-	showFieldsQuery string
+	showHistoryFlag    bool
+	showFieldsQuery    string
+	showOutputFormat   string
+	showOutputTemplate string
 )
 
 func runShowBug(cmd *cobra.Command, args []string) error {
-	backend, err := cache.NewRepoCache(repo)
+	org := showOutputFormat == outputFormatOrg
+	if org && (showOutputTemplate != "" || showFieldsQuery != "" || showHistoryFlag) {
+		return fmt.Errorf("--format %s can't be used with --template, --field or --history", outputFormatOrg)
+	}
+
+	var tmpl *template.Template
+	if !org {
+		var err error
+		tmpl, err = parseOutputTemplate(showOutputFormat, showOutputTemplate)
+		if err != nil {
+			return err
+		}
+	}
+	if tmpl != nil && showFieldsQuery != "" {
+		return fmt.Errorf("--field and --template can't be used together")
+	}
+	if showHistoryFlag && (tmpl != nil || showFieldsQuery != "") {
+		return fmt.Errorf("--history can't be used with --field or --template")
+	}
+
+	backend, err := loadBackendForReading()
 	if err != nil {
 		return err
 	}
@@ -37,6 +66,21 @@ func runShowBug(cmd *cobra.Command, args []string) error {
 
 	firstComment := snapshot.Comments[0]
 
+	if showHistoryFlag {
+		showHistory(snapshot)
+		return nil
+	}
+
+	if tmpl != nil {
+		return executeOutputTemplate(tmpl, snapshot)
+	}
+
+	if org {
+		return interchange.WriteOrg(os.Stdout, []interchange.Bug{
+			interchange.FromSnapshot(snapshot, backend.LabelStore()),
+		})
+	}
+
 	if showFieldsQuery != "" {
 		switch showFieldsQuery {
 		case "author":
@@ -50,7 +94,7 @@ func runShowBug(cmd *cobra.Command, args []string) error {
 		case "id":
 			fmt.Printf("%s\n", snapshot.Id())
 		case "labels":
-			for _, l := range snapshot.Labels {
+			for _, l := range backend.LabelStore().ResolveAll(snapshot.Labels) {
 				fmt.Printf("%s\n", l.String())
 			}
 		case "actors":
@@ -61,12 +105,28 @@ func runShowBug(cmd *cobra.Command, args []string) error {
 			for _, p := range snapshot.Participants {
 				fmt.Printf("%s\n", p.DisplayName())
 			}
+		case "dueDate":
+			if !snapshot.DueDate.IsZero() {
+				fmt.Printf("%s\n", snapshot.DueDate.Format(time.RFC3339))
+			}
+		case "assignees":
+			for _, a := range snapshot.Assignees {
+				fmt.Printf("%s\n", a.DisplayName())
+			}
 		case "shortId":
 			fmt.Printf("%s\n", snapshot.Id().Human())
 		case "status":
 			fmt.Printf("%s\n", snapshot.Status)
 		case "title":
 			fmt.Printf("%s\n", snapshot.Title)
+		case "commits":
+			commits, err := backend.CommitsReferencing(snapshot.Id())
+			if err != nil {
+				return err
+			}
+			for _, c := range commits {
+				fmt.Printf("%s %s\n", c.Hash, c.Subject)
+			}
 		default:
 			return fmt.Errorf("\nUnsupported field: %s\n", showFieldsQuery)
 		}
@@ -76,26 +136,42 @@ func runShowBug(cmd *cobra.Command, args []string) error {
 
 	// Header
 	fmt.Printf("[%s] %s %s\n\n",
-		colors.Yellow(snapshot.Status),
-		colors.Cyan(snapshot.Id().Human()),
+		colors.Status(snapshot.Status),
+		colors.Id(snapshot.Id().Human()),
 		snapshot.Title,
 	)
 
 	fmt.Printf("%s opened this issue %s\n\n",
-		colors.Magenta(firstComment.Author.DisplayName()),
+		colors.Author(firstComment.Author.DisplayName()),
 		firstComment.FormatTimeRel(),
 	)
 
 	// Labels
-	var labels = make([]string, len(snapshot.Labels))
-	for i := range snapshot.Labels {
-		labels[i] = string(snapshot.Labels[i])
+	resolvedLabels := backend.LabelStore().ResolveAll(snapshot.Labels)
+	var labels = make([]string, len(resolvedLabels))
+	for i, l := range resolvedLabels {
+		lc256 := backend.LabelStore().Color(l).Term256()
+		labels[i] = colors.Term256(int(lc256), "◼ ") + l.String()
 	}
 
 	fmt.Printf("labels: %s\n",
 		strings.Join(labels, ", "),
 	)
 
+	if !snapshot.DueDate.IsZero() {
+		fmt.Printf("due: %s\n", formatDueDate(snapshot.DueDate, snapshot.Status == bug.OpenStatus))
+	}
+
+	// Assignees
+	var assignees = make([]string, len(snapshot.Assignees))
+	for i := range snapshot.Assignees {
+		assignees[i] = colors.Author(snapshot.Assignees[i].DisplayName())
+	}
+
+	fmt.Printf("assignees: %s\n",
+		strings.Join(assignees, ", "),
+	)
+
 	// Actors
 	var actors = make([]string, len(snapshot.Actors))
 	for i := range snapshot.Actors {
@@ -112,10 +188,29 @@ func runShowBug(cmd *cobra.Command, args []string) error {
 		participants[i] = snapshot.Participants[i].DisplayName()
 	}
 
-	fmt.Printf("participants: %s\n\n",
+	fmt.Printf("participants: %s\n",
 		strings.Join(participants, ", "),
 	)
 
+	// Commits mentioning the bug
+	commits, err := backend.CommitsReferencing(snapshot.Id())
+	if err != nil {
+		return err
+	}
+	if len(commits) > 0 {
+		fmt.Printf("commits:\n")
+	}
+	for _, c := range commits {
+		fmt.Printf("  %s %s (%s, %s)\n",
+			colors.Id(c.ShortHash()),
+			c.Subject,
+			colors.Author(commitAuthorName(c.Author)),
+			humanize.Time(c.Time()),
+		)
+	}
+
+	fmt.Printf("\n")
+
 	// Comments
 	indent := "  "
 
@@ -129,7 +224,7 @@ func runShowBug(cmd *cobra.Command, args []string) error {
 		)
 
 		if comment.Message == "" {
-			message = colors.GreyBold("No description provided.")
+			message = colors.Placeholder("No description provided.")
 		} else {
 			message = comment.Message
 		}
@@ -143,9 +238,29 @@ func runShowBug(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// commitAuthorName return the name of a commit author given as "Name <email>"
+func commitAuthorName(author string) string {
+	if i := strings.Index(author, " <"); i > 0 {
+		return author[:i]
+	}
+	return author
+}
+
 var showCmd = &cobra.Command{
 	Use:     "show [<id>]",
 	Short:   "Display the details of a bug.",
+	Example: `Show the title and the number of comments of a bug with a template:
+git bug show 2f15 --format template --template '{{.Title}}: {{len .Comments}} comments'
+
+Show every operation of a bug, with the changes of the edits:
+git bug show 2f15 --history
+
+Show a bug as an Org mode headline, for the agenda of Emacs:
+git bug show 2f15 --format org
+
+Show the hash and subject of the commits mentioning a bug:
+git bug show 2f15 --field commits
+`,
 	PreRunE: loadRepo,
 	RunE:    runShowBug,
 }
@@ -153,5 +268,8 @@ var showCmd = &cobra.Command{
 func init() {
 	RootCmd.AddCommand(showCmd)
 	showCmd.Flags().StringVarP(&showFieldsQuery, "field", "f", "",
-		"Select field to display. Valid values are [author,authorEmail,createTime,humanId,id,labels,shortId,status,title,actors,participants]")
+		"Select field to display. Valid values are [author,authorEmail,createTime,humanId,id,labels,shortId,status,title,actors,participants,assignees,dueDate,commits]")
+	addOutputFormatFlags(showCmd, &showOutputFormat, &showOutputTemplate, outputFormatOrg)
+	showCmd.Flags().BoolVar(&showHistoryFlag, "history", false,
+		"Display every operation of the bug, with a diff of the edits")
 }
@@ -0,0 +1,130 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+// showHistory display every operation of a bug, with a diff for the edits
+func showHistory(snapshot *bug.Snapshot) {
+	// current message of each comment, to be able to diff the edits
+	messages := make(map[entity.Id]string)
+
+	for _, op := range snapshot.Operations {
+		fmt.Printf("%s %s %s\n",
+			colors.Id(op.Id().Human()),
+			colors.Author(op.GetAuthor().DisplayName()),
+			op.Time().Format("Mon Jan 2 15:04:05 2006 -0700"),
+		)
+
+		indent := "    "
+
+		switch op := op.(type) {
+		case *bug.CreateOperation:
+			messages[op.Id()] = op.Message
+			fmt.Printf("%s%s %s\n", indent, colors.Action("create"), op.Title)
+
+		case *bug.SetTitleOperation:
+			fmt.Printf("%s%s\n", indent, colors.Action("set title"))
+			fmt.Print(historyDiff(op.Was, op.Title, indent))
+
+		case *bug.AddCommentOperation:
+			messages[op.Id()] = op.Message
+			fmt.Printf("%s%s\n", indent, colors.Action("add comment"))
+
+		case *bug.EditCommentOperation:
+			fmt.Printf("%s%s %s\n", indent, colors.Action("edit comment"), op.Target.Human())
+			fmt.Print(historyDiff(messages[op.Target], op.Message, indent))
+			messages[op.Target] = op.Message
+
+		case *bug.SetStatusOperation:
+			fmt.Printf("%s%s %s\n", indent, colors.Action("set status"), op.Status)
+
+		case *bug.LabelChangeOperation:
+			fmt.Printf("%s%s", indent, colors.Action("change labels"))
+			for _, l := range op.Added {
+				fmt.Printf(" %s", colors.Added("+"+l.String()))
+			}
+			for _, l := range op.Removed {
+				fmt.Printf(" %s", colors.Removed("-"+l.String()))
+			}
+			fmt.Println()
+
+		case *bug.AssigneeChangeOperation:
+			fmt.Printf("%s%s", indent, colors.Action("change assignees"))
+			for _, i := range op.Added {
+				fmt.Printf(" %s", colors.Added("+"+i.DisplayName()))
+			}
+			for _, i := range op.Removed {
+				fmt.Printf(" %s", colors.Removed("-"+i.DisplayName()))
+			}
+			fmt.Println()
+
+		case *bug.SetDueDateOperation:
+			if op.IsClear() {
+				fmt.Printf("%s%s\n", indent, colors.Action("clear due date"))
+			} else {
+				fmt.Printf("%s%s %s\n", indent, colors.Action("set due date"),
+					time.Unix(op.Due, 0).Format("Mon Jan 2 15:04:05 2006"))
+			}
+
+		case *bug.SetMetadataOperation:
+			fmt.Printf("%s%s %s\n", indent, colors.Action("set metadata"), op.Target.Human())
+
+		case *bug.SnapshotOperation:
+			for _, c := range op.Comments {
+				messages[c.Id] = c.Message
+				if len(c.Edits) > 0 {
+					messages[c.Id] = c.Edits[len(c.Edits)-1].Message
+				}
+			}
+			fmt.Printf("%s%s %d operations, archived in %s\n", indent, colors.Action("collapse history"),
+				len(op.Archived), op.Archive)
+
+		case *bug.NoOpOperation:
+			fmt.Printf("%s%s\n", indent, colors.Action("no-op"))
+
+		default:
+			fmt.Printf("%s%s\n", indent, colors.Action("unknown operation"))
+		}
+
+		fmt.Println()
+	}
+}
+
+// historyDiff return a colored line diff between two texts
+func historyDiff(before string, after string, indent string) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:       difflib.SplitLines(before),
+		B:       difflib.SplitLines(after),
+		Context: 2,
+	})
+	if err != nil || diff == "" {
+		return ""
+	}
+
+	var result strings.Builder
+
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			line = colors.Separator(line)
+		case strings.HasPrefix(line, "+"):
+			line = colors.Added(line)
+		case strings.HasPrefix(line, "-"):
+			line = colors.Removed(line)
+		}
+		result.WriteString(indent)
+		result.WriteString(line)
+		result.WriteString("\n")
+	}
+
+	return result.String()
+}
@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestShowHistory(t *testing.T) {
+	testRepo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, testRepo)
+
+	repo = testRepo
+	defer func() { repo = nil }()
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	b, create, err := backend.NewBugRaw(rene, 1000, "Parser crash", "it crashes", nil, nil)
+	require.NoError(t, err)
+	comment, err := b.AddCommentRaw(rene, 1001, "on every file", nil, nil)
+	require.NoError(t, err)
+	edit, err := b.EditCommentRaw(rene, 1002, create.Id(), "it crashes\nwith a panic", nil)
+	require.NoError(t, err)
+	edit2, err := b.EditCommentRaw(rene, 1003, comment.Id(), "on the big files", nil)
+	require.NoError(t, err)
+	closeOp, err := b.CloseRaw(rene, 1004, nil)
+	require.NoError(t, err)
+	openOp, err := b.OpenRaw(rene, 1005, nil)
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+	require.NoError(t, backend.Close())
+
+	showHistoryFlag = true
+	defer func() { showHistoryFlag = false }()
+
+	output, err := captureStdout(t, func() error {
+		return runShowBug(nil, []string{b.Id().Human()})
+	})
+	require.NoError(t, err)
+
+	header := func(id string, unixTime int64) string {
+		return fmt.Sprintf("%s René Descartes %s\n", id,
+			time.Unix(unixTime, 0).Format("Mon Jan 2 15:04:05 2006 -0700"))
+	}
+
+	// the operations in order, with the edits diffed against the previous
+	// message of the comment
+	expected := header(create.Id().Human(), 1000) +
+		"    create Parser crash\n\n" +
+		header(comment.Id().Human(), 1001) +
+		"    add comment\n\n" +
+		header(edit.Id().Human(), 1002) +
+		"    edit comment " + create.Id().Human() + "\n" +
+		"    @@ -1 +1,2 @@\n" +
+		"     it crashes\n" +
+		"    +with a panic\n\n" +
+		header(edit2.Id().Human(), 1003) +
+		"    edit comment " + comment.Id().Human() + "\n" +
+		"    @@ -1 +1 @@\n" +
+		"    -on every file\n" +
+		"    +on the big files\n\n" +
+		header(closeOp.Id().Human(), 1004) +
+		"    set status closed\n\n" +
+		header(openOp.Id().Human(), 1005) +
+		"    set status open\n\n"
+
+	assert.Equal(t, expected, output)
+
+	// the history replaces the other outputs
+	showFieldsQuery = "title"
+	defer func() { showFieldsQuery = "" }()
+	err = runShowBug(nil, []string{b.Id().Human()})
+	assert.EqualError(t, err, "--history can't be used with --field or --template")
+}
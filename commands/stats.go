@@ -0,0 +1,260 @@
+package commands
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	text "github.com/MichaelMure/go-term-text"
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+const (
+	statsFormatJson = "json"
+	statsFormatCsv  = "csv"
+)
+
+var (
+	statsOutputFormat string
+)
+
+type statsJson struct {
+	Total                  int             `json:"total"`
+	ByStatus               map[string]int  `json:"by_status"`
+	ByLabel                map[string]int  `json:"by_label"`
+	ByAuthor               map[string]int  `json:"by_author"`
+	ByAssignee             map[string]int  `json:"by_assignee"`
+	Closed                 int             `json:"closed"`
+	MeanTimeToCloseSeconds int64           `json:"mean_time_to_close_seconds"`
+	Weeks                  []statsWeekJson `json:"weeks"`
+}
+
+type statsWeekJson struct {
+	Week   string `json:"week"`
+	Start  string `json:"start"`
+	Opened int    `json:"opened"`
+	Closed int    `json:"closed"`
+}
+
+func runStats(cmd *cobra.Command, args []string) error {
+	backend, err := loadBackendForReading()
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	var query *cache.Query
+	if len(args) >= 1 {
+		query, err = cache.ParseQuery(strings.Join(args, " "))
+		if err != nil {
+			return err
+		}
+	}
+
+	stats := backend.Stats(query)
+
+	switch statsOutputFormat {
+	case outputFormatDefault:
+		statsDefaultFormatter(stats)
+		return nil
+	case statsFormatJson:
+		return statsJsonFormatter(stats)
+	case statsFormatCsv:
+		return statsCsvFormatter(stats)
+	default:
+		return fmt.Errorf("unknown output format %s", statsOutputFormat)
+	}
+}
+
+func statsDefaultFormatter(stats *cache.Stats) {
+	fmt.Printf("%s %d\n", colors.Emphasis("Bugs:"), stats.Total)
+
+	printCounts := func(title string, counts map[string]int) {
+		if len(counts) == 0 {
+			return
+		}
+		fmt.Printf("\n%s\n", colors.Emphasis(title))
+		for _, key := range sortedCountKeys(counts) {
+			fmt.Printf("  %s %5d\n", text.LeftPadMaxLine(key, 30, 0), counts[key])
+		}
+	}
+
+	printCounts("By status:", statusCounts(stats))
+	printCounts("By label:", labelCounts(stats))
+	printCounts("By author:", stats.ByAuthor)
+	printCounts("By assignee:", stats.ByAssignee)
+
+	if stats.Closed > 0 {
+		fmt.Printf("\n%s %s (%d closed bugs)\n",
+			colors.Emphasis("Mean time to close:"),
+			formatStatsDuration(stats.MeanTimeToClose),
+			stats.Closed,
+		)
+	}
+
+	if len(stats.Weeks) > 0 {
+		fmt.Printf("\n%s\n", colors.Emphasis("Per week:"))
+		fmt.Printf("  %-10s %-10s %6s %6s\n", "week", "start", "opened", "closed")
+		for _, week := range stats.Weeks {
+			fmt.Printf("  %-10s %-10s %6d %6d\n",
+				isoWeek(week.Start),
+				week.Start.Format("2006-01-02"),
+				week.Opened,
+				week.Closed,
+			)
+		}
+	}
+}
+
+func statsJsonFormatter(stats *cache.Stats) error {
+	out := statsJson{
+		Total:                  stats.Total,
+		ByStatus:               statusCounts(stats),
+		ByLabel:                labelCounts(stats),
+		ByAuthor:               stats.ByAuthor,
+		ByAssignee:             stats.ByAssignee,
+		Closed:                 stats.Closed,
+		MeanTimeToCloseSeconds: int64(stats.MeanTimeToClose / time.Second),
+		Weeks:                  make([]statsWeekJson, len(stats.Weeks)),
+	}
+
+	for i, week := range stats.Weeks {
+		out.Weeks[i] = statsWeekJson{
+			Week:   isoWeek(week.Start),
+			Start:  week.Start.Format("2006-01-02"),
+			Opened: week.Opened,
+			Closed: week.Closed,
+		}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "    ")
+	return encoder.Encode(out)
+}
+
+// statsCsvFormatter output one metric per line, as metric,key,value
+func statsCsvFormatter(stats *cache.Stats) error {
+	w := csv.NewWriter(os.Stdout)
+
+	records := [][]string{
+		{"metric", "key", "value"},
+		{"total", "", strconv.Itoa(stats.Total)},
+	}
+
+	addCounts := func(metric string, counts map[string]int) {
+		for _, key := range sortedCountKeys(counts) {
+			records = append(records, []string{metric, key, strconv.Itoa(counts[key])})
+		}
+	}
+
+	addCounts("status", statusCounts(stats))
+	addCounts("label", labelCounts(stats))
+	addCounts("author", stats.ByAuthor)
+	addCounts("assignee", stats.ByAssignee)
+
+	records = append(records,
+		[]string{"closed", "", strconv.Itoa(stats.Closed)},
+		[]string{"mean_time_to_close_seconds", "", strconv.FormatInt(int64(stats.MeanTimeToClose/time.Second), 10)},
+	)
+
+	for _, week := range stats.Weeks {
+		records = append(records,
+			[]string{"opened", isoWeek(week.Start), strconv.Itoa(week.Opened)},
+			[]string{"closed", isoWeek(week.Start), strconv.Itoa(week.Closed)},
+		)
+	}
+
+	err := w.WriteAll(records)
+	if err != nil {
+		return err
+	}
+
+	return w.Error()
+}
+
+func statusCounts(stats *cache.Stats) map[string]int {
+	result := make(map[string]int, len(stats.ByStatus))
+	for status, count := range stats.ByStatus {
+		result[status.String()] = count
+	}
+	return result
+}
+
+func labelCounts(stats *cache.Stats) map[string]int {
+	result := make(map[string]int, len(stats.ByLabel))
+	for label, count := range stats.ByLabel {
+		result[label.String()] = count
+	}
+	return result
+}
+
+// sortedCountKeys return the keys sorted by decreasing count, then by name
+func sortedCountKeys(counts map[string]int) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+
+	return keys
+}
+
+func isoWeek(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// formatStatsDuration format a duration in days and hours, or hours and
+// minutes for the short ones
+func formatStatsDuration(d time.Duration) string {
+	days := int(d / (24 * time.Hour))
+	hours := int(d % (24 * time.Hour) / time.Hour)
+	minutes := int(d % time.Hour / time.Minute)
+
+	if days > 0 {
+		return fmt.Sprintf("%dd %dh", days, hours)
+	}
+	return fmt.Sprintf("%dh %dm", hours, minutes)
+}
+
+var statsCmd = &cobra.Command{
+	Use:   "stats [<query>]",
+	Short: "Display statistics about the bugs.",
+	Long: `Display statistics about the bugs: totals by status, label, author and assignee, mean time to close and the number of bugs opened and closed per week.
+
+You can pass an additional query to restrict the statistics to a subset of the bugs, with the same query language as "git bug ls".`,
+	Example: `Statistics of all the bugs:
+git bug stats
+
+Statistics of the bugs with the "bug" label, as JSON:
+git bug stats label:bug --format json
+`,
+	PreRunE: loadRepo,
+	RunE:    runStats,
+}
+
+func init() {
+	RootCmd.AddCommand(statsCmd)
+	addProfileFlags(statsCmd)
+
+	statsCmd.Flags().SortFlags = false
+
+	statsCmd.Flags().StringVar(&statsOutputFormat, "format", outputFormatDefault,
+		"Select the output format. Valid values are [default,json,csv]")
+}
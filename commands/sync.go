@@ -0,0 +1,237 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+const syncRemotesConfigKey = "git-bug.sync.remotes"
+
+var (
+	syncNoPush  bool
+	syncVerbose bool
+	syncFlush   bool
+)
+
+// syncResult summarize the synchronization with one remote
+type syncResult struct {
+	new, updated, invalid int
+	err                   error
+	// the merge results worth displaying with --verbose
+	details []string
+}
+
+func (r syncResult) String() string {
+	if r.err != nil {
+		return colors.Error("failed: ", r.err)
+	}
+
+	parts := []string{
+		fmt.Sprintf("%d new", r.new),
+		fmt.Sprintf("%d updated", r.updated),
+	}
+	if r.invalid > 0 {
+		parts = append(parts, colors.Error(fmt.Sprintf("%d invalid", r.invalid)))
+	}
+	if !syncNoPush {
+		parts = append(parts, "pushed")
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// isSyncRemoteSeparator tell if a character separate the remotes of
+// git-bug.sync.remotes
+func isSyncRemoteSeparator(r rune) bool {
+	return r == ',' || r == ' '
+}
+
+func runSync(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	if syncFlush {
+		return runSyncFlush(backend, args)
+	}
+
+	remotes, err := syncRemotes(backend, args)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+
+	for _, remote := range remotes {
+		result := syncRemote(backend, remote)
+		if result.err != nil {
+			failed++
+		}
+		fmt.Printf("%s: %s\n", colors.Emphasis(remote), result)
+		if syncVerbose {
+			for _, detail := range result.details {
+				fmt.Printf("  %s\n", detail)
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("synchronization failed with %d of %d remotes", failed, len(remotes))
+	}
+
+	return nil
+}
+
+// runSyncFlush push the changes left queued by a failed auto-push
+func runSyncFlush(backend *cache.RepoCache, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("--flush push the queued changes to their remote, without remote argument")
+	}
+
+	pushed, err := backend.FlushPushQueue()
+	if err != nil {
+		return err
+	}
+
+	if pushed == 0 {
+		fmt.Println("Nothing queued to push.")
+		return nil
+	}
+
+	fmt.Printf("%d refs pushed.\n", pushed)
+	return nil
+}
+
+// syncRemotes return the remotes to synchronize with: the ones given as
+// arguments, else the ones configured, else all the remotes
+func syncRemotes(backend *cache.RepoCache, args []string) ([]string, error) {
+	if len(args) > 0 {
+		return args, nil
+	}
+
+	configured, err := repository.ReadConfigAnyScope(backend, syncRemotesConfigKey)
+	if err != nil {
+		return nil, err
+	}
+	if configured != "" {
+		return strings.FieldsFunc(configured, isSyncRemoteSeparator), nil
+	}
+
+	all, err := backend.GetRemotes()
+	if err != nil {
+		return nil, err
+	}
+	if len(all) == 0 {
+		return nil, fmt.Errorf("no remote configured")
+	}
+
+	remotes := make([]string, 0, len(all))
+	for remote := range all {
+		remotes = append(remotes, remote)
+	}
+	sort.Strings(remotes)
+
+	return remotes, nil
+}
+
+// syncRemote fetch, merge and push the bugs and identities with a remote
+func syncRemote(backend *cache.RepoCache, remote string) syncResult {
+	var result syncResult
+
+	_, err := backend.Fetch(remote)
+	if err != nil {
+		result.err = err
+		return result
+	}
+
+	for merge := range backend.MergeAll(remote) {
+		if merge.Err != nil {
+			if result.err == nil {
+				result.err = merge.Err
+			}
+			continue
+		}
+
+		switch merge.Status {
+		case entity.MergeStatusNew:
+			result.new++
+		case entity.MergeStatusUpdated:
+			result.updated++
+		case entity.MergeStatusInvalid:
+			result.invalid++
+		}
+
+		if merge.Status != entity.MergeStatusNothing || merge.Warning != "" {
+			result.details = append(result.details, fmt.Sprintf("%s: %s", merge.Id.Human(), merge))
+		}
+	}
+
+	if result.err != nil || syncNoPush {
+		return result
+	}
+
+	_, err = backend.Push(remote)
+	if err != nil {
+		result.err = err
+	}
+
+	return result
+}
+
+var syncCmd = &cobra.Command{
+	Use:   "sync [<remote>...]",
+	Short: "Synchronize bugs and identities with git remotes.",
+	Long: `Synchronize bugs and identities with git remotes: for each remote, fetch and merge the updates, then push the local changes.
+
+Without argument, the remotes listed in the git config git-bug.sync.remotes (separated by commas or spaces) are used, or else all the remotes.
+
+A failure with a remote doesn't prevent the synchronization with the other ones.
+
+The bugs shared with a remote can be restricted with a query in the git config git-bug.remote.<remote>.share: only the matching bugs, and the identities they reference, are pushed to and pulled from it.
+
+With the git config git-bug.autoPush set to a remote, the local changes are pushed to it in the background after each command. The changes failing to be pushed stay queued until the next push, or until "git bug sync --flush".`,
+	Example: `Synchronize with all the remotes:
+git bug sync
+
+Only fetch and merge from origin and upstream:
+git bug sync origin upstream --no-push
+
+Configure the remotes to synchronize with:
+git config git-bug.sync.remotes "origin,backup"
+
+Only share the public bugs with origin, and everything with the internal mirror:
+git config git-bug.remote.origin.share "label:public"
+
+Push the local changes to origin after each command, and retry after a failure:
+git config git-bug.autoPush origin
+git bug sync --flush
+`,
+	PreRunE: loadRepo,
+	RunE:    runSync,
+}
+
+func init() {
+	RootCmd.AddCommand(syncCmd)
+	addProfileFlags(syncCmd)
+
+	syncCmd.Flags().SortFlags = false
+
+	syncCmd.Flags().BoolVar(&syncNoPush, "no-push", false,
+		"Only fetch and merge, don't push the local changes")
+	syncCmd.Flags().BoolVarP(&syncVerbose, "verbose", "v", false,
+		"Display the result of the merge of each bug and identity")
+	syncCmd.Flags().BoolVar(&syncFlush, "flush", false,
+		"Only push the changes queued by a failed auto-push")
+}
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestSync(t *testing.T) {
+	repoA, repoB, remote := repository.SetupReposAndRemote(t)
+	defer repository.CleanupTestRepos(t, repoA, repoB, remote)
+
+	cacheA, err := cache.NewRepoCache(repoA)
+	require.NoError(t, err)
+	rene, err := cacheA.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cacheA.SetUserIdentity(rene))
+	bugA, _, err := cacheA.NewBug("Parser crash", "it crashes")
+	require.NoError(t, err)
+	_, err = cacheA.Push("origin")
+	require.NoError(t, err)
+	require.NoError(t, cacheA.Close())
+
+	cacheB, err := cache.NewRepoCache(repoB)
+	require.NoError(t, err)
+	blaise, err := cacheB.NewIdentity("Blaise Pascal", "blaise@pascal.fr")
+	require.NoError(t, err)
+	require.NoError(t, cacheB.SetUserIdentity(blaise))
+	bugB, _, err := cacheB.NewBug("Slow startup", "it takes a while")
+	require.NoError(t, err)
+	require.NoError(t, cacheB.Close())
+
+	repo = repoB
+	defer func() { repo = nil }()
+
+	// the bug and the identity of A are pulled, then the ones of B pushed
+	output, err := captureStdout(t, func() error {
+		return runSync(nil, []string{"origin"})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "origin: 2 new, 0 updated, pushed\n", output)
+
+	exist, err := repoB.RefExist(bug.RefName(bugA.Id()))
+	require.NoError(t, err)
+	assert.True(t, exist)
+	exist, err = remote.RefExist(bug.RefName(bugB.Id()))
+	require.NoError(t, err)
+	assert.True(t, exist)
+
+	// a remote failing to be fetched from isn't pushed to, without preventing
+	// the synchronization with the other ones
+	require.NoError(t, repoB.AddRemote("broken", "file:///nonexistent"))
+	require.NoError(t, repoB.LocalConfig().StoreString("remote.broken.pushurl", "file://"+remote.GetPath()))
+
+	cacheB, err = cache.NewRepoCache(repoB)
+	require.NoError(t, err)
+	bugB2, _, err := cacheB.NewBug("Memory leak", "it grows")
+	require.NoError(t, err)
+	require.NoError(t, cacheB.Close())
+
+	output, err = captureStdout(t, func() error {
+		return runSync(nil, []string{"broken"})
+	})
+	assert.EqualError(t, err, "synchronization failed with 1 of 1 remotes")
+	assert.Contains(t, output, "broken: failed: ")
+
+	exist, err = remote.RefExist(bug.RefName(bugB2.Id()))
+	require.NoError(t, err)
+	assert.False(t, exist)
+
+	output, err = captureStdout(t, func() error {
+		return runSync(nil, []string{"broken", "origin"})
+	})
+	assert.EqualError(t, err, "synchronization failed with 1 of 2 remotes")
+	assert.Contains(t, output, "origin: 0 new, 0 updated, pushed\n")
+
+	exist, err = remote.RefExist(bug.RefName(bugB2.Id()))
+	require.NoError(t, err)
+	assert.True(t, exist)
+}
@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	outputFormatDefault  = "default"
+	outputFormatTemplate = "template"
+	// an Org mode headline, only for show
+	outputFormatOrg = "org"
+)
+
+// addOutputFormatFlags register the --format and --template flags, shared by
+// the listing and show commands. The extra formats are handled by the command.
+func addOutputFormatFlags(cmd *cobra.Command, format *string, tmpl *string, extraFormats ...string) {
+	formats := append([]string{outputFormatDefault, outputFormatTemplate}, extraFormats...)
+	cmd.Flags().StringVar(format, "format", outputFormatDefault,
+		fmt.Sprintf("Select the output format. Valid values are [%s]", strings.Join(formats, ",")))
+	cmd.Flags().StringVar(tmpl, "template", "",
+		"Go template used to render each item with --format template, for example '{{.Id.Human}} {{.Title}}'")
+}
+
+// parseOutputTemplate validate the output format flags and return the template
+// to use, or nil for the default output.
+func parseOutputTemplate(format string, tmpl string) (*template.Template, error) {
+	switch format {
+	case outputFormatDefault:
+		if tmpl != "" {
+			return nil, fmt.Errorf("--template require --format %s", outputFormatTemplate)
+		}
+		return nil, nil
+
+	case outputFormatTemplate:
+		if tmpl == "" {
+			return nil, fmt.Errorf("--format %s require a --template", outputFormatTemplate)
+		}
+		t, err := template.New("output").Parse(tmpl)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid template")
+		}
+		return t, nil
+
+	default:
+		return nil, fmt.Errorf("unknown output format %s", format)
+	}
+}
+
+// executeOutputTemplate render an item with the template, followed by a new line
+func executeOutputTemplate(t *template.Template, data interface{}) error {
+	err := t.Execute(os.Stdout, data)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+	return nil
+}
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestOutputTemplate(t *testing.T) {
+	testRepo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, testRepo)
+
+	repo = testRepo
+	defer func() { repo = nil }()
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	crash, _, err := backend.NewBugRaw(rene, 1000, "Parser crash", "it crashes", nil, nil)
+	require.NoError(t, err)
+	slow, _, err := backend.NewBugRaw(rene, 1001, "Slow startup", "it takes a while", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, backend.Close())
+
+	defer func() {
+		lsOutputFormat, lsOutputTemplate = outputFormatDefault, ""
+		showOutputFormat, showOutputTemplate = outputFormatDefault, ""
+	}()
+
+	// listing, with each bug rendered by the template
+	lsOutputFormat = outputFormatTemplate
+	lsOutputTemplate = "{{.Id.Human}} {{.Title}} by {{.Author}}"
+	output, err := captureStdout(t, func() error {
+		return runLsBug(lsCmd, nil)
+	})
+	require.NoError(t, err)
+	assert.Equal(t,
+		crash.Id().Human()+" Parser crash by René Descartes\n"+
+			slow.Id().Human()+" Slow startup by René Descartes\n",
+		output)
+
+	// a single bug
+	showOutputFormat = outputFormatTemplate
+	showOutputTemplate = "{{.Title}}: {{(index .Comments 0).Message}}"
+	output, err = captureStdout(t, func() error {
+		return runShowBug(nil, []string{crash.Id().Human()})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Parser crash: it crashes\n", output)
+
+	// the template and the format go together
+	lsOutputTemplate = ""
+	err = runLsBug(lsCmd, nil)
+	assert.EqualError(t, err, "--format template require a --template")
+
+	showOutputFormat = outputFormatDefault
+	err = runShowBug(nil, []string{crash.Id().Human()})
+	assert.EqualError(t, err, "--template require --format template")
+
+	lsOutputTemplate = "{{.Title"
+	err = runLsBug(lsCmd, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid template")
+
+	lsOutputFormat = "xml"
+	err = runLsBug(lsCmd, nil)
+	assert.EqualError(t, err, "unknown output format xml")
+}
@@ -1,13 +1,69 @@
 package commands
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
 	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/input"
+	"github.com/MichaelMure/git-bug/repository"
 	"github.com/MichaelMure/git-bug/termui"
 	"github.com/MichaelMure/git-bug/util/interrupt"
-	"github.com/spf13/cobra"
+)
+
+const termUIKeysConfigKey = "git-bug.termui.keys"
+const termUIPresetConfigKey = "git-bug.termui.preset"
+const webUIURLConfigKey = "git-bug.webui.url"
+
+var (
+	termUIPrintKeys bool
+	termUITheme     string
+	termUIWatch     bool
 )
 
 func runTermUI(cmd *cobra.Command, args []string) error {
+	err := loadTermUIPreset(repo)
+	if err != nil {
+		return err
+	}
+
+	err = loadTermUIKeys(repo)
+	if err != nil {
+		return err
+	}
+
+	if termUIPrintKeys {
+		return termui.WriteKeys(os.Stdout)
+	}
+
+	if termUITheme != "" {
+		err = loadTheme(termUITheme)
+		if err != nil {
+			return errors.Wrap(err, "--theme")
+		}
+	}
+
+	err = input.RequireInteractive("start the terminal UI", "")
+	if err != nil {
+		return err
+	}
+
+	err = addTermUITabs(repo)
+	if err != nil {
+		return err
+	}
+
+	err = setTermUIWebUIURL(repo)
+	if err != nil {
+		return err
+	}
+
 	backend, err := cache.NewRepoCache(repo)
 	if err != nil {
 		return err
@@ -15,17 +71,147 @@ func runTermUI(cmd *cobra.Command, args []string) error {
 	defer backend.Close()
 	interrupt.RegisterCleaner(backend.Close)
 
+	termui.SetWatch(termUIWatch)
+
 	return termui.Run(backend)
 }
 
+// loadTermUIPreset load the preset of key bindings configured with
+// git-bug.termui.preset, if any, the key bindings file applying on top of it
+func loadTermUIPreset(repo repository.RepoCommon) error {
+	preset, err := repository.ReadConfigAnyScope(repo, termUIPresetConfigKey)
+	if err != nil || preset == "" {
+		return err
+	}
+
+	return errors.Wrap(termui.LoadKeyPreset(preset), termUIPresetConfigKey)
+}
+
+// loadTermUIKeys load the key bindings file configured with
+// git-bug.termui.keys, if any
+func loadTermUIKeys(repo repository.RepoCommon) error {
+	path, err := repository.ReadConfigAnyScope(repo, termUIKeysConfigKey)
+	if err != nil || path == "" {
+		return err
+	}
+
+	return errors.Wrap(loadKeysFile(path), termUIKeysConfigKey)
+}
+
+// addTermUITabs add a tab to the termui for each alias listing bugs with a
+// query, the default aliases first
+func addTermUITabs(repo repository.RepoCommon) error {
+	aliases, err := readQueryAliases(repo)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		_, iDefault := defaultAliases[names[i]]
+		_, jDefault := defaultAliases[names[j]]
+		if iDefault != jDefault {
+			return iDefault
+		}
+		return names[i] < names[j]
+	})
+
+	for _, name := range names {
+		termui.AddTab(strings.Title(name), aliases[name])
+	}
+
+	return nil
+}
+
+// setTermUIWebUIURL give to the termui the address of the web UI, to copy
+// the url of the bugs: git-bug.webui.url if set, or the local web UI
+// listening on git-bug.webui.port
+func setTermUIWebUIURL(repo repository.RepoCommon) error {
+	url, err := repository.ReadConfigAnyScope(repo, webUIURLConfigKey)
+	if err != nil {
+		return err
+	}
+
+	if url == "" {
+		port, err := repository.ReadConfigAnyScope(repo, webUIPortConfigKey)
+		if err != nil {
+			return err
+		}
+		if port != "" {
+			url = fmt.Sprintf("http://127.0.0.1:%s", port)
+		}
+	}
+
+	termui.SetWebUIURL(url)
+	return nil
+}
+
+// loadKeysFile load a key bindings file, "~/" being expanded to the home
+// directory
+func loadKeysFile(path string) error {
+	if strings.HasPrefix(path, "~/") {
+		path = filepath.Join(os.Getenv("HOME"), path[2:])
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return errors.Wrap(termui.LoadKeys(f), path)
+}
+
 var termUICmd = &cobra.Command{
 	Use:     "termui",
 	Aliases: []string{"tui"},
 	Short:   "Launch the terminal UI.",
+	Long: `Launch the terminal UI.
+
+Press ? to list the key bindings of every part of the UI, filtered by typing.
+
+New bugs are written in a form with their title, body, labels, assignees and priority, the priority being set as a label like "priority:high". The title and the body can also be written in the editor.
+
+The vim and emacs presets of key bindings, moving around like in these editors, are selected with "git bug config set termui.preset <vim|emacs>". The vim preset adds a command line opened with ":", where "q" returns to the bug list or quits, "qa" quits and "help" lists the key bindings. The emacs preset opens it with alt+x.
+
+The key bindings can also be changed with a file configured with "git bug config set termui.keys <path>", with one action per line followed by its comma separated keys, like:
+
+    table.down = j, down, ctrl+n
+
+A key is a character, ctrl+<letter>, or one of enter, esc, space, tab, backspace, delete, insert, home, end, pgup, pgdn, up, down, left, right and f1 to f12. Any key can be prefixed by alt+.
+
+The key bindings file applies on top of the preset. The current key bindings, in the same format, are displayed with --print-keys.
+
+Above the bugs, the tabs All, Open and one for each alias listing bugs with a query, like "mine", are selected with their number. Adding an alias adds a tab:
+
+    git config git-bug.alias.triage "ls status:open no:label"
+
+Pressing v splits the screen, the bug list on the left and the selected bug on the right, following the selection.
+
+The inbox, opened with a, lists the bugs created since the previous session of the terminal UI, and the ones you authored, commented or are assigned to that the others changed since then, with a summary of their changes. The first session lists the changes of the last week.
+
+The id, the short id or the web UI url of the selected bug are copied to the clipboard with y, Y and u. The url is the one configured with "git bug config set webui.url <url>", or the local web UI when "webui.port" is set. The clipboard is set with the OSC 52 escape sequence, working over ssh in most terminals, and with pbcopy, wl-copy, xclip, xsel or clip.exe when available.
+
+The images of the comments and their attached files are displayed inline in terminals supporting the kitty, iTerm2 or sixel graphics, outside of tmux and screen. Otherwise, a placeholder with their name is displayed.
+
+The colors follow the theme configured with "git bug config set color.theme <theme>", or given with --theme: one of dark (the default), light and solarized, or the path of a theme file.`,
 	PreRunE: loadRepoEnsureUser,
 	RunE:    runTermUI,
 }
 
 func init() {
 	RootCmd.AddCommand(termUICmd)
+
+	termUICmd.Flags().SortFlags = false
+
+	termUICmd.Flags().BoolVar(&termUIPrintKeys, "print-keys", false,
+		"Display the key bindings, in the format of a key bindings file, and exit")
+	termUICmd.Flags().StringVar(&termUITheme, "theme", "",
+		"Use a color theme: dark, light, solarized, or the path of a theme file")
+	termUICmd.Flags().BoolVar(&termUIWatch, "watch", false,
+		"Watch the bugs for changes made by another process, like a git fetch, and refresh the display")
 }
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runUnassign(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	b, args, err := _select.ResolveBug(backend, args)
+	if err != nil {
+		return err
+	}
+
+	identities, err := resolveIdentities(backend, args)
+	if err != nil {
+		return err
+	}
+
+	op, err := b.ChangeAssignees(nil, identities)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range op.Removed {
+		fmt.Printf("unassigned %s\n", i.DisplayName())
+	}
+
+	return b.Commit()
+}
+
+var unassignCmd = &cobra.Command{
+	Use:   "unassign [<id>] <user>[...]",
+	Short: "Unassign identities from a bug.",
+	Long: `Unassign identities from a bug.
+
+The identities are given by a prefix of their id, as listed by "git bug user ls", or "me" for your own identity.`,
+	Example: `Unassign yourself from a bug:
+git bug unassign 2f15 me
+`,
+	PreRunE: loadRepo,
+	RunE:    runUnassign,
+}
+
+func init() {
+	RootCmd.AddCommand(unassignCmd)
+}
@@ -10,10 +10,20 @@ import (
 )
 
 var (
-	userFieldsQuery string
+	userFieldsQuery    string
+	userOutputFormat   string
+	userOutputTemplate string
 )
 
 func runUser(cmd *cobra.Command, args []string) error {
+	tmpl, err := parseOutputTemplate(userOutputFormat, userOutputTemplate)
+	if err != nil {
+		return err
+	}
+	if tmpl != nil && userFieldsQuery != "" {
+		return fmt.Errorf("--field and --template can't be used together")
+	}
+
 	backend, err := cache.NewRepoCache(repo)
 	if err != nil {
 		return err
@@ -36,6 +46,10 @@ func runUser(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if tmpl != nil {
+		return executeOutputTemplate(tmpl, id)
+	}
+
 	if userFieldsQuery != "" {
 		switch userFieldsQuery {
 		case "email":
@@ -94,4 +108,5 @@ func init() {
 
 	userCmd.Flags().StringVarP(&userFieldsQuery, "field", "f", "",
 		"Select field to display. Valid values are [email,humanId,id,lastModification,lastModificationLamport,login,metadata,name]")
+	addOutputFormatFlags(userCmd, &userOutputFormat, &userOutputTemplate)
 }
@@ -5,10 +5,15 @@ import (
 	"os"
 
 	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/identity"
 	"github.com/MichaelMure/git-bug/util/interrupt"
 	"github.com/spf13/cobra"
 )
 
+var (
+	userAdoptFor string
+)
+
 func runUserAdopt(cmd *cobra.Command, args []string) error {
 	backend, err := cache.NewRepoCache(repo)
 	if err != nil {
@@ -24,6 +29,16 @@ func runUserAdopt(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if userAdoptFor != "" {
+		err = identity.StoreIdentityRule(backend, userAdoptFor, i.Identity)
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(os.Stderr, "Your identity for repositories matching %s is now: %s\n", userAdoptFor, i.DisplayName())
+		return nil
+	}
+
 	err = backend.SetUserIdentity(i)
 	if err != nil {
 		return err
@@ -35,8 +50,21 @@ func runUserAdopt(cmd *cobra.Command, args []string) error {
 }
 
 var userAdoptCmd = &cobra.Command{
-	Use:     "adopt <user-id>",
-	Short:   "Adopt an existing identity as your own.",
+	Use:   "adopt <user-id>",
+	Short: "Adopt an existing identity as your own.",
+	Long: `Adopt an existing identity as your own.
+
+With --for, the identity is instead automatically selected for every repository
+matching the given pattern, similarly to git's includeIf. The pattern is either
+"gitdir:<glob>" to match the path of the git directory, or a glob matched against
+the remotes URL. Such a rule takes precedence over an adopted identity.
+
+Rules are stored in the global git config:
+  git-bug.identity-rule.<pattern>.identity [id]: the identity to use
+`,
+	Example: `git bug user adopt 7c4f1a2 --for "*github.com/my-company/*"
+git bug user adopt 2f153ca --for "gitdir:~/personal/"
+`,
 	PreRunE: loadRepo,
 	RunE:    runUserAdopt,
 	Args:    cobra.ExactArgs(1),
@@ -45,4 +73,7 @@ var userAdoptCmd = &cobra.Command{
 func init() {
 	userCmd.AddCommand(userAdoptCmd)
 	userAdoptCmd.Flags().SortFlags = false
+
+	userAdoptCmd.Flags().StringVar(&userAdoptFor, "for", "",
+		"Adopt the identity for the repositories matching the given pattern only")
 }
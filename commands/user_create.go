@@ -3,6 +3,7 @@ package commands
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/input"
@@ -10,6 +11,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	userCreateName   string
+	userCreateEmail  string
+	userCreateAvatar string
+)
+
 func runUserCreate(cmd *cobra.Command, args []string) error {
 	backend, err := cache.NewRepoCache(repo)
 	if err != nil {
@@ -18,36 +25,34 @@ func runUserCreate(cmd *cobra.Command, args []string) error {
 	defer backend.Close()
 	interrupt.RegisterCleaner(backend.Close)
 
-	_, _ = fmt.Fprintf(os.Stderr, "Before creating a new identity, please be aware that "+
-		"you can also use an already existing one using \"git bug user adopt\". As an example, "+
-		"you can do that if your identity has already been created by an importer.\n\n")
+	// the values given with the flags are not prompted
+	prompting := userCreateName == "" || userCreateEmail == ""
 
-	preName, err := backend.GetUserName()
-	if err != nil {
-		return err
+	if prompting && input.IsInteractive() {
+		_, _ = fmt.Fprintf(os.Stderr, "Before creating a new identity, please be aware that "+
+			"you can also use an already existing one using \"git bug user adopt\". As an example, "+
+			"you can do that if your identity has already been created by an importer.\n\n")
 	}
 
-	name, err := input.PromptValueRequired("Name", preName)
+	name, err := userCreateValue("Name", userCreateName, backend.GetUserName)
 	if err != nil {
 		return err
 	}
 
-	preEmail, err := backend.GetUserEmail()
+	email, err := userCreateValue("Email", userCreateEmail, backend.GetUserEmail)
 	if err != nil {
 		return err
 	}
 
-	email, err := input.PromptValueRequired("Email", preEmail)
-	if err != nil {
-		return err
+	avatarUrl := userCreateAvatar
+	if avatarUrl == "" && prompting {
+		avatarUrl, err = input.PromptValue("Avatar URL", "")
+		if err != nil {
+			return err
+		}
 	}
 
-	login, err := input.PromptValue("Avatar URL", "")
-	if err != nil {
-		return err
-	}
-
-	id, err := backend.NewIdentityRaw(name, email, "", login, nil)
+	id, err := backend.NewIdentityRaw(name, email, "", avatarUrl, nil)
 	if err != nil {
 		return err
 	}
@@ -62,12 +67,35 @@ func runUserCreate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	_, _ = fmt.Fprintln(os.Stderr)
+	if prompting {
+		_, _ = fmt.Fprintln(os.Stderr)
+	}
 	fmt.Println(id.Id())
 
 	return nil
 }
 
+// userCreateValue return the value given with a flag, or prompt for it with
+// the git config as default. Without a terminal, the value is required.
+func userCreateValue(name string, flagValue string, preValue func() (string, error)) (string, error) {
+	if flagValue != "" {
+		return flagValue, nil
+	}
+
+	err := input.RequireInteractive(fmt.Sprintf("prompt for the %s", strings.ToLower(name)),
+		fmt.Sprintf("give it with --%s", strings.ToLower(name)))
+	if err != nil {
+		return "", err
+	}
+
+	pre, err := preValue()
+	if err != nil {
+		return "", err
+	}
+
+	return input.PromptValueRequired(name, pre)
+}
+
 var userCreateCmd = &cobra.Command{
 	Use:     "create",
 	Short:   "Create a new identity.",
@@ -78,4 +106,11 @@ var userCreateCmd = &cobra.Command{
 func init() {
 	userCmd.AddCommand(userCreateCmd)
 	userCreateCmd.Flags().SortFlags = false
+
+	userCreateCmd.Flags().StringVarP(&userCreateName, "name", "n", "",
+		"Provide the name of the identity instead of prompting for it")
+	userCreateCmd.Flags().StringVarP(&userCreateEmail, "email", "e", "",
+		"Provide the email of the identity instead of prompting for it")
+	userCreateCmd.Flags().StringVarP(&userCreateAvatar, "avatar", "a", "",
+		"Provide the avatar URL of the identity instead of prompting for it")
 }
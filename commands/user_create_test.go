@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/input"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestUserCreateNonInteractive(t *testing.T) {
+	testRepo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, testRepo)
+
+	repo = testRepo
+	defer func() { repo = nil }()
+
+	input.SetNonInteractive(true)
+	defer input.SetNonInteractive(false)
+
+	defer func() {
+		userCreateName = ""
+		userCreateEmail = ""
+		userCreateAvatar = ""
+	}()
+
+	// a required value is missing
+	userCreateName = "René Descartes"
+	err := runUserCreate(nil, nil)
+	assert.IsType(t, input.ErrNonInteractive{}, err)
+	assert.Contains(t, err.Error(), "--email")
+
+	// the avatar is optional
+	userCreateEmail = "rene@descartes.fr"
+	require.NoError(t, runUserCreate(nil, nil))
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	user, err := backend.GetUserIdentity()
+	require.NoError(t, err)
+	assert.Equal(t, "René Descartes", user.Name())
+	assert.Equal(t, "rene@descartes.fr", user.Email())
+	assert.Equal(t, "", user.AvatarUrl())
+}
@@ -2,30 +2,86 @@ package commands
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/util/colors"
 	"github.com/MichaelMure/git-bug/util/interrupt"
 	"github.com/spf13/cobra"
 )
 
+var (
+	userLsOutputFormat   string
+	userLsOutputTemplate string
+)
+
+// userLsTemplateData is the data available in the --template of user ls
+type userLsTemplateData struct {
+	*cache.IdentityExcerpt
+	// the other identities of the same person
+	Aliases []entity.Id
+}
+
 func runUserLs(cmd *cobra.Command, args []string) error {
-	backend, err := cache.NewRepoCache(repo)
+	tmpl, err := parseOutputTemplate(userLsOutputFormat, userLsOutputTemplate)
+	if err != nil {
+		return err
+	}
+
+	backend, err := loadBackendForReading()
 	if err != nil {
 		return err
 	}
 	defer backend.Close()
 	interrupt.RegisterCleaner(backend.Close)
 
+	// identities sharing the same canonical email are the same person
+	var people [][]*cache.IdentityExcerpt
+	byEmail := make(map[string]int)
+
 	for _, id := range backend.AllIdentityIds() {
-		i, err := backend.ResolveIdentityExcerpt(id)
+		i, err := backend.ResolveCanonicalIdentityExcerpt(id)
 		if err != nil {
 			return err
 		}
 
-		fmt.Printf("%s %s\n",
-			colors.Cyan(i.Id.Human()),
-			i.DisplayName(),
+		email := strings.ToLower(i.Email)
+		if index, ok := byEmail[email]; ok && email != "" {
+			people[index] = append(people[index], i)
+			continue
+		}
+
+		byEmail[email] = len(people)
+		people = append(people, []*cache.IdentityExcerpt{i})
+	}
+
+	for _, identities := range people {
+		if tmpl != nil {
+			data := userLsTemplateData{IdentityExcerpt: identities[0]}
+			for _, i := range identities[1:] {
+				data.Aliases = append(data.Aliases, i.Id)
+			}
+			err = executeOutputTemplate(tmpl, data)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		aliases := ""
+		if len(identities) > 1 {
+			ids := make([]string, len(identities)-1)
+			for j, i := range identities[1:] {
+				ids[j] = i.Id.Human()
+			}
+			aliases = colors.Id(fmt.Sprintf(" (%s)", strings.Join(ids, ", ")))
+		}
+
+		fmt.Printf("%s %s%s\n",
+			colors.Id(identities[0].Id.Human()),
+			identities[0].DisplayName(),
+			aliases,
 		)
 	}
 
@@ -35,6 +91,12 @@ func runUserLs(cmd *cobra.Command, args []string) error {
 var userLsCmd = &cobra.Command{
 	Use:     "ls",
 	Short:   "List identities.",
+	Long: `List identities.
+
+Names and emails are rewritten according to the .mailmap of the repository, as
+well as the files configured with "mailmap.file" and "git-bug.mailmap.file".
+The identities sharing the same email after that are listed as a single person,
+along with the ids of the other identities.`,
 	PreRunE: loadRepo,
 	RunE:    runUserLs,
 }
@@ -42,4 +104,6 @@ var userLsCmd = &cobra.Command{
 func init() {
 	userCmd.AddCommand(userLsCmd)
 	userLsCmd.Flags().SortFlags = false
+
+	addOutputFormatFlags(userLsCmd, &userLsOutputFormat, &userLsOutputTemplate)
 }
@@ -1,78 +1,170 @@
 package commands
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"syscall"
 	"time"
 
-	"github.com/99designs/gqlgen/handler"
-	"github.com/gorilla/mux"
 	"github.com/phayes/freeport"
+	"github.com/pkg/errors"
 	"github.com/skratchdot/open-golang/open"
 	"github.com/spf13/cobra"
 
+	"github.com/MichaelMure/git-bug/api"
+	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/graphql"
+	"github.com/MichaelMure/git-bug/graphql/auth"
 	"github.com/MichaelMure/git-bug/repository"
-	"github.com/MichaelMure/git-bug/util/git"
-	"github.com/MichaelMure/git-bug/webui"
 )
 
 var (
-	webUIPort   int
-	webUIOpen   bool
-	webUINoOpen bool
+	webUIPort       int
+	webUIListenAddr string
+	webUIOpen       bool
+	webUINoOpen     bool
+	webUIAuth       string
+	webUIAuthHeader string
+	webUIReadOnly   bool
+	webUIWatch      bool
+
+	webUIRepoSpecs []string
+	webUIReposFile string
+
+	webUICORSOrigins []string
+	webUICORSMethods []string
+	webUICORSHeaders []string
+
+	webUIRateLimit float64
+	webUIRateBurst int
+
+	webUIMaxQueryComplexity int
+	webUIMaxQueryDepth      int
+	webUIAllowedQueries     string
+
+	webUITLSCert       string
+	webUITLSKey        string
+	webUITLSSelfSigned bool
 )
 
-const webUIOpenConfigKey = "git-bug.webui.open"
+const (
+	webUIOpenConfigKey       = "git-bug.webui.open"
+	webUIPortConfigKey       = "git-bug.webui.port"
+	webUIAuthConfigKey       = "git-bug.webui.auth"
+	webUIAuthHeaderConfigKey = "git-bug.webui.auth-header"
+
+	webUIOIDCIssuerConfigKey       = "git-bug.webui.oidc.issuer"
+	webUIOIDCClientIdConfigKey     = "git-bug.webui.oidc.client-id"
+	webUIOIDCClientSecretConfigKey = "git-bug.webui.oidc.client-secret"
+)
+
+// the ways to authenticate the users of the web UI
+const (
+	webUIAuthModeNone   = "none"
+	webUIAuthModeLocal  = "local"
+	webUIAuthModeHeader = "header"
+	webUIAuthModeOIDC   = "oidc"
+)
 
 func runWebUI(cmd *cobra.Command, args []string) error {
-	if webUIPort == 0 {
-		var err error
-		webUIPort, err = freeport.GetFreePort()
-		if err != nil {
-			return err
-		}
+	address, err := webUIAddress()
+	if err != nil {
+		return err
+	}
+
+	repos, err := webUIRepos()
+	if err != nil {
+		return err
 	}
 
-	addr := fmt.Sprintf("127.0.0.1:%d", webUIPort)
-	webUiAddr := fmt.Sprintf("http://%s", addr)
+	certFile, keyFile, err := webUITLSFiles(repo.GetPath())
+	if err != nil {
+		return err
+	}
+
+	cors, err := readWebUICORS()
+	if err != nil {
+		return err
+	}
 
-	router := mux.NewRouter()
+	limiter, err := readWebUIRateLimit()
+	if err != nil {
+		return err
+	}
 
-	graphqlHandler, err := graphql.NewHandler(repo)
+	limits, err := readWebUIQueryLimits(cmd)
 	if err != nil {
 		return err
 	}
 
-	assetsHandler := &fileSystemWithDefault{
-		FileSystem:  webui.WebUIAssets,
-		defaultFile: "index.html",
+	scheme := "http"
+	if certFile != "" {
+		scheme = "https"
 	}
 
-	// Routes
-	router.Path("/playground").Handler(handler.Playground("git-bug", "/graphql"))
-	router.Path("/graphql").Handler(graphqlHandler)
-	router.Path("/gitfile/{hash}").Handler(newGitFileHandler(repo))
-	router.Path("/upload").Methods("POST").Handler(newGitUploadFileHandler(repo))
-	router.PathPrefix("/").Handler(http.FileServer(assetsHandler))
+	repoCache, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+
+	others, err := openWebUIRepos(repos)
+	if err != nil {
+		_ = repoCache.Close()
+		return err
+	}
+
+	authenticator, err := webUIAuthenticator(repoCache)
+	if err != nil {
+		closeWebUIRepos(repoCache, others)
+		return err
+	}
+
+	apiHandler, err := api.NewHandler(repoCache, api.Options{
+		Repos:         others,
+		ReadOnly:      webUIReadOnly,
+		Authenticator: authenticator,
+		Limits:        &limits,
+	})
+	if err != nil {
+		closeWebUIRepos(repoCache, others)
+		return err
+	}
+
+	var rootHandler http.Handler = apiHandler
+
+	refresher := &cacheRefresher{lock: apiHandler.CacheLock()}
+	if webUIWatch {
+		refresher.watch(repoCache)
+		for _, other := range others {
+			refresher.watch(other)
+		}
+	}
+
+	rootHandler = limiter.middleware(rootHandler, apiHandler.Tokens().Known)
+
+	// the preflight requests come without credentials
+	rootHandler = cors.middleware(rootHandler)
+
+	listener, err := webUIListen(address)
+	if err != nil {
+		return err
+	}
 
 	srv := &http.Server{
-		Addr:    addr,
-		Handler: router,
+		Handler: rootHandler,
 	}
 
 	done := make(chan bool)
 	quit := make(chan os.Signal, 1)
 
-	// register as handler of the interrupt signal to trigger the teardown
-	signal.Notify(quit, os.Interrupt)
+	// register as handler of the interrupt and termination signals to trigger
+	// the teardown
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 
 	go func() {
 		<-quit
@@ -87,7 +179,8 @@ func runWebUI(cmd *cobra.Command, args []string) error {
 		}
 
 		// Teardown
-		err := graphqlHandler.Close()
+		refresher.stop()
+		err := apiHandler.Close()
 		if err != nil {
 			fmt.Println(err)
 		}
@@ -95,9 +188,20 @@ func runWebUI(cmd *cobra.Command, args []string) error {
 		close(done)
 	}()
 
-	fmt.Printf("Web UI: %s\n", webUiAddr)
-	fmt.Printf("Graphql API: http://%s/graphql\n", addr)
-	fmt.Printf("Graphql Playground: http://%s/playground\n", addr)
+	webUiAddr, reachable := webUIBaseURL(listener, scheme)
+	if reachable {
+		fmt.Printf("Web UI: %s\n", webUiAddr)
+		fmt.Printf("Graphql API: %s/graphql\n", webUiAddr)
+		fmt.Printf("Graphql Playground: %s/playground\n", webUiAddr)
+	} else {
+		fmt.Printf("Web UI: listening on %s:%s\n", listener.Addr().Network(), listener.Addr())
+	}
+	for _, r := range repos {
+		fmt.Printf("Repository %s: %s\n", r.name, r.path)
+	}
+	if webUIReadOnly {
+		fmt.Println("Read-only mode, the changes are rejected")
+	}
 	fmt.Println("Press Ctrl+c to quit")
 
 	configOpen, err := repo.LocalConfig().ReadBool(webUIOpenConfigKey)
@@ -108,7 +212,7 @@ func runWebUI(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	shouldOpen := (configOpen && !webUINoOpen) || webUIOpen
+	shouldOpen := reachable && ((configOpen && !webUINoOpen) || webUIOpen)
 
 	if shouldOpen {
 		err = open.Run(webUiAddr)
@@ -117,7 +221,11 @@ func runWebUI(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	err = srv.ListenAndServe()
+	if certFile != "" {
+		err = srv.ServeTLS(listener, certFile, keyFile)
+	} else {
+		err = srv.Serve(listener)
+	}
 	if err != nil && err != http.ErrServerClosed {
 		return err
 	}
@@ -128,117 +236,111 @@ func runWebUI(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-// implement a http.FileSystem that will serve a default file when the looked up
-// file doesn't exist. Useful for Single-Page App that implement routing client
-// side, where the server has to return the root index.html file for every route.
-type fileSystemWithDefault struct {
-	http.FileSystem
-	defaultFile string
-}
-
-func (fswd *fileSystemWithDefault) Open(name string) (http.File, error) {
-	f, err := fswd.FileSystem.Open(name)
-	if os.IsNotExist(err) {
-		return fswd.FileSystem.Open(fswd.defaultFile)
+// webUIAddress return the address to listen to, given by --listen,
+// git-bug.webui.listen, or on the local host by --port or git-bug.webui.port
+func webUIAddress() (string, error) {
+	if webUIListenAddr != "" && webUIPort != 0 {
+		return "", fmt.Errorf("--listen and --port can't be used together")
 	}
-	return f, err
-}
-
-// implement a http.Handler that will read and server git blob.
-type gitFileHandler struct {
-	repo repository.Repo
-}
-
-func newGitFileHandler(repo repository.Repo) http.Handler {
-	return &gitFileHandler{
-		repo: repo,
+	if webUIListenAddr != "" {
+		return webUIListenAddr, nil
 	}
-}
 
-func (gfh *gitFileHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
-	hash := git.Hash(mux.Vars(r)["hash"])
+	if webUIPort == 0 {
+		address, err := repository.ReadConfigAnyScope(repo, webUIListenConfigKey)
+		if err != nil {
+			return "", err
+		}
+		if address != "" {
+			return address, nil
+		}
 
-	if !hash.IsValid() {
-		http.Error(rw, "invalid git hash", http.StatusBadRequest)
-		return
+		port, err := repository.ReadConfigAnyScope(repo, webUIPortConfigKey)
+		if err != nil {
+			return "", err
+		}
+		if port != "" {
+			webUIPort, err = strconv.Atoi(port)
+			if err != nil {
+				return "", errors.Wrap(err, webUIPortConfigKey)
+			}
+		}
 	}
 
-	// TODO: this mean that the whole file will he buffered in memory
-	// This can be a problem for big files. There might be a way around
-	// that by implementing a io.ReadSeeker that would read and discard
-	// data when a seek is called.
-	data, err := gfh.repo.ReadData(git.Hash(hash))
-	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
-		return
+	if webUIPort == 0 {
+		var err error
+		webUIPort, err = freeport.GetFreePort()
+		if err != nil {
+			return "", err
+		}
 	}
 
-	http.ServeContent(rw, r, "", time.Now(), bytes.NewReader(data))
-}
-
-// implement a http.Handler that will accept and store content into git blob.
-type gitUploadFileHandler struct {
-	repo repository.Repo
+	return fmt.Sprintf("127.0.0.1:%d", webUIPort), nil
 }
 
-func newGitUploadFileHandler(repo repository.Repo) http.Handler {
-	return &gitUploadFileHandler{
-		repo: repo,
-	}
-}
-
-func (gufh *gitUploadFileHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
-	// 100MB (github limit)
-	var maxUploadSize int64 = 100 * 1000 * 1000
-	r.Body = http.MaxBytesReader(rw, r.Body, maxUploadSize)
-	if err := r.ParseMultipartForm(maxUploadSize); err != nil {
-		http.Error(rw, "file too big (100MB max)", http.StatusBadRequest)
-		return
-	}
-
-	file, _, err := r.FormFile("uploadfile")
-	if err != nil {
-		http.Error(rw, "invalid file", http.StatusBadRequest)
-		return
-	}
-	defer file.Close()
-	fileBytes, err := ioutil.ReadAll(file)
-	if err != nil {
-		http.Error(rw, "invalid file", http.StatusBadRequest)
-		return
+// webUIAuthenticator return how the users are authenticated, given by
+// --auth or git-bug.webui.auth, or nil without authentication
+func webUIAuthenticator(backend *cache.RepoCache) (auth.Authenticator, error) {
+	mode := webUIAuth
+	if mode == "" {
+		var err error
+		mode, err = repository.ReadConfigAnyScope(repo, webUIAuthConfigKey)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	filetype := http.DetectContentType(fileBytes)
-	if filetype != "image/jpeg" && filetype != "image/jpg" &&
-		filetype != "image/gif" && filetype != "image/png" {
-		http.Error(rw, "invalid file type", http.StatusBadRequest)
-		return
-	}
+	switch mode {
+	case "", webUIAuthModeNone:
+		return nil, nil
+
+	case webUIAuthModeLocal:
+		return auth.NewLocalAccounts(repo)
+
+	case webUIAuthModeHeader:
+		header := webUIAuthHeader
+		if header == "" {
+			var err error
+			header, err = repository.ReadConfigAnyScope(repo, webUIAuthHeaderConfigKey)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if header == "" {
+			header = auth.DefaultHeader
+		}
 
-	hash, err := gufh.repo.StoreData(fileBytes)
-	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
-		return
-	}
+		return auth.NewHeaderAuth(backend, header), nil
+
+	case webUIAuthModeOIDC:
+		var config auth.OIDCConfig
+		for key, value := range map[string]*string{
+			webUIOIDCIssuerConfigKey:       &config.Issuer,
+			webUIOIDCClientIdConfigKey:     &config.ClientId,
+			webUIOIDCClientSecretConfigKey: &config.ClientSecret,
+			webUIURLConfigKey:              &config.BaseURL,
+		} {
+			var err error
+			*value, err = repository.ReadConfigAnyScope(repo, key)
+			if err != nil {
+				return nil, err
+			}
+		}
 
-	type response struct {
-		Hash string `json:"hash"`
+		return auth.NewOIDC(backend, config)
 	}
 
-	resp := response{Hash: string(hash)}
-
-	js, err := json.Marshal(resp)
-	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
-		return
-	}
+	return nil, validateWebUIAuth(mode)
+}
 
-	rw.Header().Set("Content-Type", "application/json")
-	_, err = rw.Write(js)
-	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
-		return
+// validateWebUIAuth check a way to authenticate the users of the web UI
+func validateWebUIAuth(mode string) error {
+	switch mode {
+	case webUIAuthModeNone, webUIAuthModeLocal, webUIAuthModeHeader, webUIAuthModeOIDC:
+		return nil
 	}
+	return fmt.Errorf("unknown authentication %s, expected %s, %s, %s or %s",
+		mode, webUIAuthModeNone, webUIAuthModeLocal, webUIAuthModeHeader, webUIAuthModeOIDC)
 }
 
 var webUICmd = &cobra.Command{
@@ -246,8 +348,46 @@ var webUICmd = &cobra.Command{
 	Short: "Launch the web UI.",
 	Long: `Launch the web UI.
 
+Without authentication, the changes are made as the user identity of the repository. With an authentication, every user acts as the identity of their account:
+- local: the accounts created with "git bug webui account add", with a login and a password
+- header: the login or the email of an identity given in a header by an authenticating reverse proxy. The web UI must only be reachable through the proxy.
+- oidc: the single sign-on of an OpenID Connect provider, configured with git-bug.webui.oidc.issuer, client-id and client-secret, and git-bug.webui.url, the public url of the web UI. The client is registered with the provider with the redirect url <url>/auth/oidc/callback. The users are the identities set for their subject with "git config git-bug.webui.oidc.identity.<subject> <identity id>", or the ones with their email, when the provider says it is verified. The username chosen by the user is not trusted. The sessions last a day, and end when the web UI restarts.
+
+By default, the web UI listens on the local host. With --listen, it listens to another address like 0.0.0.0:8080, to a unix socket like unix:/run/git-bug.sock, or to the socket passed by the systemd socket activation with systemd. With the port 0, a free port is picked.
+
+With --repo or --repos-file, other repositories are served along the current one, the default. They are selected by their name with the "repository(ref: name)" GraphQL query and the "repoRef" of the mutations, and their files are under /repos/<name>/, like /repos/<name>/gitfile/<hash>.
+
+The deadlines of the bugs and the dates of the milestones are served as an iCalendar at /calendar.ics, optionally restricted with a query like /calendar.ics?q=status:open, to subscribe to from a calendar application. See "git bug export --format ics". The configuration and the accounts are the ones of the current repository, and the identities the users act as must exist in the repositories they change.
+
+The API clients, like the CI jobs and the bots, authenticate with the tokens of "git bug webui token", given as "Authorization: Bearer <token>", whatever the authentication of the users.
+
+With --cors-origins, the given sites can call the API from the browser, like an external dashboard or a browser extension, authenticated with an API token or the basic authentication. The allowed methods and headers are given by --cors-methods and --cors-headers.
+
+With --rate-limit, the requests to the API are limited per API token, or per IP address without token, to the given number of requests per second after a burst of --rate-burst requests. Behind a reverse proxy, all the requests come from the same address: the limit is better set on the proxy.
+
+The GraphQL queries are limited in cost with --max-query-complexity, where every field costs 1 and the ones of a connection are counted for every item requested, and in nesting with --max-query-depth. With --allowed-queries, only the queries of a JSON file of their SHA-256 hash to their text are accepted, and the clients can send the hash alone as an automatic persisted query. The queries of the web UI must be in the file to use it.
+
+With --tls-cert and --tls-key, the web UI is served over HTTPS. With --tls-self-signed, a certificate for the local host is generated and kept in the repository, to be trusted once in the browser.
+
+Files are attached to the comments by posting them as "uploadfile" in a multipart form to /upload. The response gives their hash and the markdown referencing them, displaying the images and linking the other files, served to be downloaded.
+
+With --read-only, the bugs can be browsed but not changed: the GraphQL mutations and the uploads are rejected, to publish a tracker without giving write access.
+
 Available git config:
+  git-bug.webui.listen [string]: the address to listen to, like --listen
+  git-bug.webui.cors-origins [string]: the sites allowed to call the API, like --cors-origins
+  git-bug.webui.cors-methods [string]: the methods allowed to the other sites, like --cors-methods
+  git-bug.webui.cors-headers [string]: the headers allowed to the other sites, like --cors-headers
+  git-bug.webui.rate-limit [number]: the requests per second to the API allowed to each client, like --rate-limit
+  git-bug.webui.rate-burst [int]: the requests allowed at once to each client, like --rate-burst
+  git-bug.webui.max-query-complexity [int]: the highest cost of a GraphQL query, like --max-query-complexity
+  git-bug.webui.max-query-depth [int]: the deepest nesting of a GraphQL query, like --max-query-depth
+  git-bug.webui.allowed-queries [path]: the only GraphQL queries accepted, like --allowed-queries
   git-bug.webui.open [bool]: control the automatic opening of the web UI in the default browser
+  git-bug.webui.auth [none|local|header|oidc]: how the users are authenticated (default: none)
+  git-bug.webui.auth-header [string]: the header holding the user with the header authentication (default: X-Forwarded-User)
+  git-bug.avatar.provider [none|gravatar|libravatar]: service used to find the avatars from the email addresses (default: none)
+  git-bug.avatar.private-hosts [bool]: fetch the avatars from the loopback, private and link-local addresses (default: false)
 `,
 	PreRunE: loadRepo,
 	RunE:    runWebUI,
@@ -260,6 +400,24 @@ func init() {
 
 	webUICmd.Flags().BoolVar(&webUIOpen, "open", false, "Automatically open the web UI in the default browser")
 	webUICmd.Flags().BoolVar(&webUINoOpen, "no-open", false, "Prevent the automatic opening of the web UI in the default browser")
-	webUICmd.Flags().IntVarP(&webUIPort, "port", "p", 0, "Port to listen to (default is random)")
+	webUICmd.Flags().IntVarP(&webUIPort, "port", "p", 0, "Port to listen to on the local host (default is git-bug.webui.port, or random)")
+	webUICmd.Flags().StringVar(&webUIListenAddr, "listen", "", "Address to listen to: [host]:port, unix:<path> for a unix socket, or systemd for the socket passed by the systemd socket activation (default is git-bug.webui.listen)")
+	webUICmd.Flags().StringArrayVar(&webUIRepoSpecs, "repo", nil, "Serve another repository, as [name=]path (default name is the one of its directory). Can be repeated")
+	webUICmd.Flags().StringVar(&webUIReposFile, "repos-file", "", "Serve the other repositories listed in a file, one [name=]path per line")
+	webUICmd.Flags().StringSliceVar(&webUICORSOrigins, "cors-origins", nil, "The origins of the sites allowed to call the API, like https://dashboard.example.com, or * for any (default is git-bug.webui.cors-origins, or none)")
+	webUICmd.Flags().StringSliceVar(&webUICORSMethods, "cors-methods", nil, "The methods allowed to the other sites (default is git-bug.webui.cors-methods, or GET,POST)")
+	webUICmd.Flags().StringSliceVar(&webUICORSHeaders, "cors-headers", nil, "The headers allowed to the other sites (default is git-bug.webui.cors-headers, or Authorization,Content-Type)")
+	webUICmd.Flags().Float64Var(&webUIRateLimit, "rate-limit", 0, "Limit the requests to the API of each token or IP address, in requests per second (default is git-bug.webui.rate-limit, or no limit)")
+	webUICmd.Flags().IntVar(&webUIRateBurst, "rate-burst", 0, "The requests allowed at once above --rate-limit (default is git-bug.webui.rate-burst, or a second of requests)")
+	webUICmd.Flags().IntVar(&webUIMaxQueryComplexity, "max-query-complexity", 0, fmt.Sprintf("The highest cost of a GraphQL query, 0 for no limit (default is git-bug.webui.max-query-complexity, or %d)", graphql.DefaultMaxComplexity))
+	webUICmd.Flags().IntVar(&webUIMaxQueryDepth, "max-query-depth", 0, fmt.Sprintf("The deepest nesting of a GraphQL query, 0 for no limit (default is git-bug.webui.max-query-depth, or %d)", graphql.DefaultMaxDepth))
+	webUICmd.Flags().StringVar(&webUIAllowedQueries, "allowed-queries", "", "Only accept the GraphQL queries of a JSON file of their SHA-256 hash to their text (default is git-bug.webui.allowed-queries)")
+	webUICmd.Flags().StringVar(&webUITLSCert, "tls-cert", "", "Serve over HTTPS with the certificate of the given PEM file")
+	webUICmd.Flags().StringVar(&webUITLSKey, "tls-key", "", "The PEM file of the private key of the certificate given by --tls-cert")
+	webUICmd.Flags().BoolVar(&webUITLSSelfSigned, "tls-self-signed", false, "Serve over HTTPS with a generated self-signed certificate")
+	webUICmd.Flags().BoolVar(&webUIReadOnly, "read-only", false, "Reject the changes, to only browse the bugs")
+	webUICmd.Flags().BoolVar(&webUIWatch, "watch", false, "Watch the bugs for changes made by another process, like a git fetch, and refresh the web UI")
+	webUICmd.Flags().StringVar(&webUIAuth, "auth", "", "How the users are authenticated: none, local for the accounts of \"git bug webui account\", header to trust the user given by a reverse proxy, or oidc for an OpenID Connect provider (default is git-bug.webui.auth, or none)")
+	webUICmd.Flags().StringVar(&webUIAuthHeader, "auth-header", "", "The header holding the login or email of the user with --auth header (default is git-bug.webui.auth-header, or X-Forwarded-User)")
 
 }
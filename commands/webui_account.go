@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/graphql/auth"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runWebUIAccount(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	accounts, err := auth.ReadAccounts(repo)
+	if err != nil {
+		return err
+	}
+
+	for _, account := range accounts {
+		name := colors.Placeholder("unknown identity")
+		if excerpt, err := backend.ResolveIdentityExcerpt(account.Identity); err == nil {
+			name = excerpt.DisplayName()
+		}
+
+		fmt.Printf("%s %s %s\n",
+			account.Login,
+			colors.Id(account.Identity.Human()),
+			name,
+		)
+	}
+
+	return nil
+}
+
+var webUIAccountCmd = &cobra.Command{
+	Use:   "account",
+	Short: "List the local accounts of the web UI.",
+	Long: `List the local accounts of the web UI.
+
+The local accounts are used with "git bug webui --auth local". Each account has a login, a password, and the identity the user acts as.`,
+	PreRunE: loadRepo,
+	RunE:    runWebUIAccount,
+	Args:    cobra.NoArgs,
+}
+
+func init() {
+	webUICmd.AddCommand(webUIAccountCmd)
+	webUIAccountCmd.Flags().SortFlags = false
+}
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/graphql/auth"
+	"github.com/MichaelMure/git-bug/input"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	webUIAccountAddPasswordFile string
+)
+
+func runWebUIAccountAdd(cmd *cobra.Command, args []string) error {
+	login := args[0]
+	if err := auth.ValidateLogin(login); err != nil {
+		return err
+	}
+
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	identities, err := resolveIdentities(backend, args[1:])
+	if err != nil {
+		return err
+	}
+
+	var password string
+	if webUIAccountAddPasswordFile != "" {
+		password, err = input.BugCommentFileInput(webUIAccountAddPasswordFile)
+		if err != nil {
+			return err
+		}
+		// only the first line, to not keep the new line of the file
+		password = strings.SplitN(password, "\n", 2)[0]
+	} else {
+		password, err = input.PromptPassword("Password")
+		if err != nil {
+			return err
+		}
+	}
+
+	err = auth.StoreAccount(repo, login, password, identities[0].Id())
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("account %s acting as %s\n", login, identities[0].DisplayName())
+	return nil
+}
+
+var webUIAccountAddCmd = &cobra.Command{
+	Use:   "add <login> <user>",
+	Short: "Create or update a local account of the web UI.",
+	Long: `Create or update a local account of the web UI.
+
+The user is the identity the account acts as, given by a prefix of its id, as listed by "git bug user ls", or "me" for your own identity. The password is asked for, unless given with --password-file.`,
+	Example: `Create an account acting as an existing identity:
+git bug webui account add alice 7c01
+
+Set the password from a file:
+git bug webui account add bob a3d9 --password-file bob.secret
+`,
+	PreRunE: loadRepo,
+	RunE:    runWebUIAccountAdd,
+	Args:    cobra.ExactArgs(2),
+}
+
+func init() {
+	webUIAccountCmd.AddCommand(webUIAccountAddCmd)
+
+	webUIAccountAddCmd.Flags().SortFlags = false
+
+	webUIAccountAddCmd.Flags().StringVarP(&webUIAccountAddPasswordFile, "password-file", "F", "",
+		"Take the password from the first line of the given file. Use - to read it from the standard input")
+}
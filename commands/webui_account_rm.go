@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/graphql/auth"
+)
+
+func runWebUIAccountRm(cmd *cobra.Command, args []string) error {
+	err := auth.RemoveAccount(repo, args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("account %s removed\n", args[0])
+	return nil
+}
+
+var webUIAccountRmCmd = &cobra.Command{
+	Use:     "rm <login>",
+	Short:   "Remove a local account of the web UI.",
+	PreRunE: loadRepo,
+	RunE:    runWebUIAccountRm,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	webUIAccountCmd.AddCommand(webUIAccountRmCmd)
+}
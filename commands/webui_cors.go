@@ -0,0 +1,140 @@
+package commands
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const (
+	webUICORSOriginsConfigKey = "git-bug.webui.cors-origins"
+	webUICORSMethodsConfigKey = "git-bug.webui.cors-methods"
+	webUICORSHeadersConfigKey = "git-bug.webui.cors-headers"
+)
+
+var (
+	defaultCORSMethods = []string{"GET", "POST"}
+	defaultCORSHeaders = []string{"Authorization", "Content-Type"}
+)
+
+// how long the browsers can cache the answer of a preflight request
+const corsMaxAge = 10 * 60
+
+// webUICORS is what the other sites can request from the web UI
+type webUICORS struct {
+	origins []string
+	methods []string
+	headers []string
+}
+
+// validateCORSOrigins check a comma-separated list of origins, like
+// https://dashboard.example.com, or * for any site
+func validateCORSOrigins(value string) error {
+	for _, origin := range splitList(value) {
+		if origin == "*" {
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || u.Scheme == "" || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			return fmt.Errorf("invalid origin %s, expected <scheme>://<host>[:port] or *", origin)
+		}
+	}
+	return nil
+}
+
+// splitList split a comma-separated list, ignoring the spaces and the empty
+// items
+func splitList(value string) []string {
+	var result []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// readWebUICORS return the origins, methods and headers allowed by the flags
+// or the config, with no origin by default
+func readWebUICORS() (webUICORS, error) {
+	var cors webUICORS
+
+	lists := []struct {
+		flag     []string
+		key      string
+		defaults []string
+		result   *[]string
+	}{
+		{webUICORSOrigins, webUICORSOriginsConfigKey, nil, &cors.origins},
+		{webUICORSMethods, webUICORSMethodsConfigKey, defaultCORSMethods, &cors.methods},
+		{webUICORSHeaders, webUICORSHeadersConfigKey, defaultCORSHeaders, &cors.headers},
+	}
+
+	for _, list := range lists {
+		*list.result = list.flag
+		if len(*list.result) == 0 {
+			value, err := repository.ReadConfigAnyScope(repo, list.key)
+			if err != nil {
+				return cors, err
+			}
+			*list.result = splitList(value)
+		}
+		if len(*list.result) == 0 {
+			*list.result = list.defaults
+		}
+	}
+
+	if err := validateCORSOrigins(strings.Join(cors.origins, ",")); err != nil {
+		return cors, err
+	}
+
+	for i, origin := range cors.origins {
+		cors.origins[i] = strings.TrimSuffix(origin, "/")
+	}
+
+	return cors, nil
+}
+
+func (c webUICORS) allowed(origin string) bool {
+	for _, allowed := range c.origins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// middleware answer the preflight requests of the allowed origins, and let
+// their browsers read the responses. The cookies are not allowed: the other
+// sites authenticate with an API token or the basic authentication.
+func (c webUICORS) middleware(next http.Handler) http.Handler {
+	if len(c.origins) == 0 {
+		return next
+	}
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Vary", "Origin")
+
+		origin := r.Header.Get("Origin")
+		if origin == "" || !c.allowed(origin) {
+			next.ServeHTTP(rw, r)
+			return
+		}
+
+		rw.Header().Set("Access-Control-Allow-Origin", origin)
+
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			rw.Header().Set("Access-Control-Allow-Methods", strings.Join(c.methods, ", "))
+			rw.Header().Set("Access-Control-Allow-Headers", strings.Join(c.headers, ", "))
+			rw.Header().Set("Access-Control-Max-Age", strconv.Itoa(corsMaxAge))
+			rw.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		next.ServeHTTP(rw, r)
+	})
+}
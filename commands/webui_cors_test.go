@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCORSMiddleware(t *testing.T) {
+	cors := webUICORS{
+		origins: []string{"https://dashboard.example.com"},
+		methods: defaultCORSMethods,
+		headers: defaultCORSHeaders,
+	}
+
+	handler := cors.middleware(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		http.Error(rw, "unauthorized", http.StatusUnauthorized)
+	}))
+
+	serve := func(method string, origin string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "/graphql", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		if method == http.MethodOptions {
+			r.Header.Set("Access-Control-Request-Method", "POST")
+		}
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, r)
+		return rw
+	}
+
+	// the preflight is answered before the authentication
+	rw := serve(http.MethodOptions, "https://dashboard.example.com")
+	require.Equal(t, http.StatusNoContent, rw.Code)
+	require.Equal(t, "https://dashboard.example.com", rw.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "GET, POST", rw.Header().Get("Access-Control-Allow-Methods"))
+	require.Equal(t, "Authorization, Content-Type", rw.Header().Get("Access-Control-Allow-Headers"))
+	require.Empty(t, rw.Header().Get("Access-Control-Allow-Credentials"))
+
+	rw = serve(http.MethodPost, "https://dashboard.example.com")
+	require.Equal(t, http.StatusUnauthorized, rw.Code)
+	require.Equal(t, "https://dashboard.example.com", rw.Header().Get("Access-Control-Allow-Origin"))
+
+	// the other sites
+	rw = serve(http.MethodOptions, "https://evil.example.com")
+	require.Equal(t, http.StatusUnauthorized, rw.Code)
+	require.Empty(t, rw.Header().Get("Access-Control-Allow-Origin"))
+
+	rw = serve(http.MethodPost, "")
+	require.Empty(t, rw.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "Origin", rw.Header().Get("Vary"))
+
+	// any site
+	cors.origins = []string{"*"}
+	handler = cors.middleware(http.NotFoundHandler())
+	rw = serve(http.MethodOptions, "http://localhost:3000")
+	require.Equal(t, http.StatusNoContent, rw.Code)
+	require.Equal(t, "http://localhost:3000", rw.Header().Get("Access-Control-Allow-Origin"))
+}
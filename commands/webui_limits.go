@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/graphql"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const (
+	webUIMaxQueryComplexityConfigKey = "git-bug.webui.max-query-complexity"
+	webUIMaxQueryDepthConfigKey      = "git-bug.webui.max-query-depth"
+	webUIAllowedQueriesConfigKey     = "git-bug.webui.allowed-queries"
+)
+
+// readWebUIQueryLimits return the limits of the GraphQL queries given by the
+// flags or the config, 0 being no limit, and the defaults otherwise
+func readWebUIQueryLimits(cmd *cobra.Command) (graphql.Limits, error) {
+	limits := graphql.DefaultLimits()
+
+	ints := []struct {
+		flag   string
+		value  int
+		key    string
+		result *int
+	}{
+		{"max-query-complexity", webUIMaxQueryComplexity, webUIMaxQueryComplexityConfigKey, &limits.MaxComplexity},
+		{"max-query-depth", webUIMaxQueryDepth, webUIMaxQueryDepthConfigKey, &limits.MaxDepth},
+	}
+
+	for _, i := range ints {
+		if cmd.Flags().Changed(i.flag) {
+			if i.value < 0 {
+				return limits, fmt.Errorf("invalid --%s %d", i.flag, i.value)
+			}
+			*i.result = i.value
+			continue
+		}
+
+		value, err := repository.ReadConfigAnyScope(repo, i.key)
+		if err != nil {
+			return limits, err
+		}
+		if value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				return limits, fmt.Errorf("invalid %s %s", i.key, value)
+			}
+			*i.result = n
+		}
+	}
+
+	path := webUIAllowedQueries
+	if path == "" {
+		var err error
+		path, err = repository.ReadConfigAnyScope(repo, webUIAllowedQueriesConfigKey)
+		if err != nil {
+			return limits, err
+		}
+	}
+	if path != "" {
+		if strings.HasPrefix(path, "~/") {
+			path = filepath.Join(os.Getenv("HOME"), path[2:])
+		}
+		queries, err := graphql.ReadAllowedQueries(path)
+		if err != nil {
+			return limits, err
+		}
+		limits.AllowedQueries = queries
+	}
+
+	return limits, nil
+}
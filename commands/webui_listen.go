@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const webUIListenConfigKey = "git-bug.webui.listen"
+
+// the forms of address the web UI can listen to, besides [host]:port
+const (
+	webUIListenUnixPrefix = "unix:"
+	webUIListenSystemd    = "systemd"
+)
+
+// the first file descriptor passed by systemd, see sd_listen_fds(3)
+const systemdListenFdsStart = 3
+
+// validateWebUIListen check an address to listen to: [host]:port,
+// unix:<path> or systemd
+func validateWebUIListen(address string) error {
+	if address == webUIListenSystemd {
+		return nil
+	}
+
+	if strings.HasPrefix(address, webUIListenUnixPrefix) {
+		if strings.TrimPrefix(address, webUIListenUnixPrefix) == "" {
+			return fmt.Errorf("missing the path of the unix socket")
+		}
+		return nil
+	}
+
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid address %s, expected [host]:port, unix:<path> or systemd", address)
+	}
+
+	// 0 let the system pick a free port
+	if n, err := strconv.Atoi(port); err != nil || n < 0 || n > 65535 {
+		return fmt.Errorf("invalid port %s", port)
+	}
+	return nil
+}
+
+// webUIListen open the listener of the web UI for an address given by
+// --listen or git-bug.webui.listen
+func webUIListen(address string) (net.Listener, error) {
+	if err := validateWebUIListen(address); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case address == webUIListenSystemd:
+		return systemdListener()
+	case strings.HasPrefix(address, webUIListenUnixPrefix):
+		return listenUnix(strings.TrimPrefix(address, webUIListenUnixPrefix))
+	default:
+		return net.Listen("tcp", address)
+	}
+}
+
+// listenUnix listen to a unix socket, replacing the one left by a previous
+// server that didn't stop cleanly
+func listenUnix(path string) (net.Listener, error) {
+	if info, err := os.Stat(path); err == nil && info.Mode()&os.ModeSocket != 0 {
+		conn, err := net.Dial("unix", path)
+		if err == nil {
+			conn.Close()
+			return nil, fmt.Errorf("the unix socket %s is already in use", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+
+	return net.Listen("unix", path)
+}
+
+// systemdListener return the socket passed by systemd with the socket
+// activation, see sd_listen_fds(3)
+func systemdListener() (net.Listener, error) {
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, fmt.Errorf("no socket passed by systemd")
+	}
+
+	fds, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || fds < 1 {
+		return nil, fmt.Errorf("no socket passed by systemd")
+	}
+	if fds > 1 {
+		return nil, fmt.Errorf("systemd passed %d sockets, expected only one", fds)
+	}
+
+	// not to be inherited by the child processes
+	os.Unsetenv("LISTEN_PID")
+	os.Unsetenv("LISTEN_FDS")
+	os.Unsetenv("LISTEN_FDNAMES")
+
+	file := os.NewFile(systemdListenFdsStart, "systemd")
+	defer file.Close()
+
+	// the listener work on a copy of the file descriptor
+	return net.FileListener(file)
+}
+
+// webUIBaseURL return the url to reach the web UI through a listener, or
+// false when it's not reachable from a browser, like with a unix socket
+func webUIBaseURL(listener net.Listener, scheme string) (string, bool) {
+	addr, ok := listener.Addr().(*net.TCPAddr)
+	if !ok {
+		return "", false
+	}
+
+	host := "127.0.0.1"
+	if !addr.IP.IsUnspecified() {
+		host = addr.IP.String()
+	}
+
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(host, strconv.Itoa(addr.Port))), true
+}
@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebUIListenTCP(t *testing.T) {
+	listener, err := webUIListen("127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NotZero(t, port)
+
+	url, reachable := webUIBaseURL(listener, "http")
+	require.True(t, reachable)
+	require.Equal(t, "http://"+listener.Addr().String(), url)
+	require.NotContains(t, url, ":0")
+}
+
+func TestWebUIListenUnix(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git-bug-listen")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	socket := path.Join(dir, "webui.sock")
+
+	// left by a server that didn't stop cleanly
+	stale, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+
+	listener, err := webUIListen("unix:" + socket)
+	require.NoError(t, err)
+
+	_, reachable := webUIBaseURL(listener, "http")
+	require.False(t, reachable)
+
+	// in use
+	_, err = webUIListen("unix:" + socket)
+	require.Error(t, err)
+
+	require.NoError(t, listener.Close())
+	_, err = os.Stat(socket)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestWebUIListenSystemd(t *testing.T) {
+	os.Unsetenv("LISTEN_PID")
+	os.Unsetenv("LISTEN_FDS")
+
+	_, err := webUIListen(webUIListenSystemd)
+	require.Error(t, err)
+}
+
+func TestValidateWebUIListen(t *testing.T) {
+	require.NoError(t, validateWebUIListen(":8080"))
+	require.NoError(t, validateWebUIListen("[::]:0"))
+	require.NoError(t, validateWebUIListen("unix:git-bug.sock"))
+	require.NoError(t, validateWebUIListen("systemd"))
+
+	require.Error(t, validateWebUIListen("unix:"))
+	require.Error(t, validateWebUIListen("localhost"))
+	require.Error(t, validateWebUIListen("localhost:http"))
+	require.Error(t, validateWebUIListen("localhost:65536"))
+}
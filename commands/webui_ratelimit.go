@@ -0,0 +1,204 @@
+package commands
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MichaelMure/git-bug/graphql/auth"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const (
+	webUIRateLimitConfigKey = "git-bug.webui.rate-limit"
+	webUIRateBurstConfigKey = "git-bug.webui.rate-burst"
+)
+
+// the paths of the API, the static files of the web UI are not limited
+var rateLimitedPaths = []string{
+	"/graphql",
+	"/gitfile/",
+	"/upload",
+	"/avatar/",
+	"/repos/",
+	auth.OIDCPathPrefix,
+}
+
+// how often the idle clients are forgotten
+const rateLimitCleanup = time.Minute
+
+func validateRateLimit(value string) error {
+	rate, err := strconv.ParseFloat(value, 64)
+	if err != nil || rate < 0 || math.IsInf(rate, 0) || math.IsNaN(rate) {
+		return fmt.Errorf("invalid rate %s, expected a number of requests per second", value)
+	}
+	return nil
+}
+
+// readWebUIRateLimit return the limiter configured by the flags or the
+// config, or nil without limit
+func readWebUIRateLimit() (*rateLimiter, error) {
+	rate := webUIRateLimit
+	if rate == 0 {
+		value, err := repository.ReadConfigAnyScope(repo, webUIRateLimitConfigKey)
+		if err != nil {
+			return nil, err
+		}
+		if value != "" {
+			if err := validateRateLimit(value); err != nil {
+				return nil, err
+			}
+			rate, _ = strconv.ParseFloat(value, 64)
+		}
+	}
+	if rate < 0 {
+		return nil, fmt.Errorf("invalid rate %v, expected a number of requests per second", rate)
+	}
+	if rate == 0 {
+		return nil, nil
+	}
+
+	burst := webUIRateBurst
+	if burst == 0 {
+		value, err := repository.ReadConfigAnyScope(repo, webUIRateBurstConfigKey)
+		if err != nil {
+			return nil, err
+		}
+		if value != "" {
+			burst, err = strconv.Atoi(value)
+			if err != nil || burst < 0 {
+				return nil, fmt.Errorf("invalid burst %s", value)
+			}
+		}
+	}
+	if burst < 0 {
+		return nil, fmt.Errorf("invalid burst %d", burst)
+	}
+	if burst == 0 {
+		// by default, a second of requests
+		burst = int(math.Ceil(rate))
+	}
+
+	return newRateLimiter(rate, burst), nil
+}
+
+// rateLimiter limit the requests of each client with a token bucket: a client
+// can make burst requests at once, then rate requests per second
+type rateLimiter struct {
+	rate  float64
+	burst float64
+	now   func() time.Time
+
+	mu          sync.Mutex
+	buckets     map[string]*rateBucket
+	lastCleanup time.Time
+}
+
+type rateBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		now:     time.Now,
+		buckets: make(map[string]*rateBucket),
+	}
+}
+
+// allow take a token from the bucket of a client, or return how long to wait
+// for the next one
+func (l *rateLimiter) allow(client string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	if now.Sub(l.lastCleanup) > rateLimitCleanup {
+		// the full buckets are the same as new ones
+		for key, b := range l.buckets {
+			if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+				delete(l.buckets, key)
+			}
+		}
+		l.lastCleanup = now
+	}
+
+	b, ok := l.buckets[client]
+	if !ok {
+		b = &rateBucket{tokens: l.burst, last: now}
+		l.buckets[client] = b
+	}
+
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+		return false, wait
+	}
+
+	b.tokens--
+	return true, 0
+}
+
+// rateLimitClient return who is limited for a request: the API token if it is
+// a known one, the IP address otherwise. An unknown token is limited with the
+// address, for the random ones not to get fresh buckets.
+func rateLimitClient(r *http.Request, knownToken func(token string) bool) string {
+	header := r.Header.Get("Authorization")
+	if strings.HasPrefix(header, "Bearer ") {
+		token := strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+		if knownToken != nil && knownToken(token) {
+			// no need to keep the tokens in memory
+			hash := sha256.Sum256([]byte(token))
+			return "token:" + hex.EncodeToString(hash[:])
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+// middleware reject the requests to the API over the limit with a 429 Too
+// Many Requests. It comes before the authentication, for it to be limited as
+// well: knownToken must tell the valid API tokens without reading the config.
+func (l *rateLimiter) middleware(next http.Handler, knownToken func(token string) bool) http.Handler {
+	if l == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		limited := false
+		for _, path := range rateLimitedPaths {
+			if strings.HasPrefix(r.URL.Path, path) {
+				limited = true
+				break
+			}
+		}
+		if !limited {
+			next.ServeHTTP(rw, r)
+			return
+		}
+
+		if ok, wait := l.allow(rateLimitClient(r, knownToken)); !ok {
+			rw.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			http.Error(rw, "too many requests", http.StatusTooManyRequests)
+			return
+		}
+
+		next.ServeHTTP(rw, r)
+	})
+}
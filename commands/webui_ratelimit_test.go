@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Now()
+	limiter := newRateLimiter(2, 3)
+	limiter.now = func() time.Time { return now }
+
+	handler := limiter.middleware(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusNoContent)
+	}), func(token string) bool { return token == "gbt_abc" })
+
+	serve := func(path string, remote string, token string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("POST", path, nil)
+		r.RemoteAddr = remote
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, r)
+		return rw
+	}
+
+	// the burst, then rejected
+	for i := 0; i < 3; i++ {
+		require.Equal(t, http.StatusNoContent, serve("/graphql", "10.0.0.1:1234", "").Code)
+	}
+	rw := serve("/graphql", "10.0.0.1:5678", "")
+	require.Equal(t, http.StatusTooManyRequests, rw.Code)
+	require.Equal(t, "1", rw.Header().Get("Retry-After"))
+
+	// the other clients and the static files are not affected
+	require.Equal(t, http.StatusNoContent, serve("/graphql", "10.0.0.2:1234", "").Code)
+	require.Equal(t, http.StatusNoContent, serve("/graphql", "10.0.0.1:1234", "gbt_abc").Code)
+	require.Equal(t, http.StatusNoContent, serve("/graphql", "10.0.0.1:1234", "gbt_abc").Code)
+
+	// an unknown token doesn't escape the limit of the address
+	require.Equal(t, http.StatusTooManyRequests, serve("/graphql", "10.0.0.1:1234", "gbt_random1").Code)
+	require.Equal(t, http.StatusTooManyRequests, serve("/graphql", "10.0.0.1:1234", "gbt_random2").Code)
+	require.Equal(t, http.StatusNoContent, serve("/bug/123", "10.0.0.1:1234", "").Code)
+	require.Equal(t, http.StatusTooManyRequests, serve("/repos/other/gitfile/abc", "10.0.0.1:1234", "").Code)
+
+	// refilled at the rate
+	now = now.Add(500 * time.Millisecond)
+	require.Equal(t, http.StatusNoContent, serve("/graphql", "10.0.0.1:1234", "").Code)
+	require.Equal(t, http.StatusTooManyRequests, serve("/graphql", "10.0.0.1:1234", "").Code)
+
+	// the idle clients are forgotten
+	now = now.Add(2 * rateLimitCleanup)
+	require.Equal(t, http.StatusNoContent, serve("/graphql", "10.0.0.3:1234", "").Code)
+	require.Len(t, limiter.buckets, 1)
+}
@@ -0,0 +1,156 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+var webUIRepoNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
+// webUIRepo is an additional repository served by the web UI
+type webUIRepo struct {
+	name string
+	path string
+}
+
+// parseWebUIRepo parse a repository given as [name=]path, with a path
+// relative to a directory. Without a name, the one of the directory of the
+// repository is used.
+func parseWebUIRepo(spec string, dir string) (webUIRepo, error) {
+	var name, path string
+	if i := strings.Index(spec, "="); i >= 0 {
+		name, path = strings.TrimSpace(spec[:i]), strings.TrimSpace(spec[i+1:])
+	} else {
+		path = spec
+	}
+
+	if path == "" {
+		return webUIRepo{}, fmt.Errorf("missing the path of the repository %s", spec)
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	path = filepath.Clean(path)
+
+	if name == "" {
+		name = filepath.Base(path)
+		if name == ".git" {
+			name = filepath.Base(filepath.Dir(path))
+		}
+		name = strings.TrimSuffix(name, ".git")
+	}
+
+	if !webUIRepoNameRegexp.MatchString(name) || name == "." || name == ".." {
+		return webUIRepo{}, fmt.Errorf("invalid repository name %s, expected letters, digits, dots, dashes or underscores", name)
+	}
+
+	return webUIRepo{name: name, path: path}, nil
+}
+
+// readWebUIReposFile read the repositories listed in a file, one [name=]path
+// per line. The empty lines and the ones starting with # are ignored.
+func readWebUIReposFile(filename string) ([]webUIRepo, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var repos []webUIRepo
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		repo, err := parseWebUIRepo(text, filepath.Dir(filename))
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", filename, line, err)
+		}
+		repos = append(repos, repo)
+	}
+
+	return repos, scanner.Err()
+}
+
+// webUIRepos return the additional repositories given by --repo and
+// --repos-file
+func webUIRepos() ([]webUIRepo, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	var repos []webUIRepo
+
+	for _, spec := range webUIRepoSpecs {
+		repo, err := parseWebUIRepo(spec, cwd)
+		if err != nil {
+			return nil, err
+		}
+		repos = append(repos, repo)
+	}
+
+	if webUIReposFile != "" {
+		fromFile, err := readWebUIReposFile(webUIReposFile)
+		if err != nil {
+			return nil, err
+		}
+		repos = append(repos, fromFile...)
+	}
+
+	names := make(map[string]bool)
+	for _, repo := range repos {
+		if names[repo.name] {
+			return nil, fmt.Errorf("multiple repositories are named %s", repo.name)
+		}
+		names[repo.name] = true
+	}
+
+	return repos, nil
+}
+
+// openWebUIRepos open the additional repositories, by name
+func openWebUIRepos(repos []webUIRepo) (map[string]*cache.RepoCache, error) {
+	result := make(map[string]*cache.RepoCache, len(repos))
+
+	for _, r := range repos {
+		repo, err := repository.OpenRepo(r.path, bug.Witnesser)
+		if err == repository.ErrNotARepo {
+			err = fmt.Errorf("%s is not a git repository", r.path)
+		}
+		if err != nil {
+			closeWebUIRepos(nil, result)
+			return nil, err
+		}
+
+		repoCache, err := cache.NewRepoCache(repo)
+		if err != nil {
+			closeWebUIRepos(nil, result)
+			return nil, err
+		}
+		result[r.name] = repoCache
+	}
+
+	return result, nil
+}
+
+// closeWebUIRepos close the repositories opened before a failure
+func closeWebUIRepos(repoCache *cache.RepoCache, others map[string]*cache.RepoCache) {
+	if repoCache != nil {
+		_ = repoCache.Close()
+	}
+	for _, r := range others {
+		_ = r.Close()
+	}
+}
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWebUIRepo(t *testing.T) {
+	cases := []struct {
+		spec string
+		name string
+		path string
+	}{
+		{"../backend", "backend", "/srv/backend"},
+		{"/srv/tracker.git", "tracker", "/srv/tracker.git"},
+		{"/srv/app/.git", "app", "/srv/app/.git"},
+		{"api=/srv/backend", "api", "/srv/backend"},
+	}
+
+	for _, c := range cases {
+		repo, err := parseWebUIRepo(c.spec, "/srv/frontend")
+		require.NoError(t, err, c.spec)
+		require.Equal(t, c.name, repo.name, c.spec)
+		require.Equal(t, c.path, repo.path, c.spec)
+	}
+
+	for _, spec := range []string{"api=", "a b=/srv/backend", "/"} {
+		_, err := parseWebUIRepo(spec, "/srv/frontend")
+		require.Error(t, err, spec)
+	}
+}
+
+func TestReadWebUIReposFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git-bug-repos")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "repos")
+	err = ioutil.WriteFile(filename, []byte("# the trackers\n\nbackend\napi = /srv/api\n"), 0644)
+	require.NoError(t, err)
+
+	repos, err := readWebUIReposFile(filename)
+	require.NoError(t, err)
+	require.Equal(t, []webUIRepo{
+		{name: "backend", path: filepath.Join(dir, "backend")},
+		{name: "api", path: "/srv/api"},
+	}, repos)
+}
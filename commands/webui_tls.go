@@ -0,0 +1,142 @@
+package commands
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path"
+	"time"
+)
+
+const webUITLSDir = "webui"
+const webUICertFile = "cert.pem"
+const webUIKeyFile = "key.pem"
+
+// how long a generated certificate is valid
+const selfSignedValidity = 365 * 24 * time.Hour
+
+// selfSignedCertificate return the files of a self-signed certificate for the
+// local host, stored in the repository to be trusted once by the browsers.
+// It's generated again when missing or about to expire.
+func selfSignedCertificate(gitDir string) (certFile string, keyFile string, err error) {
+	dir := path.Join(gitDir, "git-bug", webUITLSDir)
+	certFile = path.Join(dir, webUICertFile)
+	keyFile = path.Join(dir, webUIKeyFile)
+
+	if validCertificate(certFile, keyFile, time.Now().Add(24*time.Hour)) {
+		return certFile, keyFile, nil
+	}
+
+	certPEM, keyPEM, err := generateCertificate(time.Now())
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", "", err
+	}
+	if err := ioutil.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		return "", "", err
+	}
+	if err := ioutil.WriteFile(certFile, certPEM, 0644); err != nil {
+		return "", "", err
+	}
+
+	return certFile, keyFile, nil
+}
+
+// validCertificate tell if a key pair can be loaded and is still valid at the
+// given time
+func validCertificate(certFile string, keyFile string, at time.Time) bool {
+	pair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return false
+	}
+
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return false
+	}
+
+	return at.Before(cert.NotAfter)
+}
+
+// generateCertificate create a self-signed certificate for localhost and the
+// host name, in the PEM format
+func generateCertificate(now time.Time) (certPEM []byte, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{Organization: []string{"git-bug"}, CommonName: "localhost"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(selfSignedValidity),
+
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+
+		DNSNames:    []string{"localhost"},
+		IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+
+	if hostname, err := os.Hostname(); err == nil && hostname != "localhost" {
+		template.DNSNames = append(template.DNSNames, hostname)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return certPEM, keyPEM, nil
+}
+
+// webUITLSFiles return the certificate and key to serve the web UI with, if
+// any, from --tls-cert and --tls-key or --tls-self-signed
+func webUITLSFiles(gitDir string) (certFile string, keyFile string, err error) {
+	if webUITLSSelfSigned {
+		if webUITLSCert != "" || webUITLSKey != "" {
+			return "", "", fmt.Errorf("--tls-self-signed can't be used with --tls-cert or --tls-key")
+		}
+		return selfSignedCertificate(gitDir)
+	}
+
+	if (webUITLSCert == "") != (webUITLSKey == "") {
+		return "", "", fmt.Errorf("--tls-cert and --tls-key must be given together")
+	}
+
+	if webUITLSCert != "" {
+		// fail early instead of when the server start
+		if _, err := tls.LoadX509KeyPair(webUITLSCert, webUITLSKey); err != nil {
+			return "", "", err
+		}
+	}
+
+	return webUITLSCert, webUITLSKey, nil
+}
@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelfSignedCertificate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git-bug-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	certFile, keyFile, err := selfSignedCertificate(dir)
+	require.NoError(t, err)
+	require.True(t, validCertificate(certFile, keyFile, time.Now()))
+	require.False(t, validCertificate(certFile, keyFile, time.Now().Add(2*selfSignedValidity)))
+
+	cert, err := ioutil.ReadFile(certFile)
+	require.NoError(t, err)
+
+	// still valid, kept
+	_, _, err = selfSignedCertificate(dir)
+	require.NoError(t, err)
+	cert2, err := ioutil.ReadFile(certFile)
+	require.NoError(t, err)
+	require.Equal(t, cert, cert2)
+
+	// broken, generated again
+	require.NoError(t, ioutil.WriteFile(keyFile, []byte("nope"), 0600))
+	_, _, err = selfSignedCertificate(dir)
+	require.NoError(t, err)
+	cert3, err := ioutil.ReadFile(certFile)
+	require.NoError(t, err)
+	require.NotEqual(t, cert, cert3)
+	require.True(t, validCertificate(certFile, keyFile, time.Now()))
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/graphql/auth"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runWebUIToken(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	tokens, err := auth.ReadTokens(repo)
+	if err != nil {
+		return err
+	}
+
+	for _, token := range tokens {
+		name := colors.Placeholder("unknown identity")
+		if excerpt, err := backend.ResolveIdentityExcerpt(token.Identity); err == nil {
+			name = excerpt.DisplayName()
+		}
+
+		fmt.Printf("%s %-5s %s %s\n",
+			token.Name,
+			token.Scope,
+			colors.Id(token.Identity.Human()),
+			name,
+		)
+	}
+
+	return nil
+}
+
+var webUITokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "List the API tokens of the web UI.",
+	Long: `List the API tokens of the web UI.
+
+The API tokens let the CI jobs and the bots use the GraphQL API of a hosted web UI, given as "Authorization: Bearer <token>". Each token has a name, the identity it acts as, and a scope: read to only query, or write to also change the bugs.`,
+	PreRunE: loadRepo,
+	RunE:    runWebUIToken,
+	Args:    cobra.NoArgs,
+}
+
+func init() {
+	webUICmd.AddCommand(webUITokenCmd)
+	webUITokenCmd.Flags().SortFlags = false
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/graphql/auth"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	webUITokenCreateScope string
+)
+
+func runWebUITokenCreate(cmd *cobra.Command, args []string) error {
+	if err := auth.ValidateScope(webUITokenCreateScope); err != nil {
+		return err
+	}
+
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	users := args[1:]
+	if len(users) == 0 {
+		users = []string{"me"}
+	}
+	identities, err := resolveIdentities(backend, users)
+	if err != nil {
+		return err
+	}
+	identity := identities[0]
+
+	token, err := auth.CreateToken(repo, args[0], identity.Id(), webUITokenCreateScope)
+	if err != nil {
+		return err
+	}
+
+	// the token alone on the standard output, to be captured by a script
+	_, _ = fmt.Fprintf(os.Stderr, "token %s with the %s scope, acting as %s:\n",
+		args[0], webUITokenCreateScope, identity.DisplayName())
+	fmt.Println(token)
+	return nil
+}
+
+var webUITokenCreateCmd = &cobra.Command{
+	Use:   "create <name> [<user>]",
+	Short: "Issue an API token for the web UI.",
+	Long: `Issue an API token for the web UI.
+
+The token acts as the user, given by a prefix of its id, as listed by "git bug user ls", or your own identity by default. It's printed only once: only its hash is kept in the repository config.`,
+	Example: `Issue a read-only token for a CI job:
+git bug webui token create ci
+
+Issue a token for a bot changing the bugs as its own identity:
+git bug webui token create triage-bot 5e3a --scope write
+`,
+	PreRunE: loadRepo,
+	RunE:    runWebUITokenCreate,
+	Args:    cobra.RangeArgs(1, 2),
+}
+
+func init() {
+	webUITokenCmd.AddCommand(webUITokenCreateCmd)
+
+	webUITokenCreateCmd.Flags().SortFlags = false
+
+	webUITokenCreateCmd.Flags().StringVarP(&webUITokenCreateScope, "scope", "s", auth.ScopeRead,
+		"What the token can do: read to only query, or write to also change the bugs")
+}
@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/graphql/auth"
+)
+
+func runWebUITokenRevoke(cmd *cobra.Command, args []string) error {
+	err := auth.RevokeToken(repo, args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("token %s revoked\n", args[0])
+	return nil
+}
+
+var webUITokenRevokeCmd = &cobra.Command{
+	Use:   "revoke <name>",
+	Short: "Revoke an API token of the web UI.",
+	Long: `Revoke an API token of the web UI.
+
+A running web UI rejects the token within a few seconds.`,
+	PreRunE: loadRepo,
+	RunE:    runWebUITokenRevoke,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	webUITokenCmd.AddCommand(webUITokenRevokeCmd)
+}
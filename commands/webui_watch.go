@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// cacheRefresher refresh the caches of the web UI when their bugs are changed
+// by another process, holding the lock of the caches as they can't be used
+// concurrently with a refresh
+type cacheRefresher struct {
+	lock  *sync.RWMutex
+	stops []func()
+}
+
+// watch start refreshing a cache when its bugs change
+func (r *cacheRefresher) watch(repoCache *cache.RepoCache) {
+	stop := repoCache.Watch(cache.DefaultWatchInterval, func() {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+
+		_, err := repoCache.Refresh()
+		if err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, "Refreshing the cache:", err)
+		}
+	})
+	r.stops = append(r.stops, stop)
+}
+
+// stop stop watching the caches
+func (r *cacheRefresher) stop() {
+	for _, stop := range r.stops {
+		stop()
+	}
+	r.stops = nil
+}
@@ -26,6 +26,9 @@ type MergeResult struct {
 	// Only set for invalid status
 	Reason string
 
+	// Optionally set for a valid status, when a non-fatal problem was found
+	Warning string
+
 	// Not set for invalid status
 	Entity Interface
 }
@@ -33,13 +36,13 @@ type MergeResult struct {
 func (mr MergeResult) String() string {
 	switch mr.Status {
 	case MergeStatusNew:
-		return "new"
+		return mr.withWarning("new")
 	case MergeStatusInvalid:
 		return fmt.Sprintf("invalid data: %s", mr.Reason)
 	case MergeStatusUpdated:
-		return "updated"
+		return mr.withWarning("updated")
 	case MergeStatusNothing:
-		return "nothing to do"
+		return mr.withWarning("nothing to do")
 	case MergeStatusError:
 		return fmt.Sprintf("merge error on %s: %s", mr.Id, mr.Err.Error())
 	default:
@@ -47,6 +50,19 @@ func (mr MergeResult) String() string {
 	}
 }
 
+func (mr MergeResult) withWarning(str string) string {
+	if mr.Warning == "" {
+		return str
+	}
+	return fmt.Sprintf("%s (warning: %s)", str, mr.Warning)
+}
+
+// WithWarning return a copy of the MergeResult with a warning attached
+func (mr MergeResult) WithWarning(warning string) MergeResult {
+	mr.Warning = warning
+	return mr
+}
+
 func NewMergeError(err error, id Id) MergeResult {
 	return MergeResult{
 		Err:    err,
@@ -1,6 +1,7 @@
 //go:generate go run doc/gen_markdown.go
 //go:generate go run doc/gen_manpage.go
 //go:generate go run misc/gen_bash_completion.go
+//go:generate go run misc/gen_fish_completion.go
 //go:generate go run misc/gen_powershell_completion.go
 //go:generate go run misc/gen_zsh_completion.go
 
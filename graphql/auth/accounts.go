@@ -0,0 +1,235 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const accountConfigKeyPrefix = "git-bug.webui.account."
+
+const (
+	accountConfigPassword = "password"
+	accountConfigIdentity = "identity"
+)
+
+// Account is a local account of the web UI, stored in the git config:
+//   git-bug.webui.account.<login>.password = <hash of the password>
+//   git-bug.webui.account.<login>.identity = <id of the identity>
+type Account struct {
+	Login    string
+	Identity entity.Id
+	password string
+}
+
+// ReadAccounts read the local accounts from the repository config, sorted by
+// login
+func ReadAccounts(repo repository.RepoCommon) ([]Account, error) {
+	configs, err := repo.LocalConfig().ReadAll(accountConfigKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make(map[string]*Account)
+
+	for key, value := range configs {
+		// the login can contain dots, the setting can't
+		key = strings.TrimPrefix(key, accountConfigKeyPrefix)
+		i := strings.LastIndex(key, ".")
+		if i <= 0 {
+			continue
+		}
+		login, setting := key[:i], key[i+1:]
+
+		account, ok := accounts[login]
+		if !ok {
+			account = &Account{Login: login}
+			accounts[login] = account
+		}
+
+		switch setting {
+		case accountConfigPassword:
+			account.password = value
+		case accountConfigIdentity:
+			account.Identity = entity.Id(value)
+		}
+	}
+
+	result := make([]Account, 0, len(accounts))
+	for _, account := range accounts {
+		if account.password == "" || account.Identity == "" {
+			return nil, fmt.Errorf("account %s is incomplete", account.Login)
+		}
+		result = append(result, *account)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Login < result[j].Login
+	})
+
+	return result, nil
+}
+
+// ValidateLogin check that a login can be used for a local account
+func ValidateLogin(login string) error {
+	if login == "" {
+		return fmt.Errorf("empty login")
+	}
+	if strings.ContainsAny(login, ": \t\n") {
+		return fmt.Errorf("the login %s can't contain a colon or a space", login)
+	}
+	return nil
+}
+
+// StoreAccount create a local account, or change the password and identity of
+// an existing one
+func StoreAccount(repo repository.RepoCommon, login string, password string, identity entity.Id) error {
+	if err := ValidateLogin(login); err != nil {
+		return err
+	}
+	if password == "" {
+		return fmt.Errorf("empty password")
+	}
+
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	config := repo.LocalConfig()
+
+	err = config.StoreString(accountConfigKey(login, accountConfigPassword), hash)
+	if err != nil {
+		return err
+	}
+
+	return config.StoreString(accountConfigKey(login, accountConfigIdentity), identity.String())
+}
+
+// RemoveAccount delete a local account
+func RemoveAccount(repo repository.RepoCommon, login string) error {
+	accounts, err := ReadAccounts(repo)
+	if err != nil {
+		return err
+	}
+
+	for _, account := range accounts {
+		if account.Login == login {
+			config := repo.LocalConfig()
+			err := config.RemoveAll(accountConfigKey(login, accountConfigPassword))
+			if err != nil {
+				return err
+			}
+			return config.RemoveAll(accountConfigKey(login, accountConfigIdentity))
+		}
+	}
+
+	return fmt.Errorf("unknown account %s", login)
+}
+
+func accountConfigKey(login string, setting string) string {
+	return accountConfigKeyPrefix + login + "." + setting
+}
+
+var _ Authenticator = &LocalAccounts{}
+
+// LocalAccounts authenticate the users with the local accounts, using the
+// HTTP basic authentication
+type LocalAccounts struct {
+	accounts map[string]Account
+
+	// the passwords are expensive to check, so once verified, a digest of
+	// the password is kept to check the next requests. The digest is keyed
+	// with a random salt of the process and the stored hash, not to be
+	// usable to guess the password outside of this process.
+	mu       sync.Mutex
+	salt     []byte
+	verified map[string][]byte
+}
+
+func NewLocalAccounts(repo repository.RepoCommon) (*LocalAccounts, error) {
+	accounts, err := ReadAccounts(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(accounts) == 0 {
+		return nil, fmt.Errorf("no local account, create one with \"git bug webui account add\"")
+	}
+
+	salt := make([]byte, passwordSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	la := &LocalAccounts{
+		accounts: make(map[string]Account, len(accounts)),
+		salt:     salt,
+		verified: make(map[string][]byte),
+	}
+
+	for _, account := range accounts {
+		la.accounts[account.Login] = account
+	}
+
+	return la, nil
+}
+
+// Authenticate check the login and password of the basic authentication
+func (la *LocalAccounts) Authenticate(r *http.Request) (entity.Id, error) {
+	login, password, ok := r.BasicAuth()
+	if !ok {
+		return "", ErrNoCredentials
+	}
+
+	account, ok := la.accounts[login]
+	if !ok {
+		return "", ErrInvalidCredentials
+	}
+
+	digest := la.digest(account, password)
+
+	la.mu.Lock()
+	verified, ok := la.verified[login]
+	la.mu.Unlock()
+
+	if ok && hmac.Equal(verified, digest) {
+		return account.Identity, nil
+	}
+
+	match, err := checkPassword(account.password, password)
+	if err != nil {
+		return "", err
+	}
+	if !match {
+		return "", ErrInvalidCredentials
+	}
+
+	la.mu.Lock()
+	la.verified[login] = digest
+	la.mu.Unlock()
+
+	return account.Identity, nil
+}
+
+// digest return the digest of a password kept once verified
+func (la *LocalAccounts) digest(account Account, password string) []byte {
+	mac := hmac.New(sha256.New, la.salt)
+	mac.Write([]byte(account.password))
+	mac.Write([]byte{0})
+	mac.Write([]byte(password))
+	return mac.Sum(nil)
+}
+
+// Challenge ask for the basic authentication
+func (la *LocalAccounts) Challenge(rw http.ResponseWriter) {
+	rw.Header().Set("WWW-Authenticate", `Basic realm="git-bug", charset="UTF-8"`)
+}
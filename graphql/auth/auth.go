@@ -0,0 +1,121 @@
+// Package auth authenticate the users of the web UI, and carry the identity
+// they act as to the GraphQL resolvers.
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// ErrNoCredentials is returned when a request doesn't carry any credentials
+var ErrNoCredentials = errors.New("authentication required")
+
+// ErrInvalidCredentials is returned when the credentials of a request don't
+// match any user
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// ErrReadOnly is returned when something is about to be changed in the
+// read-only mode
+var ErrReadOnly = errors.New("the web UI is read-only")
+
+// Authenticator find the identity of the user making a request
+type Authenticator interface {
+	// Authenticate return the id of the identity of the user making the
+	// request, ErrNoCredentials or ErrInvalidCredentials
+	Authenticate(r *http.Request) (entity.Id, error)
+
+	// Challenge set the headers of an unauthorized response asking the client
+	// to authenticate, if it can
+	Challenge(rw http.ResponseWriter)
+}
+
+// LoginRedirector is an Authenticator sending the browsers to a login page
+// instead of rejecting them
+type LoginRedirector interface {
+	// LoginRedirect redirect a request without credentials to log in
+	LoginRedirect(rw http.ResponseWriter, r *http.Request)
+}
+
+type contextKey int
+
+const (
+	identityKey contextKey = iota
+	readOnlyKey
+)
+
+// ContextWithIdentity return a context carrying the identity of the
+// authenticated user
+func ContextWithIdentity(ctx context.Context, id entity.Id) context.Context {
+	return context.WithValue(ctx, identityKey, id)
+}
+
+// IdentityFromContext return the identity of the authenticated user, if any
+func IdentityFromContext(ctx context.Context) (entity.Id, bool) {
+	id, ok := ctx.Value(identityKey).(entity.Id)
+	return id, ok
+}
+
+// IsReadOnly tell if the request can't change anything
+func IsReadOnly(ctx context.Context) bool {
+	readOnly, _ := ctx.Value(readOnlyKey).(bool)
+	return readOnly
+}
+
+// UserIdentity return the identity to act as: the one of the authenticated
+// user, or the user identity of the repository without authentication. In
+// the read-only mode, there is none.
+func UserIdentity(ctx context.Context, repo *cache.RepoCache) (*cache.IdentityCache, error) {
+	if IsReadOnly(ctx) {
+		return nil, ErrReadOnly
+	}
+
+	if id, ok := IdentityFromContext(ctx); ok {
+		return repo.ResolveIdentity(id)
+	}
+
+	return repo.GetUserIdentity()
+}
+
+// Middleware reject the requests without valid credentials, and pass the
+// identity of the user in the context of the others
+func Middleware(a Authenticator, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		id, err := a.Authenticate(r)
+
+		switch err {
+		case nil:
+			next.ServeHTTP(rw, r.WithContext(ContextWithIdentity(r.Context(), id)))
+		case ErrNoCredentials:
+			if lr, ok := a.(LoginRedirector); ok && isNavigation(r) {
+				lr.LoginRedirect(rw, r)
+				return
+			}
+			a.Challenge(rw)
+			http.Error(rw, err.Error(), http.StatusUnauthorized)
+		case ErrInvalidCredentials:
+			a.Challenge(rw)
+			http.Error(rw, err.Error(), http.StatusUnauthorized)
+		default:
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// isNavigation tell if a request is a browser loading a page, not an API
+// call
+func isNavigation(r *http.Request) bool {
+	return r.Method == http.MethodGet && strings.Contains(r.Header.Get("Accept"), "text/html")
+}
+
+// ReadOnly mark the requests as unable to change anything
+func ReadOnly(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), readOnlyKey, true)
+		next.ServeHTTP(rw, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,249 @@
+package auth
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestPassword(t *testing.T) {
+	hash, err := hashPassword("secret")
+	require.NoError(t, err)
+
+	match, err := checkPassword(hash, "secret")
+	require.NoError(t, err)
+	require.True(t, match)
+
+	match, err = checkPassword(hash, "Secret")
+	require.NoError(t, err)
+	require.False(t, match)
+
+	// the salt is random
+	other, err := hashPassword("secret")
+	require.NoError(t, err)
+	require.NotEqual(t, hash, other)
+
+	_, err = checkPassword("md5$abc", "secret")
+	require.Error(t, err)
+}
+
+func TestPBKDF2(t *testing.T) {
+	// test vectors from RFC 6070, with HMAC-SHA1, except the one with 16777216
+	// iterations
+	var tests = []struct {
+		password   string
+		salt       string
+		iterations int
+		expected   string
+	}{
+		{"password", "salt", 1, "0c60c80f961f0e71f3a9b524af6012062fe037a6"},
+		{"password", "salt", 2, "ea6c014dc72d6f8ccd1ed92ace1d41f0d8de8957"},
+		{"password", "salt", 4096, "4b007901b765489abead49d926f721d065a429c1"},
+		{"passwordPASSWORDpassword", "saltSALTsaltSALTsaltSALTsaltSALTsalt", 4096,
+			"3d2eec4fe41c849b80c8d83662c0e44a8b291a964cf2f07038"},
+		{"pass\x00word", "sa\x00lt", 4096, "56fa6aa75548099dcc37d7f03425e0c3"},
+	}
+
+	for _, test := range tests {
+		key := pbkdf2([]byte(test.password), []byte(test.salt), test.iterations, len(test.expected)/2, sha1.New)
+		require.Equal(t, test.expected, hex.EncodeToString(key), test.password)
+	}
+
+	// test vector from RFC 7914, with HMAC-SHA256 as for the passwords
+	key := pbkdf2([]byte("passwd"), []byte("salt"), 1, 64, sha256.New)
+	require.Equal(t, "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc"+
+		"49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783",
+		hex.EncodeToString(key))
+}
+
+func TestLocalAccounts(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+
+	require.NoError(t, StoreAccount(repo, "alice", "secret", "a1"))
+	require.NoError(t, StoreAccount(repo, "bob.smith", "password", "b2"))
+	require.Error(t, StoreAccount(repo, "carol:c", "password", "c3"))
+	require.Error(t, StoreAccount(repo, "carol", "", "c3"))
+
+	accounts, err := ReadAccounts(repo)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	require.Equal(t, "alice", accounts[0].Login)
+	require.Equal(t, entity.Id("a1"), accounts[0].Identity)
+	require.Equal(t, "bob.smith", accounts[1].Login)
+
+	la, err := NewLocalAccounts(repo)
+	require.NoError(t, err)
+
+	request := func(login, password string) *http.Request {
+		r := httptest.NewRequest("GET", "/", nil)
+		if login != "" {
+			r.SetBasicAuth(login, password)
+		}
+		return r
+	}
+
+	id, err := la.Authenticate(request("alice", "secret"))
+	require.NoError(t, err)
+	require.Equal(t, entity.Id("a1"), id)
+
+	// verified before
+	id, err = la.Authenticate(request("alice", "secret"))
+	require.NoError(t, err)
+	require.Equal(t, entity.Id("a1"), id)
+
+	// the digest kept depends on the stored hash and on the process
+	digest := sha256.Sum256([]byte("secret"))
+	require.NotEqual(t, digest[:], la.verified["alice"])
+	la2, err := NewLocalAccounts(repo)
+	require.NoError(t, err)
+	require.NotEqual(t, la.digest(la.accounts["alice"], "secret"), la2.digest(la2.accounts["alice"], "secret"))
+	require.NotEqual(t, la.digest(la.accounts["alice"], "secret"), la.digest(la.accounts["bob.smith"], "secret"))
+
+	_, err = la.Authenticate(request("alice", "password"))
+	require.Equal(t, ErrInvalidCredentials, err)
+	_, err = la.Authenticate(request("dave", "secret"))
+	require.Equal(t, ErrInvalidCredentials, err)
+	_, err = la.Authenticate(request("", ""))
+	require.Equal(t, ErrNoCredentials, err)
+
+	require.NoError(t, RemoveAccount(repo, "alice"))
+	require.Error(t, RemoveAccount(repo, "alice"))
+
+	accounts, err = ReadAccounts(repo)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+}
+
+func TestHeaderAuth(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentityFull("René Descartes", "rene@descartes.fr", "rene", "")
+	require.NoError(t, err)
+	isaac, err := backend.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+
+	ha := NewHeaderAuth(backend, DefaultHeader)
+
+	var seen entity.Id
+	handler := Middleware(ha, http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		seen, _ = IdentityFromContext(r.Context())
+	}))
+
+	serve := func(user string) int {
+		r := httptest.NewRequest("GET", "/", nil)
+		if user != "" {
+			r.Header.Set(DefaultHeader, user)
+		}
+		rw := httptest.NewRecorder()
+		seen = ""
+		handler.ServeHTTP(rw, r)
+		return rw.Code
+	}
+
+	require.Equal(t, http.StatusOK, serve("rene"))
+	require.Equal(t, rene.Id(), seen)
+
+	require.Equal(t, http.StatusOK, serve("Isaac@Newton.uk"))
+	require.Equal(t, isaac.Id(), seen)
+
+	require.Equal(t, http.StatusUnauthorized, serve("nobody"))
+	require.Equal(t, http.StatusUnauthorized, serve(""))
+	require.Equal(t, entity.Id(""), seen)
+}
+
+func TestTokens(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+
+	read, err := CreateToken(repo, "ci", "a1", ScopeRead)
+	require.NoError(t, err)
+	write, err := CreateToken(repo, "bot", "b2", ScopeWrite)
+	require.NoError(t, err)
+	require.NotEqual(t, read, write)
+
+	_, err = CreateToken(repo, "ci", "a1", ScopeRead)
+	require.Error(t, err)
+	_, err = CreateToken(repo, "admin", "a1", "all")
+	require.Error(t, err)
+
+	tokens, err := ReadTokens(repo)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	require.Equal(t, "bot", tokens[0].Name)
+	require.Equal(t, ScopeWrite, tokens[0].Scope)
+	require.Equal(t, entity.Id("a1"), tokens[1].Identity)
+
+	tt, err := NewTokens(repo)
+	require.NoError(t, err)
+
+	var seen entity.Id
+	var readOnly bool
+	next := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		seen, _ = IdentityFromContext(r.Context())
+		readOnly = IsReadOnly(r.Context())
+	})
+	other := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		http.Error(rw, "other authentication", http.StatusTeapot)
+	})
+	handler := tt.Middleware(other, next)
+
+	serve := func(token string) int {
+		r := httptest.NewRequest("POST", "/graphql", nil)
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		rw := httptest.NewRecorder()
+		seen = ""
+		handler.ServeHTTP(rw, r)
+		return rw.Code
+	}
+
+	require.Equal(t, http.StatusOK, serve(read))
+	require.Equal(t, entity.Id("a1"), seen)
+	require.True(t, readOnly)
+
+	require.Equal(t, http.StatusOK, serve(write))
+	require.Equal(t, entity.Id("b2"), seen)
+	require.False(t, readOnly)
+
+	require.Equal(t, http.StatusUnauthorized, serve("gbt_invalid"))
+	require.Equal(t, http.StatusTeapot, serve(""))
+
+	require.NoError(t, RevokeToken(repo, "ci"))
+	require.Error(t, RevokeToken(repo, "ci"))
+
+	// the revoked token is rejected by the running middleware
+	require.Equal(t, http.StatusUnauthorized, serve(read))
+	require.Equal(t, http.StatusOK, serve(write))
+
+	// the tokens revoked by another process are read again after a while
+	now := time.Now()
+	tt.now = func() time.Time { return now }
+	require.Equal(t, http.StatusOK, serve(write))
+	for _, setting := range []string{tokenConfigHash, tokenConfigIdentity, tokenConfigScope} {
+		require.NoError(t, repo.LocalConfig().RemoveAll(tokenConfigKey("bot", setting)))
+	}
+	require.Equal(t, http.StatusOK, serve(write))
+	require.True(t, tt.Known(write))
+	require.False(t, tt.Known("gbt_invalid"))
+	now = now.Add(tokensCacheDuration + time.Second)
+	require.Equal(t, http.StatusUnauthorized, serve(write))
+	require.False(t, tt.Known(write))
+
+	tokens, err = ReadTokens(repo)
+	require.NoError(t, err)
+	require.Empty(t, tokens)
+}
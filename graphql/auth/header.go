@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// DefaultHeader is the header set by most authenticating reverse proxies
+const DefaultHeader = "X-Forwarded-User"
+
+var _ Authenticator = &HeaderAuth{}
+
+// HeaderAuth trust a header set by an authenticating reverse proxy, holding
+// the login or the email of the user. The header must not be reachable by
+// the clients: the server has to be exposed only through the proxy.
+type HeaderAuth struct {
+	repo   *cache.RepoCache
+	header string
+}
+
+func NewHeaderAuth(repo *cache.RepoCache, header string) *HeaderAuth {
+	return &HeaderAuth{
+		repo:   repo,
+		header: header,
+	}
+}
+
+// Authenticate match the value of the header with the login or the email of
+// an identity
+func (ha *HeaderAuth) Authenticate(r *http.Request) (entity.Id, error) {
+	user := strings.TrimSpace(r.Header.Get(ha.header))
+	if user == "" {
+		return "", ErrNoCredentials
+	}
+
+	return matchIdentity(ha.repo, user)
+}
+
+// matchIdentity find the identity with a login or an email, ignoring the
+// case of the email
+func matchIdentity(repo *cache.RepoCache, user string) (entity.Id, error) {
+	var matching []entity.Id
+
+	for _, id := range repo.AllIdentityIds() {
+		excerpt, err := repo.ResolveIdentityExcerpt(id)
+		if err != nil {
+			return "", err
+		}
+
+		if excerpt.Login == user || strings.EqualFold(excerpt.Email, user) {
+			matching = append(matching, id)
+		}
+	}
+
+	switch len(matching) {
+	case 0:
+		return "", ErrInvalidCredentials
+	case 1:
+		return matching[0], nil
+	default:
+		return "", fmt.Errorf("multiple identities match the user %s", user)
+	}
+}
+
+// Challenge do nothing, the proxy is in charge of the authentication
+func (ha *HeaderAuth) Challenge(rw http.ResponseWriter) {}
@@ -0,0 +1,445 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// OIDCPathPrefix is where the login, the callback and the logout of the
+// OpenID Connect authentication are served, outside of the authentication
+const OIDCPathPrefix = "/auth/oidc/"
+
+const (
+	oidcLoginPath    = OIDCPathPrefix + "login"
+	oidcCallbackPath = OIDCPathPrefix + "callback"
+	oidcLogoutPath   = OIDCPathPrefix + "logout"
+)
+
+const oidcIdentityConfigKeyPrefix = "git-bug.webui.oidc.identity."
+
+const (
+	sessionCookie = "git-bug-session"
+	loginCookie   = "git-bug-login"
+)
+
+// how long a user stay logged in, and how long they have to log in with
+// the provider
+const (
+	sessionDuration = 24 * time.Hour
+	loginDuration   = 10 * time.Minute
+)
+
+var _ Authenticator = &OIDC{}
+var _ LoginRedirector = &OIDC{}
+
+// OIDCConfig is the configuration of the client registered with an OpenID
+// Connect provider
+type OIDCConfig struct {
+	// the url of the provider, serving /.well-known/openid-configuration
+	Issuer       string
+	ClientId     string
+	ClientSecret string
+	// the public url of the web UI, to build the redirect url registered
+	// with the provider: <BaseURL>/auth/oidc/callback
+	BaseURL string
+}
+
+// OIDC authenticate the users with an OpenID Connect provider, like the
+// single sign-on of an organization. The browsers are sent to the provider to
+// log in, and come back with a session cookie.
+//
+// The user is the identity mapped to the subject of the ID token in the git
+// config, as git-bug.webui.oidc.identity.<subject> = <id of the identity>, or
+// the one with the verified email. The preferred username is chosen by the
+// user and never verified, it's not used.
+type OIDC struct {
+	repo     *cache.RepoCache
+	issuer   string
+	oauth    oauth2.Config
+	jwksURL  string
+	host     string
+	secure   bool
+	mapping  map[string]entity.Id
+	client   *http.Client
+	signing  []byte
+	nowFunc  func() time.Time
+	keysLock sync.Mutex
+	keys     map[string]crypto.PublicKey
+}
+
+// NewOIDC discover the endpoints of the provider, and read the identity
+// mapping from the repository config
+func NewOIDC(repo *cache.RepoCache, config OIDCConfig) (*OIDC, error) {
+	switch {
+	case config.Issuer == "":
+		return nil, fmt.Errorf("missing the OpenID Connect issuer")
+	case config.ClientId == "":
+		return nil, fmt.Errorf("missing the OpenID Connect client id")
+	case config.BaseURL == "":
+		return nil, fmt.Errorf("missing the public url of the web UI")
+	}
+
+	base, err := url.Parse(config.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping, err := readOIDCMapping(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	// the sessions don't survive a restart
+	signing := make([]byte, 32)
+	if _, err := rand.Read(signing); err != nil {
+		return nil, err
+	}
+
+	o := &OIDC{
+		repo:    repo,
+		issuer:  strings.TrimSuffix(config.Issuer, "/"),
+		host:    base.Host,
+		secure:  base.Scheme == "https",
+		mapping: mapping,
+		client:  &http.Client{Timeout: 30 * time.Second},
+		signing: signing,
+		nowFunc: time.Now,
+	}
+
+	var discovery struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		JwksURI               string `json:"jwks_uri"`
+	}
+
+	err = o.getJSON(o.issuer+"/.well-known/openid-configuration", &discovery)
+	if err != nil {
+		return nil, fmt.Errorf("OpenID Connect discovery: %v", err)
+	}
+	if discovery.Issuer != o.issuer {
+		return nil, fmt.Errorf("OpenID Connect discovery: the issuer is %s instead of %s", discovery.Issuer, o.issuer)
+	}
+
+	o.jwksURL = discovery.JwksURI
+	o.oauth = oauth2.Config{
+		ClientID:     config.ClientId,
+		ClientSecret: config.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  discovery.AuthorizationEndpoint,
+			TokenURL: discovery.TokenEndpoint,
+		},
+		RedirectURL: strings.TrimSuffix(config.BaseURL, "/") + oidcCallbackPath,
+		Scopes:      []string{"openid", "email", "profile"},
+	}
+
+	return o, nil
+}
+
+// readOIDCMapping read the identities mapped to the subjects of the provider
+func readOIDCMapping(repo *cache.RepoCache) (map[string]entity.Id, error) {
+	configs, err := repo.LocalConfig().ReadAll(oidcIdentityConfigKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping := make(map[string]entity.Id, len(configs))
+	for key, value := range configs {
+		mapping[strings.TrimPrefix(key, oidcIdentityConfigKeyPrefix)] = entity.Id(value)
+	}
+	return mapping, nil
+}
+
+// Authenticate check the session cookie set after the login
+func (o *OIDC) Authenticate(r *http.Request) (entity.Id, error) {
+	cookie, err := r.Cookie(sessionCookie)
+	if err != nil {
+		return "", ErrNoCredentials
+	}
+
+	// the browsers send the cookie with the requests of the other sites
+	if origin := r.Header.Get("Origin"); origin != "" {
+		u, err := url.Parse(origin)
+		if err != nil || u.Host != o.host {
+			return "", ErrInvalidCredentials
+		}
+	}
+
+	value, err := o.verify(cookie.Value)
+	if err != nil {
+		// expired or from a previous run, log in again
+		return "", ErrNoCredentials
+	}
+
+	return entity.Id(value), nil
+}
+
+// Challenge do nothing, the browsers are redirected to the login
+func (o *OIDC) Challenge(rw http.ResponseWriter) {}
+
+// LoginRedirect send a browser to the login with the provider, to come back
+// on the requested page
+func (o *OIDC) LoginRedirect(rw http.ResponseWriter, r *http.Request) {
+	target := oidcLoginPath + "?" + url.Values{"return": {r.URL.RequestURI()}}.Encode()
+	http.Redirect(rw, r, target, http.StatusFound)
+}
+
+// ServeHTTP serve the login, the callback and the logout under OIDCPathPrefix
+func (o *OIDC) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case oidcLoginPath:
+		o.login(rw, r)
+	case oidcCallbackPath:
+		o.callback(rw, r)
+	case oidcLogoutPath:
+		o.setCookie(rw, sessionCookie, "", -1)
+		http.Redirect(rw, r, "/", http.StatusFound)
+	default:
+		http.NotFound(rw, r)
+	}
+}
+
+// login redirect to the provider, remembering the state of the login in a
+// cookie to check the callback
+func (o *OIDC) login(rw http.ResponseWriter, r *http.Request) {
+	state, err := randomString()
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	nonce, err := randomString()
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	returnTo := r.URL.Query().Get("return")
+	if !localPath(returnTo) {
+		returnTo = "/"
+	}
+
+	value := o.sign(strings.Join([]string{state, nonce, returnTo}, " "), loginDuration)
+	o.setCookie(rw, loginCookie, value, loginDuration)
+
+	authURL := o.oauth.AuthCodeURL(state, oauth2.SetAuthURLParam("nonce", nonce))
+	http.Redirect(rw, r, authURL, http.StatusFound)
+}
+
+// callback check the answer of the provider and open the session of the user
+func (o *OIDC) callback(rw http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	if e := query.Get("error"); e != "" {
+		http.Error(rw, fmt.Sprintf("login failed: %s %s", e, query.Get("error_description")), http.StatusUnauthorized)
+		return
+	}
+
+	cookie, err := r.Cookie(loginCookie)
+	if err != nil {
+		http.Error(rw, "login expired, try again", http.StatusBadRequest)
+		return
+	}
+	login, err := o.verify(cookie.Value)
+	if err != nil {
+		http.Error(rw, "login expired, try again", http.StatusBadRequest)
+		return
+	}
+	fields := strings.SplitN(login, " ", 3)
+	if len(fields) != 3 || !hmac.Equal([]byte(fields[0]), []byte(query.Get("state"))) {
+		http.Error(rw, "invalid login state", http.StatusBadRequest)
+		return
+	}
+	nonce, returnTo := fields[1], fields[2]
+
+	ctx := context.WithValue(r.Context(), oauth2.HTTPClient, o.client)
+	token, err := o.oauth.Exchange(ctx, query.Get("code"))
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		http.Error(rw, "no ID token given by the provider", http.StatusUnauthorized)
+		return
+	}
+
+	claims, err := parseIDToken(rawIDToken, o.key)
+	if err == nil {
+		err = claims.validate(o.issuer, o.oauth.ClientID, nonce, o.nowFunc())
+	}
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	id, err := o.identity(claims)
+	if err == ErrInvalidCredentials {
+		http.Error(rw, fmt.Sprintf("no identity for the user %s", claims.Subject), http.StatusForbidden)
+		return
+	}
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	o.setCookie(rw, loginCookie, "", -1)
+	o.setCookie(rw, sessionCookie, o.sign(id.String(), sessionDuration), sessionDuration)
+	http.Redirect(rw, r, returnTo, http.StatusFound)
+}
+
+// identity find the identity of the user of an ID token: the one mapped to
+// its subject, or matching its verified email
+func (o *OIDC) identity(claims idTokenClaims) (entity.Id, error) {
+	if id, ok := o.mapping[claims.Subject]; ok {
+		identity, err := o.repo.ResolveIdentity(id)
+		if err != nil {
+			return "", err
+		}
+		return identity.Id(), nil
+	}
+
+	// an email is only trusted when the provider say it's verified, a missing
+	// claim could let a user take the email of someone else
+	if claims.Email != "" && claims.EmailVerified != nil && *claims.EmailVerified {
+		return matchIdentity(o.repo, claims.Email)
+	}
+
+	return "", ErrInvalidCredentials
+}
+
+// key return the public key of the provider with an id, fetching the keys
+// again when it's unknown as they are rotated
+func (o *OIDC) key(kid string) (crypto.PublicKey, error) {
+	o.keysLock.Lock()
+	defer o.keysLock.Unlock()
+
+	if key, ok := o.keys[kid]; ok {
+		return key, nil
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := o.getJSON(o.jwksURL, &jwks); err != nil {
+		return nil, err
+	}
+
+	o.keys = make(map[string]crypto.PublicKey)
+	for _, k := range jwks.Keys {
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, err
+		}
+		if key != nil {
+			o.keys[k.Kid] = key
+		}
+	}
+
+	key, ok := o.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown ID token key %s", kid)
+	}
+	return key, nil
+}
+
+func (o *OIDC) getJSON(url string, v interface{}) error {
+	resp, err := o.client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", url, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func (o *OIDC) setCookie(rw http.ResponseWriter, name string, value string, duration time.Duration) {
+	cookie := &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   o.secure,
+	}
+	if duration < 0 {
+		cookie.MaxAge = -1
+	} else {
+		cookie.MaxAge = int(duration / time.Second)
+	}
+	http.SetCookie(rw, cookie)
+}
+
+// sign encode a value valid for a duration, with a signature to detect
+// the tampering
+func (o *OIDC) sign(value string, duration time.Duration) string {
+	expiry := strconv.FormatInt(o.nowFunc().Add(duration).Unix(), 10)
+	payload := base64.RawURLEncoding.EncodeToString([]byte(expiry + " " + value))
+	return payload + "." + o.mac(payload)
+}
+
+// verify return the value encoded by sign, if not tampered with and not
+// expired
+func (o *OIDC) verify(signed string) (string, error) {
+	i := strings.LastIndex(signed, ".")
+	if i < 0 || !hmac.Equal([]byte(signed[i+1:]), []byte(o.mac(signed[:i]))) {
+		return "", fmt.Errorf("invalid signature")
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(signed[:i])
+	if err != nil {
+		return "", err
+	}
+
+	fields := strings.SplitN(string(data), " ", 2)
+	if len(fields) != 2 {
+		return "", fmt.Errorf("invalid value")
+	}
+
+	expiry, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil || o.nowFunc().Unix() > expiry {
+		return "", fmt.Errorf("expired")
+	}
+
+	return fields[1], nil
+}
+
+func (o *OIDC) mac(payload string) string {
+	mac := hmac.New(sha256.New, o.signing)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func randomString() (string, error) {
+	data := make([]byte, 16)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// localPath tell if a url is a path of the web UI, not to redirect to
+// another site after the login
+func localPath(path string) bool {
+	return strings.HasPrefix(path, "/") && !strings.HasPrefix(path, "//") && !strings.HasPrefix(path, "/\\")
+}
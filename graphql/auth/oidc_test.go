@@ -0,0 +1,264 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func encodeSegment(t *testing.T, v interface{}) string {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims interface{}) string {
+	signed := encodeSegment(t, map[string]string{"alg": "RS256", "kid": kid}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// fakeProvider is an OpenID Connect provider issuing an ID token with the
+// given claims for any code
+func fakeProvider(t *testing.T, key *rsa.PrivateKey, claims map[string]interface{}) *httptest.Server {
+	var srv *httptest.Server
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(rw http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(rw).Encode(map[string]string{
+			"issuer":                 srv.URL,
+			"authorization_endpoint": srv.URL + "/authorize",
+			"token_endpoint":         srv.URL + "/token",
+			"jwks_uri":               srv.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(rw http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(rw).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "k1",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(rw http.ResponseWriter, r *http.Request) {
+		claims["iss"] = srv.URL
+		rw.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(rw).Encode(map[string]interface{}{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"id_token":     signRS256(t, key, "k1", claims),
+		})
+	})
+
+	srv = httptest.NewServer(mux)
+	return srv
+}
+
+func TestOIDC(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	isaac, err := backend.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+	require.NoError(t, repo.LocalConfig().StoreString(oidcIdentityConfigKeyPrefix+"isaac-sub", isaac.Id().String()))
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	claims := map[string]interface{}{
+		"sub":            "rene-sub",
+		"aud":            "git-bug",
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"email":          "Rene@Descartes.fr",
+		"email_verified": true,
+	}
+	provider := fakeProvider(t, key, claims)
+	defer provider.Close()
+
+	o, err := NewOIDC(backend, OIDCConfig{
+		Issuer:       provider.URL,
+		ClientId:     "git-bug",
+		ClientSecret: "secret",
+		BaseURL:      "http://bugs.example.com",
+	})
+	require.NoError(t, err)
+
+	// login, back from the provider to the callback
+	login := func() (*httptest.ResponseRecorder, *http.Cookie) {
+		rw := httptest.NewRecorder()
+		o.ServeHTTP(rw, httptest.NewRequest("GET", "/auth/oidc/login?return=/bug/123", nil))
+		require.Equal(t, http.StatusFound, rw.Code)
+
+		authURL, err := url.Parse(rw.Header().Get("Location"))
+		require.NoError(t, err)
+		require.Equal(t, "http://bugs.example.com/auth/oidc/callback", authURL.Query().Get("redirect_uri"))
+		claims["nonce"] = authURL.Query().Get("nonce")
+
+		r := httptest.NewRequest("GET", "/auth/oidc/callback?code=abc&state="+authURL.Query().Get("state"), nil)
+		r.AddCookie(rw.Result().Cookies()[0])
+		rw = httptest.NewRecorder()
+		o.ServeHTTP(rw, r)
+
+		for _, c := range rw.Result().Cookies() {
+			if c.Name == sessionCookie {
+				return rw, c
+			}
+		}
+		return rw, nil
+	}
+
+	authenticate := func(session *http.Cookie, origin string) (entity.Id, error) {
+		r := httptest.NewRequest("POST", "/graphql", nil)
+		if session != nil {
+			r.AddCookie(session)
+		}
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		return o.Authenticate(r)
+	}
+
+	// matched by email
+	rw, session := login()
+	require.Equal(t, http.StatusFound, rw.Code, rw.Body.String())
+	require.Equal(t, "/bug/123", rw.Header().Get("Location"))
+
+	id, err := authenticate(session, "http://bugs.example.com")
+	require.NoError(t, err)
+	require.Equal(t, rene.Id(), id)
+
+	_, err = authenticate(session, "http://evil.example.com")
+	require.Equal(t, ErrInvalidCredentials, err)
+	_, err = authenticate(nil, "")
+	require.Equal(t, ErrNoCredentials, err)
+
+	session.Value = "x" + session.Value
+	_, err = authenticate(session, "")
+	require.Equal(t, ErrNoCredentials, err)
+
+	// mapped by subject
+	claims["sub"] = "isaac-sub"
+	_, session = login()
+	id, err = authenticate(session, "")
+	require.NoError(t, err)
+	require.Equal(t, isaac.Id(), id)
+
+	// unknown user
+	claims["sub"] = "nobody"
+	claims["email"] = "nobody@example.com"
+	rw, session = login()
+	require.Equal(t, http.StatusForbidden, rw.Code)
+	require.Nil(t, session)
+
+	// an email not verified, or not said to be, is not matched
+	claims["email"] = "rene@descartes.fr"
+	claims["email_verified"] = false
+	rw, session = login()
+	require.Equal(t, http.StatusForbidden, rw.Code)
+	require.Nil(t, session)
+
+	delete(claims, "email_verified")
+	rw, session = login()
+	require.Equal(t, http.StatusForbidden, rw.Code)
+	require.Nil(t, session)
+
+	// the username is chosen by the user, and not trusted as email or login
+	delete(claims, "email")
+	claims["preferred_username"] = "rene@descartes.fr"
+	rw, session = login()
+	require.Equal(t, http.StatusForbidden, rw.Code)
+	require.Nil(t, session)
+	delete(claims, "preferred_username")
+
+	// the ID token of another client
+	claims["sub"] = "rene-sub"
+	claims["email"] = "rene@descartes.fr"
+	claims["aud"] = []string{"other"}
+	rw, session = login()
+	require.Equal(t, http.StatusUnauthorized, rw.Code)
+	require.Nil(t, session)
+
+	// the browsers are sent to the login, the other clients rejected
+	handler := Middleware(o, http.NotFoundHandler())
+
+	r := httptest.NewRequest("GET", "/bug/123", nil)
+	r.Header.Set("Accept", "text/html,application/xhtml+xml")
+	rw = httptest.NewRecorder()
+	handler.ServeHTTP(rw, r)
+	require.Equal(t, http.StatusFound, rw.Code)
+	require.Equal(t, "/auth/oidc/login?return=%2Fbug%2F123", rw.Header().Get("Location"))
+
+	rw = httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest("POST", "/graphql", nil))
+	require.Equal(t, http.StatusUnauthorized, rw.Code)
+}
+
+func TestIDToken(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	now := time.Now()
+	claims := map[string]interface{}{
+		"iss":   "https://sso.example.com",
+		"sub":   "rene",
+		"aud":   []string{"git-bug", "other"},
+		"exp":   now.Add(time.Hour).Unix(),
+		"nonce": "n1",
+	}
+
+	signed := encodeSegment(t, map[string]string{"alg": "ES256", "kid": "k2"}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(signed))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+	signature := make([]byte, 64)
+	rb, sb := r.Bytes(), s.Bytes()
+	copy(signature[32-len(rb):32], rb)
+	copy(signature[64-len(sb):], sb)
+	token := signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+
+	keys := func(kid string) (crypto.PublicKey, error) {
+		require.Equal(t, "k2", kid)
+		return &key.PublicKey, nil
+	}
+
+	parsed, err := parseIDToken(token, keys)
+	require.NoError(t, err)
+	require.Equal(t, "rene", parsed.Subject)
+
+	require.NoError(t, parsed.validate("https://sso.example.com", "git-bug", "n1", now))
+	require.Error(t, parsed.validate("https://other.example.com", "git-bug", "n1", now))
+	require.Error(t, parsed.validate("https://sso.example.com", "git-bug2", "n1", now))
+	require.Error(t, parsed.validate("https://sso.example.com", "git-bug", "n2", now))
+	require.Error(t, parsed.validate("https://sso.example.com", "git-bug", "n1", now.Add(2*time.Hour)))
+
+	// tampered
+	_, err = parseIDToken(token[:len(token)-4]+"AAAA", keys)
+	require.Error(t, err)
+}
@@ -0,0 +1,293 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const tokenConfigKeyPrefix = "git-bug.webui.token."
+
+const (
+	tokenConfigHash     = "hash"
+	tokenConfigIdentity = "identity"
+	tokenConfigScope    = "scope"
+)
+
+// the scopes of the API tokens
+const (
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+)
+
+// the prefix of the tokens, to recognize them in the secrets of a CI
+const tokenPrefix = "gbt_"
+
+// Token is an API token of the web UI, stored in the git config:
+//   git-bug.webui.token.<name>.hash = <SHA-256 of the token>
+//   git-bug.webui.token.<name>.identity = <id of the identity>
+//   git-bug.webui.token.<name>.scope = read or write
+type Token struct {
+	Name     string
+	Identity entity.Id
+	Scope    string
+	hash     string
+}
+
+// ReadTokens read the API tokens from the repository config, sorted by name
+func ReadTokens(repo repository.RepoCommon) ([]Token, error) {
+	configs, err := repo.LocalConfig().ReadAll(tokenConfigKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make(map[string]*Token)
+
+	for key, value := range configs {
+		key = strings.TrimPrefix(key, tokenConfigKeyPrefix)
+		i := strings.LastIndex(key, ".")
+		if i <= 0 {
+			continue
+		}
+		name, setting := key[:i], key[i+1:]
+
+		token, ok := tokens[name]
+		if !ok {
+			token = &Token{Name: name}
+			tokens[name] = token
+		}
+
+		switch setting {
+		case tokenConfigHash:
+			token.hash = value
+		case tokenConfigIdentity:
+			token.Identity = entity.Id(value)
+		case tokenConfigScope:
+			token.Scope = value
+		}
+	}
+
+	result := make([]Token, 0, len(tokens))
+	for _, token := range tokens {
+		if token.hash == "" || token.Identity == "" || ValidateScope(token.Scope) != nil {
+			return nil, fmt.Errorf("token %s is incomplete", token.Name)
+		}
+		result = append(result, *token)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// ValidateScope check the scope of an API token
+func ValidateScope(scope string) error {
+	if scope != ScopeRead && scope != ScopeWrite {
+		return fmt.Errorf("unknown scope %s, expected %s or %s", scope, ScopeRead, ScopeWrite)
+	}
+	return nil
+}
+
+// CreateToken issue a new API token acting as an identity, and return it. Only
+// its hash is stored, it can't be read again.
+func CreateToken(repo repository.RepoCommon, name string, identity entity.Id, scope string) (string, error) {
+	if err := ValidateLogin(name); err != nil {
+		return "", err
+	}
+	if err := ValidateScope(scope); err != nil {
+		return "", err
+	}
+
+	tokens, err := ReadTokens(repo)
+	if err != nil {
+		return "", err
+	}
+	for _, token := range tokens {
+		if token.Name == name {
+			return "", fmt.Errorf("the token %s already exist", name)
+		}
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	value := tokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	config := repo.LocalConfig()
+
+	err = config.StoreString(tokenConfigKey(name, tokenConfigHash), hashToken(value))
+	if err != nil {
+		return "", err
+	}
+	err = config.StoreString(tokenConfigKey(name, tokenConfigIdentity), identity.String())
+	if err != nil {
+		return "", err
+	}
+	err = config.StoreString(tokenConfigKey(name, tokenConfigScope), scope)
+	if err != nil {
+		return "", err
+	}
+
+	atomic.AddUint64(&tokensGeneration, 1)
+
+	return value, nil
+}
+
+// RevokeToken delete an API token
+func RevokeToken(repo repository.RepoCommon, name string) error {
+	tokens, err := ReadTokens(repo)
+	if err != nil {
+		return err
+	}
+
+	for _, token := range tokens {
+		if token.Name == name {
+			config := repo.LocalConfig()
+			defer atomic.AddUint64(&tokensGeneration, 1)
+			for _, setting := range []string{tokenConfigHash, tokenConfigIdentity, tokenConfigScope} {
+				err := config.RemoveAll(tokenConfigKey(name, setting))
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown token %s", name)
+}
+
+func tokenConfigKey(name string, setting string) string {
+	return tokenConfigKeyPrefix + name + "." + setting
+}
+
+// the tokens are long random values, a simple hash is enough to not store
+// them in clear
+func hashToken(value string) string {
+	hash := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(hash[:])
+}
+
+// how long the tokens read from the config are trusted, for the tokens
+// created or revoked by another process
+const tokensCacheDuration = 5 * time.Second
+
+// incremented when the tokens are changed in this process, to read them again
+// right away
+var tokensGeneration uint64
+
+// Tokens check the API tokens given as "Authorization: Bearer <token>". The
+// tokens read from the config are kept for a few seconds, and read again
+// right away when created or revoked by this process.
+type Tokens struct {
+	repo repository.RepoCommon
+	now  func() time.Time
+
+	mu         sync.Mutex
+	byHash     map[string]Token
+	readAt     time.Time
+	generation uint64
+}
+
+func NewTokens(repo repository.RepoCommon) (*Tokens, error) {
+	t := &Tokens{repo: repo, now: time.Now}
+
+	// fail early on an invalid config
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err := t.read(); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// read the tokens from the config, the lock being held
+func (t *Tokens) read() error {
+	generation := atomic.LoadUint64(&tokensGeneration)
+
+	tokens, err := ReadTokens(t.repo)
+	if err != nil {
+		return err
+	}
+
+	t.byHash = make(map[string]Token, len(tokens))
+	for _, token := range tokens {
+		t.byHash[token.hash] = token
+	}
+	t.readAt = t.now()
+	t.generation = generation
+
+	return nil
+}
+
+// lookup find the token matching a value given by a client
+func (t *Tokens) lookup(value string) (Token, bool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.now().Sub(t.readAt) > tokensCacheDuration || t.generation != atomic.LoadUint64(&tokensGeneration) {
+		if err := t.read(); err != nil {
+			return Token{}, false, err
+		}
+	}
+
+	token, ok := t.byHash[hashToken(value)]
+	return token, ok, nil
+}
+
+// Known tell if a value given by a client is a valid token, from the tokens
+// already read. It never read the config, for the invalid tokens to be cheap
+// to reject.
+func (t *Tokens) Known(value string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	_, ok := t.byHash[hashToken(value)]
+	return ok
+}
+
+// Middleware serve the requests with a valid token as its identity, read-only
+// with the read scope, and reject the ones with an invalid token. The
+// requests without token are passed to the other authentication.
+func (t *Tokens) Middleware(authenticated http.Handler, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		if !strings.HasPrefix(header, "Bearer ") {
+			authenticated.ServeHTTP(rw, r)
+			return
+		}
+
+		token, ok, err := t.lookup(strings.TrimSpace(strings.TrimPrefix(header, "Bearer ")))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if !ok {
+			rw.Header().Set("WWW-Authenticate", `Bearer realm="git-bug", error="invalid_token"`)
+			http.Error(rw, ErrInvalidCredentials.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		ctx := ContextWithIdentity(r.Context(), token.Identity)
+		if token.Scope == ScopeRead {
+			ctx = context.WithValue(ctx, readOnlyKey, true)
+		}
+		next.ServeHTTP(rw, r.WithContext(ctx))
+	})
+}
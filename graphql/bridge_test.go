@@ -0,0 +1,209 @@
+package graphql
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlgen/client"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/graphql/resolvers"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// fakeBridge import a bug and an identity, once released
+type fakeBridge struct{}
+
+// created for each run of the test
+var fakeBridgeImporting chan struct{}
+var fakeBridgeRelease chan struct{}
+
+func (fakeBridge) Target() string { return "fake" }
+
+func (fakeBridge) Configure(repo repository.RepoCommon, params core.BridgeParams) (core.Configuration, error) {
+	return core.Configuration{}, nil
+}
+
+func (fakeBridge) ValidateConfig(conf core.Configuration) error { return nil }
+
+func (fakeBridge) NewImporter() core.Importer { return &fakeImporter{} }
+
+func (fakeBridge) NewExporter() core.Exporter { return nil }
+
+type fakeImporter struct{}
+
+func (*fakeImporter) Init(conf core.Configuration) error { return nil }
+
+func (*fakeImporter) ImportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan core.ImportResult, error) {
+	close(fakeBridgeImporting)
+
+	out := make(chan core.ImportResult)
+	go func() {
+		defer close(out)
+		<-fakeBridgeRelease
+
+		i, err := repo.NewIdentity("René Descartes", "rene@descartes.fr")
+		if err != nil {
+			out <- core.NewImportError(err, "")
+			return
+		}
+		out <- core.NewImportIdentity(i.Id())
+
+		b, _, err := repo.NewBugRaw(i, time.Now().Unix(), "imported", "message", nil, nil)
+		if err != nil {
+			out <- core.NewImportError(err, "")
+			return
+		}
+		out <- core.NewImportBug(b.Id())
+		out <- core.NewImportNothing(b.Id(), "already imported")
+	}()
+	return out, nil
+}
+
+func TestBridges(t *testing.T) {
+	core.Register(fakeBridge{})
+
+	fakeBridgeImporting = make(chan struct{})
+	fakeBridgeRelease = make(chan struct{})
+
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	token := core.NewToken("secret", "fake")
+	require.NoError(t, core.StoreToken(repo, token))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.bridge.upstream.target", "fake"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.bridge.upstream.token-id", token.ID().String()))
+
+	handler, err := NewHandler(repo)
+	require.NoError(t, err)
+	defer handler.Close()
+
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	c := client.New(srv.URL)
+
+	type bridgeSync struct {
+		Id         string
+		Status     string
+		Bugs       int
+		Identities int
+		Errors     []string
+	}
+
+	var pulled struct {
+		PullBridge struct{ Sync bridgeSync }
+	}
+	c.MustPost(`mutation { pullBridge(input: {}) { sync { id status bugs identities errors } } }`, &pulled)
+	require.Equal(t, "RUNNING", pulled.PullBridge.Sync.Status)
+
+	// the requests are served during the import, but the mutations are
+	// refused
+	<-fakeBridgeImporting
+	var count struct {
+		DefaultRepository struct {
+			AllBugs struct{ TotalCount int }
+		}
+	}
+	c.MustPost(`query { defaultRepository { allBugs { totalCount } } }`, &count)
+	require.Equal(t, 0, count.DefaultRepository.AllBugs.TotalCount)
+
+	ws := c.Websocket(`query { defaultRepository { allBugs { totalCount } } }`)
+	err = ws.Next(&count)
+	for err != nil && strings.Contains(err.Error(), `Type:"ka"`) {
+		err = ws.Next(&count)
+	}
+	require.NoError(t, err)
+	require.Equal(t, 0, count.DefaultRepository.AllBugs.TotalCount)
+	require.NoError(t, ws.Close())
+
+	err = c.Post(`mutation { newBug(input: {title: "title", message: "message"}) { bug { id } } }`, &struct{}{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), resolvers.ErrImporting.Error())
+
+	sub := c.Websocket(`subscription($id: String!) {
+		bridgeSync(id: $id) { message error sync { id status bugs identities errors } }
+	}`, client.Var("id", pulled.PullBridge.Sync.Id))
+	defer sub.Close()
+
+	close(fakeBridgeRelease)
+
+	// the progress until the end
+	var last bridgeSync
+	for last.Status != "SUCCESS" {
+		var event struct {
+			BridgeSync struct {
+				Message string
+				Error   bool
+				Sync    bridgeSync
+			}
+		}
+		err := sub.Next(&event)
+		if err != nil && strings.Contains(err.Error(), `Type:"ka"`) {
+			// the keep-alive messages are not handled by the client
+			continue
+		}
+		require.NoError(t, err)
+		require.False(t, event.BridgeSync.Error, event.BridgeSync.Message)
+		last = event.BridgeSync.Sync
+		require.NotEqual(t, "FAILURE", last.Status)
+	}
+
+	require.Equal(t, bridgeSync{
+		Id:         pulled.PullBridge.Sync.Id,
+		Status:     "SUCCESS",
+		Bugs:       1,
+		Identities: 1,
+		Errors:     []string{},
+	}, last)
+
+	var resp struct {
+		DefaultRepository struct {
+			Bridges []struct {
+				Name       string
+				Target     string
+				LastImport *string
+				LastSync   *bridgeSync
+			}
+			AllBugs struct{ TotalCount int }
+		}
+	}
+	c.MustPost(`query { defaultRepository {
+		bridges { name target lastImport lastSync { id status bugs identities errors } }
+		allBugs { totalCount }
+	} }`, &resp)
+
+	require.Len(t, resp.DefaultRepository.Bridges, 1)
+	require.Equal(t, "upstream", resp.DefaultRepository.Bridges[0].Name)
+	require.Equal(t, "fake", resp.DefaultRepository.Bridges[0].Target)
+	require.NotNil(t, resp.DefaultRepository.Bridges[0].LastImport)
+	require.Equal(t, &last, resp.DefaultRepository.Bridges[0].LastSync)
+	require.Equal(t, 1, resp.DefaultRepository.AllBugs.TotalCount)
+
+	// the export is not supported
+	var pushed struct {
+		PushBridge struct{ Sync bridgeSync }
+	}
+	c.MustPost(`mutation { pushBridge(input: {name: "upstream"}) { sync { id status bugs identities errors } } }`, &pushed)
+	for deadline := time.Now().Add(5 * time.Second); ; {
+		var resp struct {
+			DefaultRepository struct {
+				Bridges []struct{ LastSync bridgeSync }
+			}
+		}
+		c.MustPost(`query { defaultRepository { bridges { lastSync { status errors } } } }`, &resp)
+		if resp.DefaultRepository.Bridges[0].LastSync.Status == "FAILURE" {
+			require.Equal(t, []string{core.ErrExportNotSupported.Error()}, resp.DefaultRepository.Bridges[0].LastSync.Errors)
+			break
+		}
+		require.True(t, time.Now().Before(deadline), "the push didn't fail")
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	err = c.Post(`mutation { pullBridge(input: {name: "unknown"}) { sync { id } } }`, &struct{}{})
+	require.Error(t, err)
+}
@@ -43,3 +43,22 @@ func CursorToOffset(cursor string) (int, error) {
 	}
 	return offset, nil
 }
+
+// cursorIndex find the index of the element of a cursor, given the edges of
+// the elements. The cursors are usually made from the offsets, the element is
+// then found without going through all of them.
+func cursorIndex(cursor string, length int, edgeAt func(i int) Edge) (int, bool) {
+	if offset, err := CursorToOffset(cursor); err == nil && offset >= 0 && offset < length {
+		if edgeAt(offset).GetCursor() == cursor {
+			return offset, true
+		}
+	}
+
+	for i := 0; i < length; i++ {
+		if edgeAt(i).GetCursor() == cursor {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
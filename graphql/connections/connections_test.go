@@ -0,0 +1,65 @@
+package connections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/graphql/models"
+)
+
+func TestLabelCon(t *testing.T) {
+	source := []bug.Label{"a", "b", "c", "d", "e"}
+
+	edger := func(label bug.Label, offset int) Edge {
+		return models.LabelEdge{Node: label, Cursor: OffsetToCursor(offset)}
+	}
+	conMaker := func(edges []*models.LabelEdge, nodes []bug.Label, info *models.PageInfo, totalCount int) (*models.LabelConnection, error) {
+		return &models.LabelConnection{Edges: edges, Nodes: nodes, PageInfo: info, TotalCount: totalCount}, nil
+	}
+
+	intPtr := func(i int) *int { return &i }
+	cursor := func(offset int) *string {
+		c := OffsetToCursor(offset)
+		return &c
+	}
+
+	tests := []struct {
+		name            string
+		input           models.ConnectionInput
+		nodes           []bug.Label
+		hasPreviousPage bool
+		hasNextPage     bool
+	}{
+		{"all", models.ConnectionInput{}, source, false, false},
+		{"first", models.ConnectionInput{First: intPtr(2)}, []bug.Label{"a", "b"}, false, true},
+		{"first after", models.ConnectionInput{First: intPtr(2), After: cursor(1)}, []bug.Label{"c", "d"}, true, true},
+		{"first after to the end", models.ConnectionInput{First: intPtr(5), After: cursor(2)}, []bug.Label{"d", "e"}, true, false},
+		{"last", models.ConnectionInput{Last: intPtr(2)}, []bug.Label{"d", "e"}, true, false},
+		{"last before", models.ConnectionInput{Last: intPtr(2), Before: cursor(3)}, []bug.Label{"b", "c"}, true, true},
+		{"last before to the start", models.ConnectionInput{Last: intPtr(5), Before: cursor(2)}, []bug.Label{"a", "b"}, false, true},
+		{"after before", models.ConnectionInput{After: cursor(0), Before: cursor(4)}, []bug.Label{"b", "c", "d"}, true, true},
+		{"unknown cursor", models.ConnectionInput{After: cursor(12)}, source, false, false},
+		{"empty", models.ConnectionInput{First: intPtr(0)}, []bug.Label{}, false, true},
+	}
+
+	for _, tt := range tests {
+		con, err := LabelCon(source, edger, conMaker, tt.input)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.nodes, con.Nodes, tt.name)
+		require.Len(t, con.Edges, len(tt.nodes), tt.name)
+		require.Equal(t, 5, con.TotalCount, tt.name)
+		require.Equal(t, tt.hasPreviousPage, con.PageInfo.HasPreviousPage, tt.name)
+		require.Equal(t, tt.hasNextPage, con.PageInfo.HasNextPage, tt.name)
+
+		if len(tt.nodes) > 0 {
+			require.Equal(t, con.Edges[0].Cursor, con.PageInfo.StartCursor, tt.name)
+			require.Equal(t, con.Edges[len(con.Edges)-1].Cursor, con.PageInfo.EndCursor, tt.name)
+			require.Equal(t, tt.nodes[0], con.Edges[0].Node, tt.name)
+		}
+	}
+
+	_, err := LabelCon(source, edger, conMaker, models.ConnectionInput{Last: intPtr(-1)})
+	require.Error(t, err)
+}
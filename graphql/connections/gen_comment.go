@@ -22,7 +22,9 @@ type CommentConMaker func(
 	info *models.PageInfo,
 	totalCount int) (*models.CommentConnection, error)
 
-// CommentCon will paginate a source according to the input of a relay connection
+// CommentCon will paginate a source according to the input of a relay connection.
+// Only the edges of the page are created, and the page info tells if there are
+// elements on either side of the page, whichever direction is paginated.
 func CommentCon(source []bug.Comment, edgeMaker CommentEdgeMaker, conMaker CommentConMaker, input models.ConnectionInput) (*models.CommentConnection, error) {
 	var nodes []bug.Comment
 	var edges []*models.CommentEdge
@@ -32,75 +34,52 @@ func CommentCon(source []bug.Comment, edgeMaker CommentEdgeMaker, conMaker Comme
 
 	emptyCon, _ := conMaker(edges, nodes, pageInfo, 0)
 
-	offset := 0
+	if input.First != nil && *input.First < 0 {
+		return emptyCon, fmt.Errorf("first less than zero")
+	}
+	if input.Last != nil && *input.Last < 0 {
+		return emptyCon, fmt.Errorf("last less than zero")
+	}
+
+	// the page is source[start:end]
+	start, end := 0, len(source)
 
 	if input.After != nil {
-		for i, value := range source {
-			edge := edgeMaker(value, i)
-			if edge.GetCursor() == *input.After {
-				// remove all previous element including the "after" one
-				source = source[i+1:]
-				offset = i + 1
-				pageInfo.HasPreviousPage = true
-				break
-			}
+		if i, ok := cursorIndex(*input.After, len(source), func(i int) Edge { return edgeMaker(source[i], i) }); ok {
+			// remove all previous element including the "after" one
+			start = i + 1
 		}
 	}
 
 	if input.Before != nil {
-		for i, value := range source {
-			edge := edgeMaker(value, i+offset)
-
-			if edge.GetCursor() == *input.Before {
-				// remove all after element including the "before" one
-				pageInfo.HasNextPage = true
-				break
-			}
-
-			e := edge.(models.CommentEdge)
-			edges = append(edges, &e)
-			cursors = append(cursors, edge.GetCursor())
-			nodes = append(nodes, value)
-		}
-	} else {
-		edges = make([]*models.CommentEdge, len(source))
-		cursors = make([]string, len(source))
-		nodes = source
-
-		for i, value := range source {
-			edge := edgeMaker(value, i+offset)
-			e := edge.(models.CommentEdge)
-			edges[i] = &e
-			cursors[i] = edge.GetCursor()
+		if i, ok := cursorIndex(*input.Before, len(source), func(i int) Edge { return edgeMaker(source[i], i) }); ok && i >= start {
+			// remove all after element including the "before" one
+			end = i
 		}
 	}
 
-	if input.First != nil {
-		if *input.First < 0 {
-			return emptyCon, fmt.Errorf("first less than zero")
-		}
+	if input.First != nil && end-start > *input.First {
+		// Slice result to be of length first by removing edges from the end
+		end = start + *input.First
+	}
 
-		if len(edges) > *input.First {
-			// Slice result to be of length first by removing edges from the end
-			edges = edges[:*input.First]
-			cursors = cursors[:*input.First]
-			nodes = nodes[:*input.First]
-			pageInfo.HasNextPage = true
-		}
+	if input.Last != nil && end-start > *input.Last {
+		// Slice result to be of length last by removing edges from the start
+		start = end - *input.Last
 	}
 
-	if input.Last != nil {
-		if *input.Last < 0 {
-			return emptyCon, fmt.Errorf("last less than zero")
-		}
+	pageInfo.HasPreviousPage = start > 0
+	pageInfo.HasNextPage = end < len(source)
 
-		if len(edges) > *input.Last {
-			// Slice result to be of length last by removing edges from the start
-			edges = edges[len(edges)-*input.Last:]
-			cursors = cursors[len(cursors)-*input.Last:]
-			nodes = nodes[len(nodes)-*input.Last:]
-			pageInfo.HasPreviousPage = true
-		}
+	nodes = source[start:end]
+	edges = make([]*models.CommentEdge, len(nodes))
+	cursors = make([]string, len(nodes))
+
+	for i, value := range nodes {
+		edge := edgeMaker(value, start+i)
+		e := edge.(models.CommentEdge)
+		edges[i] = &e
+		cursors[i] = edge.GetCursor()
 	}
 
 	// Fill up pageInfo cursors
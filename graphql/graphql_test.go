@@ -1,11 +1,15 @@
 package graphql
 
 import (
+	"encoding/json"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/vektah/gqlgen/client"
 
+	"github.com/MichaelMure/git-bug/graphql/auth"
 	"github.com/MichaelMure/git-bug/graphql/models"
 	"github.com/MichaelMure/git-bug/misc/random_bugs"
 	"github.com/MichaelMure/git-bug/repository"
@@ -49,6 +53,15 @@ func TestQueries(t *testing.T) {
               status
               title
 
+              commits {
+                hash
+                shortHash
+                author
+                date
+                subject
+                files
+              }
+
               actors(first: 10) {
                 pageInfo {
                   endCursor
@@ -176,6 +189,15 @@ func TestQueries(t *testing.T) {
 					Status    string
 					Title     string
 
+					Commits []struct {
+						Hash      string
+						ShortHash string `json:"shortHash"`
+						Author    string
+						Date      string
+						Subject   string
+						Files     []string
+					}
+
 					Actors struct {
 						PageInfo models.PageInfo
 						Nodes    []Identity
@@ -215,3 +237,472 @@ func TestQueries(t *testing.T) {
 
 	c.MustPost(query, &resp)
 }
+
+func TestMutations(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	handler, err := NewHandler(repo)
+	require.NoError(t, err)
+
+	backend, err := handler.DefaultRepo()
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	b, _, err := backend.NewBug("title", "message")
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(handler)
+	c := client.New(srv.URL)
+
+	var resp struct {
+		EditComment struct {
+			Bug struct {
+				Comments struct {
+					Nodes []struct {
+						Message string
+					}
+				}
+			}
+		}
+		ChangeAssignees struct {
+			Bug struct {
+				Assignees []struct {
+					Name string
+				}
+			}
+		}
+		SetDueDate struct {
+			Bug struct {
+				DueDate string
+			}
+		}
+	}
+
+	query := `
+    mutation($prefix: String!, $target: String!, $user: String!) {
+      editComment(input: {prefix: $prefix, target: $target, message: "edited"}) {
+        bug { comments(first: 1) { nodes { message } } }
+      }
+      changeAssignees(input: {prefix: $prefix, added: [$user]}) {
+        bug { assignees { name } }
+      }
+      setDueDate(input: {prefix: $prefix, dueDate: "2030-01-02T15:04:05Z"}) {
+        bug { dueDate }
+      }
+    }`
+
+	c.MustPost(query, &resp,
+		client.Var("prefix", b.Id().Human()),
+		client.Var("target", b.Snapshot().Comments[0].Id().Human()),
+		client.Var("user", rene.Id().Human()),
+	)
+
+	require.Equal(t, "edited", resp.EditComment.Bug.Comments.Nodes[0].Message)
+	require.Equal(t, "René Descartes", resp.ChangeAssignees.Bug.Assignees[0].Name)
+	require.Equal(t, "2030-01-02T15:04:05Z", resp.SetDueDate.Bug.DueDate)
+
+	// invalid inputs are reported with the faulty field
+	invalid := []struct {
+		query string
+		field string
+	}{
+		{`mutation { setTitle(input: {prefix: "` + b.Id().Human() + `", title: "multi\nline"}) { bug { id } } }`, "title"},
+		{`mutation { setTitle(input: {prefix: "unknown", title: "title"}) { bug { id } } }`, "prefix"},
+		{`mutation { editComment(input: {prefix: "` + b.Id().Human() + `", target: "unknown", message: "edited"}) { bug { id } } }`, "target"},
+		{`mutation { changeAssignees(input: {prefix: "` + b.Id().Human() + `", removed: ["unknown"]}) { bug { id } } }`, "removed"},
+	}
+
+	for _, tc := range invalid {
+		err := c.Post(tc.query, &struct{}{})
+		require.Error(t, err)
+
+		var errs []struct {
+			Extensions map[string]string
+		}
+		require.NoError(t, json.Unmarshal(err.(client.RawJsonError).RawMessage, &errs))
+		require.Len(t, errs, 1)
+		require.Equal(t, "INVALID_INPUT", errs[0].Extensions["code"])
+		require.Equal(t, tc.field, errs[0].Extensions["field"])
+	}
+}
+
+func TestLabelMutations(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	handler, err := NewHandler(repo)
+	require.NoError(t, err)
+
+	backend, err := handler.DefaultRepo()
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	b, _, err := backend.NewBug("title", "message")
+	require.NoError(t, err)
+	_, _, err = b.ChangeLabels([]string{"bug"}, nil)
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(handler)
+	c := client.New(srv.URL)
+
+	type Label struct {
+		Name        string
+		Color       struct{ R, G, B int }
+		Description string
+		Aliases     []string
+		Usage       int
+	}
+
+	var created struct {
+		CreateLabel struct {
+			Label Label
+		}
+	}
+
+	c.MustPost(`mutation {
+      createLabel(input: {name: "bug", color: "#d73a4a", description: "Something isn't working"}) {
+        label { name color { R G B } description aliases usage }
+      }
+    }`, &created)
+
+	require.Equal(t, Label{
+		Name:        "bug",
+		Color:       struct{ R, G, B int }{0xd7, 0x3a, 0x4a},
+		Description: "Something isn't working",
+		Aliases:     []string{},
+		Usage:       1,
+	}, created.CreateLabel.Label)
+
+	var edited struct {
+		EditLabel struct {
+			Label Label
+		}
+	}
+
+	c.MustPost(`mutation {
+      editLabel(input: {name: "bug", newName: "defect", description: "Not working"}) {
+        label { name color { R G B } description aliases usage }
+      }
+    }`, &edited)
+
+	require.Equal(t, Label{
+		Name:        "defect",
+		Color:       struct{ R, G, B int }{0xd7, 0x3a, 0x4a},
+		Description: "Not working",
+		Aliases:     []string{"bug"},
+		Usage:       1,
+	}, edited.EditLabel.Label)
+
+	// the label is already defined, under its new name
+	err = c.Post(`mutation { createLabel(input: {name: "defect"}) { label { name } } }`, &struct{}{})
+	require.Error(t, err)
+
+	err = c.Post(`mutation { createLabel(input: {name: "other", color: "red"}) { label { name } } }`, &struct{}{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `"field":"color"`)
+}
+
+func TestReadOnly(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	handler, err := NewHandler(repo)
+	require.NoError(t, err)
+
+	backend, err := handler.DefaultRepo()
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	srv := httptest.NewServer(auth.ReadOnly(handler))
+	c := client.New(srv.URL)
+
+	var resp struct {
+		DefaultRepository struct {
+			UserIdentity *struct {
+				Name string
+			}
+		}
+	}
+
+	// nobody to act as
+	c.MustPost(`query { defaultRepository { userIdentity { name } } }`, &resp)
+	require.Nil(t, resp.DefaultRepository.UserIdentity)
+
+	err = c.Post(`mutation { newBug(input: {title: "title", message: "message"}) { bug { id } } }`, &struct{}{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), auth.ErrReadOnly.Error())
+
+	err = c.Post(`mutation { createLabel(input: {name: "bug"}) { label { name } } }`, &struct{}{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), auth.ErrReadOnly.Error())
+
+	require.Empty(t, backend.AllBugsIds())
+	require.False(t, backend.LabelStore().IsDefined("bug"))
+}
+
+func TestRepositories(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	other := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo, other)
+
+	random_bugs.FillRepoWithSeed(other, 3, 42)
+
+	handler, err := NewHandler(repo)
+	require.NoError(t, err)
+	require.NoError(t, handler.RegisterRepository("other", other))
+
+	srv := httptest.NewServer(handler)
+	c := client.New(srv.URL)
+
+	type repository struct {
+		Name    *string
+		AllBugs struct {
+			TotalCount int
+		}
+	}
+
+	var resp struct {
+		DefaultRepository repository
+		Repository        repository
+		Repositories      []repository
+	}
+
+	c.MustPost(`query {
+		defaultRepository { name allBugs { totalCount } }
+		repository(ref: "other") { name allBugs { totalCount } }
+		repositories { name allBugs { totalCount } }
+	}`, &resp)
+
+	require.Nil(t, resp.DefaultRepository.Name)
+	require.Equal(t, 0, resp.DefaultRepository.AllBugs.TotalCount)
+	require.Equal(t, "other", *resp.Repository.Name)
+	require.Equal(t, 3, resp.Repository.AllBugs.TotalCount)
+	require.Equal(t, []repository{resp.DefaultRepository, resp.Repository}, resp.Repositories)
+
+	err = c.Post(`query { repository(ref: "unknown") { name } }`, &struct{}{})
+	require.Error(t, err)
+}
+
+func TestSearch(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	handler, err := NewHandler(repo)
+	require.NoError(t, err)
+
+	backend, err := handler.MultiRepoCache.DefaultRepo()
+	require.NoError(t, err)
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	_, _, err = backend.NewBug("Typo", "a typo in the parser")
+	require.NoError(t, err)
+	crash, _, err := backend.NewBug("Crash", "the parser crashes")
+	require.NoError(t, err)
+	_, err = crash.AddComment("again in the parser")
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(handler)
+	c := client.New(srv.URL)
+
+	var resp struct {
+		Search []struct {
+			Bug     struct{ Title string }
+			Score   int
+			Matches []struct {
+				Comment *struct{ Message string }
+				Excerpt []struct {
+					Text        string
+					Highlighted bool
+				}
+			}
+		}
+	}
+
+	c.MustPost(`query {
+		search(query: "parser", first: 1) {
+			bug { title }
+			score
+			matches { comment { message } excerpt { text highlighted } }
+		}
+	}`, &resp)
+
+	require.Len(t, resp.Search, 1)
+	require.Equal(t, "Crash", resp.Search[0].Bug.Title)
+	require.Equal(t, 2, resp.Search[0].Score)
+	require.Len(t, resp.Search[0].Matches, 2)
+	require.Equal(t, "the parser crashes", resp.Search[0].Matches[0].Comment.Message)
+	require.Equal(t, "parser", resp.Search[0].Matches[0].Excerpt[1].Text)
+	require.True(t, resp.Search[0].Matches[0].Excerpt[1].Highlighted)
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	handler, err := NewHandler(repo)
+	require.NoError(t, err)
+
+	backend, err := handler.MultiRepoCache.DefaultRepo()
+	require.NoError(t, err)
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	b, _, err := backend.NewBug("Crash", "**bold** <script>alert(1)</script>")
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(handler)
+	c := client.New(srv.URL)
+
+	var resp struct {
+		RenderMarkdown    string
+		DefaultRepository struct {
+			Bug struct {
+				Comments struct {
+					Nodes []struct{ MessageHtml string }
+				}
+				Timeline struct {
+					Nodes []struct{ MessageHtml string }
+				}
+			}
+		}
+	}
+
+	c.MustPost(`query($prefix: String!) {
+		renderMarkdown(markdown: "[link](javascript:alert(1)) ![image](javascript:alert(1)) _text_")
+		defaultRepository {
+			bug(prefix: $prefix) {
+				comments { nodes { messageHtml } }
+				timeline { nodes { ... on CreateTimelineItem { messageHtml } } }
+			}
+		}
+	}`, &resp, client.Var("prefix", b.Id().String()))
+
+	require.Equal(t, "<p><tt>link</tt> image <em>text</em></p>\n", resp.RenderMarkdown)
+
+	expected := "<p><strong>bold</strong> alert(1)</p>\n"
+	require.Equal(t, expected, resp.DefaultRepository.Bug.Comments.Nodes[0].MessageHtml)
+	require.Equal(t, expected, resp.DefaultRepository.Bug.Timeline.Nodes[0].MessageHtml)
+}
+
+func TestIdentityMutations(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	handler, err := NewHandler(repo)
+	require.NoError(t, err)
+
+	backend, err := handler.DefaultRepo()
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(handler)
+	c := client.New(srv.URL)
+
+	type identity struct {
+		Id        string
+		Name      string
+		Email     string
+		AvatarUrl string
+	}
+
+	me := func() *identity {
+		var resp struct {
+			DefaultRepository struct{ Me *identity }
+		}
+		c.MustPost(`query { defaultRepository { me { id name email avatarUrl } } }`, &resp)
+		return resp.DefaultRepository.Me
+	}
+
+	// a new user
+	require.Nil(t, me())
+
+	var created struct {
+		CreateIdentity struct{ Identity identity }
+	}
+	c.MustPost(`mutation {
+		createIdentity(input: {name: "René Descartes", email: "rene@descartes.fr", adopt: true}) {
+			identity { id name email avatarUrl }
+		}
+	}`, &created)
+
+	require.Equal(t, "René Descartes", created.CreateIdentity.Identity.Name)
+	require.Equal(t, &created.CreateIdentity.Identity, me())
+
+	var edited struct {
+		EditIdentity struct{ Identity identity }
+	}
+	c.MustPost(`mutation {
+		editIdentity(input: {email: "", avatarUrl: "https://descartes.fr/rene.png"}) {
+			identity { id name email avatarUrl }
+		}
+	}`, &edited)
+
+	require.Equal(t, identity{
+		Id:        created.CreateIdentity.Identity.Id,
+		Name:      "René Descartes",
+		AvatarUrl: "https://descartes.fr/rene.png",
+	}, edited.EditIdentity.Identity)
+
+	// the edit is committed
+	rene, err := backend.ResolveIdentityPrefix(created.CreateIdentity.Identity.Id)
+	require.NoError(t, err)
+	require.False(t, rene.NeedCommit())
+	require.Equal(t, "", rene.Email())
+
+	var adopted struct {
+		AdoptIdentity struct{ Identity identity }
+	}
+	isaac, err := backend.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+	c.MustPost(`mutation($prefix: String!) {
+		adoptIdentity(input: {prefix: $prefix}) { identity { id name email avatarUrl } }
+	}`, &adopted, client.Var("prefix", isaac.Id().Human()))
+	require.Equal(t, "Isaac Newton", me().Name)
+
+	// invalid inputs are reported with the faulty field
+	invalid := []struct {
+		query string
+		field string
+	}{
+		{`mutation { createIdentity(input: {email: "nobody@example.com"}) { identity { id } } }`, "name"},
+		{`mutation { createIdentity(input: {name: "Nobody", avatarUrl: "nowhere"}) { identity { id } } }`, "avatarUrl"},
+		{`mutation { editIdentity(input: {name: "multi\nline"}) { identity { id } } }`, "name"},
+		{`mutation { adoptIdentity(input: {prefix: "unknown"}) { identity { id } } }`, "prefix"},
+	}
+
+	for _, tc := range invalid {
+		err := c.Post(tc.query, &struct{}{})
+		require.Error(t, err, tc.query)
+
+		var errs []struct {
+			Extensions map[string]string
+		}
+		require.NoError(t, json.Unmarshal(err.(client.RawJsonError).RawMessage, &errs))
+		require.Len(t, errs, 1)
+		require.Equal(t, tc.field, errs[0].Extensions["field"], tc.query)
+	}
+
+	// an authenticated user can't adopt another identity
+	srv = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(rw, r.WithContext(auth.ContextWithIdentity(r.Context(), rene.Id())))
+	}))
+	c = client.New(srv.URL)
+	require.Equal(t, "René Descartes", me().Name)
+
+	err = c.Post(`mutation($prefix: String!) {
+		adoptIdentity(input: {prefix: $prefix}) { identity { id } }
+	}`, &struct{}{}, client.Var("prefix", isaac.Id().Human()))
+	require.Error(t, err)
+}
@@ -4,9 +4,14 @@
 package graphql
 
 import (
+	"context"
 	"net/http"
+	"strings"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/handler"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/graphql/auth"
 	"github.com/MichaelMure/git-bug/graphql/graph"
 	"github.com/MichaelMure/git-bug/graphql/resolvers"
 	"github.com/MichaelMure/git-bug/repository"
@@ -19,20 +24,116 @@ type Handler struct {
 }
 
 func NewHandler(repo repository.ClockedRepo) (Handler, error) {
-	h := Handler{
-		RootResolver: resolvers.NewRootResolver(),
-	}
+	h := newHandler(DefaultLimits())
 
 	err := h.RootResolver.RegisterDefaultRepository(repo)
 	if err != nil {
 		return Handler{}, err
 	}
 
+	return h, nil
+}
+
+// NewCacheHandler serve an already opened repository as the default one, with
+// the given limits. It's closed with the handler.
+func NewCacheHandler(repoCache *cache.RepoCache, limits Limits) (Handler, error) {
+	h := newHandler(limits)
+
+	err := h.RootResolver.RegisterRepoCache("", repoCache)
+	if err != nil {
+		return Handler{}, err
+	}
+
+	return h, nil
+}
+
+func newHandler(limits Limits) Handler {
+	h := Handler{
+		RootResolver: resolvers.NewRootResolver(),
+	}
+
 	config := graph.Config{
 		Resolvers: h.RootResolver,
 	}
+	setComplexity(&config.Complexity)
 
-	h.HandlerFunc = handler.GraphQL(graph.NewExecutableSchema(config))
+	options := []handler.Option{
+		handler.ResolverMiddleware(h.lockWebsocketResolvers(h.mutations)),
+	}
+	if limits.MaxComplexity > 0 {
+		options = append(options, handler.ComplexityLimit(limits.MaxComplexity))
+	}
+	if limits.MaxDepth > 0 || limits.AllowedQueries != nil {
+		options = append(options, handler.RequestMiddleware(limits.middleware))
+	}
+	if limits.AllowedQueries != nil {
+		options = append(options, handler.EnablePersistedQueryCache(allowedQueries(limits.AllowedQueries)))
+	}
 
-	return h, nil
+	h.HandlerFunc = h.LockCaches(handler.GraphQL(graph.NewExecutableSchema(config), options...)).ServeHTTP
+
+	return h
+}
+
+type websocketKey struct{}
+
+// LockCaches hold the lock of the caches for reading during the requests, so
+// that the caches are not changed in the background while being used. The
+// websocket connections last as long as the client, and would block the
+// changes forever: the lock is taken around each of their resolvers instead.
+func (h Handler) LockCaches(next http.Handler) http.Handler {
+	lock := h.CacheLock()
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if strings.EqualFold(req.Header.Get("Upgrade"), "websocket") {
+			ctx := context.WithValue(req.Context(), websocketKey{}, true)
+			next.ServeHTTP(w, req.WithContext(ctx))
+			return
+		}
+		lock.RLock()
+		defer lock.RUnlock()
+		next.ServeHTTP(w, req)
+	})
+}
+
+// lockWebsocketResolvers hold the lock of the caches for reading around the
+// resolvers of the queries and mutations sent over a websocket. The lock is
+// already held for the whole of the other requests. The subscriptions only
+// stream the progress of the bridges, which is not in the caches.
+func (h Handler) lockWebsocketResolvers(next graphql.FieldMiddleware) graphql.FieldMiddleware {
+	lock := h.CacheLock()
+	return func(ctx context.Context, resolver graphql.Resolver) (interface{}, error) {
+		if websocket, _ := ctx.Value(websocketKey{}).(bool); websocket && !inSubscription(ctx) {
+			lock.RLock()
+			defer lock.RUnlock()
+		}
+		return next(ctx, resolver)
+	}
+}
+
+func inSubscription(ctx context.Context) bool {
+	for rc := graphql.GetResolverContext(ctx); rc != nil; rc = rc.Parent {
+		if rc.Object == "Subscription" {
+			return true
+		}
+	}
+	return false
+}
+
+// mutations fail all the mutations of the read-only requests, and the ones
+// made during the pull of a bridge
+func (h Handler) mutations(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	if graphql.GetResolverContext(ctx).Object != "Mutation" {
+		return next(ctx)
+	}
+	if auth.IsReadOnly(ctx) {
+		return nil, auth.ErrReadOnly
+	}
+
+	end, err := h.BeginMutation()
+	if err != nil {
+		return nil, err
+	}
+	defer end()
+
+	return next(ctx)
 }
@@ -0,0 +1,214 @@
+package graphql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/gqlerror"
+
+	"github.com/MichaelMure/git-bug/graphql/graph"
+	"github.com/MichaelMure/git-bug/graphql/resolvers"
+)
+
+// the codes in the extensions of the errors of the rejected queries
+const (
+	QueryTooDeepErrorCode    = "QUERY_TOO_DEEP"
+	QueryNotAllowedErrorCode = "QUERY_NOT_ALLOWED"
+)
+
+// the default limits, well above what the web UI needs
+const (
+	DefaultMaxComplexity = 20000
+	DefaultMaxDepth      = 15
+)
+
+// the items counted for a connection requested without first or last, as
+// all of them are returned
+const unboundedPageItems = 100
+
+// Limits protect a public server from the costly queries. The zero value
+// doesn't limit anything.
+type Limits struct {
+	// MaxComplexity is the highest cost of a query. Every field cost 1, and
+	// the ones of the nodes of a connection are counted for every item
+	// requested with first or last.
+	MaxComplexity int
+
+	// MaxDepth is the deepest nesting of fields in a query
+	MaxDepth int
+
+	// AllowedQueries are the only queries accepted if not nil, by their
+	// SHA-256 hash. The clients can send the hash alone, as an automatic
+	// persisted query.
+	AllowedQueries map[string]string
+}
+
+// DefaultLimits return the limits of the web UI, without allow-list
+func DefaultLimits() Limits {
+	return Limits{
+		MaxComplexity: DefaultMaxComplexity,
+		MaxDepth:      DefaultMaxDepth,
+	}
+}
+
+// ReadAllowedQueries read a JSON file holding the allowed queries, as an
+// object of their SHA-256 hash to their text
+func ReadAllowedQueries(filename string) (map[string]string, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var queries map[string]string
+	err = json.Unmarshal(data, &queries)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+
+	for hash, query := range queries {
+		if hashQuery(query) != hash {
+			return nil, fmt.Errorf("%s: the hash %s is not the one of its query", filename, hash)
+		}
+	}
+
+	return queries, nil
+}
+
+func hashQuery(query string) string {
+	hash := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(hash[:])
+}
+
+// setComplexity count the nodes of the connections once per item requested
+func setComplexity(c *graph.ComplexityRoot) {
+	page := func(childComplexity int, after *string, before *string, first *int, last *int) int {
+		return pageComplexity(childComplexity, first, last)
+	}
+
+	c.Bug.Actors = page
+	c.Bug.Comments = page
+	c.Bug.Operations = page
+	c.Bug.Participants = page
+	c.Bug.Timeline = page
+	c.Repository.AllIdentities = page
+	c.Repository.ValidLabels = page
+	c.Repository.AllBugs = func(childComplexity int, after *string, before *string, first *int, last *int, query *string) int {
+		return pageComplexity(childComplexity, first, last)
+	}
+	c.Query.Search = func(childComplexity int, ref *string, query string, first *int) int {
+		if first == nil {
+			first = new(int)
+			*first = resolvers.DefaultSearchResults
+		}
+		return pageComplexity(childComplexity, first, nil)
+	}
+}
+
+func pageComplexity(childComplexity int, first *int, last *int) int {
+	items := unboundedPageItems
+	switch {
+	case first != nil:
+		items = *first
+	case last != nil:
+		items = *last
+	}
+	if items < 1 {
+		items = 1
+	}
+
+	// saturate instead of overflowing with the nested connections
+	if childComplexity > 0 && items > (math.MaxInt32-1)/childComplexity {
+		return math.MaxInt32
+	}
+	return 1 + childComplexity*items
+}
+
+// middleware reject the queries too deep, or not in the allow-list
+func (l Limits) middleware(ctx context.Context, next func(ctx context.Context) []byte) []byte {
+	reqCtx := graphql.GetRequestContext(ctx)
+
+	if l.AllowedQueries != nil {
+		if _, ok := l.AllowedQueries[hashQuery(reqCtx.RawQuery)]; !ok {
+			graphql.AddError(ctx, &gqlerror.Error{
+				Message:    "the query is not allowed",
+				Extensions: map[string]interface{}{"code": QueryNotAllowedErrorCode},
+			})
+			return nil
+		}
+	}
+
+	if l.MaxDepth > 0 {
+		depth := queryDepth(reqCtx.Doc)
+		if depth > l.MaxDepth {
+			graphql.AddError(ctx, &gqlerror.Error{
+				Message:    fmt.Sprintf("the query has a depth of %d, above the limit of %d", depth, l.MaxDepth),
+				Extensions: map[string]interface{}{"code": QueryTooDeepErrorCode},
+			})
+			return nil
+		}
+	}
+
+	return next(ctx)
+}
+
+// queryDepth return the deepest nesting of fields of the operations of a
+// query, through the fragments
+func queryDepth(doc *ast.QueryDocument) int {
+	// the fragments are computed once, a fragment can be spread many times
+	fragments := make(map[string]int)
+
+	var depth func(set ast.SelectionSet) int
+	depth = func(set ast.SelectionSet) int {
+		result := 0
+		for _, selection := range set {
+			var d int
+			switch selection := selection.(type) {
+			case *ast.Field:
+				d = 1 + depth(selection.SelectionSet)
+			case *ast.InlineFragment:
+				d = depth(selection.SelectionSet)
+			case *ast.FragmentSpread:
+				var ok bool
+				d, ok = fragments[selection.Name]
+				if !ok {
+					// the cycles are rejected by the validation
+					if fragment := doc.Fragments.ForName(selection.Name); fragment != nil {
+						d = depth(fragment.SelectionSet)
+					}
+					fragments[selection.Name] = d
+				}
+			}
+			if d > result {
+				result = d
+			}
+		}
+		return result
+	}
+
+	result := 0
+	for _, op := range doc.Operations {
+		if d := depth(op.SelectionSet); d > result {
+			result = d
+		}
+	}
+	return result
+}
+
+// allowedQueries serve the allowed queries as persisted queries
+type allowedQueries map[string]string
+
+func (q allowedQueries) Add(ctx context.Context, hash string, query string) {
+	// only the allowed ones are kept
+}
+
+func (q allowedQueries) Get(ctx context.Context, hash string) (string, bool) {
+	query, ok := q[hash]
+	return query, ok
+}
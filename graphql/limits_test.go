@@ -0,0 +1,119 @@
+package graphql
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestLimits(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	repoCache, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+
+	allowed := `{ defaultRepository { allBugs(first: 10) { totalCount } } }`
+
+	handler, err := NewCacheHandler(repoCache, Limits{
+		MaxComplexity:  1000,
+		MaxDepth:       4,
+		AllowedQueries: map[string]string{hashQuery(allowed): allowed},
+	})
+	require.NoError(t, err)
+	defer handler.Close()
+
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	// the same repository, without allow-list
+	other, err := NewCacheHandler(repoCache, Limits{MaxComplexity: 1000, MaxDepth: 4})
+	require.NoError(t, err)
+	otherSrv := httptest.NewServer(other)
+	defer otherSrv.Close()
+
+	url := srv.URL
+	post := func(body map[string]interface{}) (int, string) {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+		resp, err := http.Post(url, "application/json", strings.NewReader(string(data)))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		respBody, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(respBody)
+	}
+
+	code, body := post(map[string]interface{}{"query": allowed})
+	require.Equal(t, http.StatusOK, code)
+	require.JSONEq(t, `{"data": {"defaultRepository": {"allBugs": {"totalCount": 0}}}}`, body)
+
+	// by its hash only
+	code, body = post(map[string]interface{}{
+		"extensions": map[string]interface{}{
+			"persistedQuery": map[string]interface{}{"version": 1, "sha256Hash": hashQuery(allowed)},
+		},
+	})
+	require.Equal(t, http.StatusOK, code)
+	require.Contains(t, body, `"totalCount":0`)
+
+	code, body = post(map[string]interface{}{"query": `{ defaultRepository { allBugs(first: 1) { totalCount } } }`})
+	require.Equal(t, http.StatusOK, code)
+	require.Contains(t, body, QueryNotAllowedErrorCode)
+	require.Contains(t, body, `"data":null`)
+
+	// every item of the page is counted
+	url = otherSrv.URL
+
+	code, body = post(map[string]interface{}{"query": `{ defaultRepository { allBugs(first: 10) { nodes { id title } } } }`})
+	require.Equal(t, http.StatusOK, code, body)
+
+	code, body = post(map[string]interface{}{"query": `{ defaultRepository { allBugs(first: 1000) { nodes { id title } } } }`})
+	require.Equal(t, http.StatusUnprocessableEntity, code)
+	require.Contains(t, body, "exceeds the limit of 1000")
+
+	code, body = post(map[string]interface{}{"query": `{ defaultRepository { allBugs(first: 1) { nodes { author { name } } } } }`})
+	require.Equal(t, http.StatusOK, code)
+	require.Contains(t, body, QueryTooDeepErrorCode)
+
+	// through the fragments as well
+	code, body = post(map[string]interface{}{"query": `
+		query { defaultRepository { ...bugs } }
+		fragment bugs on Repository { allBugs(first: 1) { nodes { author { name } } } }`})
+	require.Equal(t, http.StatusOK, code)
+	require.Contains(t, body, QueryTooDeepErrorCode)
+}
+
+func TestReadAllowedQueries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git-bug-queries")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	query := `{ defaultRepository { name } }`
+	path := filepath.Join(dir, "queries.json")
+
+	data, err := json.Marshal(map[string]string{hashQuery(query): query})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, data, 0644))
+
+	queries, err := ReadAllowedQueries(path)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{hashQuery(query): query}, queries)
+
+	data, err = json.Marshal(map[string]string{hashQuery(query): query + " "})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, data, 0644))
+
+	_, err = ReadAllowedQueries(path)
+	require.Error(t, err)
+}
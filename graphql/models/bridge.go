@@ -0,0 +1,156 @@
+package models
+
+import (
+	"sync"
+	"time"
+)
+
+// the events kept for a subscriber reading slower than they happen, the
+// others are dropped
+const bridgeSyncSubscriberBuffer = 100
+
+// BridgeSync is a pull or a push of a bridge running in the background. It's
+// updated as it progresses and read by the requests concurrently.
+type BridgeSync struct {
+	Id        string
+	Bridge    string
+	Direction BridgeSyncDirection
+	StartedAt time.Time
+
+	// the progress, shared by the copies of the sync
+	*bridgeSyncState
+}
+
+type bridgeSyncState struct {
+	mu          sync.Mutex
+	status      BridgeSyncStatus
+	finishedAt  *time.Time
+	bugs        int
+	identities  int
+	errors      []string
+	subscribers []chan *BridgeSyncEvent
+}
+
+func NewBridgeSync(id string, bridge string, direction BridgeSyncDirection) *BridgeSync {
+	return &BridgeSync{
+		Id:        id,
+		Bridge:    bridge,
+		Direction: direction,
+		StartedAt: time.Now(),
+		bridgeSyncState: &bridgeSyncState{
+			status: BridgeSyncStatusRunning,
+			errors: []string{},
+		},
+	}
+}
+
+func (s *BridgeSync) Status() BridgeSyncStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.status
+}
+
+func (s *BridgeSync) FinishedAt() *time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.finishedAt
+}
+
+func (s *BridgeSync) Bugs() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.bugs
+}
+
+func (s *BridgeSync) Identities() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.identities
+}
+
+func (s *BridgeSync) Errors() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.errors...)
+}
+
+// Record count an event of the sync and send it to the subscribers
+func (s *BridgeSync) Record(message string, bug bool, identity bool, err bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if bug {
+		s.bugs++
+	}
+	if identity {
+		s.identities++
+	}
+	if err {
+		s.errors = append(s.errors, message)
+	}
+
+	s.publish(&BridgeSyncEvent{Sync: s, Message: message, Error: err})
+}
+
+// Finish mark the end of the sync, failed if any error happened, and close
+// the subscriptions
+func (s *BridgeSync) Finish(message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.finishedAt = &now
+	s.status = BridgeSyncStatusSuccess
+	if len(s.errors) > 0 {
+		s.status = BridgeSyncStatusFailure
+	}
+
+	s.publish(&BridgeSyncEvent{Sync: s, Message: message, Error: false})
+
+	for _, sub := range s.subscribers {
+		close(sub)
+	}
+	s.subscribers = nil
+}
+
+// Subscribe return the events of the sync from now on, until it finishes or
+// the subscription is canceled
+func (s *BridgeSync) Subscribe() (<-chan *BridgeSyncEvent, func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub := make(chan *BridgeSyncEvent, bridgeSyncSubscriberBuffer)
+
+	if s.finishedAt != nil {
+		sub <- &BridgeSyncEvent{Sync: s, Message: "finished", Error: false}
+		close(sub)
+		return sub, func() {}
+	}
+
+	s.subscribers = append(s.subscribers, sub)
+
+	cancel := func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for i, other := range s.subscribers {
+			if other == sub {
+				s.subscribers = append(s.subscribers[:i], s.subscribers[i+1:]...)
+				close(sub)
+				return
+			}
+		}
+	}
+
+	return sub, cancel
+}
+
+// publish send an event to the subscribers without waiting for them, it must
+// be called with the lock held
+func (s *BridgeSync) publish(event *BridgeSyncEvent) {
+	for _, sub := range s.subscribers {
+		select {
+		case sub <- event:
+		default:
+		}
+	}
+}
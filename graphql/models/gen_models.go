@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/identity"
@@ -39,6 +40,44 @@ type AddCommentPayload struct {
 	Operation *bug.AddCommentOperation `json:"operation"`
 }
 
+type AdoptIdentityInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The identity ID's prefix.
+	Prefix string `json:"prefix"`
+}
+
+type AdoptIdentityPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The adopted identity.
+	Identity identity.Interface `json:"identity"`
+}
+
+// A bridge to an external bug tracker
+type Bridge struct {
+	// The name of the bridge.
+	Name string `json:"name"`
+	// The kind of bug tracker, like github or gitlab.
+	Target string `json:"target"`
+	// When the bridge last imported without error, null if it never did.
+	LastImport *time.Time `json:"lastImport"`
+	// The last pull or push of the bridge started through the API since the start of the server.
+	LastSync *BridgeSync `json:"lastSync"`
+}
+
+// A step of a pull or a push
+type BridgeSyncEvent struct {
+	// The sync and its progress.
+	Sync *BridgeSync `json:"sync"`
+	// What happened, like "new issue: <id>".
+	Message string `json:"message"`
+	// The event is an error.
+	Error bool `json:"error"`
+}
+
 // The connection type for Bug.
 type BugConnection struct {
 	// A list of edges.
@@ -58,6 +97,28 @@ type BugEdge struct {
 	Node *bug.Snapshot `json:"node"`
 }
 
+type ChangeAssigneesInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The bug ID's prefix.
+	Prefix string `json:"prefix"`
+	// The ID's prefix of the identities to assign.
+	Added []string `json:"added"`
+	// The ID's prefix of the identities to unassign.
+	Removed []string `json:"removed"`
+}
+
+type ChangeAssigneesPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug *bug.Snapshot `json:"bug"`
+	// The resulting operation.
+	Operation *bug.AssigneeChangeOperation `json:"operation"`
+}
+
 type ChangeLabelInput struct {
 	// A unique identifier for the client performing the mutation.
 	ClientMutationID *string `json:"clientMutationId"`
@@ -144,6 +205,116 @@ type CommitPayload struct {
 	Bug *bug.Snapshot `json:"bug"`
 }
 
+type CreateIdentityInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The name of the person. Either the name or the login must be set.
+	Name *string `json:"name"`
+	// The email of the person.
+	Email *string `json:"email"`
+	// The login of the person.
+	Login *string `json:"login"`
+	// An url to an avatar.
+	AvatarURL *string `json:"avatarUrl"`
+	// Adopt the new identity as the user identity of the repository, only without authentication.
+	Adopt *bool `json:"adopt"`
+}
+
+type CreateIdentityPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The created identity.
+	Identity identity.Interface `json:"identity"`
+}
+
+type CreateLabelInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The name of the new label.
+	Name string `json:"name"`
+	// The color of the label, as #rrggbb. If not set, the color is derived from the name.
+	Color *string `json:"color"`
+	// The description of the label.
+	Description *string `json:"description"`
+}
+
+type CreateLabelPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The created label.
+	Label bug.Label `json:"label"`
+}
+
+type EditCommentInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The bug ID's prefix.
+	Prefix string `json:"prefix"`
+	// The ID's prefix of the comment to edit, as the ID of its timeline item.
+	Target string `json:"target"`
+	// The new message of the comment. An empty message redact the comment.
+	Message string `json:"message"`
+}
+
+type EditCommentPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug *bug.Snapshot `json:"bug"`
+	// The resulting operation.
+	Operation *bug.EditCommentOperation `json:"operation"`
+}
+
+type EditIdentityInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The new name of the person.
+	Name *string `json:"name"`
+	// The new email of the person, removed if empty.
+	Email *string `json:"email"`
+	// The new login of the person, removed if empty.
+	Login *string `json:"login"`
+	// The new url of the avatar, removed if empty.
+	AvatarURL *string `json:"avatarUrl"`
+}
+
+type EditIdentityPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The edited identity.
+	Identity identity.Interface `json:"identity"`
+}
+
+type EditLabelInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The name of the label, or one of its previous names.
+	Name string `json:"name"`
+	// The new name of the label. The bugs keep the old name as an alias.
+	NewName *string `json:"newName"`
+	// The new color of the label, as #rrggbb.
+	Color *string `json:"color"`
+	// The new description of the label.
+	Description *string `json:"description"`
+}
+
+type EditLabelPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The edited label.
+	Label bug.Label `json:"label"`
+}
+
 type IdentityConnection struct {
 	Edges      []*IdentityEdge      `json:"edges"`
 	Nodes      []identity.Interface `json:"nodes"`
@@ -234,6 +405,74 @@ type PageInfo struct {
 	EndCursor string `json:"endCursor"`
 }
 
+type PullBridgeInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The name of the bridge. If not set, the default bridge is used.
+	Name *string `json:"name"`
+}
+
+type PullBridgePayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The started sync.
+	Sync *BridgeSync `json:"sync"`
+}
+
+type PushBridgeInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The name of the bridge. If not set, the default bridge is used.
+	Name *string `json:"name"`
+}
+
+type PushBridgePayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The started sync.
+	Sync *BridgeSync `json:"sync"`
+}
+
+// An excerpt of the title or of a comment containing the words searched
+type SearchMatch struct {
+	// The comment of the excerpt, null for the title.
+	Comment *bug.Comment `json:"comment"`
+	Excerpt []*TextPart  `json:"excerpt"`
+}
+
+// A bug found by a full-text search
+type SearchResult struct {
+	Bug *bug.Snapshot `json:"bug"`
+	// The relevance of the bug, higher when the words are found more, preferably in the title.
+	Score int `json:"score"`
+	// Excerpts of the title or of the first comments containing the words searched.
+	Matches []*SearchMatch `json:"matches"`
+}
+
+type SetDueDateInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The bug ID's prefix.
+	Prefix string `json:"prefix"`
+	// The new due date. If not set, the due date is removed.
+	DueDate *time.Time `json:"dueDate"`
+}
+
+type SetDueDatePayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug *bug.Snapshot `json:"bug"`
+	// The resulting operation.
+	Operation *bug.SetDueDateOperation `json:"operation"`
+}
+
 type SetTitleInput struct {
 	// A unique identifier for the client performing the mutation.
 	ClientMutationID *string `json:"clientMutationId"`
@@ -254,6 +493,12 @@ type SetTitlePayload struct {
 	Operation *bug.SetTitleOperation `json:"operation"`
 }
 
+// A piece of an excerpt, highlighted if it's a word searched
+type TextPart struct {
+	Text        string `json:"text"`
+	Highlighted bool   `json:"highlighted"`
+}
+
 // The connection type for TimelineItem
 type TimelineItemConnection struct {
 	Edges      []*TimelineItemEdge `json:"edges"`
@@ -268,6 +513,94 @@ type TimelineItemEdge struct {
 	Node   bug.TimelineItem `json:"node"`
 }
 
+type BridgeSyncDirection string
+
+const (
+	// Import from the bug tracker.
+	BridgeSyncDirectionPull BridgeSyncDirection = "PULL"
+	// Export to the bug tracker.
+	BridgeSyncDirectionPush BridgeSyncDirection = "PUSH"
+)
+
+var AllBridgeSyncDirection = []BridgeSyncDirection{
+	BridgeSyncDirectionPull,
+	BridgeSyncDirectionPush,
+}
+
+func (e BridgeSyncDirection) IsValid() bool {
+	switch e {
+	case BridgeSyncDirectionPull, BridgeSyncDirectionPush:
+		return true
+	}
+	return false
+}
+
+func (e BridgeSyncDirection) String() string {
+	return string(e)
+}
+
+func (e *BridgeSyncDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BridgeSyncDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BridgeSyncDirection", str)
+	}
+	return nil
+}
+
+func (e BridgeSyncDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type BridgeSyncStatus string
+
+const (
+	BridgeSyncStatusRunning BridgeSyncStatus = "RUNNING"
+	// Finished without error.
+	BridgeSyncStatusSuccess BridgeSyncStatus = "SUCCESS"
+	// Finished with some errors, or failed to start.
+	BridgeSyncStatusFailure BridgeSyncStatus = "FAILURE"
+)
+
+var AllBridgeSyncStatus = []BridgeSyncStatus{
+	BridgeSyncStatusRunning,
+	BridgeSyncStatusSuccess,
+	BridgeSyncStatusFailure,
+}
+
+func (e BridgeSyncStatus) IsValid() bool {
+	switch e {
+	case BridgeSyncStatusRunning, BridgeSyncStatusSuccess, BridgeSyncStatusFailure:
+		return true
+	}
+	return false
+}
+
+func (e BridgeSyncStatus) String() string {
+	return string(e)
+}
+
+func (e *BridgeSyncStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BridgeSyncStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BridgeSyncStatus", str)
+	}
+	return nil
+}
+
+func (e BridgeSyncStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type LabelChangeStatus string
 
 const (
@@ -15,6 +15,8 @@ type ConnectionInput struct {
 type Repository struct {
 	Cache *cache.MultiRepoCache
 	Repo  *cache.RepoCache
+	// the reference of a named repository, empty for the unnamed default one
+	Ref string
 }
 
 type RepositoryMutation struct {
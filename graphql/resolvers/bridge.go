@@ -0,0 +1,339 @@
+package resolvers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge"
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/graphql/graph"
+	"github.com/MichaelMure/git-bug/graphql/models"
+)
+
+// ErrImporting is returned by the mutations made during the pull of a bridge
+var ErrImporting = errors.New("a bridge is importing, try again when the pull is done")
+
+// bridgeSyncs keep track of the pulls and pushes of the bridges started
+// through the API, which outlive the requests starting them
+type bridgeSyncs struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu   sync.Mutex
+	byId map[string]*models.BridgeSync
+	// the last sync of each bridge
+	last map[bridgeKey]*models.BridgeSync
+
+	// the changes made during a pull are flagged as imported: the mutations
+	// are refused during the pulls, which wait for the running mutations
+	muChanges sync.Mutex
+	noChanges *sync.Cond
+	importing int
+	mutating  int
+	// the pulls run one at a time, as they flag the changes of the caches
+	muPull sync.Mutex
+}
+
+type bridgeKey struct {
+	repo *cache.RepoCache
+	name string
+}
+
+func newBridgeSyncs() *bridgeSyncs {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &bridgeSyncs{
+		ctx:    ctx,
+		cancel: cancel,
+		byId:   make(map[string]*models.BridgeSync),
+		last:   make(map[bridgeKey]*models.BridgeSync),
+	}
+	s.noChanges = sync.NewCond(&s.muChanges)
+	return s
+}
+
+// beginMutation fail with ErrImporting during a pull, or return the function
+// to call at the end of the mutation
+func (s *bridgeSyncs) beginMutation() (end func(), err error) {
+	s.muChanges.Lock()
+	defer s.muChanges.Unlock()
+
+	if s.importing > 0 {
+		return nil, ErrImporting
+	}
+
+	s.mutating++
+	return func() {
+		s.muChanges.Lock()
+		defer s.muChanges.Unlock()
+		s.mutating--
+		s.noChanges.Broadcast()
+	}, nil
+}
+
+// beginImport wait for the running mutations, and refuse the new ones until
+// the returned function is called
+func (s *bridgeSyncs) beginImport() (end func()) {
+	s.muChanges.Lock()
+	defer s.muChanges.Unlock()
+
+	s.importing++
+	for s.mutating > 0 {
+		s.noChanges.Wait()
+	}
+
+	return func() {
+		s.muChanges.Lock()
+		defer s.muChanges.Unlock()
+		s.importing--
+	}
+}
+
+func (s *bridgeSyncs) get(id string) (*models.BridgeSync, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bs, ok := s.byId[id]
+	return bs, ok
+}
+
+func (s *bridgeSyncs) lastSync(repo *cache.RepoCache, name string) *models.BridgeSync {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.last[bridgeKey{repo: repo, name: name}]
+}
+
+// start run a pull or a push of a bridge in the background, unless one is
+// already running
+func (s *bridgeSyncs) start(repo *cache.RepoCache, b *core.Bridge, direction models.BridgeSyncDirection) (*models.BridgeSync, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := bridgeKey{repo: repo, name: b.Name}
+	if last, ok := s.last[key]; ok && last.Status() == models.BridgeSyncStatusRunning {
+		return nil, fmt.Errorf("a sync of the bridge %s is already running", b.Name)
+	}
+
+	random := make([]byte, 8)
+	if _, err := rand.Read(random); err != nil {
+		return nil, err
+	}
+
+	bs := models.NewBridgeSync(hex.EncodeToString(random), b.Name, direction)
+	s.byId[bs.Id] = bs
+	s.last[key] = bs
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		if direction == models.BridgeSyncDirectionPull {
+			s.muPull.Lock()
+			defer s.muPull.Unlock()
+			defer s.beginImport()()
+			pullBridge(s.ctx, repo, b, bs)
+		} else {
+			pushBridge(s.ctx, b, bs)
+		}
+	}()
+
+	return bs, nil
+}
+
+// close stop the running syncs, and wait for them to finish
+func (s *bridgeSyncs) close() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// pullBridge import the changes of a bridge. The cache serialize the imported
+// changes with the requests, which keep being served during the import. No
+// mutation must run meanwhile, for only the imported changes to be flagged as
+// such.
+func pullBridge(ctx context.Context, repo *cache.RepoCache, b *core.Bridge, progress *models.BridgeSync) {
+	repo.SetImporting(true)
+	defer repo.SetImporting(false)
+
+	events, err := b.ImportAll(ctx)
+	if err != nil {
+		progress.Record(err.Error(), false, false, true)
+		progress.Finish("import failed")
+		return
+	}
+
+	importedIssues := 0
+	importedIdentities := 0
+	summary := cache.ImportSummary{Source: b.Name}
+	for result := range events {
+		if result.Event == core.ImportEventNothing {
+			continue
+		}
+
+		switch result.Event {
+		case core.ImportEventBug:
+			importedIssues++
+			summary.Bugs = append(summary.Bugs, result.ID)
+		case core.ImportEventIdentity:
+			importedIdentities++
+		}
+
+		progress.Record(result.String(),
+			result.Event == core.ImportEventBug,
+			result.Event == core.ImportEventIdentity,
+			result.Event == core.ImportEventError,
+		)
+	}
+
+	err = repo.RunHook(cache.HookPostImport, "", summary)
+	if err != nil {
+		progress.Record(err.Error(), false, false, true)
+	}
+
+	progress.Finish(fmt.Sprintf("imported %d issues and %d identities", importedIssues, importedIdentities))
+}
+
+func pushBridge(ctx context.Context, b *core.Bridge, progress *models.BridgeSync) {
+	events, err := b.ExportAll(ctx, time.Time{})
+	if err != nil {
+		progress.Record(err.Error(), false, false, true)
+		progress.Finish("export failed")
+		return
+	}
+
+	exportedIssues := 0
+	for result := range events {
+		if result.Event == core.ExportEventNothing {
+			continue
+		}
+
+		if result.Event == core.ExportEventBug {
+			exportedIssues++
+		}
+
+		progress.Record(result.String(),
+			result.Event == core.ExportEventBug,
+			false,
+			result.Event == core.ExportEventError,
+		)
+	}
+
+	progress.Finish(fmt.Sprintf("exported %d issues", exportedIssues))
+}
+
+// loadBridge return the named bridge of a repository, or the default one
+func loadBridge(repo *cache.RepoCache, name *string) (*core.Bridge, error) {
+	if name == nil {
+		return bridge.DefaultBridge(repo)
+	}
+
+	b, err := bridge.LoadBridge(repo, *name)
+	if err != nil {
+		return nil, newInputError("name", err)
+	}
+	return b, nil
+}
+
+func (r repoResolver) Bridges(ctx context.Context, obj *models.Repository) ([]*models.Bridge, error) {
+	repo := obj.Repo
+
+	names, err := bridge.ConfiguredBridges(repo)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	result := make([]*models.Bridge, len(names))
+
+	for i, name := range names {
+		b, err := bridge.LoadBridge(repo, name)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = &models.Bridge{
+			Name:     name,
+			Target:   b.Target(),
+			LastSync: r.syncs.lastSync(repo, name),
+		}
+
+		lastImport, err := b.LastImportTime()
+		if err != nil {
+			return nil, err
+		}
+		if !lastImport.IsZero() {
+			result[i].LastImport = &lastImport
+		}
+	}
+
+	return result, nil
+}
+
+var _ graph.SubscriptionResolver = &subscriptionResolver{}
+
+type subscriptionResolver struct {
+	syncs *bridgeSyncs
+}
+
+func (r subscriptionResolver) BridgeSync(ctx context.Context, id string) (<-chan *models.BridgeSyncEvent, error) {
+	bs, ok := r.syncs.get(id)
+	if !ok {
+		return nil, fmt.Errorf("unknown bridge sync %s", id)
+	}
+
+	events, cancel := bs.Subscribe()
+	go func() {
+		<-ctx.Done()
+		cancel()
+	}()
+
+	return events, nil
+}
+
+func (r mutationResolver) PullBridge(ctx context.Context, input models.PullBridgeInput) (*models.PullBridgePayload, error) {
+	repo, err := r.getRepo(input.RepoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := loadBridge(repo, input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	bs, err := r.syncs.start(repo, b, models.BridgeSyncDirectionPull)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.PullBridgePayload{
+		ClientMutationID: input.ClientMutationID,
+		Sync:             bs,
+	}, nil
+}
+
+func (r mutationResolver) PushBridge(ctx context.Context, input models.PushBridgeInput) (*models.PushBridgePayload, error) {
+	repo, err := r.getRepo(input.RepoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := loadBridge(repo, input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	bs, err := r.syncs.start(repo, b, models.BridgeSyncDirectionPush)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.PushBridgePayload{
+		ClientMutationID: input.ClientMutationID,
+		Sync:             bs,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/graphql/connections"
 	"github.com/MichaelMure/git-bug/graphql/graph"
 	"github.com/MichaelMure/git-bug/graphql/models"
@@ -13,7 +14,9 @@ import (
 
 var _ graph.BugResolver = &bugResolver{}
 
-type bugResolver struct{}
+type bugResolver struct {
+	cache *cache.MultiRepoCache
+}
 
 func (bugResolver) ID(ctx context.Context, obj *bug.Snapshot) (string, error) {
 	return obj.Id().String(), nil
@@ -43,9 +46,9 @@ func (bugResolver) Comments(ctx context.Context, obj *bug.Snapshot, after *strin
 	}
 
 	conMaker := func(edges []*models.CommentEdge, nodes []bug.Comment, info *models.PageInfo, totalCount int) (*models.CommentConnection, error) {
-		var commentNodes []*bug.Comment
-		for _, c := range nodes {
-			commentNodes = append(commentNodes, &c)
+		commentNodes := make([]*bug.Comment, len(nodes))
+		for i := range nodes {
+			commentNodes[i] = &nodes[i]
 		}
 		return &models.CommentConnection{
 			Edges:      edges,
@@ -117,6 +120,14 @@ func (bugResolver) LastEdit(ctx context.Context, obj *bug.Snapshot) (*time.Time,
 	return &t, nil
 }
 
+func (bugResolver) DueDate(ctx context.Context, obj *bug.Snapshot) (*time.Time, error) {
+	if obj.DueDate.IsZero() {
+		return nil, nil
+	}
+	t := obj.DueDate
+	return &t, nil
+}
+
 func (bugResolver) Actors(ctx context.Context, obj *bug.Snapshot, after *string, before *string, first *int, last *int) (*models.IdentityConnection, error) {
 	input := models.ConnectionInput{
 		Before: before,
@@ -170,3 +181,41 @@ func (bugResolver) Participants(ctx context.Context, obj *bug.Snapshot, after *s
 
 	return connections.IdentityCon(obj.Participants, edger, conMaker, input)
 }
+
+func (r bugResolver) Commits(ctx context.Context, obj *bug.Snapshot) ([]*cache.CommitExcerpt, error) {
+	repo, err := r.bugRepo(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	commits, err := repo.CommitsReferencing(obj.Id())
+	if err != nil {
+		return nil, err
+	}
+	if commits == nil {
+		commits = []*cache.CommitExcerpt{}
+	}
+
+	return commits, nil
+}
+
+// bugRepo return the repository of a bug, the default one first
+func (r bugResolver) bugRepo(obj *bug.Snapshot) (*cache.RepoCache, error) {
+	if repo, err := r.cache.DefaultRepo(); err == nil {
+		if _, err := repo.ResolveBugExcerpt(obj.Id()); err == nil {
+			return repo, nil
+		}
+	}
+
+	for _, ref := range r.cache.RepoRefs() {
+		repo, err := r.cache.ResolveRepo(ref)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := repo.ResolveBugExcerpt(obj.Id()); err == nil {
+			return repo, nil
+		}
+	}
+
+	return nil, bug.ErrBugNotExist
+}
@@ -0,0 +1,17 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/graphql/graph"
+	"github.com/MichaelMure/git-bug/interchange"
+)
+
+var _ graph.CommentResolver = &commentResolver{}
+
+type commentResolver struct{}
+
+func (commentResolver) MessageHTML(ctx context.Context, obj *bug.Comment) (string, error) {
+	return interchange.RenderMarkdown(obj.Message), nil
+}
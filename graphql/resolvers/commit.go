@@ -0,0 +1,18 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/graphql/graph"
+)
+
+var _ graph.CommitResolver = &commitResolver{}
+
+type commitResolver struct{}
+
+func (commitResolver) Date(ctx context.Context, obj *cache.CommitExcerpt) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
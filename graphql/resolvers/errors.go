@@ -0,0 +1,49 @@
+package resolvers
+
+import (
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+// InputErrorCode is the code in the extensions of the GraphQL errors caused
+// by an invalid input of a mutation
+const InputErrorCode = "INVALID_INPUT"
+
+var _ error = &InputError{}
+
+// InputError is a field of the input of a mutation that can't be used. It's
+// presented with the InputErrorCode and the name of the field in the
+// extensions of the error, to point the user at the faulty field.
+type InputError struct {
+	Field string
+	Err   error
+}
+
+func newInputError(field string, err error) *InputError {
+	return &InputError{Field: field, Err: err}
+}
+
+func (e *InputError) Error() string {
+	return e.Err.Error()
+}
+
+// Extensions implement graphql.ExtendedError
+func (e *InputError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code":  InputErrorCode,
+		"field": e.Field,
+	}
+}
+
+// resolveError tell if an error from resolving an entity by its id prefix
+// is caused by the prefix itself, and make it an InputError if so
+func resolveError(field string, err error) error {
+	if _, ok := err.(*entity.ErrMultipleMatch); ok {
+		return newInputError(field, err)
+	}
+	if err == bug.ErrBugNotExist || err == identity.ErrIdentityNotExist {
+		return newInputError(field, err)
+	}
+	return err
+}
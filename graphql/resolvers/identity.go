@@ -3,13 +3,16 @@ package resolvers
 import (
 	"context"
 
+	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/graphql/graph"
 	"github.com/MichaelMure/git-bug/identity"
 )
 
 var _ graph.IdentityResolver = &identityResolver{}
 
-type identityResolver struct{}
+type identityResolver struct {
+	cache *cache.MultiRepoCache
+}
 
 func (identityResolver) ID(ctx context.Context, obj *identity.Interface) (string, error) {
 	return (*obj).Id().String(), nil
@@ -39,6 +42,20 @@ func (identityResolver) AvatarURL(ctx context.Context, obj *identity.Interface)
 	return nilIfEmpty((*obj).AvatarUrl())
 }
 
+func (r identityResolver) Avatar(ctx context.Context, obj *identity.Interface) (*string, error) {
+	repo, err := r.cache.DefaultRepo()
+	if err != nil {
+		return nil, err
+	}
+
+	if repo.AvatarSourceUrl(*obj) == "" {
+		return nil, nil
+	}
+
+	url := "/avatar/" + (*obj).Id().String()
+	return &url, nil
+}
+
 func (identityResolver) IsProtected(ctx context.Context, obj *identity.Interface) (bool, error) {
 	return (*obj).IsProtected(), nil
 }
@@ -6,23 +6,59 @@ import (
 	"image/color"
 
 	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/graphql/graph"
 	"github.com/MichaelMure/git-bug/graphql/models"
 )
 
 var _ graph.LabelResolver = &labelResolver{}
 
-type labelResolver struct{}
+type labelResolver struct {
+	cache *cache.MultiRepoCache
+}
+
+// labelStore return the label definitions of the default repository, used to
+// resolve the renamed labels and their color
+func (r labelResolver) labelStore() *bug.LabelStore {
+	repo, err := r.cache.DefaultRepo()
+	if err != nil {
+		return nil
+	}
+	return repo.LabelStore()
+}
 
-func (labelResolver) Name(ctx context.Context, obj *bug.Label) (string, error) {
-	return obj.String(), nil
+func (r labelResolver) Name(ctx context.Context, obj *bug.Label) (string, error) {
+	return r.labelStore().Resolve(*obj).String(), nil
 }
 
-func (labelResolver) Color(ctx context.Context, obj *bug.Label) (*color.RGBA, error) {
-	rgba := obj.Color().RGBA()
+func (r labelResolver) Color(ctx context.Context, obj *bug.Label) (*color.RGBA, error) {
+	rgba := r.labelStore().Color(*obj).RGBA()
 	return &rgba, nil
 }
 
+func (r labelResolver) Description(ctx context.Context, obj *bug.Label) (string, error) {
+	return r.labelStore().Description(*obj), nil
+}
+
+func (r labelResolver) Aliases(ctx context.Context, obj *bug.Label) ([]string, error) {
+	aliases := r.labelStore().Aliases(*obj)
+
+	result := make([]string, len(aliases))
+	for i, alias := range aliases {
+		result[i] = alias.String()
+	}
+
+	return result, nil
+}
+
+func (r labelResolver) Usage(ctx context.Context, obj *bug.Label) (int, error) {
+	repo, err := r.cache.DefaultRepo()
+	if err != nil {
+		return 0, err
+	}
+	return repo.LabelUsage(*obj), nil
+}
+
 var _ graph.LabelChangeResultResolver = &labelChangeResultResolver{}
 
 type labelChangeResultResolver struct{}
@@ -2,34 +2,76 @@ package resolvers
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/graphql/auth"
 	"github.com/MichaelMure/git-bug/graphql/graph"
 	"github.com/MichaelMure/git-bug/graphql/models"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/text"
 )
 
 var _ graph.MutationResolver = &mutationResolver{}
 
 type mutationResolver struct {
 	cache *cache.MultiRepoCache
+	syncs *bridgeSyncs
 }
 
 func (r mutationResolver) getRepo(ref *string) (*cache.RepoCache, error) {
 	if ref != nil {
-		return r.cache.ResolveRepo(*ref)
+		repo, err := r.cache.ResolveRepo(*ref)
+		if err != nil {
+			return nil, newInputError("repoRef", err)
+		}
+		return repo, nil
 	}
 
 	return r.cache.DefaultRepo()
 }
 
+func (r mutationResolver) getBug(repoRef *string, prefix string) (*cache.BugCache, error) {
+	repo, err := r.getRepo(repoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := repo.ResolveBugPrefix(prefix)
+	if err != nil {
+		return nil, resolveError("prefix", err)
+	}
+
+	return b, nil
+}
+
+// getAuthor return the identity the operations are made as: the one of the
+// authenticated user, or the user identity of the repository
+func (r mutationResolver) getAuthor(ctx context.Context, repoRef *string) (*cache.IdentityCache, error) {
+	repo, err := r.getRepo(repoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.UserIdentity(ctx, repo)
+}
+
 func (r mutationResolver) NewBug(ctx context.Context, input models.NewBugInput) (*models.NewBugPayload, error) {
 	repo, err := r.getRepo(input.RepoRef)
 	if err != nil {
 		return nil, err
 	}
 
-	b, op, err := repo.NewBugWithFiles(input.Title, input.Message, input.Files)
+	author, err := auth.UserIdentity(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	b, op, err := repo.NewBugRaw(author, time.Now().Unix(), input.Title, input.Message, input.Files, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -42,17 +84,17 @@ func (r mutationResolver) NewBug(ctx context.Context, input models.NewBugInput)
 }
 
 func (r mutationResolver) AddComment(ctx context.Context, input models.AddCommentInput) (*models.AddCommentPayload, error) {
-	repo, err := r.getRepo(input.RepoRef)
+	b, err := r.getBug(input.RepoRef, input.Prefix)
 	if err != nil {
 		return nil, err
 	}
 
-	b, err := repo.ResolveBugPrefix(input.Prefix)
+	author, err := r.getAuthor(ctx, input.RepoRef)
 	if err != nil {
 		return nil, err
 	}
 
-	op, err := b.AddCommentWithFiles(input.Message, input.Files)
+	op, err := b.AddCommentRaw(author, time.Now().Unix(), input.Message, input.Files, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -65,17 +107,20 @@ func (r mutationResolver) AddComment(ctx context.Context, input models.AddCommen
 }
 
 func (r mutationResolver) ChangeLabels(ctx context.Context, input *models.ChangeLabelInput) (*models.ChangeLabelPayload, error) {
-	repo, err := r.getRepo(input.RepoRef)
+	b, err := r.getBug(input.RepoRef, input.Prefix)
 	if err != nil {
 		return nil, err
 	}
 
-	b, err := repo.ResolveBugPrefix(input.Prefix)
+	author, err := r.getAuthor(ctx, input.RepoRef)
 	if err != nil {
 		return nil, err
 	}
 
-	results, op, err := b.ChangeLabels(input.Added, input.Removed)
+	added := b.MatchLabelNames(input.Added)
+	removed := b.MatchLabelNames(input.Removed)
+
+	results, op, err := b.ChangeLabelsRaw(author, time.Now().Unix(), added, removed, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -94,17 +139,17 @@ func (r mutationResolver) ChangeLabels(ctx context.Context, input *models.Change
 }
 
 func (r mutationResolver) OpenBug(ctx context.Context, input models.OpenBugInput) (*models.OpenBugPayload, error) {
-	repo, err := r.getRepo(input.RepoRef)
+	b, err := r.getBug(input.RepoRef, input.Prefix)
 	if err != nil {
 		return nil, err
 	}
 
-	b, err := repo.ResolveBugPrefix(input.Prefix)
+	author, err := r.getAuthor(ctx, input.RepoRef)
 	if err != nil {
 		return nil, err
 	}
 
-	op, err := b.Open()
+	op, err := b.OpenRaw(author, time.Now().Unix(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -117,17 +162,17 @@ func (r mutationResolver) OpenBug(ctx context.Context, input models.OpenBugInput
 }
 
 func (r mutationResolver) CloseBug(ctx context.Context, input models.CloseBugInput) (*models.CloseBugPayload, error) {
-	repo, err := r.getRepo(input.RepoRef)
+	b, err := r.getBug(input.RepoRef, input.Prefix)
 	if err != nil {
 		return nil, err
 	}
 
-	b, err := repo.ResolveBugPrefix(input.Prefix)
+	author, err := r.getAuthor(ctx, input.RepoRef)
 	if err != nil {
 		return nil, err
 	}
 
-	op, err := b.Close()
+	op, err := b.CloseRaw(author, time.Now().Unix(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -140,17 +185,21 @@ func (r mutationResolver) CloseBug(ctx context.Context, input models.CloseBugInp
 }
 
 func (r mutationResolver) SetTitle(ctx context.Context, input models.SetTitleInput) (*models.SetTitlePayload, error) {
-	repo, err := r.getRepo(input.RepoRef)
+	b, err := r.getBug(input.RepoRef, input.Prefix)
 	if err != nil {
 		return nil, err
 	}
 
-	b, err := repo.ResolveBugPrefix(input.Prefix)
+	author, err := r.getAuthor(ctx, input.RepoRef)
 	if err != nil {
 		return nil, err
 	}
 
-	op, err := b.SetTitle(input.Title)
+	// without an operation, the title has been rejected
+	op, err := b.SetTitleRaw(author, time.Now().Unix(), input.Title, nil)
+	if err != nil && op == nil {
+		return nil, newInputError("title", err)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -162,35 +211,272 @@ func (r mutationResolver) SetTitle(ctx context.Context, input models.SetTitleInp
 	}, nil
 }
 
-func (r mutationResolver) Commit(ctx context.Context, input models.CommitInput) (*models.CommitPayload, error) {
+func (r mutationResolver) EditComment(ctx context.Context, input models.EditCommentInput) (*models.EditCommentPayload, error) {
+	b, err := r.getBug(input.RepoRef, input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := resolveComment(b.Snapshot(), input.Target)
+	if err != nil {
+		return nil, newInputError("target", err)
+	}
+
+	author, err := r.getAuthor(ctx, input.RepoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	// without an operation, the message has been rejected
+	op, err := b.EditCommentRaw(author, time.Now().Unix(), target, input.Message, nil)
+	if err != nil && op == nil {
+		return nil, newInputError("message", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.EditCommentPayload{
+		ClientMutationID: input.ClientMutationID,
+		Bug:              b.Snapshot(),
+		Operation:        op,
+	}, nil
+}
+
+// resolveComment find the comment of a bug matching an id prefix
+func resolveComment(snap *bug.Snapshot, prefix string) (entity.Id, error) {
+	var matching []entity.Id
+	for _, comment := range snap.Comments {
+		if comment.Id().HasPrefix(prefix) {
+			matching = append(matching, comment.Id())
+		}
+	}
+
+	switch len(matching) {
+	case 0:
+		return "", fmt.Errorf("no comment matching %s", prefix)
+	case 1:
+		return matching[0], nil
+	default:
+		return "", bug.NewErrMultipleMatchOp(matching)
+	}
+}
+
+func (r mutationResolver) ChangeAssignees(ctx context.Context, input models.ChangeAssigneesInput) (*models.ChangeAssigneesPayload, error) {
+	b, err := r.getBug(input.RepoRef, input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
 	repo, err := r.getRepo(input.RepoRef)
 	if err != nil {
 		return nil, err
 	}
 
-	b, err := repo.ResolveBugPrefix(input.Prefix)
+	added, err := resolveIdentities(repo, "added", input.Added)
 	if err != nil {
 		return nil, err
 	}
 
-	err = b.Commit()
+	removed, err := resolveIdentities(repo, "removed", input.Removed)
 	if err != nil {
 		return nil, err
 	}
 
-	return &models.CommitPayload{
+	author, err := auth.UserIdentity(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	// without an operation, the change has been rejected, most likely as it
+	// doesn't change anything
+	op, err := b.ChangeAssigneesRaw(author, time.Now().Unix(), added, removed, nil)
+	if err != nil && op == nil {
+		return nil, newInputError("added", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ChangeAssigneesPayload{
 		ClientMutationID: input.ClientMutationID,
 		Bug:              b.Snapshot(),
+		Operation:        op,
 	}, nil
 }
 
-func (r mutationResolver) CommitAsNeeded(ctx context.Context, input models.CommitAsNeededInput) (*models.CommitAsNeededPayload, error) {
+// resolveIdentities resolve a list of identity id prefixes, given in a field
+// of the input
+func resolveIdentities(repo *cache.RepoCache, field string, prefixes []string) ([]*cache.IdentityCache, error) {
+	result := make([]*cache.IdentityCache, len(prefixes))
+
+	for i, prefix := range prefixes {
+		var err error
+		result[i], err = repo.ResolveIdentityPrefix(prefix)
+		if err != nil {
+			return nil, resolveError(field, err)
+		}
+	}
+
+	return result, nil
+}
+
+func (r mutationResolver) SetDueDate(ctx context.Context, input models.SetDueDateInput) (*models.SetDueDatePayload, error) {
+	b, err := r.getBug(input.RepoRef, input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := r.getAuthor(ctx, input.RepoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var op *bug.SetDueDateOperation
+	if input.DueDate == nil {
+		op, err = b.ClearDueDateRaw(author, time.Now().Unix(), nil)
+	} else {
+		op, err = b.SetDueDateRaw(author, time.Now().Unix(), *input.DueDate, nil)
+	}
+
+	// without an operation, the due date has been rejected
+	if err != nil && op == nil {
+		return nil, newInputError("dueDate", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SetDueDatePayload{
+		ClientMutationID: input.ClientMutationID,
+		Bug:              b.Snapshot(),
+		Operation:        op,
+	}, nil
+}
+
+func (r mutationResolver) CreateLabel(ctx context.Context, input models.CreateLabelInput) (*models.CreateLabelPayload, error) {
 	repo, err := r.getRepo(input.RepoRef)
 	if err != nil {
 		return nil, err
 	}
 
-	b, err := repo.ResolveBugPrefix(input.Prefix)
+	label := bug.Label(input.Name)
+	if err := label.Validate(); err != nil {
+		return nil, newInputError("name", err)
+	}
+
+	store := repo.LabelStore()
+	if resolved := store.Resolve(label); resolved != label {
+		return nil, newInputError("name", fmt.Errorf("label %s is renamed to %s", label, resolved))
+	}
+	if store.IsDefined(label) {
+		return nil, newInputError("name", fmt.Errorf("label %s is already defined", label))
+	}
+
+	// the color is always stored, for the label to be defined even without
+	// a description
+	color := label.Color()
+	if input.Color != nil {
+		color, err = bug.ParseLabelColor(*input.Color)
+		if err != nil {
+			return nil, newInputError("color", err)
+		}
+	}
+
+	err = repo.SetLabelColor(label, color)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Description != nil && *input.Description != "" {
+		err = repo.SetLabelDescription(label, *input.Description)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.CreateLabelPayload{
+		ClientMutationID: input.ClientMutationID,
+		Label:            label,
+	}, nil
+}
+
+func (r mutationResolver) EditLabel(ctx context.Context, input models.EditLabelInput) (*models.EditLabelPayload, error) {
+	repo, err := r.getRepo(input.RepoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	label := bug.Label(input.Name)
+	if err := label.Validate(); err != nil {
+		return nil, newInputError("name", err)
+	}
+
+	// the color is checked first, to not rename the label if it's invalid
+	var color bug.LabelColor
+	if input.Color != nil {
+		color, err = bug.ParseLabelColor(*input.Color)
+		if err != nil {
+			return nil, newInputError("color", err)
+		}
+	}
+
+	if input.NewName != nil {
+		newName := bug.Label(*input.NewName)
+		if err := newName.Validate(); err != nil {
+			return nil, newInputError("newName", err)
+		}
+		if repo.LabelStore().Resolve(label) == newName {
+			return nil, newInputError("newName", fmt.Errorf("label %s already has this name", newName))
+		}
+
+		err = repo.RenameLabel(label, newName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	label = repo.LabelStore().Resolve(label)
+
+	if input.Color != nil {
+		err = repo.SetLabelColor(label, color)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Description != nil {
+		err = repo.SetLabelDescription(label, *input.Description)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.EditLabelPayload{
+		ClientMutationID: input.ClientMutationID,
+		Label:            label,
+	}, nil
+}
+
+func (r mutationResolver) Commit(ctx context.Context, input models.CommitInput) (*models.CommitPayload, error) {
+	b, err := r.getBug(input.RepoRef, input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.CommitPayload{
+		ClientMutationID: input.ClientMutationID,
+		Bug:              b.Snapshot(),
+	}, nil
+}
+
+func (r mutationResolver) CommitAsNeeded(ctx context.Context, input models.CommitAsNeededInput) (*models.CommitAsNeededPayload, error) {
+	b, err := r.getBug(input.RepoRef, input.Prefix)
 	if err != nil {
 		return nil, err
 	}
@@ -205,3 +491,162 @@ func (r mutationResolver) CommitAsNeeded(ctx context.Context, input models.Commi
 		Bug:              b.Snapshot(),
 	}, nil
 }
+
+// validateIdentityField check a field of an identity as done when it's
+// committed, to point at the faulty one
+func validateIdentityField(field string, value *string) error {
+	if value == nil {
+		return nil
+	}
+	if strings.Contains(*value, "\n") {
+		return newInputError(field, fmt.Errorf("%s should be a single line", field))
+	}
+	if !text.Safe(*value) {
+		return newInputError(field, fmt.Errorf("%s is not fully printable", field))
+	}
+	if field == "avatarUrl" && *value != "" && !text.ValidUrl(*value) {
+		return newInputError(field, fmt.Errorf("avatarUrl is not a valid URL"))
+	}
+	return nil
+}
+
+func validateIdentityInput(name, email, login, avatarUrl *string) error {
+	for field, value := range map[string]*string{
+		"name":      name,
+		"email":     email,
+		"login":     login,
+		"avatarUrl": avatarUrl,
+	} {
+		if err := validateIdentityField(field, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// the user identity of the repository is only the one of the user without
+// authentication
+var errAdoptAuthenticated = fmt.Errorf("the identity of an authenticated user is given by the authentication")
+
+func (r mutationResolver) CreateIdentity(ctx context.Context, input models.CreateIdentityInput) (*models.CreateIdentityPayload, error) {
+	repo, err := r.getRepo(input.RepoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateIdentityInput(input.Name, input.Email, input.Login, input.AvatarURL); err != nil {
+		return nil, err
+	}
+
+	var name, email, login, avatarUrl string
+	for _, field := range []struct {
+		value  *string
+		result *string
+	}{
+		{input.Name, &name},
+		{input.Email, &email},
+		{input.Login, &login},
+		{input.AvatarURL, &avatarUrl},
+	} {
+		if field.value != nil {
+			*field.result = strings.TrimSpace(*field.value)
+		}
+	}
+
+	if text.Empty(name) && text.Empty(login) {
+		return nil, newInputError("name", fmt.Errorf("either name or login should be set"))
+	}
+
+	adopt := input.Adopt != nil && *input.Adopt
+	if _, ok := auth.IdentityFromContext(ctx); ok && adopt {
+		return nil, newInputError("adopt", errAdoptAuthenticated)
+	}
+
+	i, err := repo.NewIdentityFull(name, email, login, avatarUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	if adopt {
+		err = repo.SetUserIdentity(i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.CreateIdentityPayload{
+		ClientMutationID: input.ClientMutationID,
+		Identity:         i.Identity,
+	}, nil
+}
+
+func (r mutationResolver) AdoptIdentity(ctx context.Context, input models.AdoptIdentityInput) (*models.AdoptIdentityPayload, error) {
+	repo, err := r.getRepo(input.RepoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := auth.IdentityFromContext(ctx); ok {
+		return nil, errAdoptAuthenticated
+	}
+
+	i, err := repo.ResolveIdentityPrefix(input.Prefix)
+	if err != nil {
+		return nil, resolveError("prefix", err)
+	}
+
+	err = repo.SetUserIdentity(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AdoptIdentityPayload{
+		ClientMutationID: input.ClientMutationID,
+		Identity:         i.Identity,
+	}, nil
+}
+
+func (r mutationResolver) EditIdentity(ctx context.Context, input models.EditIdentityInput) (*models.EditIdentityPayload, error) {
+	repo, err := r.getRepo(input.RepoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateIdentityInput(input.Name, input.Email, input.Login, input.AvatarURL); err != nil {
+		return nil, err
+	}
+
+	i, err := auth.UserIdentity(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.Mutate(func(orig identity.Mutator) identity.Mutator {
+		if input.Name != nil {
+			orig.Name = strings.TrimSpace(*input.Name)
+		}
+		if input.Email != nil {
+			orig.Email = strings.TrimSpace(*input.Email)
+		}
+		if input.Login != nil {
+			orig.Login = strings.TrimSpace(*input.Login)
+		}
+		if input.AvatarURL != nil {
+			orig.AvatarUrl = strings.TrimSpace(*input.AvatarURL)
+		}
+		return orig
+	})
+	if err != nil {
+		return nil, newInputError("name", err)
+	}
+
+	err = i.CommitAsNeeded()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.EditIdentityPayload{
+		ClientMutationID: input.ClientMutationID,
+		Identity:         i.Identity,
+	}, nil
+}
@@ -66,6 +66,57 @@ func (labelChangeOperationResolver) Date(ctx context.Context, obj *bug.LabelChan
 	return &t, nil
 }
 
+var _ graph.AssigneeChangeOperationResolver = assigneeChangeOperationResolver{}
+
+type assigneeChangeOperationResolver struct{}
+
+func (assigneeChangeOperationResolver) ID(ctx context.Context, obj *bug.AssigneeChangeOperation) (string, error) {
+	return obj.Id().String(), nil
+}
+
+func (assigneeChangeOperationResolver) Date(ctx context.Context, obj *bug.AssigneeChangeOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+var _ graph.SetDueDateOperationResolver = setDueDateOperationResolver{}
+
+type setDueDateOperationResolver struct{}
+
+func (setDueDateOperationResolver) ID(ctx context.Context, obj *bug.SetDueDateOperation) (string, error) {
+	return obj.Id().String(), nil
+}
+
+func (setDueDateOperationResolver) Date(ctx context.Context, obj *bug.SetDueDateOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+func (setDueDateOperationResolver) Due(ctx context.Context, obj *bug.SetDueDateOperation) (*time.Time, error) {
+	if obj.IsClear() {
+		return nil, nil
+	}
+	t := time.Unix(obj.Due, 0)
+	return &t, nil
+}
+
+var _ graph.SnapshotOperationResolver = snapshotOperationResolver{}
+
+type snapshotOperationResolver struct{}
+
+func (snapshotOperationResolver) ID(ctx context.Context, obj *bug.SnapshotOperation) (string, error) {
+	return obj.Id().String(), nil
+}
+
+func (snapshotOperationResolver) Date(ctx context.Context, obj *bug.SnapshotOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+func (snapshotOperationResolver) ArchivedCount(ctx context.Context, obj *bug.SnapshotOperation) (int, error) {
+	return len(obj.Archived), nil
+}
+
 var _ graph.SetStatusOperationResolver = setStatusOperationResolver{}
 
 type setStatusOperationResolver struct{}
@@ -2,10 +2,12 @@ package resolvers
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/graphql/graph"
 	"github.com/MichaelMure/git-bug/graphql/models"
+	"github.com/MichaelMure/git-bug/interchange"
 )
 
 var _ graph.QueryResolver = &rootQueryResolver{}
@@ -21,9 +23,16 @@ func (r rootQueryResolver) DefaultRepository(ctx context.Context) (*models.Repos
 		return nil, err
 	}
 
+	// the default repository can be the only named one
+	ref := ""
+	if _, err := r.cache.ResolveRepo(""); err != nil {
+		ref = r.cache.RepoRefs()[0]
+	}
+
 	return &models.Repository{
 		Cache: r.cache,
 		Repo:  repo,
+		Ref:   ref,
 	}, nil
 }
 
@@ -37,5 +46,100 @@ func (r rootQueryResolver) Repository(ctx context.Context, ref string) (*models.
 	return &models.Repository{
 		Cache: r.cache,
 		Repo:  repo,
+		Ref:   ref,
 	}, nil
 }
+
+func (r rootQueryResolver) Repositories(ctx context.Context) ([]*models.Repository, error) {
+	var result []*models.Repository
+
+	if repo, err := r.cache.ResolveRepo(""); err == nil {
+		result = append(result, &models.Repository{
+			Cache: r.cache,
+			Repo:  repo,
+		})
+	}
+
+	for _, ref := range r.cache.RepoRefs() {
+		repo, err := r.cache.ResolveRepo(ref)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &models.Repository{
+			Cache: r.cache,
+			Repo:  repo,
+			Ref:   ref,
+		})
+	}
+
+	return result, nil
+}
+
+// DefaultSearchResults is the number of search results given by default
+const DefaultSearchResults = 20
+
+func (r rootQueryResolver) Search(ctx context.Context, ref *string, query string, first *int) ([]*models.SearchResult, error) {
+	var repo *cache.RepoCache
+	var err error
+	if ref != nil {
+		repo, err = r.cache.ResolveRepo(*ref)
+	} else {
+		repo, err = r.cache.DefaultRepo()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	limit := DefaultSearchResults
+	if first != nil {
+		if *first < 0 {
+			return nil, fmt.Errorf("first can't be negative")
+		}
+		limit = *first
+	}
+
+	found, err := repo.Search(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(found) > limit {
+		found = found[:limit]
+	}
+
+	result := make([]*models.SearchResult, len(found))
+
+	for i, f := range found {
+		b, err := repo.ResolveBug(f.Id)
+		if err != nil {
+			return nil, err
+		}
+		snap := b.Snapshot()
+
+		matches := make([]*models.SearchMatch, len(f.Matches))
+		for j, m := range f.Matches {
+			match := &models.SearchMatch{}
+			if m.Comment >= 0 {
+				match.Comment = &snap.Comments[m.Comment]
+			}
+			for _, part := range m.Excerpt {
+				match.Excerpt = append(match.Excerpt, &models.TextPart{
+					Text:        part.Text,
+					Highlighted: part.Highlighted,
+				})
+			}
+			matches[j] = match
+		}
+
+		result[i] = &models.SearchResult{
+			Bug:     snap,
+			Score:   f.Score,
+			Matches: matches,
+		}
+	}
+
+	return result, nil
+}
+
+func (rootQueryResolver) RenderMarkdown(ctx context.Context, markdown string) (string, error) {
+	return interchange.RenderMarkdown(markdown), nil
+}
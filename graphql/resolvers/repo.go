@@ -6,6 +6,7 @@ import (
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/graphql/auth"
 	"github.com/MichaelMure/git-bug/graphql/connections"
 	"github.com/MichaelMure/git-bug/graphql/graph"
 	"github.com/MichaelMure/git-bug/graphql/models"
@@ -14,7 +15,16 @@ import (
 
 var _ graph.RepositoryResolver = &repoResolver{}
 
-type repoResolver struct{}
+type repoResolver struct {
+	syncs *bridgeSyncs
+}
+
+func (repoResolver) Name(ctx context.Context, obj *models.Repository) (*string, error) {
+	if obj.Ref == "" {
+		return nil, nil
+	}
+	return &obj.Ref, nil
+}
 
 func (repoResolver) AllBugs(ctx context.Context, obj *models.Repository, after *string, before *string, first *int, last *int, queryStr *string) (*models.BugConnection, error) {
 	input := models.ConnectionInput{
@@ -150,8 +160,37 @@ func (repoResolver) Identity(ctx context.Context, obj *models.Repository, prefix
 }
 
 func (repoResolver) UserIdentity(ctx context.Context, obj *models.Repository) (identity.Interface, error) {
-	i, err := obj.Repo.GetUserIdentity()
+	i, err := auth.UserIdentity(ctx, obj.Repo)
+
+	// nobody can act in the read-only mode
+	if err == auth.ErrReadOnly {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return i.Identity, nil
+}
 
+func (repoResolver) Me(ctx context.Context, obj *models.Repository) (identity.Interface, error) {
+	if id, ok := auth.IdentityFromContext(ctx); ok {
+		i, err := obj.Repo.ResolveIdentity(id)
+		if err != nil {
+			return nil, err
+		}
+		return i.Identity, nil
+	}
+
+	// without authentication, the read-only mode has no user
+	if auth.IsReadOnly(ctx) {
+		return nil, nil
+	}
+
+	i, err := obj.Repo.GetUserIdentity()
+	if err == identity.ErrNoIdentitySet {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,8 @@
 package resolvers
 
 import (
+	"sync"
+
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/graphql/graph"
 )
@@ -10,14 +12,39 @@ var _ graph.ResolverRoot = &RootResolver{}
 
 type RootResolver struct {
 	cache.MultiRepoCache
+	syncs     *bridgeSyncs
+	cacheLock *sync.RWMutex
 }
 
 func NewRootResolver() *RootResolver {
+	cacheLock := &sync.RWMutex{}
 	return &RootResolver{
 		MultiRepoCache: cache.NewMultiRepoCache(),
+		syncs:          newBridgeSyncs(),
+		cacheLock:      cacheLock,
 	}
 }
 
+// CacheLock return the lock of the repository caches. The requests hold it
+// for reading. It has to be held for writing to change the caches from
+// outside, like to refresh them.
+func (r *RootResolver) CacheLock() *sync.RWMutex {
+	return r.cacheLock
+}
+
+// BeginMutation fail with ErrImporting during the pull of a bridge, or return
+// the function to call at the end of the mutation. The pulls wait for the
+// running mutations.
+func (r *RootResolver) BeginMutation() (end func(), err error) {
+	return r.syncs.beginMutation()
+}
+
+// Close stop the running syncs of the bridges, then close the repositories
+func (r *RootResolver) Close() error {
+	r.syncs.close()
+	return r.MultiRepoCache.Close()
+}
+
 func (r RootResolver) Query() graph.QueryResolver {
 	return &rootQueryResolver{
 		cache: &r.MultiRepoCache,
@@ -27,27 +54,50 @@ func (r RootResolver) Query() graph.QueryResolver {
 func (r RootResolver) Mutation() graph.MutationResolver {
 	return &mutationResolver{
 		cache: &r.MultiRepoCache,
+		syncs: r.syncs,
+	}
+}
+
+func (r RootResolver) Subscription() graph.SubscriptionResolver {
+	return &subscriptionResolver{
+		syncs: r.syncs,
+	}
+}
+
+func (r RootResolver) Repository() graph.RepositoryResolver {
+	return &repoResolver{
+		syncs: r.syncs,
 	}
 }
 
-func (RootResolver) Repository() graph.RepositoryResolver {
-	return &repoResolver{}
+func (r RootResolver) Bug() graph.BugResolver {
+	return &bugResolver{
+		cache: &r.MultiRepoCache,
+	}
 }
 
-func (RootResolver) Bug() graph.BugResolver {
-	return &bugResolver{}
+func (RootResolver) Comment() graph.CommentResolver {
+	return &commentResolver{}
+}
+
+func (RootResolver) Commit() graph.CommitResolver {
+	return &commitResolver{}
 }
 
 func (RootResolver) Color() graph.ColorResolver {
 	return &colorResolver{}
 }
 
-func (RootResolver) Label() graph.LabelResolver {
-	return &labelResolver{}
+func (r RootResolver) Label() graph.LabelResolver {
+	return &labelResolver{
+		cache: &r.MultiRepoCache,
+	}
 }
 
 func (r RootResolver) Identity() graph.IdentityResolver {
-	return &identityResolver{}
+	return &identityResolver{
+		cache: &r.MultiRepoCache,
+	}
 }
 
 func (RootResolver) CommentHistoryStep() graph.CommentHistoryStepResolver {
@@ -66,6 +116,14 @@ func (r RootResolver) LabelChangeTimelineItem() graph.LabelChangeTimelineItemRes
 	return &labelChangeTimelineItem{}
 }
 
+func (r RootResolver) AssigneeChangeTimelineItem() graph.AssigneeChangeTimelineItemResolver {
+	return &assigneeChangeTimelineItem{}
+}
+
+func (r RootResolver) SetDueDateTimelineItem() graph.SetDueDateTimelineItemResolver {
+	return &setDueDateTimelineItem{}
+}
+
 func (r RootResolver) SetStatusTimelineItem() graph.SetStatusTimelineItemResolver {
 	return &setStatusTimelineItem{}
 }
@@ -90,6 +148,18 @@ func (RootResolver) LabelChangeOperation() graph.LabelChangeOperationResolver {
 	return &labelChangeOperationResolver{}
 }
 
+func (RootResolver) AssigneeChangeOperation() graph.AssigneeChangeOperationResolver {
+	return &assigneeChangeOperationResolver{}
+}
+
+func (RootResolver) SetDueDateOperation() graph.SetDueDateOperationResolver {
+	return &setDueDateOperationResolver{}
+}
+
+func (RootResolver) SnapshotOperation() graph.SnapshotOperationResolver {
+	return &snapshotOperationResolver{}
+}
+
 func (RootResolver) SetStatusOperation() graph.SetStatusOperationResolver {
 	return &setStatusOperationResolver{}
 }
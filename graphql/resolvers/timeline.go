@@ -7,6 +7,7 @@ import (
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/graphql/graph"
 	"github.com/MichaelMure/git-bug/graphql/models"
+	"github.com/MichaelMure/git-bug/interchange"
 )
 
 var _ graph.CommentHistoryStepResolver = commentHistoryStepResolver{}
@@ -26,6 +27,10 @@ func (addCommentTimelineItemResolver) ID(ctx context.Context, obj *bug.AddCommen
 	return obj.Id().String(), nil
 }
 
+func (addCommentTimelineItemResolver) MessageHTML(ctx context.Context, obj *bug.AddCommentTimelineItem) (string, error) {
+	return interchange.RenderMarkdown(obj.Message), nil
+}
+
 func (addCommentTimelineItemResolver) CreatedAt(ctx context.Context, obj *bug.AddCommentTimelineItem) (*time.Time, error) {
 	t := obj.CreatedAt.Time()
 	return &t, nil
@@ -44,6 +49,10 @@ func (createTimelineItemResolver) ID(ctx context.Context, obj *bug.CreateTimelin
 	return obj.Id().String(), nil
 }
 
+func (createTimelineItemResolver) MessageHTML(ctx context.Context, obj *bug.CreateTimelineItem) (string, error) {
+	return interchange.RenderMarkdown(obj.Message), nil
+}
+
 func (createTimelineItemResolver) CreatedAt(ctx context.Context, obj *bug.CreateTimelineItem) (*time.Time, error) {
 	t := obj.CreatedAt.Time()
 	return &t, nil
@@ -67,6 +76,40 @@ func (labelChangeTimelineItem) Date(ctx context.Context, obj *bug.LabelChangeTim
 	return &t, nil
 }
 
+var _ graph.AssigneeChangeTimelineItemResolver = assigneeChangeTimelineItem{}
+
+type assigneeChangeTimelineItem struct{}
+
+func (assigneeChangeTimelineItem) ID(ctx context.Context, obj *bug.AssigneeChangeTimelineItem) (string, error) {
+	return obj.Id().String(), nil
+}
+
+func (assigneeChangeTimelineItem) Date(ctx context.Context, obj *bug.AssigneeChangeTimelineItem) (*time.Time, error) {
+	t := obj.UnixTime.Time()
+	return &t, nil
+}
+
+var _ graph.SetDueDateTimelineItemResolver = setDueDateTimelineItem{}
+
+type setDueDateTimelineItem struct{}
+
+func (setDueDateTimelineItem) ID(ctx context.Context, obj *bug.SetDueDateTimelineItem) (string, error) {
+	return obj.Id().String(), nil
+}
+
+func (setDueDateTimelineItem) Date(ctx context.Context, obj *bug.SetDueDateTimelineItem) (*time.Time, error) {
+	t := obj.UnixTime.Time()
+	return &t, nil
+}
+
+func (setDueDateTimelineItem) Due(ctx context.Context, obj *bug.SetDueDateTimelineItem) (*time.Time, error) {
+	if obj.Due == 0 {
+		return nil, nil
+	}
+	t := obj.Due.Time()
+	return &t, nil
+}
+
 var _ graph.SetStatusTimelineItemResolver = setStatusTimelineItem{}
 
 type setStatusTimelineItem struct{}
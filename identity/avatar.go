@@ -0,0 +1,123 @@
+package identity
+
+import (
+	"crypto/md5"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const avatarProviderConfigKey = "git-bug.avatar.provider"
+
+// AvatarProvider is a service able to provide an avatar from an email address
+type AvatarProvider int
+
+const (
+	_ AvatarProvider = iota
+	// AvatarProviderNone only use the avatar URL of the identity, if any
+	AvatarProviderNone
+	// AvatarProviderGravatar fallback to https://gravatar.com
+	AvatarProviderGravatar
+	// AvatarProviderLibravatar fallback to https://libravatar.org
+	AvatarProviderLibravatar
+)
+
+func (p AvatarProvider) String() string {
+	switch p {
+	case AvatarProviderNone:
+		return "none"
+	case AvatarProviderGravatar:
+		return "gravatar"
+	case AvatarProviderLibravatar:
+		return "libravatar"
+	default:
+		return "unknown provider"
+	}
+}
+
+func AvatarProviderFromString(str string) (AvatarProvider, error) {
+	cleaned := strings.ToLower(strings.TrimSpace(str))
+
+	switch cleaned {
+	case "none":
+		return AvatarProviderNone, nil
+	case "gravatar":
+		return AvatarProviderGravatar, nil
+	case "libravatar":
+		return AvatarProviderLibravatar, nil
+	default:
+		return 0, fmt.Errorf("unknown avatar provider %s", str)
+	}
+}
+
+// GetAvatarProvider read the avatar provider configured for the repository,
+// or globally. It default to AvatarProviderNone, as querying a provider leak
+// the email addresses hashes to a third party.
+func GetAvatarProvider(repo repository.RepoCommon) (AvatarProvider, error) {
+	val, err := repository.ReadConfigAnyScope(repo, avatarProviderConfigKey)
+	if err != nil {
+		return 0, err
+	}
+	if val == "" {
+		return AvatarProviderNone, nil
+	}
+
+	return AvatarProviderFromString(val)
+}
+
+// AvatarSourceUrl return the remote URL where the avatar of an identity can be
+// found. The avatar URL of the identity (for example given by a bridge) take
+// precedence, otherwise it's derived from the email with the provider.
+// An empty string is returned if there is no avatar source.
+func AvatarSourceUrl(i Interface, provider AvatarProvider, size int) string {
+	if i.AvatarUrl() != "" {
+		return i.AvatarUrl()
+	}
+
+	email := strings.ToLower(strings.TrimSpace(i.Email()))
+	if email == "" {
+		return ""
+	}
+
+	// d=404 make the provider fail instead of returning a generic image, so
+	// that we can fallback on something else
+	hash := md5.Sum([]byte(email))
+
+	switch provider {
+	case AvatarProviderGravatar:
+		return fmt.Sprintf("https://www.gravatar.com/avatar/%x?s=%d&d=404", hash, size)
+	case AvatarProviderLibravatar:
+		return fmt.Sprintf("https://seccdn.libravatar.org/avatar/%x?s=%d&d=404", hash, size)
+	default:
+		return ""
+	}
+}
+
+// Initials return up to two letters that can be used in place of an avatar
+func Initials(i Interface) string {
+	name := i.Name()
+	if name == "" {
+		name = i.Login()
+	}
+
+	var result []rune
+	for _, word := range strings.Fields(name) {
+		for _, r := range word {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				result = append(result, unicode.ToUpper(r))
+				break
+			}
+		}
+	}
+
+	switch len(result) {
+	case 0:
+		return "?"
+	case 1:
+		return string(result)
+	default:
+		return string(result[0]) + string(result[len(result)-1])
+	}
+}
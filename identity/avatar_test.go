@@ -0,0 +1,57 @@
+package identity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestAvatarSourceUrl(t *testing.T) {
+	withUrl := NewIdentityFull("René Descartes", "rene@descartes.fr", "", "https://example.com/rene.png")
+	withEmail := NewIdentity("René Descartes", " Rene@Descartes.fr ")
+	withoutEmail := NewIdentity("René Descartes", "")
+
+	assert.Equal(t, "https://example.com/rene.png", AvatarSourceUrl(withUrl, AvatarProviderGravatar, 64))
+
+	assert.Equal(t, "", AvatarSourceUrl(withEmail, AvatarProviderNone, 64))
+	assert.Equal(t, "https://www.gravatar.com/avatar/a0360ea9987c12cd7c028d526d275fbd?s=64&d=404",
+		AvatarSourceUrl(withEmail, AvatarProviderGravatar, 64))
+	assert.Equal(t, "https://seccdn.libravatar.org/avatar/a0360ea9987c12cd7c028d526d275fbd?s=64&d=404",
+		AvatarSourceUrl(withEmail, AvatarProviderLibravatar, 64))
+
+	assert.Equal(t, "", AvatarSourceUrl(withoutEmail, AvatarProviderGravatar, 64))
+}
+
+func TestGetAvatarProvider(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+
+	provider, err := GetAvatarProvider(repo)
+	require.NoError(t, err)
+	assert.Equal(t, AvatarProviderNone, provider)
+
+	require.NoError(t, repo.GlobalConfig().StoreString(avatarProviderConfigKey, "gravatar"))
+	provider, err = GetAvatarProvider(repo)
+	require.NoError(t, err)
+	assert.Equal(t, AvatarProviderGravatar, provider)
+
+	// the repository config take precedence
+	require.NoError(t, repo.LocalConfig().StoreString(avatarProviderConfigKey, "libravatar"))
+	provider, err = GetAvatarProvider(repo)
+	require.NoError(t, err)
+	assert.Equal(t, AvatarProviderLibravatar, provider)
+
+	require.NoError(t, repo.LocalConfig().StoreString(avatarProviderConfigKey, "foo"))
+	_, err = GetAvatarProvider(repo)
+	assert.Error(t, err)
+}
+
+func TestInitials(t *testing.T) {
+	assert.Equal(t, "RD", Initials(NewIdentity("René Descartes", "")))
+	assert.Equal(t, "JV", Initials(NewIdentity("jean-paul de la vallée", "")))
+	assert.Equal(t, "É", Initials(NewIdentity("émile", "")))
+	assert.Equal(t, "L", Initials(NewIdentityFull("", "", "login", "")))
+	assert.Equal(t, "?", Initials(NewIdentity("", "")))
+}
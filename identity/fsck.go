@@ -0,0 +1,37 @@
+package identity
+
+import (
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const identityFsckQuarantineRefPattern = "refs/quarantine/fsck/identities/"
+
+// Fsck check the integrity of a local identity: the structure of its git
+// data, the validity of its versions and the ordering of their clocks. It
+// return all the problems found, or nil if the identity is sound.
+func Fsck(repo repository.Repo, id entity.Id) []error {
+	i, err := ReadLocal(repo, id)
+	if err != nil {
+		return []error{err}
+	}
+
+	if err := i.Validate(); err != nil {
+		return []error{err}
+	}
+
+	return nil
+}
+
+// QuarantineLocal move a local identity aside, out of refs/identities/, so
+// that it's not read or pushed anymore but still available for inspection.
+func QuarantineLocal(repo repository.Repo, id entity.Id) error {
+	ref := identityRefPattern + id.String()
+
+	err := repo.CopyRef(ref, identityFsckQuarantineRefPattern+id.String())
+	if err != nil {
+		return err
+	}
+
+	return repo.RemoveRef(ref)
+}
@@ -196,6 +196,21 @@ func readAllIdentities(repo repository.ClockedRepo, refPrefix string) <-chan Str
 	return out
 }
 
+// ListLocalIds list all the available local identity ids
+func ListLocalIds(repo repository.Repo) ([]entity.Id, error) {
+	refs, err := repo.ListRefs(identityRefPattern)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]entity.Id, len(refs))
+	for i, ref := range refs {
+		ids[i] = entity.Id(ref[len(identityRefPattern):])
+	}
+
+	return ids, nil
+}
+
 // NewFromGitUser will query the repository for user detail and
 // build the corresponding Identity
 func NewFromGitUser(repo repository.Repo) (*Identity, error) {
@@ -218,8 +233,17 @@ func NewFromGitUser(repo repository.Repo) (*Identity, error) {
 	return NewIdentity(name, email), nil
 }
 
-// IsUserIdentitySet tell if the user identity is correctly set.
+// IsUserIdentitySet tell if the user identity is correctly set, either
+// explicitly or with a matching IdentityRule.
 func IsUserIdentitySet(repo repository.RepoCommon) (bool, error) {
+	rule, err := matchingIdentityRule(repo)
+	if err != nil {
+		return false, err
+	}
+	if rule != nil {
+		return true, nil
+	}
+
 	configs, err := repo.LocalConfig().ReadAll(identityConfigKey)
 	if err != nil {
 		return false, err
@@ -237,8 +261,21 @@ func SetUserIdentity(repo repository.RepoCommon, identity *Identity) error {
 	return repo.LocalConfig().StoreString(identityConfigKey, identity.Id().String())
 }
 
-// GetUserIdentity read the current user identity, set with a git config entry
+// GetUserIdentity read the current user identity. A matching IdentityRule
+// takes precedence over the identity set with a git config entry.
 func GetUserIdentity(repo repository.Repo) (*Identity, error) {
+	rule, err := matchingIdentityRule(repo)
+	if err != nil {
+		return nil, err
+	}
+	if rule != nil {
+		i, err := ReadLocal(repo, rule.Identity)
+		if err == ErrIdentityNotExist {
+			return nil, fmt.Errorf("identity %s selected by the rule \"%s\" doesn't exist in this repository", rule.Identity.Human(), rule.Pattern)
+		}
+		return i, err
+	}
+
 	configs, err := repo.LocalConfig().ReadAll(identityConfigKey)
 	if err != nil {
 		return nil, err
@@ -277,6 +314,48 @@ func (i *Identity) AddVersion(version *Version) {
 	i.versions = append(i.versions, version)
 }
 
+// Mutator is the information of an Identity that can be changed by Mutate
+type Mutator struct {
+	Name      string
+	Email     string
+	Login     string
+	AvatarUrl string
+}
+
+// Mutate add a new version of the Identity with the information changed by
+// the given function, keeping the keys. Nothing is added if nothing changed,
+// or if the new information is invalid. The new version still need to be
+// committed.
+func (i *Identity) Mutate(f func(orig Mutator) Mutator) error {
+	last := i.lastVersion()
+
+	orig := Mutator{
+		Name:      last.name,
+		Email:     last.email,
+		Login:     last.login,
+		AvatarUrl: last.avatarURL,
+	}
+	mutated := f(orig)
+	if mutated == orig {
+		return nil
+	}
+
+	version := &Version{
+		name:      mutated.Name,
+		email:     mutated.Email,
+		login:     mutated.Login,
+		avatarURL: mutated.AvatarUrl,
+		keys:      last.keys,
+		nonce:     makeNonce(20),
+	}
+	if err := version.Validate(); err != nil {
+		return err
+	}
+
+	i.versions = append(i.versions, version)
+	return nil
+}
+
 // Write the identity into the Repository. In particular, this ensure that
 // the Id is properly set.
 func (i *Identity) Commit(repo repository.ClockedRepo) error {
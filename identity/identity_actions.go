@@ -9,18 +9,31 @@ import (
 	"github.com/pkg/errors"
 )
 
+// FetchRefSpec return the refspec used to fetch the identities of a remote
+func FetchRefSpec(remote string) string {
+	remoteRefSpec := fmt.Sprintf(identityRemoteRefPattern, remote)
+	return fmt.Sprintf("%s*:%s*", identityRefPattern, remoteRefSpec)
+}
+
+// PushRefSpec return the refspec used to push the identities to a remote
+func PushRefSpec() string {
+	return identityRefPattern + "*"
+}
+
+// RefName return the git reference of an identity
+func RefName(id entity.Id) string {
+	return identityRefPattern + id.String()
+}
+
 // Fetch retrieve updates from a remote
 // This does not change the local identities state
 func Fetch(repo repository.Repo, remote string) (string, error) {
-	remoteRefSpec := fmt.Sprintf(identityRemoteRefPattern, remote)
-	fetchRefSpec := fmt.Sprintf("%s*:%s*", identityRefPattern, remoteRefSpec)
-
-	return repo.FetchRefs(remote, fetchRefSpec)
+	return repo.FetchRefs(remote, FetchRefSpec(remote))
 }
 
 // Push update a remote with the local changes
 func Push(repo repository.Repo, remote string) (string, error) {
-	return repo.PushRefs(remote, identityRefPattern+"*")
+	return repo.PushRefs(remote, PushRefSpec())
 }
 
 // Pull will do a Fetch + MergeAll
@@ -0,0 +1,154 @@
+package identity
+
+import (
+	"fmt"
+	"os/user"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const identityRuleConfigPrefix = "git-bug.identity-rule."
+const identityRuleConfigSuffix = ".identity"
+const gitDirPatternPrefix = "gitdir:"
+
+// IdentityRule select automatically the user identity for the repositories
+// matching a pattern, similarly to git's includeIf.
+//
+// The pattern is either:
+// - "gitdir:<glob>", matched against the path of the git directory
+// - "<glob>", matched against the URL of each remote of the repository
+//
+// In a glob, "*" matches any sequence of characters and "?" a single one.
+// They are stored in the git config as:
+//   git-bug.identity-rule.<pattern>.identity = <identity id>
+type IdentityRule struct {
+	Pattern  string
+	Identity entity.Id
+}
+
+// ReadIdentityRules read the identity rules from the repository and global
+// git config. Repository rules are first.
+func ReadIdentityRules(repo repository.RepoCommon) ([]IdentityRule, error) {
+	var result []IdentityRule
+
+	for _, config := range []repository.Config{repo.LocalConfig(), repo.GlobalConfig()} {
+		configs, err := config.ReadAll(identityRuleConfigPrefix)
+		if err != nil {
+			return nil, err
+		}
+
+		rules := make([]IdentityRule, 0, len(configs))
+		for key, value := range configs {
+			if !strings.HasSuffix(key, identityRuleConfigSuffix) {
+				continue
+			}
+
+			pattern := strings.TrimPrefix(key, identityRuleConfigPrefix)
+			pattern = strings.TrimSuffix(pattern, identityRuleConfigSuffix)
+
+			rules = append(rules, IdentityRule{
+				Pattern:  pattern,
+				Identity: entity.Id(value),
+			})
+		}
+
+		// have a deterministic order
+		sort.Slice(rules, func(i, j int) bool {
+			return rules[i].Pattern < rules[j].Pattern
+		})
+
+		result = append(result, rules...)
+	}
+
+	return result, nil
+}
+
+// StoreIdentityRule write a new identity rule in the global git config
+func StoreIdentityRule(repo repository.RepoCommon, pattern string, identity *Identity) error {
+	if err := validateRulePattern(pattern); err != nil {
+		return err
+	}
+
+	key := identityRuleConfigPrefix + pattern + identityRuleConfigSuffix
+	return repo.GlobalConfig().StoreString(key, identity.Id().String())
+}
+
+func validateRulePattern(pattern string) error {
+	if strings.TrimPrefix(pattern, gitDirPatternPrefix) == "" {
+		return fmt.Errorf("empty pattern")
+	}
+	if strings.ContainsAny(pattern, " \t\n") {
+		return fmt.Errorf("pattern should not contain spaces")
+	}
+	return nil
+}
+
+// Match tell if the rule apply to the given repository
+func (r IdentityRule) Match(repo repository.RepoCommon) (bool, error) {
+	if strings.HasPrefix(r.Pattern, gitDirPatternPrefix) {
+		pattern := strings.TrimPrefix(r.Pattern, gitDirPatternPrefix)
+		if strings.HasPrefix(pattern, "~/") {
+			u, err := user.Current()
+			if err != nil {
+				return false, err
+			}
+			pattern = u.HomeDir + pattern[1:]
+		}
+		// as with git's includeIf, a trailing "/" match everything inside
+		if strings.HasSuffix(pattern, "/") {
+			pattern += "*"
+		}
+		return matchGlob(pattern, repo.GetPath()), nil
+	}
+
+	remotes, err := repo.GetRemotes()
+	if err != nil {
+		return false, err
+	}
+
+	for _, url := range remotes {
+		if matchGlob(r.Pattern, url) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// matchingIdentityRule return the first identity rule matching the
+// repository, if any
+func matchingIdentityRule(repo repository.RepoCommon) (*IdentityRule, error) {
+	rules, err := ReadIdentityRules(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		match, err := rule.Match(repo)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			return &rule, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func matchGlob(pattern string, str string) bool {
+	quoted := regexp.QuoteMeta(pattern)
+	quoted = strings.Replace(quoted, `\*`, ".*", -1)
+	quoted = strings.Replace(quoted, `\?`, ".", -1)
+
+	r, err := regexp.Compile("^" + quoted + "$")
+	if err != nil {
+		return false
+	}
+
+	return r.MatchString(str)
+}
@@ -0,0 +1,50 @@
+package identity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestMatchGlob(t *testing.T) {
+	assert.True(t, matchGlob("*github.com/MichaelMure/*", "git://github.com/MichaelMure/git-bug"))
+	assert.True(t, matchGlob("git@github.com:acme/*", "git@github.com:acme/project.git"))
+	assert.True(t, matchGlob("/home/rene/work/*", "/home/rene/work/project/.git"))
+	assert.True(t, matchGlob("git-bu?", "git-bug"))
+	assert.False(t, matchGlob("*gitlab.com*", "git://github.com/MichaelMure/git-bug"))
+	assert.False(t, matchGlob("github.com", "git://github.com/MichaelMure/git-bug"))
+}
+
+func TestIdentityRule(t *testing.T) {
+	mockRepo := repository.NewMockRepoForTest()
+
+	adopted := NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, adopted.Commit(mockRepo))
+	require.NoError(t, SetUserIdentity(mockRepo, adopted))
+
+	work := NewIdentity("René Descartes", "rene@acme.com")
+	require.NoError(t, work.Commit(mockRepo))
+
+	// a rule that doesn't match the mock remote
+	require.NoError(t, StoreIdentityRule(mockRepo, "*gitlab.com*", work))
+
+	i, err := GetUserIdentity(mockRepo)
+	require.NoError(t, err)
+	assert.Equal(t, adopted.Id(), i.Id())
+
+	// a rule matching the mock remote takes precedence
+	require.NoError(t, StoreIdentityRule(mockRepo, "*github.com/MichaelMure/*", work))
+
+	rules, err := ReadIdentityRules(mockRepo)
+	require.NoError(t, err)
+	assert.Len(t, rules, 2)
+
+	i, err = GetUserIdentity(mockRepo)
+	require.NoError(t, err)
+	assert.Equal(t, work.Id(), i.Id())
+
+	assert.Error(t, StoreIdentityRule(mockRepo, "gitdir:", work))
+}
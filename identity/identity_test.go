@@ -209,6 +209,45 @@ func TestMetadata(t *testing.T) {
 	assertHasKeyValue(t, loaded.MutableMetadata(), "key1", "value2")
 }
 
+func TestMutate(t *testing.T) {
+	mockRepo := repository.NewMockRepoForTest()
+
+	identity := NewIdentity("René Descartes", "rene.descartes@example.com")
+	err := identity.Commit(mockRepo)
+	assert.NoError(t, err)
+
+	// nothing changed
+	err = identity.Mutate(func(orig Mutator) Mutator { return orig })
+	assert.NoError(t, err)
+	assert.False(t, identity.NeedCommit())
+
+	// invalid
+	err = identity.Mutate(func(orig Mutator) Mutator {
+		orig.Name = "René\nDescartes"
+		return orig
+	})
+	assert.Error(t, err)
+	assert.False(t, identity.NeedCommit())
+
+	err = identity.Mutate(func(orig Mutator) Mutator {
+		orig.Login = "rene"
+		orig.AvatarUrl = "https://example.com/rene.png"
+		return orig
+	})
+	assert.NoError(t, err)
+	assert.True(t, identity.NeedCommit())
+
+	err = identity.Commit(mockRepo)
+	assert.NoError(t, err)
+
+	loaded, err := ReadLocal(mockRepo, identity.id)
+	assert.NoError(t, err)
+	assert.Equal(t, "René Descartes", loaded.Name())
+	assert.Equal(t, "rene.descartes@example.com", loaded.Email())
+	assert.Equal(t, "rene", loaded.Login())
+	assert.Equal(t, "https://example.com/rene.png", loaded.AvatarUrl())
+}
+
 func assertHasKeyValue(t *testing.T, metadata map[string]string, key, value string) {
 	val, ok := metadata[key]
 	assert.True(t, ok)
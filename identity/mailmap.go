@@ -0,0 +1,185 @@
+package identity
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const gitMailmapConfigKey = "mailmap.file"
+const mailmapConfigKey = "git-bug.mailmap.file"
+
+// Mailmap rewrite the names and emails of identities to their canonical
+// version, as described in git's .mailmap files. This allow to present
+// someone that changed email or name over time as a single person.
+//
+// See https://git-scm.com/docs/gitmailmap for the format.
+type Mailmap struct {
+	// commit email --> canonical name/email
+	byEmail map[string]mailmapEntry
+	// commit name + commit email --> canonical name/email
+	byNameEmail map[string]mailmapEntry
+}
+
+type mailmapEntry struct {
+	name  string
+	email string
+}
+
+func NewMailmap() *Mailmap {
+	return &Mailmap{
+		byEmail:     make(map[string]mailmapEntry),
+		byNameEmail: make(map[string]mailmapEntry),
+	}
+}
+
+// ReadMailmap read the mailmap files of a repository. In order, later
+// entries overriding earlier ones:
+// - the .mailmap file at the root of the working tree
+// - the file configured with git's mailmap.file
+// - the file configured with git-bug.mailmap.file
+func ReadMailmap(repo repository.RepoCommon) (*Mailmap, error) {
+	m := NewMailmap()
+
+	var files []string
+
+	// in a non-bare repository, the working tree is the parent of the git dir
+	if filepath.Base(repo.GetPath()) == ".git" {
+		files = append(files, filepath.Join(filepath.Dir(repo.GetPath()), ".mailmap"))
+	}
+
+	for _, key := range []string{gitMailmapConfigKey, mailmapConfigKey} {
+		file, err := repository.ReadConfigAnyScope(repo, key)
+		if err != nil {
+			return nil, err
+		}
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+
+	for _, file := range files {
+		err := m.readFile(file)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+func (m *Mailmap) readFile(path string) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return m.Parse(f)
+}
+
+// Parse read mailmap entries and add them to the Mailmap
+func (m *Mailmap) Parse(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		properName, properEmail, commitName, commitEmail, ok := parseMailmapLine(line)
+		if !ok {
+			// git silently ignore the malformed lines
+			continue
+		}
+
+		entries, key := m.byEmail, strings.ToLower(commitEmail)
+		if commitName != "" {
+			entries, key = m.byNameEmail, mailmapKey(commitName, commitEmail)
+		}
+
+		// as git does, entries for the same commit identity are merged
+		entry := entries[key]
+		if properName != "" {
+			entry.name = properName
+		}
+		if properEmail != "" {
+			entry.email = properEmail
+		}
+		entries[key] = entry
+	}
+
+	return scanner.Err()
+}
+
+// parseMailmapLine parse one of these forms:
+//   Proper Name <commit@email>
+//   <proper@email> <commit@email>
+//   Proper Name <proper@email> <commit@email>
+//   Proper Name <proper@email> Commit Name <commit@email>
+func parseMailmapLine(line string) (properName, properEmail, commitName, commitEmail string, ok bool) {
+	var names, emails []string
+
+	for len(names) < 2 {
+		start := strings.IndexByte(line, '<')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(line[start:], '>')
+		if end < 0 {
+			break
+		}
+		end += start
+
+		names = append(names, strings.TrimSpace(line[:start]))
+		emails = append(emails, strings.TrimSpace(line[start+1:end]))
+		line = line[end+1:]
+	}
+
+	switch len(emails) {
+	case 1:
+		// only the name is replaced
+		return names[0], "", "", emails[0], emails[0] != ""
+	case 2:
+		return names[0], emails[0], names[1], emails[1], emails[1] != ""
+	default:
+		return "", "", "", "", false
+	}
+}
+
+func mailmapKey(name string, email string) string {
+	return strings.ToLower(name) + "\x00" + strings.ToLower(email)
+}
+
+// Map return the canonical name and email for the given ones. Values not
+// covered by the mailmap are returned unchanged.
+func (m *Mailmap) Map(name string, email string) (string, string) {
+	if m == nil || email == "" {
+		return name, email
+	}
+
+	entry, ok := m.byNameEmail[mailmapKey(name, email)]
+	if !ok {
+		entry, ok = m.byEmail[strings.ToLower(email)]
+	}
+	if !ok {
+		return name, email
+	}
+
+	if entry.name != "" {
+		name = entry.name
+	}
+	if entry.email != "" {
+		email = entry.email
+	}
+
+	return name, email
+}
@@ -0,0 +1,49 @@
+package identity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMailmap(t *testing.T) {
+	m := NewMailmap()
+
+	err := m.Parse(strings.NewReader(`
+# comment
+René Descartes <rene@old.fr>
+<rene@descartes.fr> <Rene@Old.fr>
+Isaac Newton <isaac@newton.uk> <isaac@apple.uk>
+Jane Doe <jane@doe.com> J. D. <jd@example.com>
+malformed line
+`))
+	require.NoError(t, err)
+
+	cases := []struct {
+		name, email         string
+		expName, expEmailed string
+	}{
+		// both the name and email entries apply
+		{"rene", "rene@old.fr", "René Descartes", "rene@descartes.fr"},
+		{"Isaac", "isaac@apple.uk", "Isaac Newton", "isaac@newton.uk"},
+		{"j. d.", "jd@example.com", "Jane Doe", "jane@doe.com"},
+		// the name doesn't match
+		{"John", "jd@example.com", "John", "jd@example.com"},
+		{"Unknown", "unknown@example.com", "Unknown", "unknown@example.com"},
+		{"No Email", "", "No Email", ""},
+	}
+
+	for _, c := range cases {
+		name, email := m.Map(c.name, c.email)
+		assert.Equal(t, c.expName, name)
+		assert.Equal(t, c.expEmailed, email)
+	}
+
+	// a nil mailmap doesn't rewrite anything
+	var nilMailmap *Mailmap
+	name, email := nilMailmap.Map("rene", "rene@old.fr")
+	assert.Equal(t, "rene", name)
+	assert.Equal(t, "rene@old.fr", email)
+}
@@ -35,7 +35,18 @@ const bugTitleCommentTemplate = `%s%s
 // BugCreateEditorInput will open the default editor in the terminal with a
 // template for the user to fill. The file is then processed to extract title
 // and message.
+//
+// If no message is provided, the template configured with git-bug.add.template
+// is used instead.
 func BugCreateEditorInput(repo repository.RepoCommon, preTitle string, preMessage string) (string, string, error) {
+	if preMessage == "" {
+		var err error
+		preMessage, err = BugCreateTemplate(repo)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
 	if preMessage != "" {
 		preMessage = "\n\n" + preMessage
 	}
@@ -263,6 +274,11 @@ func launchEditor(repo repository.RepoCommon, fileName string) (string, error) {
 	path := fmt.Sprintf("%s/%s", repo.GetPath(), fileName)
 	defer os.Remove(path)
 
+	err := RequireInteractive("open an editor", "provide the input with the command flags")
+	if err != nil {
+		return "", err
+	}
+
 	editor, err := repo.GetCoreEditor()
 	if err != nil {
 		return "", fmt.Errorf("Unable to detect default git editor: %v\n", err)
@@ -316,6 +332,11 @@ func fromFile(fileName string) (string, error) {
 			return string(output), err
 		}
 
+		err = RequireInteractive("read the message from the terminal", "pipe it to the standard input")
+		if err != nil {
+			return "", err
+		}
+
 		fmt.Printf("(reading comment from standard input)\n")
 		var output bytes.Buffer
 		s := bufio.NewScanner(os.Stdin)
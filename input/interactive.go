@@ -0,0 +1,49 @@
+package input
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/MichaelMure/git-bug/util/term"
+)
+
+// set with the --non-interactive flag
+var nonInteractive bool
+
+// SetNonInteractive forbid any interaction with the user, even if the
+// standard input is a terminal
+func SetNonInteractive(value bool) {
+	nonInteractive = value
+}
+
+// IsInteractive tell if the user can be prompted: the non-interactive mode is
+// not set and the standard input is a terminal.
+func IsInteractive() bool {
+	return !nonInteractive && term.IsTerminal(os.Stdin)
+}
+
+// ErrNonInteractive is returned when an interaction with the user is needed,
+// but not possible
+type ErrNonInteractive struct {
+	// what was about to be done, like "prompt for the name"
+	Action string
+	// an optional hint to provide the value otherwise
+	Hint string
+}
+
+func (e ErrNonInteractive) Error() string {
+	if e.Hint != "" {
+		return fmt.Sprintf("can't %s in non-interactive mode, %s", e.Action, e.Hint)
+	}
+	return fmt.Sprintf("can't %s in non-interactive mode", e.Action)
+}
+
+// RequireInteractive return an ErrNonInteractive if the user can't be
+// prompted, to fail early instead of waiting for an input that will never
+// come.
+func RequireInteractive(action string, hint string) error {
+	if IsInteractive() {
+		return nil
+	}
+	return ErrNonInteractive{Action: action, Hint: hint}
+}
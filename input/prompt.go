@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 	"strings"
+
+	"github.com/MichaelMure/git-bug/util/term"
 )
 
 func PromptValue(name string, preValue string) (string, error) {
@@ -16,6 +18,11 @@ func PromptValueRequired(name string, preValue string) (string, error) {
 }
 
 func promptValue(name string, preValue string, required bool) (string, error) {
+	err := RequireInteractive(fmt.Sprintf("prompt for the %s", strings.ToLower(name)), "")
+	if err != nil {
+		return "", err
+	}
+
 	for {
 		if preValue != "" {
 			_, _ = fmt.Fprintf(os.Stderr, "%s [%s]: ", name, preValue)
@@ -42,3 +49,46 @@ func promptValue(name string, preValue string, required bool) (string, error) {
 		return line, nil
 	}
 }
+
+// PromptPassword ask for a non-empty password without echoing it, twice to
+// confirm it
+func PromptPassword(name string) (string, error) {
+	err := RequireInteractive(fmt.Sprintf("prompt for the %s", strings.ToLower(name)), "")
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		password, err := readPassword(name)
+		if err != nil {
+			return "", err
+		}
+
+		if password == "" {
+			_, _ = fmt.Fprintf(os.Stderr, "%s is empty\n", name)
+			continue
+		}
+
+		confirmation, err := readPassword("Confirm " + strings.ToLower(name))
+		if err != nil {
+			return "", err
+		}
+
+		if confirmation != password {
+			_, _ = fmt.Fprintln(os.Stderr, "the values don't match")
+			continue
+		}
+
+		return password, nil
+	}
+}
+
+func readPassword(name string) (string, error) {
+	_, _ = fmt.Fprintf(os.Stderr, "%s: ", name)
+
+	password, err := term.ReadPassword()
+	// ReadPassword clip the new line entered by the user
+	_, _ = fmt.Fprintln(os.Stderr)
+
+	return password, err
+}
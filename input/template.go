@@ -0,0 +1,139 @@
+package input
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const (
+	// path of a file used to pre-fill the message of a new bug
+	addTemplateConfigKey = "git-bug.add.template"
+	// maximum length of the title of a new bug
+	addTitleMaxLengthConfigKey = "git-bug.add.title-max-length"
+	// comma separated list of the sections required in the message of a new bug
+	addRequiredSectionsConfigKey = "git-bug.add.required-sections"
+)
+
+// ErrInvalidBug is returned when a new bug doesn't follow the rules configured
+// in the repository
+type ErrInvalidBug struct {
+	Problems []string
+}
+
+func (e ErrInvalidBug) Error() string {
+	return fmt.Sprintf("invalid bug: %s", strings.Join(e.Problems, ", "))
+}
+
+// BugCreateTemplate read the template configured for the message of the new
+// bugs, if any. An empty string is returned if there is none.
+func BugCreateTemplate(repo repository.RepoCommon) (string, error) {
+	path, err := repository.ReadConfigAnyScope(repo, addTemplateConfigKey)
+	if err != nil || path == "" {
+		return "", err
+	}
+
+	if strings.HasPrefix(path, "~/") {
+		path = filepath.Join(os.Getenv("HOME"), path[2:])
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("can't read the bug template: %v", err)
+	}
+
+	return strings.TrimRight(string(data), "\n"), nil
+}
+
+// ValidateBug check the title and message of a new bug against the rules
+// configured in the repository:
+//   - git-bug.add.title-max-length: the maximum length of the title
+//   - git-bug.add.required-sections: the lines that must be present in the
+//     message, each followed by some content
+func ValidateBug(repo repository.RepoCommon, title string, message string) error {
+	var problems []string
+
+	rawMax, err := repository.ReadConfigAnyScope(repo, addTitleMaxLengthConfigKey)
+	if err != nil {
+		return err
+	}
+	if rawMax != "" {
+		max, err := strconv.Atoi(rawMax)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", addTitleMaxLengthConfigKey, err)
+		}
+		if length := len([]rune(title)); max > 0 && length > max {
+			problems = append(problems,
+				fmt.Sprintf("the title is too long (%d characters, maximum %d)", length, max))
+		}
+	}
+
+	rawSections, err := repository.ReadConfigAnyScope(repo, addRequiredSectionsConfigKey)
+	if err != nil {
+		return err
+	}
+	var sections []string
+	for _, section := range strings.Split(rawSections, ",") {
+		section = strings.TrimSpace(section)
+		if section != "" {
+			sections = append(sections, section)
+		}
+	}
+	problems = append(problems, checkSections(message, sections)...)
+
+	if len(problems) > 0 {
+		return ErrInvalidBug{Problems: problems}
+	}
+
+	return nil
+}
+
+// checkSections verify that each section is present in the message, as a
+// line of its own, and is followed by some content before the next section.
+func checkSections(message string, sections []string) []string {
+	if len(sections) == 0 {
+		return nil
+	}
+
+	isSection := func(line string) (int, bool) {
+		for i, section := range sections {
+			if strings.EqualFold(line, section) {
+				return i, true
+			}
+		}
+		return 0, false
+	}
+
+	found := make([]bool, len(sections))
+	filled := make([]bool, len(sections))
+	current := -1
+
+	for _, line := range strings.Split(message, "\n") {
+		line = strings.TrimSpace(line)
+		if i, ok := isSection(line); ok {
+			found[i] = true
+			current = i
+			continue
+		}
+		if current >= 0 && line != "" {
+			filled[current] = true
+		}
+	}
+
+	var problems []string
+	for i, section := range sections {
+		switch {
+		case !found[i]:
+			problems = append(problems, fmt.Sprintf("missing section \"%s\"", section))
+		case !filled[i]:
+			problems = append(problems, fmt.Sprintf("empty section \"%s\"", section))
+		}
+	}
+
+	return problems
+}
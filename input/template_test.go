@@ -0,0 +1,65 @@
+package input
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestBugCreateTemplate(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+
+	template, err := BugCreateTemplate(repo)
+	require.NoError(t, err)
+	assert.Equal(t, "", template)
+
+	dir, err := ioutil.TempDir("", "git-bug-template")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := path.Join(dir, "template")
+	require.NoError(t, ioutil.WriteFile(file, []byte("# describe the bug\nSteps to reproduce:\n\n"), 0644))
+	require.NoError(t, repo.LocalConfig().StoreString(addTemplateConfigKey, file))
+
+	template, err = BugCreateTemplate(repo)
+	require.NoError(t, err)
+	assert.Equal(t, "# describe the bug\nSteps to reproduce:", template)
+
+	require.NoError(t, repo.LocalConfig().StoreString(addTemplateConfigKey, path.Join(dir, "missing")))
+	_, err = BugCreateTemplate(repo)
+	assert.Error(t, err)
+}
+
+func TestValidateBug(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+
+	// no rules
+	require.NoError(t, ValidateBug(repo, "a very very long title", ""))
+
+	require.NoError(t, repo.GlobalConfig().StoreString(addTitleMaxLengthConfigKey, "10"))
+	require.NoError(t, repo.LocalConfig().StoreString(addRequiredSectionsConfigKey, "Steps:, Expected:"))
+
+	err := ValidateBug(repo, "a very very long title", "")
+	require.IsType(t, ErrInvalidBug{}, err)
+	assert.Equal(t, []string{
+		"the title is too long (22 characters, maximum 10)",
+		"missing section \"Steps:\"",
+		"missing section \"Expected:\"",
+	}, err.(ErrInvalidBug).Problems)
+
+	err = ValidateBug(repo, "crash", "steps:\n\nExpected:\nno crash\n")
+	require.IsType(t, ErrInvalidBug{}, err)
+	assert.Equal(t, []string{"empty section \"Steps:\""}, err.(ErrInvalidBug).Problems)
+
+	require.NoError(t, ValidateBug(repo, "crash", "Steps:\nrun it\n\nExpected:\nno crash\n"))
+
+	require.NoError(t, repo.GlobalConfig().StoreString(addTitleMaxLengthConfigKey, "ten"))
+	err = ValidateBug(repo, "crash", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,255 @@
+package interchange
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// the kind of operations accepted by Apply
+const (
+	OpNew     = "new"
+	OpComment = "comment"
+	OpTitle   = "title"
+	OpLabel   = "label"
+	OpStatus  = "status"
+)
+
+// Operation is a single change of a bug, as read by Apply from a stream of
+// JSON objects, one per line.
+type Operation struct {
+	Op string `json:"op"`
+
+	// for a new bug, an optional name to refer to it in the following
+	// operations of the same stream
+	Ref string `json:"ref,omitempty"`
+	// the target bug, either an id prefix or the ref of a new bug
+	Bug string `json:"bug,omitempty"`
+
+	// only with the override allowed, default to the user identity and the
+	// current time
+	Author *Person    `json:"author,omitempty"`
+	Time   *time.Time `json:"time,omitempty"`
+
+	Title   string   `json:"title,omitempty"`
+	Message string   `json:"message,omitempty"`
+	Add     []string `json:"add,omitempty"`
+	Remove  []string `json:"remove,omitempty"`
+	Status  string   `json:"status,omitempty"`
+}
+
+// ApplyResult is the outcome of one operation of the stream
+type ApplyResult struct {
+	// the line of the operation, starting at 1
+	Line int `json:"line"`
+	// the ref given to a new bug
+	Ref string `json:"ref,omitempty"`
+	// the bug created or changed
+	Bug entity.Id `json:"bug"`
+}
+
+// Apply read a stream of operations, one JSON object per line, and apply them
+// in order. The empty lines are ignored. The result of each operation is given
+// to the callback as soon as it's applied.
+//
+// Unless allowOverride is true, the operations can't set their author or time
+// and are done by the user identity at the current time.
+//
+// Apply stop at the first invalid operation. The operations already applied
+// are kept.
+func Apply(repo *cache.RepoCache, r io.Reader, allowOverride bool, onResult func(ApplyResult)) error {
+	ap := &applier{
+		importer: importer{
+			repo:       repo,
+			identities: make(map[Person]*cache.IdentityCache),
+		},
+		allowOverride: allowOverride,
+		refs:          make(map[string]*cache.BugCache),
+		changed:       make(map[entity.Id]*cache.BugCache),
+	}
+
+	err := ap.applyAll(r, onResult)
+
+	// commit what has been done, even in case of error
+	for _, b := range ap.changed {
+		commitErr := b.CommitAsNeeded()
+		if err == nil {
+			err = commitErr
+		}
+	}
+
+	return err
+}
+
+type applier struct {
+	importer
+	allowOverride bool
+	// the bugs created in the stream, by ref
+	refs map[string]*cache.BugCache
+	// the bugs with uncommitted operations
+	changed map[entity.Id]*cache.BugCache
+}
+
+func (ap *applier) applyAll(r io.Reader, onResult func(ApplyResult)) error {
+	scanner := bufio.NewScanner(r)
+	// allow long messages
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		raw := strings.TrimSpace(scanner.Text())
+		if raw == "" {
+			continue
+		}
+
+		var op Operation
+		err := json.Unmarshal([]byte(raw), &op)
+		if err != nil {
+			return fmt.Errorf("line %d: %v", line, err)
+		}
+
+		b, err := ap.apply(op)
+		if err != nil {
+			return fmt.Errorf("line %d: %v", line, err)
+		}
+
+		if onResult != nil {
+			onResult(ApplyResult{Line: line, Ref: op.Ref, Bug: b.Id()})
+		}
+	}
+
+	return scanner.Err()
+}
+
+func (ap *applier) apply(op Operation) (*cache.BugCache, error) {
+	author, unixTime, err := ap.authorAndTime(op)
+	if err != nil {
+		return nil, err
+	}
+
+	if op.Op == OpNew {
+		return ap.applyNew(op, author, unixTime)
+	}
+
+	if op.Ref != "" {
+		return nil, fmt.Errorf("a ref can only be given to a new bug")
+	}
+
+	b, err := ap.resolveBug(op.Bug)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case OpComment:
+		if op.Message == "" {
+			return nil, fmt.Errorf("empty message")
+		}
+		_, err = b.AddCommentRaw(author, unixTime, op.Message, nil, nil)
+
+	case OpTitle:
+		if op.Title == "" {
+			return nil, fmt.Errorf("empty title")
+		}
+		_, err = b.SetTitleRaw(author, unixTime, op.Title, nil)
+
+	case OpLabel:
+		if len(op.Add) == 0 && len(op.Remove) == 0 {
+			return nil, fmt.Errorf("no label to add or remove")
+		}
+		_, _, err = b.ChangeLabelsRaw(author, unixTime, op.Add, op.Remove, nil)
+
+	case OpStatus:
+		var status bug.Status
+		status, err = bug.StatusFromString(op.Status)
+		if err != nil {
+			return nil, err
+		}
+		if status == bug.OpenStatus {
+			_, err = b.OpenRaw(author, unixTime, nil)
+		} else {
+			_, err = b.CloseRaw(author, unixTime, nil)
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown operation \"%s\"", op.Op)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	ap.changed[b.Id()] = b
+	return b, nil
+}
+
+func (ap *applier) applyNew(op Operation, author *cache.IdentityCache, unixTime int64) (*cache.BugCache, error) {
+	if op.Bug != "" {
+		return nil, fmt.Errorf("a new bug can't have a target bug")
+	}
+	if op.Ref != "" {
+		if _, ok := ap.refs[op.Ref]; ok {
+			return nil, fmt.Errorf("duplicated ref \"%s\"", op.Ref)
+		}
+	}
+
+	b, _, err := ap.repo.NewBugRaw(author, unixTime, op.Title, op.Message, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if op.Ref != "" {
+		ap.refs[op.Ref] = b
+	}
+
+	return b, nil
+}
+
+// authorAndTime return the author and time of an operation, defaulting to the
+// user identity and the current time
+func (ap *applier) authorAndTime(op Operation) (*cache.IdentityCache, int64, error) {
+	if !ap.allowOverride && (op.Author != nil || op.Time != nil) {
+		return nil, 0, fmt.Errorf("setting the author or time of an operation is not allowed")
+	}
+
+	var author *cache.IdentityCache
+	var err error
+	if op.Author != nil {
+		author, err = ap.ensurePerson(*op.Author)
+	} else {
+		author, err = ap.repo.GetUserIdentity()
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	unixTime := time.Now().Unix()
+	if op.Time != nil {
+		unixTime = op.Time.Unix()
+	}
+
+	return author, unixTime, nil
+}
+
+// resolveBug find the target of an operation, either a bug created earlier in
+// the stream or an existing one
+func (ap *applier) resolveBug(target string) (*cache.BugCache, error) {
+	if target == "" {
+		return nil, fmt.Errorf("missing target bug")
+	}
+
+	if b, ok := ap.refs[target]; ok {
+		return b, nil
+	}
+
+	return ap.repo.ResolveBugPrefix(target)
+}
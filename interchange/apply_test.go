@@ -0,0 +1,74 @@
+package interchange
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestApply(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	user, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(user))
+
+	stream := `{"op": "new", "ref": "crash", "title": "Crash", "message": "it crash"}
+
+{"op": "label", "bug": "crash", "add": ["bug"]}
+{"op": "comment", "bug": "crash", "message": "I can reproduce it.", "author": {"name": "Blaise Pascal"}, "time": "2020-01-03T09:00:00+01:00"}
+`
+
+	// setting the author is not allowed by default
+	var results []ApplyResult
+	err = Apply(backend, strings.NewReader(stream), false, func(result ApplyResult) {
+		results = append(results, result)
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "line 4")
+	require.Len(t, results, 2)
+	require.Equal(t, 1, results[0].Line)
+	require.Equal(t, "crash", results[0].Ref)
+	require.Equal(t, 3, results[1].Line)
+
+	// the operations before the error are kept
+	b, err := backend.ResolveBug(results[0].Bug)
+	require.NoError(t, err)
+	require.False(t, b.NeedCommit())
+	require.Equal(t, []bug.Label{"bug"}, b.Snapshot().Labels)
+
+	stream += `{"op": "status", "bug": "` + b.Id().Human() + `", "status": "closed"}
+`
+
+	results = nil
+	err = Apply(backend, strings.NewReader(stream), true, func(result ApplyResult) {
+		results = append(results, result)
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	// the ref point to the new bug created by this stream
+	require.NotEqual(t, b.Id(), results[2].Bug)
+	require.Equal(t, b.Id(), results[3].Bug)
+	require.Equal(t, bug.ClosedStatus, b.Snapshot().Status)
+
+	b, err = backend.ResolveBug(results[2].Bug)
+	require.NoError(t, err)
+	snap := b.Snapshot()
+	require.Len(t, snap.Comments, 2)
+	require.Equal(t, "Blaise Pascal", snap.Comments[1].Author.Name())
+	require.Equal(t, int64(1578038400), snap.Comments[1].UnixTime.Time().Unix())
+
+	err = Apply(backend, strings.NewReader(`{"op": "rename", "bug": "crash"}`), false, nil)
+	require.Error(t, err)
+}
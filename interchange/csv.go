@@ -0,0 +1,148 @@
+package interchange
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// the columns of the CSV format, in order
+var csvHeader = []string{
+	"id", "title", "status", "labels", "closed_at",
+	"author_name", "author_email", "created_at", "message",
+}
+
+// WriteCSV encode the bugs as CSV, with one line per comment. The first line
+// of a bug hold its body, the following ones its comments. The bug fields are
+// repeated on each line to ease the filtering in a spreadsheet.
+func WriteCSV(w io.Writer, bugs []Bug) error {
+	cw := csv.NewWriter(w)
+
+	err := cw.Write(csvHeader)
+	if err != nil {
+		return err
+	}
+
+	for _, b := range bugs {
+		var closedAt string
+		if b.ClosedAt != nil {
+			closedAt = b.ClosedAt.Format(time.RFC3339)
+		}
+
+		bugFields := []string{b.Id, b.Title, b.Status, strings.Join(b.Labels, ","), closedAt}
+
+		record := append(bugFields,
+			b.Author.Name, b.Author.Email, b.CreatedAt.Format(time.RFC3339), b.Body)
+		err = cw.Write(record)
+		if err != nil {
+			return err
+		}
+
+		for _, comment := range b.Comments {
+			record := append(bugFields[:len(bugFields):len(bugFields)],
+				comment.Author.Name, comment.Author.Email, comment.CreatedAt.Format(time.RFC3339), comment.Message)
+			err = cw.Write(record)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadCSV decode the bugs from the format written by WriteCSV. The columns can
+// be in any order, only title, author_name, created_at and message are
+// required. The consecutive lines with the same non-empty id are the comments
+// of the same bug.
+func ReadCSV(r io.Reader) ([]Bug, error) {
+	cr := csv.NewReader(r)
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+
+	for _, name := range []string{"title", "author_name", "created_at", "message"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing column %s", name)
+		}
+	}
+
+	var bugs []Bug
+
+	for n := 1; ; n++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		get := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return record[i]
+			}
+			return ""
+		}
+
+		author := Person{Name: get("author_name"), Email: get("author_email")}
+
+		createdAt, err := time.Parse(time.RFC3339, get("created_at"))
+		if err != nil {
+			return nil, fmt.Errorf("record %d: invalid created_at: %v", n, err)
+		}
+
+		id := get("id")
+
+		// a comment of the previous bug
+		if id != "" && len(bugs) > 0 && bugs[len(bugs)-1].Id == id {
+			last := &bugs[len(bugs)-1]
+			last.Comments = append(last.Comments, Comment{
+				Author:    author,
+				CreatedAt: createdAt,
+				Message:   get("message"),
+			})
+			continue
+		}
+
+		b := Bug{
+			Id:        id,
+			Title:     get("title"),
+			Status:    get("status"),
+			Author:    author,
+			CreatedAt: createdAt,
+			Body:      get("message"),
+		}
+
+		if labels := get("labels"); labels != "" {
+			for _, label := range strings.Split(labels, ",") {
+				b.Labels = append(b.Labels, strings.TrimSpace(label))
+			}
+		}
+
+		if closedAt := get("closed_at"); closedAt != "" {
+			t, err := time.Parse(time.RFC3339, closedAt)
+			if err != nil {
+				return nil, fmt.Errorf("record %d: invalid closed_at: %v", n, err)
+			}
+			b.ClosedAt = &t
+		}
+
+		bugs = append(bugs, b)
+	}
+
+	return bugs, nil
+}
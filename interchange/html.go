@@ -0,0 +1,95 @@
+package interchange
+
+import (
+	"html/template"
+	"io"
+	"strings"
+)
+
+var htmlFuncs = template.FuncMap{
+	"join": strings.Join,
+	"date": formatDate,
+}
+
+const htmlStyle = `<style>
+body { font-family: sans-serif; max-width: 50em; margin: 2em auto; color: #24292e; }
+table { border-collapse: collapse; width: 100%; }
+th, td { text-align: left; padding: .3em .6em; border-bottom: 1px solid #e1e4e8; }
+.meta { color: #586069; }
+.comment { border: 1px solid #e1e4e8; border-radius: 3px; margin: 1em 0; }
+.comment header { background: #f6f8fa; padding: .5em 1em; border-bottom: 1px solid #e1e4e8; }
+.comment pre { padding: 0 1em; white-space: pre-wrap; font-family: inherit; }
+.status { border-radius: 3px; padding: .1em .4em; color: white; background: #2cbe4e; }
+.status.closed { background: #cb2431; }
+</style>`
+
+var htmlBugTemplate = template.Must(template.New("bug").Funcs(htmlFuncs).Parse(
+	`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+` + htmlStyle + `
+</head>
+<body>
+<h1>{{.Title}} <span class="meta">{{.HumanId}}</span></h1>
+<p>
+<span class="status {{.Status}}">{{.Status}}</span>
+<span class="meta">opened by {{.Author.Name}} on {{date .CreatedAt}}
+{{- if .ClosedAt}}, closed on {{date .ClosedAt}}{{end}}</span>
+</p>
+{{- if .Labels}}
+<p>Labels: {{join .Labels ", "}}</p>
+{{- end}}
+<div class="comment">
+<header><strong>{{.Author.Name}}</strong> <span class="meta">{{date .CreatedAt}}</span></header>
+<pre>{{if .Body}}{{.Body}}{{else}}No description provided.{{end}}</pre>
+</div>
+{{- range .Comments}}
+<div class="comment">
+<header><strong>{{.Author.Name}}</strong> <span class="meta">{{date .CreatedAt}}</span></header>
+<pre>{{.Message}}</pre>
+</div>
+{{- end}}
+<p><a href="index.html">All bugs</a></p>
+</body>
+</html>
+`))
+
+var htmlIndexTemplate = template.Must(template.New("index").Funcs(htmlFuncs).Parse(
+	`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Bugs</title>
+` + htmlStyle + `
+</head>
+<body>
+<h1>Bugs</h1>
+<table>
+<tr><th>Id</th><th>Title</th><th>Status</th><th>Labels</th><th>Author</th><th>Created</th></tr>
+{{- range .}}
+<tr>
+<td><a href="{{.HumanId}}.html">{{.HumanId}}</a></td>
+<td>{{.Title}}</td>
+<td><span class="status {{.Status}}">{{.Status}}</span></td>
+<td>{{join .Labels ", "}}</td>
+<td>{{.Author.Name}}</td>
+<td>{{date .CreatedAt}}</td>
+</tr>
+{{- end}}
+</table>
+</body>
+</html>
+`))
+
+// WriteHTML render a bug and its comments as a standalone HTML page
+func WriteHTML(w io.Writer, b Bug) error {
+	return htmlBugTemplate.Execute(w, b)
+}
+
+// WriteHTMLIndex render a table of the bugs, linking to the pages written by
+// WriteHTML in files named <human id>.html
+func WriteHTMLIndex(w io.Writer, bugs []Bug) error {
+	return htmlIndexTemplate.Execute(w, bugs)
+}
@@ -0,0 +1,152 @@
+package interchange
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// WriteICS render the deadlines of the bugs as an iCalendar, to subscribe to
+// from a calendar application: a VTODO per bug with a due date, and an all-day
+// VEVENT per milestone with a date having some of the bugs.
+//
+// See https://tools.ietf.org/html/rfc5545
+func WriteICS(w io.Writer, bugs []Bug, milestones []cache.MilestoneDate) error {
+	var buf strings.Builder
+
+	icsLine(&buf, "BEGIN:VCALENDAR")
+	icsLine(&buf, "VERSION:2.0")
+	icsLine(&buf, "PRODID:-//git-bug//git-bug//EN")
+	icsLine(&buf, "CALSCALE:GREGORIAN")
+
+	for _, b := range bugs {
+		if b.DueAt == nil {
+			continue
+		}
+
+		icsLine(&buf, "BEGIN:VTODO")
+		icsLine(&buf, "UID:"+b.Id+"@git-bug")
+		icsLine(&buf, "DTSTAMP:"+icsTime(lastChange(b)))
+		icsLine(&buf, "CREATED:"+icsTime(b.CreatedAt))
+		icsLine(&buf, "SUMMARY:"+icsText(b.Title))
+		if b.Body != "" {
+			icsLine(&buf, "DESCRIPTION:"+icsText(b.Body))
+		}
+		if len(b.Labels) > 0 {
+			labels := make([]string, len(b.Labels))
+			for i, label := range b.Labels {
+				labels[i] = icsText(label)
+			}
+			icsLine(&buf, "CATEGORIES:"+strings.Join(labels, ","))
+		}
+		icsLine(&buf, "DUE:"+icsTime(*b.DueAt))
+		if b.Status == bug.ClosedStatus.String() {
+			icsLine(&buf, "STATUS:COMPLETED")
+			if b.ClosedAt != nil {
+				icsLine(&buf, "COMPLETED:"+icsTime(*b.ClosedAt))
+			}
+		} else {
+			icsLine(&buf, "STATUS:NEEDS-ACTION")
+		}
+		icsLine(&buf, "END:VTODO")
+	}
+
+	for _, milestone := range milestones {
+		var inMilestone []Bug
+		for _, b := range bugs {
+			if strings.EqualFold(bugMilestone(b), milestone.Name) {
+				inMilestone = append(inMilestone, b)
+			}
+		}
+		if len(inMilestone) == 0 {
+			continue
+		}
+
+		var stamp time.Time
+		var description strings.Builder
+		for _, b := range inMilestone {
+			if change := lastChange(b); change.After(stamp) {
+				stamp = change
+			}
+			_, _ = fmt.Fprintf(&description, "- %s (%s)\n", b.Title, b.Status)
+		}
+
+		day := milestone.Due.Format("20060102")
+		nextDay := milestone.Due.AddDate(0, 0, 1).Format("20060102")
+
+		icsLine(&buf, "BEGIN:VEVENT")
+		icsLine(&buf, "UID:milestone-"+icsText(milestone.Name)+"@git-bug")
+		icsLine(&buf, "DTSTAMP:"+icsTime(stamp))
+		icsLine(&buf, "SUMMARY:"+icsText("Milestone "+milestone.Name))
+		icsLine(&buf, "DESCRIPTION:"+icsText(description.String()))
+		icsLine(&buf, "DTSTART;VALUE=DATE:"+day)
+		icsLine(&buf, "DTEND;VALUE=DATE:"+nextDay)
+		icsLine(&buf, "TRANSP:TRANSPARENT")
+		icsLine(&buf, "END:VEVENT")
+	}
+
+	icsLine(&buf, "END:VCALENDAR")
+
+	_, err := io.WriteString(w, buf.String())
+	return err
+}
+
+// bugMilestone return the milestone of a bug given by its labels
+func bugMilestone(b Bug) string {
+	labels := make([]bug.Label, len(b.Labels))
+	for i, label := range b.Labels {
+		labels[i] = bug.Label(label)
+	}
+	return bug.LabelsMilestone(labels)
+}
+
+// lastChange return the time of the last known change of a bug, so that the
+// calendar applications notice the changes
+func lastChange(b Bug) time.Time {
+	last := b.CreatedAt
+	if b.ClosedAt != nil && b.ClosedAt.After(last) {
+		last = *b.ClosedAt
+	}
+	for _, comment := range b.Comments {
+		if comment.CreatedAt.After(last) {
+			last = comment.CreatedAt
+		}
+	}
+	return last
+}
+
+func icsTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// icsText escape a text value
+func icsText(text string) string {
+	text = strings.Replace(text, "\\", "\\\\", -1)
+	text = strings.Replace(text, ";", "\\;", -1)
+	text = strings.Replace(text, ",", "\\,", -1)
+	text = strings.Replace(text, "\r\n", "\n", -1)
+	return strings.Replace(text, "\n", "\\n", -1)
+}
+
+// icsLine write a content line, folded after 75 octets without splitting a
+// character, and ended by CRLF
+func icsLine(buf *strings.Builder, line string) {
+	const maxLen = 75
+
+	for len(line) > maxLen {
+		cut := maxLen
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut] + "\r\n")
+		// the continuation lines start with a space, counted in their length
+		line = " " + line[cut:]
+	}
+
+	buf.WriteString(line + "\r\n")
+}
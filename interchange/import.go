@@ -0,0 +1,175 @@
+package interchange
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// metaKeyImportId is the creation metadata storing the id of an imported bug
+// in its source, to not import it twice
+const metaKeyImportId = "interchange-id"
+
+// ImportResult is the outcome of the import of a bug
+type ImportResult struct {
+	// the id in the source
+	SourceId string
+	// the git-bug id
+	Id entity.Id
+	// true if the bug was already imported
+	Skipped bool
+}
+
+// Import create the given bugs in the repository. The authors are matched
+// with the existing identities by name and email, or created as needed. The
+// bugs with an id already imported are skipped.
+func Import(repo *cache.RepoCache, bugs []Bug) ([]ImportResult, error) {
+	im := &importer{
+		repo:       repo,
+		identities: make(map[Person]*cache.IdentityCache),
+	}
+
+	results := make([]ImportResult, 0, len(bugs))
+
+	for i, b := range bugs {
+		result, err := im.importBug(b)
+		if err != nil {
+			return results, fmt.Errorf("bug %d (%s): %v", i+1, b.Title, err)
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+type importer struct {
+	repo       *cache.RepoCache
+	identities map[Person]*cache.IdentityCache
+}
+
+func (im *importer) importBug(b Bug) (ImportResult, error) {
+	result := ImportResult{SourceId: b.Id}
+
+	if b.Id != "" {
+		existing, err := im.repo.ResolveBugCreateMetadata(metaKeyImportId, b.Id)
+		if err == nil {
+			result.Id = existing.Id()
+			result.Skipped = true
+			return result, nil
+		}
+		if _, ok := err.(entity.ErrMultipleMatch); ok {
+			return result, err
+		}
+	}
+
+	status := bug.OpenStatus
+	if b.Status != "" {
+		var err error
+		status, err = bug.StatusFromString(b.Status)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	if b.CreatedAt.IsZero() {
+		return result, fmt.Errorf("missing creation time")
+	}
+
+	author, err := im.ensurePerson(b.Author)
+	if err != nil {
+		return result, err
+	}
+
+	var metadata map[string]string
+	if b.Id != "" {
+		metadata = map[string]string{metaKeyImportId: b.Id}
+	}
+
+	bugCache, _, err := im.repo.NewBugRaw(author, b.CreatedAt.Unix(), b.Title, b.Body, nil, metadata)
+	if err != nil {
+		return result, err
+	}
+	result.Id = bugCache.Id()
+
+	if len(b.Labels) > 0 {
+		_, err = bugCache.ForceChangeLabelsRaw(author, b.CreatedAt.Unix(), b.Labels, nil, nil)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	if b.DueAt != nil {
+		_, err = bugCache.SetDueDateRaw(author, b.CreatedAt.Unix(), *b.DueAt, nil)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	lastTime := b.CreatedAt
+	for _, comment := range b.Comments {
+		commentAuthor, err := im.ensurePerson(comment.Author)
+		if err != nil {
+			return result, err
+		}
+		_, err = bugCache.AddCommentRaw(commentAuthor, comment.CreatedAt.Unix(), comment.Message, nil, nil)
+		if err != nil {
+			return result, err
+		}
+		if comment.CreatedAt.After(lastTime) {
+			lastTime = comment.CreatedAt
+		}
+	}
+
+	if status == bug.ClosedStatus {
+		// the author of the closing is not known, the author of the bug is
+		// used instead
+		closedAt := lastTime
+		if b.ClosedAt != nil {
+			closedAt = *b.ClosedAt
+		}
+		_, err = bugCache.CloseRaw(author, closedAt.Unix(), nil)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	return result, bugCache.CommitAsNeeded()
+}
+
+// ensurePerson return an identity for a person, matching an existing one by
+// name and email, or creating it
+func (im *importer) ensurePerson(p Person) (*cache.IdentityCache, error) {
+	if p.Name == "" {
+		return nil, fmt.Errorf("missing author name")
+	}
+
+	if i, ok := im.identities[p]; ok {
+		return i, nil
+	}
+
+	for _, id := range im.repo.AllIdentityIds() {
+		excerpt, err := im.repo.ResolveIdentityExcerpt(id)
+		if err != nil {
+			return nil, err
+		}
+		if excerpt.Name == p.Name && strings.EqualFold(excerpt.Email, p.Email) {
+			i, err := im.repo.ResolveIdentity(id)
+			if err != nil {
+				return nil, err
+			}
+			im.identities[p] = i
+			return i, nil
+		}
+	}
+
+	i, err := im.repo.NewIdentityRaw(p.Name, p.Email, "", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	im.identities[p] = i
+	return i, nil
+}
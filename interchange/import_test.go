@@ -0,0 +1,44 @@
+package interchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestImport(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	results, err := Import(backend, testBugs())
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.False(t, results[0].Skipped)
+
+	b, err := backend.ResolveBug(results[0].Id)
+	require.NoError(t, err)
+
+	snap := b.Snapshot()
+	require.Equal(t, "Crash on startup", snap.Title)
+	require.Equal(t, bug.ClosedStatus, snap.Status)
+	require.Equal(t, []bug.Label{"bug", "ui"}, snap.Labels)
+	require.Len(t, snap.Comments, 2)
+	require.Equal(t, "René Descartes", snap.Author.Name())
+	require.Equal(t, "Blaise Pascal", snap.Comments[1].Author.Name())
+	require.Equal(t, testBugs()[0].ClosedAt.Unix(), snap.ClosedUnix())
+
+	// importing again skip the bug
+	results, err = Import(backend, testBugs())
+	require.NoError(t, err)
+	require.True(t, results[0].Skipped)
+	require.Len(t, backend.AllBugsIds(), 1)
+	require.Len(t, backend.AllIdentityIds(), 2)
+}
@@ -0,0 +1,118 @@
+// Package interchange define a simple structure to exchange bugs with other
+// tools, and its encoding in JSON, CSV, Markdown, HTML, Org and iCalendar.
+//
+// See doc/interchange.md for the description of the format.
+package interchange
+
+import (
+	"time"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+// Person is the author of a bug or a comment
+type Person struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+// Comment is a comment of a bug, except the first one that is the body of
+// the bug
+type Comment struct {
+	Author    Person    `json:"author"`
+	CreatedAt time.Time `json:"created_at"`
+	Message   string    `json:"message"`
+}
+
+// Bug is a bug with its comments
+type Bug struct {
+	// on export, the git-bug id. On import, any id meaningful for the source,
+	// used to not import twice the same bug.
+	Id        string     `json:"id"`
+	Title     string     `json:"title"`
+	Status    string     `json:"status"`
+	Labels    []string   `json:"labels,omitempty"`
+	Author    Person     `json:"author"`
+	CreatedAt time.Time  `json:"created_at"`
+	ClosedAt  *time.Time `json:"closed_at,omitempty"`
+	DueAt     *time.Time `json:"due_at,omitempty"`
+	Body      string     `json:"body"`
+	Comments  []Comment  `json:"comments,omitempty"`
+}
+
+// FromSnapshot convert a bug to the interchange structure. The labels are
+// resolved with the given label store, which can be nil.
+func FromSnapshot(snap *bug.Snapshot, labels *bug.LabelStore) Bug {
+	b := Bug{
+		Id:        snap.Id().String(),
+		Title:     snap.Title,
+		Status:    snap.Status.String(),
+		Author:    personFromIdentity(snap.Author),
+		CreatedAt: snap.CreatedAt,
+	}
+
+	for _, label := range labels.ResolveAll(snap.Labels) {
+		b.Labels = append(b.Labels, label.String())
+	}
+
+	if closed := snap.ClosedUnix(); closed != 0 {
+		t := time.Unix(closed, 0)
+		b.ClosedAt = &t
+	}
+
+	if !snap.DueDate.IsZero() {
+		due := snap.DueDate
+		b.DueAt = &due
+	}
+
+	for i, comment := range snap.Comments {
+		if i == 0 {
+			b.Body = comment.Message
+			continue
+		}
+
+		b.Comments = append(b.Comments, Comment{
+			Author:    personFromIdentity(comment.Author),
+			CreatedAt: comment.UnixTime.Time(),
+			Message:   comment.Message,
+		})
+	}
+
+	return b
+}
+
+func personFromIdentity(i identity.Interface) Person {
+	name := i.Name()
+	if name == "" {
+		name = i.DisplayName()
+	}
+
+	return Person{
+		Name:  name,
+		Email: i.Email(),
+	}
+}
+
+// HumanId return a shorter version of the id, when it's a git-bug id
+func (b Bug) HumanId() string {
+	if len(b.Id) > 7 {
+		return b.Id[:7]
+	}
+	return b.Id
+}
+
+// formatDate format a time for display, accepting a nil *time.Time
+func formatDate(t interface{}) string {
+	switch t := t.(type) {
+	case time.Time:
+		return t.Format("2006-01-02 15:04")
+	case *time.Time:
+		if t == nil {
+			return ""
+		}
+		return t.Format("2006-01-02 15:04")
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,252 @@
+package interchange
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+func testBugs() []Bug {
+	created := time.Date(2020, 1, 2, 15, 4, 5, 0, time.UTC)
+	closed := created.Add(72 * time.Hour)
+	due := time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	return []Bug{
+		{
+			Id:        "42",
+			Title:     "Crash on startup",
+			Status:    "closed",
+			Labels:    []string{"bug", "ui"},
+			Author:    Person{Name: "René Descartes", Email: "rene@descartes.fr"},
+			CreatedAt: created,
+			ClosedAt:  &closed,
+			DueAt:     &due,
+			Body:      "The application crash",
+			Comments: []Comment{
+				{
+					Author:    Person{Name: "Blaise Pascal"},
+					CreatedAt: created.Add(time.Hour),
+					Message:   "I can reproduce it.\nTwice.",
+				},
+			},
+		},
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, testBugs()))
+
+	expected := `id,title,status,labels,closed_at,author_name,author_email,created_at,message
+42,Crash on startup,closed,"bug,ui",2020-01-05T15:04:05Z,René Descartes,rene@descartes.fr,2020-01-02T15:04:05Z,The application crash
+42,Crash on startup,closed,"bug,ui",2020-01-05T15:04:05Z,Blaise Pascal,,2020-01-02T16:04:05Z,"I can reproduce it.
+Twice."
+`
+	require.Equal(t, expected, buf.String())
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteMarkdown(&buf, testBugs()[0]))
+
+	require.Contains(t, buf.String(), "# Crash on startup")
+	require.Contains(t, buf.String(), "- **Labels:** bug, ui")
+	require.Contains(t, buf.String(), "**Blaise Pascal** commented on 2020-01-02 16:04")
+
+	buf.Reset()
+	require.NoError(t, WriteMarkdownIndex(&buf, testBugs()))
+	require.Contains(t, buf.String(), "| [42](42.md) | Crash on startup | closed | bug, ui |")
+}
+
+func TestWriteOrg(t *testing.T) {
+	bugs := testBugs()
+	bugs[0].Labels = append(bugs[0].Labels, "priority:high")
+	bugs[0].Body = "The application crash\n* not a headline"
+	bugs = append(bugs, Bug{
+		Id:        "43",
+		Title:     "Slow\nsearch",
+		Status:    "open",
+		Author:    Person{Name: "Blaise Pascal"},
+		CreatedAt: bugs[0].CreatedAt,
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteOrg(&buf, bugs))
+
+	expected := `* DONE Crash on startup :bug:ui:priority_high:
+CLOSED: [2020-01-05 Sun 15:04] DEADLINE: <2020-01-10 Fri>
+:PROPERTIES:
+:ID:       42
+:AUTHOR:   René Descartes <rene@descartes.fr>
+:CREATED:  [2020-01-02 Thu 15:04]
+:END:
+The application crash
+,* not a headline
+** Blaise Pascal [2020-01-02 Thu 16:04]
+I can reproduce it.
+Twice.
+* TODO Slow search
+:PROPERTIES:
+:ID:       43
+:AUTHOR:   Blaise Pascal
+:CREATED:  [2020-01-02 Thu 15:04]
+:END:
+`
+	require.Equal(t, expected, buf.String())
+}
+
+func TestWriteICS(t *testing.T) {
+	bugs := testBugs()
+	bugs[0].Labels = append(bugs[0].Labels, "milestone:v1.0")
+	bugs[0].Body = "The application crash, with a very long message that has to be folded in several lines"
+	bugs = append(bugs, Bug{
+		Id:        "43",
+		Title:     "Slow search",
+		Status:    "open",
+		Labels:    []string{"milestone:V1.0"},
+		Author:    Person{Name: "Blaise Pascal"},
+		CreatedAt: bugs[0].CreatedAt,
+	})
+
+	milestones := []cache.MilestoneDate{
+		{Name: "v1.0", Due: time.Date(2020, 3, 1, 23, 59, 59, 0, time.UTC)},
+		{Name: "v2.0", Due: time.Date(2020, 6, 1, 23, 59, 59, 0, time.UTC)},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteICS(&buf, bugs, milestones))
+
+	expected := strings.Replace(`BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//git-bug//git-bug//EN
+CALSCALE:GREGORIAN
+BEGIN:VTODO
+UID:42@git-bug
+DTSTAMP:20200105T150405Z
+CREATED:20200102T150405Z
+SUMMARY:Crash on startup
+DESCRIPTION:The application crash\, with a very long message that has to be
+  folded in several lines
+CATEGORIES:bug,ui,milestone:v1.0
+DUE:20200110T000000Z
+STATUS:COMPLETED
+COMPLETED:20200105T150405Z
+END:VTODO
+BEGIN:VEVENT
+UID:milestone-v1.0@git-bug
+DTSTAMP:20200105T150405Z
+SUMMARY:Milestone v1.0
+DESCRIPTION:- Crash on startup (closed)\n- Slow search (open)\n
+DTSTART;VALUE=DATE:20200301
+DTEND;VALUE=DATE:20200302
+TRANSP:TRANSPARENT
+END:VEVENT
+END:VCALENDAR
+`, "\n", "\r\n", -1)
+	require.Equal(t, expected, buf.String())
+}
+
+func TestWriteHTML(t *testing.T) {
+	b := testBugs()[0]
+	b.Title = "<script>"
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteHTML(&buf, b))
+
+	require.Contains(t, buf.String(), "&lt;script&gt;")
+	require.NotContains(t, buf.String(), "<script>")
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	for _, payload := range []string{
+		"![x](javascript:alert(1))",
+		"![x](JavaScript:alert(1))",
+		"![x](data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==)",
+		"![x](vbscript:msgbox(1))",
+		"![x]( javascript:alert(1))",
+		"[x](javascript:alert(1))",
+		"[x](data:text/html,<script>alert(1)</script>)",
+	} {
+		rendered := RenderMarkdown(payload)
+		require.NotContains(t, rendered, "<img", payload)
+		require.NotContains(t, rendered, "href=", payload)
+		require.Contains(t, rendered, "x", payload)
+	}
+
+	require.Equal(t, "<p><img src=\"https://example.com/a.png\" alt=\"a\"></p>\n",
+		RenderMarkdown("![a](https://example.com/a.png)"))
+	require.Equal(t, "<p><img src=\"/gitfile/abc\" alt=\"a\"></p>\n",
+		RenderMarkdown("![a](/gitfile/abc)"))
+	require.Equal(t, "<p>&lt;b&gt;</p>\n",
+		RenderMarkdown("![<b>](javascript:alert(1))"))
+}
+
+func TestWriteSite(t *testing.T) {
+	hash := "f9e7f3d4c2a1b0e8f7d6c5b4a39281706f5e4d3c"
+
+	b := testBugs()[0]
+	b.Body = "**Crash** <script>alert(1)</script>\n\n![screenshot](/gitfile/" + hash + ")"
+	b.Comments[0].Message = "[log](/repos/backend/gitfile/" + hash + ") [x](javascript:alert(1))"
+
+	require.Equal(t, []string{hash}, SiteFiles(b))
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteSiteBug(&buf, b))
+
+	require.Contains(t, buf.String(), "<strong>Crash</strong>")
+	require.Contains(t, buf.String(), `<img src="../files/`+hash+`" alt="screenshot">`)
+	require.Contains(t, buf.String(), `<a href="../files/`+hash+`"`)
+	require.NotContains(t, buf.String(), "<script>")
+	require.NotContains(t, buf.String(), `href="javascript`)
+
+	open := testBugs()[0]
+	open.Id = "43"
+	open.Status = "open"
+	open.ClosedAt = nil
+
+	buf.Reset()
+	require.NoError(t, WriteSiteIndex(&buf, "Bugs", []Bug{b, open}, false))
+	require.Contains(t, buf.String(), `<a href="bugs/43.html">43</a>`)
+	require.NotContains(t, buf.String(), "bugs/42.html")
+	require.Contains(t, buf.String(), "1 open")
+	require.Contains(t, buf.String(), "1 closed")
+
+	buf.Reset()
+	require.NoError(t, WriteSiteIndex(&buf, "Bugs", []Bug{b, open}, true))
+	require.Contains(t, buf.String(), `<a href="bugs/42.html">42</a>`)
+	require.NotContains(t, buf.String(), "bugs/43.html")
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, testBugs()))
+
+	bugs, err := ReadCSV(&buf)
+	require.NoError(t, err)
+	require.Len(t, bugs, 1)
+
+	expected := testBugs()[0]
+	require.Equal(t, expected.Title, bugs[0].Title)
+	require.Equal(t, expected.Labels, bugs[0].Labels)
+	require.Equal(t, expected.Body, bugs[0].Body)
+	require.True(t, expected.CreatedAt.Equal(bugs[0].CreatedAt))
+	require.True(t, expected.ClosedAt.Equal(*bugs[0].ClosedAt))
+	require.Len(t, bugs[0].Comments, 1)
+	require.Equal(t, expected.Comments[0].Message, bugs[0].Comments[0].Message)
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteJSON(&buf, testBugs()))
+
+	bugs, err := ReadJSON(&buf)
+	require.NoError(t, err)
+	require.Len(t, bugs, 1)
+	require.Equal(t, testBugs()[0].Comments[0].Author, bugs[0].Comments[0].Author)
+	require.True(t, testBugs()[0].DueAt.Equal(*bugs[0].DueAt))
+}
@@ -0,0 +1,25 @@
+package interchange
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// WriteJSON encode the bugs as a JSON array
+func WriteJSON(w io.Writer, bugs []Bug) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "    ")
+	return encoder.Encode(bugs)
+}
+
+// ReadJSON decode the bugs from a JSON array
+func ReadJSON(r io.Reader) ([]Bug, error) {
+	var bugs []Bug
+
+	err := json.NewDecoder(r).Decode(&bugs)
+	if err != nil {
+		return nil, err
+	}
+
+	return bugs, nil
+}
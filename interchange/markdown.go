@@ -0,0 +1,61 @@
+package interchange
+
+import (
+	"io"
+	"strings"
+	"text/template"
+)
+
+var markdownFuncs = template.FuncMap{
+	"join": strings.Join,
+	"date": formatDate,
+	"cell": markdownCell,
+}
+
+var markdownBugTemplate = template.Must(template.New("bug").Funcs(markdownFuncs).Parse(
+	`# {{.Title}}
+
+- **Id:** {{.Id}}
+- **Status:** {{.Status}}
+{{- if .Labels}}
+- **Labels:** {{join .Labels ", "}}
+{{- end}}
+- **Author:** {{.Author.Name}}
+- **Created:** {{date .CreatedAt}}
+{{- if .ClosedAt}}
+- **Closed:** {{date .ClosedAt}}
+{{- end}}
+
+{{if .Body}}{{.Body}}{{else}}_No description provided._{{end}}
+{{range .Comments}}
+---
+
+**{{.Author.Name}}** commented on {{date .CreatedAt}}
+
+{{.Message}}
+{{end}}`))
+
+var markdownIndexTemplate = template.Must(template.New("index").Funcs(markdownFuncs).Parse(
+	`# Bugs
+
+| Id | Title | Status | Labels | Author | Created |
+|----|-------|--------|--------|--------|---------|
+{{range .}}| [{{.HumanId}}]({{.HumanId}}.md) | {{cell .Title}} | {{.Status}} | {{cell (join .Labels ", ")}} | {{cell .Author.Name}} | {{date .CreatedAt}} |
+{{end}}`))
+
+// WriteMarkdown render a bug and its comments as a Markdown document
+func WriteMarkdown(w io.Writer, b Bug) error {
+	return markdownBugTemplate.Execute(w, b)
+}
+
+// WriteMarkdownIndex render a table of the bugs, linking to the documents
+// written by WriteMarkdown in files named <human id>.md
+func WriteMarkdownIndex(w io.Writer, bugs []Bug) error {
+	return markdownIndexTemplate.Execute(w, bugs)
+}
+
+// markdownCell escape a text to be used in a table cell
+func markdownCell(text string) string {
+	text = strings.Replace(text, "|", "\\|", -1)
+	return strings.Replace(text, "\n", " ", -1)
+}
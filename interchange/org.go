@@ -0,0 +1,128 @@
+package interchange
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// WriteOrg render the bugs as Org mode headlines, to be read in the agenda of
+// Emacs: a TODO or DONE headline per bug with the labels as tags, the due date
+// as a deadline, the id and the author as properties, and a sub-headline per
+// comment.
+func WriteOrg(w io.Writer, bugs []Bug) error {
+	for _, b := range bugs {
+		err := writeOrgBug(w, b)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeOrgBug(w io.Writer, b Bug) error {
+	var buf strings.Builder
+
+	state := "TODO"
+	if b.Status == "closed" {
+		state = "DONE"
+	}
+
+	buf.WriteString("* " + state + " " + orgHeadline(b.Title))
+	if tags := orgTags(b.Labels); tags != "" {
+		buf.WriteString(" " + tags)
+	}
+	buf.WriteString("\n")
+
+	var planning []string
+	if b.ClosedAt != nil {
+		planning = append(planning, "CLOSED: "+orgTimestamp(*b.ClosedAt, false, true))
+	}
+	if b.DueAt != nil {
+		planning = append(planning, "DEADLINE: "+orgTimestamp(*b.DueAt, true, false))
+	}
+	if len(planning) > 0 {
+		buf.WriteString(strings.Join(planning, " ") + "\n")
+	}
+
+	buf.WriteString(":PROPERTIES:\n")
+	_, _ = fmt.Fprintf(&buf, ":ID:       %s\n", b.Id)
+	_, _ = fmt.Fprintf(&buf, ":AUTHOR:   %s\n", orgPerson(b.Author))
+	_, _ = fmt.Fprintf(&buf, ":CREATED:  %s\n", orgTimestamp(b.CreatedAt, false, true))
+	buf.WriteString(":END:\n")
+
+	if b.Body != "" {
+		buf.WriteString(orgText(b.Body))
+	}
+
+	for _, comment := range b.Comments {
+		_, _ = fmt.Fprintf(&buf, "** %s %s\n",
+			orgHeadline(comment.Author.Name), orgTimestamp(comment.CreatedAt, false, true))
+		if comment.Message != "" {
+			buf.WriteString(orgText(comment.Message))
+		}
+	}
+
+	_, err := io.WriteString(w, buf.String())
+	return err
+}
+
+// orgHeadline keep a text on a single line
+func orgHeadline(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// orgTags format the labels as the tags of a headline, like :bug:ui:. The
+// characters not allowed in a tag are replaced by underscores.
+func orgTags(labels []string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	tags := make([]string, len(labels))
+	for i, label := range labels {
+		tags[i] = strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_@#%", r) {
+				return r
+			}
+			return '_'
+		}, label)
+	}
+
+	return ":" + strings.Join(tags, ":") + ":"
+}
+
+// orgTimestamp format a time as an active timestamp, shown in the agenda, or
+// an inactive one
+func orgTimestamp(t time.Time, active bool, withTime bool) string {
+	layout := "2006-01-02 Mon"
+	if withTime {
+		layout += " 15:04"
+	}
+
+	if active {
+		return "<" + t.Format(layout) + ">"
+	}
+	return "[" + t.Format(layout) + "]"
+}
+
+func orgPerson(p Person) string {
+	if p.Email == "" {
+		return p.Name
+	}
+	return fmt.Sprintf("%s <%s>", p.Name, p.Email)
+}
+
+// orgText escape the lines of a message that Org would read as headlines or
+// keywords, and end it with a new line
+func orgText(text string) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, "*") || strings.HasPrefix(line, "#+") {
+			lines[i] = "," + line
+		}
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
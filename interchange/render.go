@@ -0,0 +1,55 @@
+package interchange
+
+import (
+	"bytes"
+	"html"
+	"net/url"
+	"strings"
+
+	"github.com/russross/blackfriday"
+)
+
+const markdownExtensions = blackfriday.EXTENSION_NO_INTRA_EMPHASIS |
+	blackfriday.EXTENSION_TABLES |
+	blackfriday.EXTENSION_FENCED_CODE |
+	blackfriday.EXTENSION_AUTOLINK |
+	blackfriday.EXTENSION_STRIKETHROUGH |
+	blackfriday.EXTENSION_SPACE_HEADERS
+
+// the raw HTML of the messages is dropped, not to run scripts in the pages
+const markdownHTMLFlags = blackfriday.HTML_SKIP_HTML |
+	blackfriday.HTML_SKIP_STYLE |
+	blackfriday.HTML_SAFELINK |
+	blackfriday.HTML_NOFOLLOW_LINKS
+
+// RenderMarkdown render a message to HTML, safe to include in a page. The
+// static sites and the clients of the API share it, so that a message is
+// displayed the same everywhere.
+func RenderMarkdown(message string) string {
+	renderer := safeImageRenderer{blackfriday.HtmlRenderer(markdownHTMLFlags, "", "")}
+	return string(blackfriday.Markdown([]byte(message), renderer, markdownExtensions))
+}
+
+// safeImageRenderer only render the images served over http or https, or
+// from the same site: HTML_SAFELINK doesn't apply to the images, which can be
+// javascript: or data: urls
+type safeImageRenderer struct {
+	blackfriday.Renderer
+}
+
+func (r safeImageRenderer) Image(out *bytes.Buffer, link []byte, title []byte, alt []byte) {
+	if !isSafeImage(string(link)) {
+		out.WriteString(html.EscapeString(string(alt)))
+		return
+	}
+	r.Renderer.Image(out, link, title, alt)
+}
+
+func isSafeImage(link string) bool {
+	u, err := url.Parse(strings.TrimSpace(link))
+	if err != nil {
+		return false
+	}
+	// without scheme, a path on the same site
+	return u.Scheme == "" || u.Scheme == "http" || u.Scheme == "https"
+}
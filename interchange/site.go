@@ -0,0 +1,173 @@
+package interchange
+
+import (
+	"html/template"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// SiteBugsDir and SiteFilesDir are the directories of a static site holding
+// the pages of the bugs and the files attached to them
+const (
+	SiteBugsDir  = "bugs"
+	SiteFilesDir = "files"
+)
+
+// the url of a file of the web UI, in the default repository or a named one
+var siteFileRegexp = regexp.MustCompile(`(?:/repos/[a-zA-Z0-9_.-]+)?/gitfile/([0-9a-f]{40}|[0-9a-f]{64})\b`)
+
+var siteFuncs = template.FuncMap{
+	"join":     strings.Join,
+	"date":     formatDate,
+	"markdown": siteMarkdown,
+}
+
+const siteStyle = `<style>
+body { font-family: sans-serif; max-width: 50em; margin: 2em auto; color: #24292e; }
+a { color: #0366d6; text-decoration: none; }
+nav a { margin-right: 1em; }
+nav a.current { font-weight: bold; }
+table { border-collapse: collapse; width: 100%; }
+th, td { text-align: left; padding: .3em .6em; border-bottom: 1px solid #e1e4e8; }
+.meta { color: #586069; }
+.label { border-radius: 3px; padding: .1em .4em; background: #e1e4e8; font-size: .9em; }
+.comment { border: 1px solid #e1e4e8; border-radius: 3px; margin: 1em 0; }
+.comment header { background: #f6f8fa; padding: .5em 1em; border-bottom: 1px solid #e1e4e8; }
+.comment .message { padding: 0 1em; overflow-wrap: break-word; }
+.comment img { max-width: 100%; }
+.comment pre { background: #f6f8fa; padding: .5em; overflow: auto; }
+.status { border-radius: 3px; padding: .1em .4em; color: white; background: #2cbe4e; }
+.status.closed { background: #cb2431; }
+</style>`
+
+var siteBugTemplate = template.Must(template.New("bug").Funcs(siteFuncs).Parse(
+	`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+` + siteStyle + `
+</head>
+<body>
+<nav><a href="../index.html">Open</a><a href="../closed.html">Closed</a></nav>
+<h1>{{.Title}} <span class="meta">{{.HumanId}}</span></h1>
+<p>
+<span class="status {{.Status}}">{{.Status}}</span>
+<span class="meta">opened by {{.Author.Name}} on {{date .CreatedAt}}
+{{- if .ClosedAt}}, closed on {{date .ClosedAt}}{{end}}</span>
+{{- range .Labels}}
+<span class="label">{{.}}</span>
+{{- end}}
+</p>
+<div class="comment">
+<header><strong>{{.Author.Name}}</strong> <span class="meta">{{date .CreatedAt}}</span></header>
+<div class="message">{{if .Body}}{{markdown .Body}}{{else}}<p class="meta">No description provided.</p>{{end}}</div>
+</div>
+{{- range .Comments}}
+<div class="comment">
+<header><strong>{{.Author.Name}}</strong> <span class="meta">{{date .CreatedAt}}</span></header>
+<div class="message">{{markdown .Message}}</div>
+</div>
+{{- end}}
+</body>
+</html>
+`))
+
+var siteIndexTemplate = template.Must(template.New("index").Funcs(siteFuncs).Parse(
+	`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+` + siteStyle + `
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<nav>
+<a href="index.html"{{if not .Closed}} class="current"{{end}}>{{.OpenCount}} open</a>
+<a href="closed.html"{{if .Closed}} class="current"{{end}}>{{.ClosedCount}} closed</a>
+</nav>
+<table>
+<tr><th>Id</th><th>Title</th><th>Labels</th><th>Author</th><th>Created</th></tr>
+{{- range .Bugs}}
+<tr>
+<td><a href="bugs/{{.HumanId}}.html">{{.HumanId}}</a></td>
+<td><a href="bugs/{{.HumanId}}.html">{{.Title}}</a></td>
+<td>{{range .Labels}}<span class="label">{{.}}</span> {{end}}</td>
+<td>{{.Author.Name}}</td>
+<td>{{date .CreatedAt}}</td>
+</tr>
+{{- end}}
+</table>
+</body>
+</html>
+`))
+
+// WriteSiteBug render the page of a bug in a static site, in the SiteBugsDir
+// directory. The messages are rendered from markdown, with the files of the
+// web UI linked in the SiteFilesDir directory.
+func WriteSiteBug(w io.Writer, b Bug) error {
+	return siteBugTemplate.Execute(w, b)
+}
+
+// WriteSiteIndex render the list of the open bugs of a static site, or the
+// closed ones, linking to the pages written by WriteSiteBug
+func WriteSiteIndex(w io.Writer, title string, bugs []Bug, closed bool) error {
+	data := struct {
+		Title       string
+		Closed      bool
+		OpenCount   int
+		ClosedCount int
+		Bugs        []Bug
+	}{
+		Title:  title,
+		Closed: closed,
+	}
+
+	for _, b := range bugs {
+		isClosed := b.Status == "closed"
+		if isClosed {
+			data.ClosedCount++
+		} else {
+			data.OpenCount++
+		}
+		if isClosed == closed {
+			data.Bugs = append(data.Bugs, b)
+		}
+	}
+
+	return siteIndexTemplate.Execute(w, data)
+}
+
+// SiteFiles return the hashes of the files of the web UI referenced in the
+// messages of a bug, to be copied in the SiteFilesDir directory of the site
+func SiteFiles(b Bug) []string {
+	var hashes []string
+	seen := make(map[string]bool)
+
+	messages := []string{b.Body}
+	for _, c := range b.Comments {
+		messages = append(messages, c.Message)
+	}
+
+	for _, message := range messages {
+		for _, match := range siteFileRegexp.FindAllStringSubmatch(message, -1) {
+			if !seen[match[1]] {
+				seen[match[1]] = true
+				hashes = append(hashes, match[1])
+			}
+		}
+	}
+
+	return hashes
+}
+
+// siteMarkdown render a message of a bug page, linking the files of the web
+// UI to their copy in the site
+func siteMarkdown(message string) template.HTML {
+	message = siteFileRegexp.ReplaceAllString(message, "../"+SiteFilesDir+"/$1")
+	return template.HTML(RenderMarkdown(message))
+}
@@ -17,7 +17,7 @@ func main() {
 
 	fmt.Println("Generating Bash completion file ...")
 
-	err := commands.RootCmd.GenBashCompletionFile(dir)
+	err := commands.GenBashCompletionFile(dir)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,24 @@
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path"
+
+	"github.com/MichaelMure/git-bug/commands"
+)
+
+func main() {
+	cwd, _ := os.Getwd()
+	filepath := path.Join(cwd, "misc", "fish_completion", "git-bug")
+
+	fmt.Println("Generating Fish completion file ...")
+
+	err := commands.GenFishCompletionFile(filepath)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
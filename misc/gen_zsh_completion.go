@@ -17,7 +17,7 @@ func main() {
 
 	fmt.Println("Generating ZSH completion file ...")
 
-	err := commands.RootCmd.GenZshCompletionFile(filepath)
+	err := commands.GenZshCompletionFile(filepath)
 	if err != nil {
 		log.Fatal(err)
 	}
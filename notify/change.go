@@ -0,0 +1,170 @@
+package notify
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// Reason is why a user is told about a change
+type Reason string
+
+const (
+	// ReasonWatching is a change of a bug the user participate in, or
+	// matching their query
+	ReasonWatching Reason = "watching"
+	// ReasonAssigned is the assignment of the user to a bug
+	ReasonAssigned Reason = "assigned"
+	// ReasonMentioned is a message mentioning the user with @<login>
+	ReasonMentioned Reason = "mentioned"
+)
+
+// Change is an operation on a bug, as told to the users
+type Change struct {
+	BugId entity.Id `json:"bug_id"`
+	// the title of the bug when the change was found
+	Title  string    `json:"title"`
+	Author string    `json:"author"`
+	Time   time.Time `json:"time"`
+	// what happened, like "commented"
+	Summary string `json:"summary"`
+	// the message of a new or edited comment
+	Message string `json:"message,omitempty"`
+	Reason  Reason `json:"reason"`
+}
+
+// newChange describe an operation, or return false for the operations not
+// worth telling, like the metadata
+func newChange(snap *bug.Snapshot, op bug.Operation) (Change, bool) {
+	change := Change{
+		BugId:  snap.Id(),
+		Title:  snap.Title,
+		Author: op.GetAuthor().DisplayName(),
+		Time:   op.Time(),
+	}
+
+	switch op := op.(type) {
+	case *bug.CreateOperation:
+		change.Summary = "opened the bug"
+		change.Message = op.Message
+
+	case *bug.SetTitleOperation:
+		change.Summary = fmt.Sprintf("changed the title from %q", op.Was)
+
+	case *bug.AddCommentOperation:
+		change.Summary = "commented"
+		change.Message = op.Message
+
+	case *bug.EditCommentOperation:
+		change.Summary = "edited a comment"
+		change.Message = op.Message
+
+	case *bug.SetStatusOperation:
+		if op.Status == bug.ClosedStatus {
+			change.Summary = "closed the bug"
+		} else {
+			change.Summary = "reopened the bug"
+		}
+
+	case *bug.LabelChangeOperation:
+		var changes []string
+		for _, l := range op.Added {
+			changes = append(changes, "+"+l.String())
+		}
+		for _, l := range op.Removed {
+			changes = append(changes, "-"+l.String())
+		}
+		change.Summary = "changed the labels " + strings.Join(changes, " ")
+
+	case *bug.AssigneeChangeOperation:
+		var changes []string
+		for _, i := range op.Added {
+			changes = append(changes, "+"+i.DisplayName())
+		}
+		for _, i := range op.Removed {
+			changes = append(changes, "-"+i.DisplayName())
+		}
+		change.Summary = "changed the assignees " + strings.Join(changes, " ")
+
+	case *bug.SetDueDateOperation:
+		if op.IsClear() {
+			change.Summary = "cleared the due date"
+		} else {
+			change.Summary = "set the due date to " + time.Unix(op.Due, 0).Format("Mon Jan 2 2006")
+		}
+
+	default:
+		return Change{}, false
+	}
+
+	return change, true
+}
+
+// bugURL return the address of a bug in the web UI, or an empty string
+func bugURL(webUIURL string, id entity.Id) string {
+	if webUIURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/bug/%s", webUIURL, id.Human())
+}
+
+// formatChanges write the changes of a bug as text, the messages being
+// indented
+func formatChanges(changes []Change) string {
+	var buf strings.Builder
+
+	for i, change := range changes {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		_, _ = fmt.Fprintf(&buf, "%s %s on %s\n",
+			change.Author, change.Summary, change.Time.Format("Mon Jan 2 15:04:05 2006"))
+
+		if change.Message != "" {
+			buf.WriteString("\n")
+			for _, line := range strings.Split(strings.TrimRight(change.Message, "\n"), "\n") {
+				buf.WriteString(strings.TrimRight("    "+line, " ") + "\n")
+			}
+		}
+	}
+
+	return buf.String()
+}
+
+// groupByBug split changes by bug, in the order of their first change
+func groupByBug(changes []Change) [][]Change {
+	var result [][]Change
+	index := make(map[entity.Id]int)
+
+	for _, change := range changes {
+		i, ok := index[change.BugId]
+		if !ok {
+			i = len(result)
+			index[change.BugId] = i
+			result = append(result, nil)
+		}
+		result[i] = append(result[i], change)
+	}
+
+	return result
+}
+
+// reasonText explain why a user receive a notification
+func reasonText(changes []Change) string {
+	reasons := make(map[Reason]bool)
+	for _, change := range changes {
+		reasons[change.Reason] = true
+	}
+
+	switch {
+	case reasons[ReasonAssigned]:
+		return "You are receiving this because you were assigned."
+	case reasons[ReasonMentioned]:
+		return "You are receiving this because you were mentioned."
+	default:
+		return "You are receiving this because you are watching this bug."
+	}
+}
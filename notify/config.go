@@ -0,0 +1,185 @@
+package notify
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const (
+	smtpConfigKey           = "git-bug.notify.smtp"
+	smtpUsernameConfigKey   = "git-bug.notify.smtp-username"
+	smtpPasswordConfigKey   = "git-bug.notify.smtp-password"
+	fromConfigKey           = "git-bug.notify.from"
+	digestIntervalConfigKey = "git-bug.notify.digest-interval"
+	webUIURLConfigKey       = "git-bug.webui.url"
+
+	// the recipients are configured with git-bug.notify.user.<email>.mode
+	// and git-bug.notify.user.<email>.query
+	userConfigPrefix = "git-bug.notify.user."
+	userModeSuffix   = ".mode"
+	userQuerySuffix  = ".query"
+)
+
+// DefaultDigestInterval is the interval between two digests when none is
+// configured
+const DefaultDigestInterval = 24 * time.Hour
+
+// Mode is how a user receive the notifications
+type Mode string
+
+const (
+	// ModeImmediate send an email for each changed bug
+	ModeImmediate Mode = "immediate"
+	// ModeDigest send an email listing the changes every digest interval
+	ModeDigest Mode = "digest"
+	// ModeNone doesn't send anything
+	ModeNone Mode = "none"
+)
+
+// ParseMode parse the mode of a recipient
+func ParseMode(value string) (Mode, error) {
+	switch mode := Mode(strings.ToLower(strings.TrimSpace(value))); mode {
+	case ModeImmediate, ModeDigest, ModeNone:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown notification mode %s, expected immediate, digest or none", value)
+	}
+}
+
+// Recipient is a user receiving the notifications, matched with an identity
+// by email
+type Recipient struct {
+	Email string
+	Mode  Mode
+	// the query of the bugs watched in addition to the ones the user
+	// participate in, empty if none
+	Query string
+}
+
+// Config is the configuration of the notifications
+type Config struct {
+	// the address of the SMTP server, as host:port. With the port 465 the
+	// connection is encrypted from the start, otherwise with STARTTLS when
+	// the server support it.
+	SMTP     string
+	Username string
+	Password string
+	// the sender of the emails
+	From string
+
+	DigestInterval time.Duration
+
+	// the address of the web UI, to link to the bugs, empty if unknown
+	WebUIURL string
+
+	Recipients []Recipient
+}
+
+// ReadConfig read the configuration of the notifications. The server and the
+// sender are read from the repository or the global configuration, the
+// recipients only from the repository.
+func ReadConfig(repo repository.RepoCommon) (Config, error) {
+	var config Config
+	var err error
+
+	for key, value := range map[string]*string{
+		smtpConfigKey:         &config.SMTP,
+		smtpUsernameConfigKey: &config.Username,
+		smtpPasswordConfigKey: &config.Password,
+		fromConfigKey:         &config.From,
+		webUIURLConfigKey:     &config.WebUIURL,
+	} {
+		*value, err = repository.ReadConfigAnyScope(repo, key)
+		if err != nil {
+			return Config{}, err
+		}
+		*value = strings.TrimSpace(*value)
+	}
+	config.WebUIURL = strings.TrimRight(config.WebUIURL, "/")
+
+	config.DigestInterval = DefaultDigestInterval
+	interval, err := repository.ReadConfigAnyScope(repo, digestIntervalConfigKey)
+	if err != nil {
+		return Config{}, err
+	}
+	if interval = strings.TrimSpace(interval); interval != "" {
+		config.DigestInterval, err = time.ParseDuration(interval)
+		if err != nil {
+			return Config{}, fmt.Errorf("%s: %v", digestIntervalConfigKey, err)
+		}
+	}
+
+	config.Recipients, err = readRecipients(repo)
+	if err != nil {
+		return Config{}, err
+	}
+
+	return config, nil
+}
+
+// Validate check that the emails can be sent
+func (c Config) Validate() error {
+	if c.SMTP == "" {
+		return fmt.Errorf("no SMTP server, set %s", smtpConfigKey)
+	}
+	if c.From == "" {
+		return fmt.Errorf("no sender, set %s", fromConfigKey)
+	}
+	if c.DigestInterval <= 0 {
+		return fmt.Errorf("%s must be positive", digestIntervalConfigKey)
+	}
+	return nil
+}
+
+func readRecipients(repo repository.RepoCommon) ([]Recipient, error) {
+	entries, err := repo.LocalConfig().ReadAll(userConfigPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	byEmail := make(map[string]*Recipient)
+	var emails []string
+
+	recipient := func(email string) *Recipient {
+		r, ok := byEmail[strings.ToLower(email)]
+		if !ok {
+			r = &Recipient{Email: email, Mode: ModeImmediate}
+			byEmail[strings.ToLower(email)] = r
+			emails = append(emails, strings.ToLower(email))
+		}
+		return r
+	}
+
+	for key, value := range entries {
+		if !strings.HasPrefix(key, userConfigPrefix) {
+			continue
+		}
+		rest := strings.TrimPrefix(key, userConfigPrefix)
+
+		switch {
+		case strings.HasSuffix(rest, userModeSuffix):
+			mode, err := ParseMode(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", key, err)
+			}
+			recipient(strings.TrimSuffix(rest, userModeSuffix)).Mode = mode
+		case strings.HasSuffix(rest, userQuerySuffix):
+			recipient(strings.TrimSuffix(rest, userQuerySuffix)).Query = value
+		}
+	}
+
+	sort.Strings(emails)
+
+	var result []Recipient
+	for _, email := range emails {
+		if byEmail[email].Mode != ModeNone {
+			result = append(result, *byEmail[email])
+		}
+	}
+
+	return result, nil
+}
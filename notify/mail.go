@@ -0,0 +1,117 @@
+package notify
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Mailer send the emails
+type Mailer interface {
+	Send(to string, subject string, body string) error
+}
+
+// SMTPMailer send the emails through the SMTP server of the configuration
+type SMTPMailer struct {
+	config Config
+}
+
+func NewSMTPMailer(config Config) *SMTPMailer {
+	return &SMTPMailer{config: config}
+}
+
+func (m *SMTPMailer) Send(to string, subject string, body string) error {
+	from, err := mail.ParseAddress(m.config.From)
+	if err != nil {
+		return fmt.Errorf("invalid sender %s: %v", m.config.From, err)
+	}
+
+	host, port, err := net.SplitHostPort(m.config.SMTP)
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if m.config.Username != "" {
+		auth = smtp.PlainAuth("", m.config.Username, m.config.Password, host)
+	}
+
+	msg := buildMessage(m.config.From, to, subject, body, time.Now())
+
+	// the submission port with an encryption from the start, not supported
+	// by smtp.SendMail
+	if port == "465" {
+		return sendImplicitTLS(m.config.SMTP, host, auth, from.Address, to, msg)
+	}
+
+	return smtp.SendMail(m.config.SMTP, auth, from.Address, []string{to}, msg)
+}
+
+func sendImplicitTLS(addr string, host string, auth smtp.Auth, from string, to string, msg []byte) error {
+	conn, err := tls.Dial("tcp", addr, &tls.Config{ServerName: host})
+	if err != nil {
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if auth != nil {
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// buildMessage write a plain text email, with CRLF line endings
+func buildMessage(from string, to string, subject string, body string, date time.Time) []byte {
+	var buf bytes.Buffer
+
+	header := func(name string, value string) {
+		_, _ = fmt.Fprintf(&buf, "%s: %s\r\n", name, value)
+	}
+
+	header("From", from)
+	header("To", to)
+	header("Subject", mime.QEncoding.Encode("utf-8", subject))
+	header("Date", date.Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "8bit")
+	header("Auto-Submitted", "auto-generated")
+	buf.WriteString("\r\n")
+
+	body = strings.Replace(body, "\r\n", "\n", -1)
+	buf.WriteString(strings.Replace(body, "\n", "\r\n", -1))
+
+	return buf.Bytes()
+}
@@ -0,0 +1,326 @@
+// Package notify tell the users by email about the changes of the bugs they
+// watch: the bugs they participate in or matching their query, and the
+// changes assigning or mentioning them.
+//
+// It also post the local changes and the imports to chat webhooks, from the
+// post-op and post-import hooks of the cache.
+package notify
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// Notifier find the new operations of the bugs, and send them to the
+// recipients interested in them
+type Notifier struct {
+	repo   *cache.RepoCache
+	config Config
+	mailer Mailer
+	state  *state
+
+	queries map[string]*cache.Query
+}
+
+// NewNotifier load the state of the previous runs of the notifications of a
+// repository
+func NewNotifier(repo *cache.RepoCache, config Config, mailer Mailer) (*Notifier, error) {
+	s, err := readState(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := make(map[string]*cache.Query)
+	for _, r := range config.Recipients {
+		if r.Query == "" {
+			continue
+		}
+		query, err := cache.ParseQuery(r.Query)
+		if err != nil {
+			return nil, fmt.Errorf("query of %s: %v", r.Email, err)
+		}
+		queries[r.Email] = query
+	}
+
+	return &Notifier{
+		repo:    repo,
+		config:  config,
+		mailer:  mailer,
+		state:   s,
+		queries: queries,
+	}, nil
+}
+
+// Check queue the operations of the bugs not seen before for the recipients
+// interested in them, and send what is due. The first check only record the
+// existing operations.
+func (n *Notifier) Check(now time.Time) error {
+	first := n.state.Bugs == nil
+	if first {
+		n.state.Bugs = make(map[entity.Id]*bugState)
+	}
+
+	recipients := n.resolveRecipients()
+
+	for _, id := range n.repo.AllBugsIds() {
+		excerpt, err := n.repo.ResolveBugExcerpt(id)
+		if err != nil {
+			return err
+		}
+
+		previous, ok := n.state.Bugs[id]
+		if ok && previous.Head == excerpt.Head {
+			continue
+		}
+
+		b, err := n.repo.ResolveBug(id)
+		if err != nil {
+			return err
+		}
+		snap := b.Snapshot()
+
+		seen := make(map[entity.Id]bool)
+		if previous != nil {
+			for _, opId := range previous.Ops {
+				seen[opId] = true
+			}
+		}
+
+		current := &bugState{Head: excerpt.Head}
+		for _, op := range snap.Operations {
+			current.Ops = append(current.Ops, op.Id())
+			// nothing is sent about the past
+			if first || seen[op.Id()] {
+				continue
+			}
+			change, ok := newChange(snap, op)
+			if !ok {
+				continue
+			}
+			for _, r := range recipients {
+				if reason, ok := n.reason(r, excerpt, op); ok {
+					change.Reason = reason
+					n.enqueue(r.Email, change, now)
+				}
+			}
+		}
+		n.state.Bugs[id] = current
+	}
+
+	err := n.state.write(n.repo)
+	if err != nil {
+		return err
+	}
+
+	return n.Flush(now)
+}
+
+// Flush send the changes queued for the recipients in immediate mode, and
+// the digests due. The changes failing to be sent stay in the queue.
+func (n *Notifier) Flush(now time.Time) error {
+	var firstErr error
+
+	for _, r := range n.config.Recipients {
+		q, ok := n.state.Queues[r.Email]
+		if !ok || len(q.Changes) == 0 {
+			continue
+		}
+
+		var err error
+		switch r.Mode {
+		case ModeImmediate:
+			err = n.sendImmediate(r, q)
+		case ModeDigest:
+			if now.Sub(q.Since) < n.config.DigestInterval {
+				continue
+			}
+			err = n.sendDigest(r, q)
+		}
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("sending to %s: %v", r.Email, err)
+		}
+		if len(q.Changes) == 0 {
+			delete(n.state.Queues, r.Email)
+		}
+	}
+
+	err := n.state.write(n.repo)
+	if err != nil {
+		return err
+	}
+
+	return firstErr
+}
+
+func (n *Notifier) enqueue(email string, change Change, now time.Time) {
+	q, ok := n.state.Queues[email]
+	if !ok {
+		q = &queue{Since: now}
+		n.state.Queues[email] = q
+	}
+	q.Changes = append(q.Changes, change)
+}
+
+// sendImmediate send an email per bug, and remove the sent changes from the
+// queue
+func (n *Notifier) sendImmediate(r Recipient, q *queue) error {
+	var kept []Change
+	var firstErr error
+
+	for _, changes := range groupByBug(q.Changes) {
+		subject := fmt.Sprintf("[git-bug] %s (%s)", changes[len(changes)-1].Title, changes[0].BugId.Human())
+
+		var body strings.Builder
+		body.WriteString(formatChanges(changes))
+		body.WriteString("\n-- \n")
+		if url := bugURL(n.config.WebUIURL, changes[0].BugId); url != "" {
+			body.WriteString(url + "\n")
+		}
+		body.WriteString(reasonText(changes) + "\n")
+
+		err := n.mailer.Send(r.Email, subject, body.String())
+		if err != nil {
+			kept = append(kept, changes...)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	q.Changes = kept
+	return firstErr
+}
+
+// sendDigest send all the queued changes in a single email
+func (n *Notifier) sendDigest(r Recipient, q *queue) error {
+	groups := groupByBug(q.Changes)
+
+	subject := fmt.Sprintf("[git-bug] %d changes in %d bugs", len(q.Changes), len(groups))
+
+	var body strings.Builder
+	for i, changes := range groups {
+		if i > 0 {
+			body.WriteString("\n\n")
+		}
+		last := changes[len(changes)-1]
+		title := fmt.Sprintf("%s (%s)", last.Title, last.BugId.Human())
+		body.WriteString(title + "\n" + strings.Repeat("=", len([]rune(title))) + "\n")
+		if url := bugURL(n.config.WebUIURL, last.BugId); url != "" {
+			body.WriteString(url + "\n")
+		}
+		body.WriteString("\n" + formatChanges(changes))
+	}
+
+	err := n.mailer.Send(r.Email, subject, body.String())
+	if err != nil {
+		return err
+	}
+
+	q.Changes = nil
+	return nil
+}
+
+// resolvedRecipient is a recipient with their identity, if known
+type resolvedRecipient struct {
+	Recipient
+	identity *cache.IdentityExcerpt
+	mention  *regexp.Regexp
+}
+
+func (n *Notifier) resolveRecipients() []resolvedRecipient {
+	result := make([]resolvedRecipient, len(n.config.Recipients))
+
+	for i, r := range n.config.Recipients {
+		result[i].Recipient = r
+
+		for _, id := range n.repo.AllIdentityIds() {
+			excerpt, err := n.repo.ResolveIdentityExcerpt(id)
+			if err != nil || !strings.EqualFold(excerpt.Email, r.Email) {
+				continue
+			}
+			result[i].identity = excerpt
+			result[i].mention = mentionRegexp(excerpt)
+			break
+		}
+	}
+
+	return result
+}
+
+// mentionRegexp match the mentions of an identity, as @ followed by their
+// login or, without login, the part of their email before the @
+func mentionRegexp(i *cache.IdentityExcerpt) *regexp.Regexp {
+	name := i.Login
+	if name == "" {
+		name = strings.SplitN(i.Email, "@", 2)[0]
+	}
+	if name == "" {
+		return nil
+	}
+	return regexp.MustCompile(`(?i)(^|[^\w@.])@` + regexp.QuoteMeta(name) + `\b`)
+}
+
+// reason tell why a recipient is interested in an operation, if they are
+func (n *Notifier) reason(r resolvedRecipient, excerpt *cache.BugExcerpt, op bug.Operation) (Reason, bool) {
+	if r.identity != nil {
+		// nobody wants to be told about their own changes
+		if op.GetAuthor().Id() == r.identity.Id {
+			return "", false
+		}
+
+		if op, ok := op.(*bug.AssigneeChangeOperation); ok {
+			for _, i := range op.Added {
+				if i.Id() == r.identity.Id {
+					return ReasonAssigned, true
+				}
+			}
+		}
+
+		if r.mention != nil && r.mention.MatchString(opMessage(op)) {
+			return ReasonMentioned, true
+		}
+
+		if participate(excerpt, r.identity.Id) {
+			return ReasonWatching, true
+		}
+	}
+
+	if query, ok := n.queries[r.Email]; ok && query.Match(n.repo, excerpt) {
+		return ReasonWatching, true
+	}
+
+	return "", false
+}
+
+// participate tell if an identity authored, acted on or is assigned to a bug
+func participate(excerpt *cache.BugExcerpt, id entity.Id) bool {
+	if excerpt.AuthorId == id {
+		return true
+	}
+	for _, ids := range [][]entity.Id{excerpt.Actors, excerpt.Participants, excerpt.Assignees} {
+		for _, other := range ids {
+			if other == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func opMessage(op bug.Operation) string {
+	switch op := op.(type) {
+	case *bug.CreateOperation:
+		return op.Message
+	case *bug.AddCommentOperation:
+		return op.Message
+	case *bug.EditCommentOperation:
+		return op.Message
+	}
+	return ""
+}
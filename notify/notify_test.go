@@ -0,0 +1,178 @@
+package notify
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+type sentMail struct {
+	to      string
+	subject string
+	body    string
+}
+
+type fakeMailer struct {
+	sent []sentMail
+	fail bool
+}
+
+func (m *fakeMailer) Send(to string, subject string, body string) error {
+	if m.fail {
+		return errors.New("server unavailable")
+	}
+	m.sent = append(m.sent, sentMail{to: to, subject: subject, body: body})
+	return nil
+}
+
+func (m *fakeMailer) reset() {
+	m.sent = nil
+}
+
+func TestNotifier(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.notify.smtp", "localhost:25"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.notify.from", "git-bug <bugs@example.com>"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.notify.digest-interval", "1h"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.notify.user.rene@descartes.fr.mode", "immediate"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.notify.user.isaac@newton.uk.mode", "digest"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.notify.user.isaac@newton.uk.query", "label:physics"))
+
+	config, err := ReadConfig(repo)
+	require.NoError(t, err)
+	require.NoError(t, config.Validate())
+	require.Len(t, config.Recipients, 2)
+
+	repoCache, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer repoCache.Close()
+
+	rene, err := repoCache.NewIdentityFull("René Descartes", "rene@descartes.fr", "rene", "")
+	require.NoError(t, err)
+	isaac, err := repoCache.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+	require.NoError(t, repoCache.SetUserIdentity(isaac))
+
+	old, _, err := repoCache.NewBugRaw(rene, time.Now().Unix(), "old", "before the notifications", nil, nil)
+	require.NoError(t, err)
+
+	mailer := &fakeMailer{}
+	notifier, err := NewNotifier(repoCache, config, mailer)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	// the first check only record the existing operations
+	require.NoError(t, notifier.Check(now))
+	assert.Empty(t, mailer.sent)
+
+	// a comment on a bug rene created
+	_, err = old.AddComment("a new comment")
+	require.NoError(t, err)
+	require.NoError(t, old.Commit())
+	require.NoError(t, notifier.Check(now))
+	require.Len(t, mailer.sent, 1)
+	assert.Equal(t, "rene@descartes.fr", mailer.sent[0].to)
+	assert.Contains(t, mailer.sent[0].subject, "old")
+	assert.Contains(t, mailer.sent[0].body, "Isaac Newton commented")
+	assert.Contains(t, mailer.sent[0].body, "    a new comment")
+	assert.Contains(t, mailer.sent[0].body, "you are watching this bug")
+	mailer.reset()
+
+	// nothing changed
+	require.NoError(t, notifier.Check(now))
+	assert.Empty(t, mailer.sent)
+
+	// a mention in a bug rene doesn't participate in
+	mentioned, _, err := repoCache.NewBug("mention", "what do you think @rene?")
+	require.NoError(t, err)
+	require.NoError(t, notifier.Check(now))
+	require.Len(t, mailer.sent, 1)
+	assert.Contains(t, mailer.sent[0].body, "you were mentioned")
+	mailer.reset()
+
+	// an assignment
+	_, err = mentioned.ChangeAssignees([]*cache.IdentityCache{rene}, nil)
+	require.NoError(t, err)
+	require.NoError(t, mentioned.Commit())
+	require.NoError(t, notifier.Check(now))
+	require.Len(t, mailer.sent, 1)
+	assert.Contains(t, mailer.sent[0].body, "changed the assignees +René Descartes (rene)")
+	assert.Contains(t, mailer.sent[0].body, "you were assigned")
+	mailer.reset()
+
+	// nobody is told about their own changes, but isaac watch the label
+	_, err = old.AddCommentRaw(rene, time.Now().Unix(), "my own comment", nil, nil)
+	require.NoError(t, err)
+	_, _, err = old.ChangeLabelsRaw(rene, time.Now().Unix(), []string{"physics"}, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, old.Commit())
+	require.NoError(t, notifier.Check(now))
+	assert.Empty(t, mailer.sent)
+
+	// the digest of isaac is sent once the interval elapsed
+	later := now.Add(time.Hour)
+	require.NoError(t, notifier.Flush(later))
+	require.Len(t, mailer.sent, 1)
+	assert.Equal(t, "isaac@newton.uk", mailer.sent[0].to)
+	assert.Equal(t, "[git-bug] 2 changes in 1 bugs", mailer.sent[0].subject)
+	assert.Contains(t, mailer.sent[0].body, "René Descartes (rene) commented")
+	assert.Contains(t, mailer.sent[0].body, "René Descartes (rene) changed the labels +physics")
+	mailer.reset()
+
+	// the failed emails are sent again later
+	mailer.fail = true
+	_, err = old.AddComment("another comment")
+	require.NoError(t, err)
+	require.NoError(t, old.Commit())
+	require.Error(t, notifier.Check(later))
+
+	// the state is kept across the runs
+	mailer.fail = false
+	notifier, err = NewNotifier(repoCache, config, mailer)
+	require.NoError(t, err)
+	require.NoError(t, notifier.Flush(later))
+	require.Len(t, mailer.sent, 1)
+	assert.Equal(t, "rene@descartes.fr", mailer.sent[0].to)
+	assert.Contains(t, mailer.sent[0].body, "another comment")
+}
+
+func TestReadConfigRecipients(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.notify.user.b@example.com.query", "status:open"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.notify.user.a@example.com.mode", "digest"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.notify.user.c@example.com.mode", "none"))
+
+	config, err := ReadConfig(repo)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultDigestInterval, config.DigestInterval)
+	assert.Equal(t, []Recipient{
+		{Email: "a@example.com", Mode: ModeDigest},
+		{Email: "b@example.com", Mode: ModeImmediate, Query: "status:open"},
+	}, config.Recipients)
+	assert.Error(t, config.Validate())
+
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.notify.user.a@example.com.mode", "sometimes"))
+	_, err = ReadConfig(repo)
+	assert.Error(t, err)
+}
+
+func TestBuildMessage(t *testing.T) {
+	date := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	msg := string(buildMessage("git-bug <bugs@example.com>", "rene@descartes.fr", "Crash à l'ouverture", "line 1\nline 2\n", date))
+
+	assert.Contains(t, msg, "Subject: =?utf-8?q?Crash_=C3=A0_l'ouverture?=\r\n")
+	assert.Contains(t, msg, "Date: Thu, 02 Jan 2020 03:04:05 +0000\r\n")
+	assert.True(t, strings.HasSuffix(msg, "\r\n\r\nline 1\r\nline 2\r\n"))
+}
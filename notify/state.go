@@ -0,0 +1,71 @@
+package notify
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+// the operations already seen and the changes waiting to be sent
+const stateFile = "notify-state"
+
+func stateFilePath(repo repository.RepoCommon) string {
+	return path.Join(repo.GetPath(), "git-bug", stateFile)
+}
+
+// state is what the notifier remember between two runs
+type state struct {
+	// nil until the first check, which only record the existing operations
+	Bugs map[entity.Id]*bugState `json:"bugs"`
+	// the changes waiting to be sent, by recipient email
+	Queues map[string]*queue `json:"queues"`
+}
+
+// bugState is the version of a bug already seen
+type bugState struct {
+	Head git.Hash    `json:"head"`
+	Ops  []entity.Id `json:"ops"`
+}
+
+// queue is the changes waiting to be sent to a recipient
+type queue struct {
+	// the time of the first change, or of the last digest
+	Since   time.Time `json:"since"`
+	Changes []Change  `json:"changes"`
+}
+
+func readState(repo repository.RepoCommon) (*state, error) {
+	data, err := ioutil.ReadFile(stateFilePath(repo))
+	if os.IsNotExist(err) {
+		return &state{Queues: make(map[string]*queue)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var s state
+	err = json.Unmarshal(data, &s)
+	if err != nil {
+		return nil, err
+	}
+	if s.Queues == nil {
+		s.Queues = make(map[string]*queue)
+	}
+
+	return &s, nil
+}
+
+func (s *state) write(repo repository.RepoCommon) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(stateFilePath(repo), data, 0644)
+}
@@ -0,0 +1,493 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// the webhooks are configured with git-bug.notify.webhook.<name>.<field>
+const webhookConfigPrefix = "git-bug.notify.webhook."
+
+const webhookTimeout = 10 * time.Second
+
+// how long the webhooks read from the config are used, before reading them
+// again for the changes
+const webhooksCacheDuration = 5 * time.Second
+
+func init() {
+	cache.RegisterHookHandler(runWebhooks)
+}
+
+// Event is a kind of change announced by a webhook
+type Event string
+
+const (
+	EventNew     Event = "new"
+	EventComment Event = "comment"
+	EventEdit    Event = "edit"
+	EventStatus  Event = "status"
+	EventLabel   Event = "label"
+	EventAssign  Event = "assign"
+	EventTitle   Event = "title"
+	EventDue     Event = "due"
+	// EventImport is the new bugs of an import, announced together
+	EventImport Event = "import"
+)
+
+var allEvents = []Event{EventNew, EventComment, EventEdit, EventStatus,
+	EventLabel, EventAssign, EventTitle, EventDue, EventImport}
+
+// WebhookFormat is the chat service a webhook post to
+type WebhookFormat string
+
+const (
+	// FormatSlack post {"text": ...}, also understood by Mattermost and
+	// Rocket.Chat
+	FormatSlack WebhookFormat = "slack"
+	// FormatDiscord post {"content": ...}
+	FormatDiscord WebhookFormat = "discord"
+	// FormatMatrix send a m.room.message event with the client-server API,
+	// the url being the send endpoint of the room without the transaction id
+	FormatMatrix WebhookFormat = "matrix"
+)
+
+// the default templates, by event
+const (
+	defaultOperationTemplate = `[{{.Id}}] {{.Title}}: {{.Author}} {{.Summary}}{{with .URL}} {{.}}{{end}}`
+	defaultImportTemplate    = `{{len .Bugs}} bugs imported from {{.Source}}{{range .Bugs}}
+[{{.Id}}] {{.Title}}{{with .URL}} {{.}}{{end}}{{end}}`
+)
+
+// Webhook is a chat channel receiving some changes of the bugs
+type Webhook struct {
+	Name   string
+	URL    string
+	Format WebhookFormat
+	// the access token, for matrix
+	Token string
+	// the events sent, all if empty
+	Events []Event
+	// the bugs announced, all if nil
+	Query    *cache.Query
+	Template *template.Template
+}
+
+// WebhookData is given to the template of the messages. For an import, only
+// Event, Source and Bugs are set.
+type WebhookData struct {
+	Event Event
+	// the change of the bug, with its title, author and summary
+	Change
+	// the human id of the bug
+	Id     string
+	URL    string
+	Status string
+	Labels []string
+
+	Source string
+	Bugs   []WebhookBug
+}
+
+// WebhookBug is an imported bug
+type WebhookBug struct {
+	Id    string
+	Title string
+	URL   string
+}
+
+// ParseEvents parse a comma separated list of events
+func ParseEvents(value string) ([]Event, error) {
+	var result []Event
+
+	for _, s := range strings.Split(value, ",") {
+		s = strings.ToLower(strings.TrimSpace(s))
+		if s == "" {
+			continue
+		}
+		found := false
+		for _, e := range allEvents {
+			if string(e) == s {
+				result = append(result, e)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown event %s", s)
+		}
+	}
+
+	return result, nil
+}
+
+func parseWebhookFormat(value string) (WebhookFormat, error) {
+	switch format := WebhookFormat(strings.ToLower(strings.TrimSpace(value))); format {
+	case "":
+		return FormatSlack, nil
+	case FormatSlack, FormatDiscord, FormatMatrix:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown webhook format %s, expected slack, discord or matrix", value)
+	}
+}
+
+// ReadWebhooks read the webhooks configured in the repository, sorted by name
+func ReadWebhooks(repo repository.RepoCommon) ([]*Webhook, error) {
+	entries, err := repo.LocalConfig().ReadAll(webhookConfigPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]map[string]string)
+	for key, value := range entries {
+		rest := strings.TrimPrefix(key, webhookConfigPrefix)
+		i := strings.LastIndex(rest, ".")
+		if i <= 0 {
+			continue
+		}
+		name, field := rest[:i], rest[i+1:]
+		if fields[name] == nil {
+			fields[name] = make(map[string]string)
+		}
+		fields[name][field] = strings.TrimSpace(value)
+	}
+
+	var names []string
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var result []*Webhook
+	for _, name := range names {
+		w, err := newWebhook(name, fields[name])
+		if err != nil {
+			return nil, fmt.Errorf("webhook %s: %v", name, err)
+		}
+		result = append(result, w)
+	}
+
+	return result, nil
+}
+
+func newWebhook(name string, fields map[string]string) (*Webhook, error) {
+	w := &Webhook{
+		Name:  name,
+		URL:   fields["url"],
+		Token: fields["token"],
+	}
+
+	if w.URL == "" {
+		return nil, fmt.Errorf("no url")
+	}
+
+	var err error
+	w.Format, err = parseWebhookFormat(fields["format"])
+	if err != nil {
+		return nil, err
+	}
+
+	w.Events, err = ParseEvents(fields["events"])
+	if err != nil {
+		return nil, err
+	}
+
+	if fields["query"] != "" {
+		w.Query, err = cache.ParseQuery(fields["query"])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if fields["template"] != "" {
+		w.Template, err = template.New(name).Parse(fields["template"])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return w, nil
+}
+
+// Accept tell if the webhook send an event
+func (w *Webhook) Accept(event Event) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Message render the message of an event
+func (w *Webhook) Message(data WebhookData) (string, error) {
+	tmpl := w.Template
+	if tmpl == nil {
+		text := defaultOperationTemplate
+		if data.Event == EventImport {
+			text = defaultImportTemplate
+		}
+		tmpl = template.Must(template.New(w.Name).Parse(text))
+	}
+
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, data)
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// Send post a message to the chat
+func (w *Webhook) Send(message string) error {
+	method := http.MethodPost
+	url := w.URL
+
+	var payload interface{}
+	switch w.Format {
+	case FormatSlack:
+		payload = map[string]string{"text": message}
+	case FormatDiscord:
+		payload = map[string]string{"content": message}
+	case FormatMatrix:
+		method = http.MethodPut
+		url = fmt.Sprintf("%s/git-bug-%d", strings.TrimRight(url, "/"), time.Now().UnixNano())
+		payload = map[string]string{"msgtype": "m.text", "body": message}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+w.Token)
+	}
+
+	client := &http.Client{Timeout: webhookTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("%s answered %s", w.Name, resp.Status)
+	}
+
+	return nil
+}
+
+// operationEvent return the event of an operation, or false for the
+// operations not announced
+func operationEvent(op bug.Operation) (Event, bool) {
+	switch op.(type) {
+	case *bug.CreateOperation:
+		return EventNew, true
+	case *bug.AddCommentOperation:
+		return EventComment, true
+	case *bug.EditCommentOperation:
+		return EventEdit, true
+	case *bug.SetStatusOperation:
+		return EventStatus, true
+	case *bug.LabelChangeOperation:
+		return EventLabel, true
+	case *bug.AssigneeChangeOperation:
+		return EventAssign, true
+	case *bug.SetTitleOperation:
+		return EventTitle, true
+	case *bug.SetDueDateOperation:
+		return EventDue, true
+	}
+	return "", false
+}
+
+// the webhooks read from the config of each cache, with the url of the web UI
+var webhooksCache = struct {
+	sync.Mutex
+	byRepo map[*cache.RepoCache]*webhooksConfig
+}{byRepo: make(map[*cache.RepoCache]*webhooksConfig)}
+
+type webhooksConfig struct {
+	webhooks []*Webhook
+	webUIURL string
+	readAt   time.Time
+}
+
+// readWebhooksConfig return the webhooks of a cache, read again from the config
+// after a while
+func readWebhooksConfig(repo *cache.RepoCache) (*webhooksConfig, error) {
+	webhooksCache.Lock()
+	defer webhooksCache.Unlock()
+
+	config, ok := webhooksCache.byRepo[repo]
+	if ok && time.Since(config.readAt) < webhooksCacheDuration {
+		return config, nil
+	}
+
+	webhooks, err := ReadWebhooks(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	webUIURL, err := repository.ReadConfigAnyScope(repo, webUIURLConfigKey)
+	if err != nil {
+		return nil, err
+	}
+
+	config = &webhooksConfig{
+		webhooks: webhooks,
+		webUIURL: strings.TrimRight(strings.TrimSpace(webUIURL), "/"),
+		readAt:   time.Now(),
+	}
+	webhooksCache.byRepo[repo] = config
+
+	return config, nil
+}
+
+// runWebhooks send the committed operations and the imports to the webhooks
+// of the repository accepting them. The messages are posted in the
+// background, one at a time.
+func runWebhooks(repo *cache.RepoCache, hook cache.Hook, id entity.Id, data interface{}) error {
+	if hook != cache.HookPostOperation && hook != cache.HookPostImport {
+		return nil
+	}
+
+	config, err := readWebhooksConfig(repo)
+	if err != nil || len(config.webhooks) == 0 {
+		return err
+	}
+
+	switch hook {
+	case cache.HookPostOperation:
+		op, ok := data.(bug.Operation)
+		if !ok {
+			return nil
+		}
+		return sendOperation(repo, config.webhooks, config.webUIURL, id, op)
+	default:
+		summary, ok := data.(cache.ImportSummary)
+		if !ok {
+			return nil
+		}
+		return sendImport(repo, config.webhooks, config.webUIURL, summary)
+	}
+}
+
+func sendOperation(repo *cache.RepoCache, webhooks []*Webhook, webUIURL string, id entity.Id, op bug.Operation) error {
+	event, ok := operationEvent(op)
+	if !ok {
+		return nil
+	}
+
+	b, err := repo.ResolveBug(id)
+	if err != nil {
+		return err
+	}
+	snap := b.Snapshot()
+
+	change, ok := newChange(snap, op)
+	if !ok {
+		return nil
+	}
+
+	excerpt, err := repo.ResolveBugExcerpt(id)
+	if err != nil {
+		return err
+	}
+
+	data := WebhookData{
+		Event:  event,
+		Change: change,
+		Id:     id.Human(),
+		URL:    bugURL(webUIURL, id),
+		Status: snap.Status.String(),
+	}
+	for _, l := range snap.Labels {
+		data.Labels = append(data.Labels, l.String())
+	}
+
+	var firstErr error
+	for _, w := range webhooks {
+		if !w.Accept(event) || (w.Query != nil && !w.Query.Match(repo, excerpt)) {
+			continue
+		}
+		err := send(repo, cache.HookPostOperation, w, data)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+func sendImport(repo *cache.RepoCache, webhooks []*Webhook, webUIURL string, summary cache.ImportSummary) error {
+	var firstErr error
+
+	for _, w := range webhooks {
+		if !w.Accept(EventImport) {
+			continue
+		}
+
+		data := WebhookData{
+			Event:  EventImport,
+			Source: summary.Source,
+		}
+		for _, id := range summary.Bugs {
+			excerpt, err := repo.ResolveBugExcerpt(id)
+			if err != nil {
+				return err
+			}
+			if w.Query != nil && !w.Query.Match(repo, excerpt) {
+				continue
+			}
+			data.Bugs = append(data.Bugs, WebhookBug{
+				Id:    id.Human(),
+				Title: excerpt.Title,
+				URL:   bugURL(webUIURL, id),
+			})
+		}
+		if len(data.Bugs) == 0 {
+			continue
+		}
+
+		err := send(repo, cache.HookPostImport, w, data)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// send render the message right away, and post it in the background
+func send(repo *cache.RepoCache, hook cache.Hook, w *Webhook, data WebhookData) error {
+	message, err := w.Message(data)
+	if err != nil {
+		return fmt.Errorf("webhook %s: %v", w.Name, err)
+	}
+	return repo.RunHookInBackground(hook, func() error {
+		return w.Send(message)
+	})
+}
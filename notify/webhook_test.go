@@ -0,0 +1,133 @@
+package notify
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestWebhooks(t *testing.T) {
+	var received []map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		payload["path"] = r.URL.Path
+		payload["auth"] = r.Header.Get("Authorization")
+		received = append(received, payload)
+	}))
+	defer server.Close()
+
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	config := repo.LocalConfig()
+	require.NoError(t, config.StoreString("git-bug.webui.url", "https://bugs.example.com/"))
+	require.NoError(t, config.StoreString("git-bug.notify.webhook.incidents.url", server.URL+"/slack"))
+	require.NoError(t, config.StoreString("git-bug.notify.webhook.incidents.events", "label, status"))
+	require.NoError(t, config.StoreString("git-bug.notify.webhook.incidents.query", "label:critical"))
+	require.NoError(t, config.StoreString("git-bug.notify.webhook.all.url", server.URL+"/discord"))
+	require.NoError(t, config.StoreString("git-bug.notify.webhook.all.format", "discord"))
+	require.NoError(t, config.StoreString("git-bug.notify.webhook.all.events", "new,comment,import"))
+	require.NoError(t, config.StoreString("git-bug.notify.webhook.all.template", "{{.Event}} {{.Id}} {{.Message}}{{range .Bugs}}{{.Title}}{{end}}"))
+	require.NoError(t, config.StoreString("git-bug.notify.webhook.matrix.url", server.URL+"/matrix/send/m.room.message"))
+	require.NoError(t, config.StoreString("git-bug.notify.webhook.matrix.format", "matrix"))
+	require.NoError(t, config.StoreString("git-bug.notify.webhook.matrix.token", "secret"))
+	require.NoError(t, config.StoreString("git-bug.notify.webhook.matrix.events", "title"))
+
+	webhooks, err := ReadWebhooks(repo)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 3)
+	assert.Equal(t, "all", webhooks[0].Name)
+	assert.Equal(t, FormatDiscord, webhooks[0].Format)
+	assert.Equal(t, "incidents", webhooks[1].Name)
+	assert.Equal(t, FormatSlack, webhooks[1].Format)
+	assert.Equal(t, []Event{EventLabel, EventStatus}, webhooks[1].Events)
+
+	repoCache, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer repoCache.Close()
+
+	rene, err := repoCache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, repoCache.SetUserIdentity(rene))
+
+	b, _, err := repoCache.NewBug("crash", "it crashes")
+	require.NoError(t, err)
+	repoCache.WaitHooks()
+	require.Len(t, received, 1)
+	assert.Equal(t, "/discord", received[0]["path"])
+	assert.Equal(t, "new "+b.Id().Human()+" it crashes", received[0]["content"])
+	received = nil
+
+	// not matching the query of incidents yet
+	_, _, err = b.ChangeLabels([]string{"bug"}, nil)
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+	repoCache.WaitHooks()
+	assert.Empty(t, received)
+
+	// sent once committed
+	_, _, err = b.ChangeLabels([]string{"critical"}, nil)
+	require.NoError(t, err)
+	repoCache.WaitHooks()
+	assert.Empty(t, received)
+	require.NoError(t, b.Commit())
+	repoCache.WaitHooks()
+	require.Len(t, received, 1)
+	assert.Equal(t, "/slack", received[0]["path"])
+	assert.Equal(t, "["+b.Id().Human()+"] crash: René Descartes changed the labels +critical https://bugs.example.com/bug/"+b.Id().Human(), received[0]["text"])
+	received = nil
+
+	_, err = b.SetTitle("crash on startup")
+	require.NoError(t, err)
+	require.NoError(t, b.CommitAsNeeded())
+	repoCache.WaitHooks()
+	require.Len(t, received, 1)
+	assert.Contains(t, received[0]["path"], "/matrix/send/m.room.message/git-bug-")
+	assert.Equal(t, "Bearer secret", received[0]["auth"])
+	assert.Equal(t, "m.text", received[0]["msgtype"])
+	received = nil
+
+	// during an import, only the import is sent
+	repoCache.SetImporting(true)
+	imported, _, err := repoCache.NewBug("imported", "from elsewhere")
+	require.NoError(t, err)
+	_, err = imported.AddComment("imported comment")
+	require.NoError(t, err)
+	require.NoError(t, imported.Commit())
+	repoCache.WaitHooks()
+	assert.Empty(t, received)
+
+	err = repoCache.RunHook(cache.HookPostImport, "", cache.ImportSummary{Source: "github", Bugs: []entity.Id{imported.Id()}})
+	require.NoError(t, err)
+	repoCache.WaitHooks()
+	require.Len(t, received, 1)
+	assert.Equal(t, "import  imported", received[0]["content"])
+}
+
+func TestReadWebhooksErrors(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.notify.webhook.chat.events", "new"))
+	_, err := ReadWebhooks(repo)
+	assert.Error(t, err)
+
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.notify.webhook.chat.url", "https://chat.example.com/hook"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.notify.webhook.chat.events", "new,party"))
+	_, err = ReadWebhooks(repo)
+	assert.Error(t, err)
+
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.notify.webhook.chat.events", "new"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.notify.webhook.chat.format", "irc"))
+	_, err = ReadWebhooks(repo)
+	assert.Error(t, err)
+}
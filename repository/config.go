@@ -34,6 +34,16 @@ type Config interface {
 	// there is zero or more than one entry for this key
 	ReadTimestamp(key string) (time.Time, error)
 
+	// ReadAllValues read all the values of a multi-valued key, like the
+	// refspecs of a remote. There is no error if the key doesn't exist.
+	ReadAllValues(key string) ([]string, error)
+
+	// AddString add a value to a multi-valued key, keeping the existing ones
+	AddString(key, value string) error
+
+	// RemoveValue remove a single value of a multi-valued key
+	RemoveValue(key, value string) error
+
 	// RemoveAll removes all key/value pair matching the key prefix
 	RemoveAll(keyPrefix string) error
 }
@@ -46,3 +56,21 @@ func ParseTimestamp(s string) (time.Time, error) {
 
 	return time.Unix(int64(timestamp), 0), nil
 }
+
+// ReadConfigAnyScope read a config value in the repository config first,
+// then in the global config. An empty string is returned if the key is not
+// set at all.
+func ReadConfigAnyScope(repo RepoCommon, key string) (string, error) {
+	for _, config := range []Config{repo.LocalConfig(), repo.GlobalConfig()} {
+		val, err := config.ReadString(key)
+		if err == ErrNoConfigEntry {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		return val, nil
+	}
+
+	return "", nil
+}
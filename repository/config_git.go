@@ -45,7 +45,8 @@ func (gc *gitConfig) StoreTimestamp(key string, value time.Time) error {
 
 // ReadAll read all key/value pair matching the key prefix
 func (gc *gitConfig) ReadAll(keyPrefix string) (map[string]string, error) {
-	stdout, err := gc.repo.runGitCommand("config", gc.localityFlag, "--get-regexp", keyPrefix)
+	// --null allow keys (in a subsection) and values to contain spaces
+	stdout, err := gc.repo.runGitCommand("config", gc.localityFlag, "--null", "--get-regexp", keyPrefix)
 
 	//   / \
 	//  / ! \
@@ -57,18 +58,18 @@ func (gc *gitConfig) ReadAll(keyPrefix string) (map[string]string, error) {
 		return nil, nil
 	}
 
-	lines := strings.Split(stdout, "\n")
+	entries := strings.Split(stdout, "\x00")
 
-	result := make(map[string]string, len(lines))
+	result := make(map[string]string, len(entries))
 
-	for _, line := range lines {
-		if strings.TrimSpace(line) == "" {
+	for _, entry := range entries {
+		if strings.TrimSpace(entry) == "" {
 			continue
 		}
 
-		parts := strings.Fields(line)
+		parts := strings.SplitN(entry, "\n", 2)
 		if len(parts) != 2 {
-			return nil, fmt.Errorf("bad git config: %s", line)
+			return nil, fmt.Errorf("bad git config: %s", entry)
 		}
 
 		result[parts[0]] = parts[1]
@@ -102,6 +103,30 @@ func (gc *gitConfig) ReadString(key string) (string, error) {
 	return lines[0], nil
 }
 
+func (gc *gitConfig) ReadAllValues(key string) ([]string, error) {
+	stdout, err := gc.repo.runGitCommand("config", gc.localityFlag, "--get-all", key)
+
+	// same problem as ReadString, a missing key can't be distinguished
+	// from a real error
+	if err != nil || stdout == "" {
+		return nil, nil
+	}
+
+	return strings.Split(stdout, "\n"), nil
+}
+
+func (gc *gitConfig) AddString(key string, value string) error {
+	_, err := gc.repo.runGitCommand("config", gc.localityFlag, "--add", key, value)
+	return err
+}
+
+func (gc *gitConfig) RemoveValue(key string, value string) error {
+	// the value is matched with a regex
+	pattern := "^" + regexp.QuoteMeta(value) + "$"
+	_, err := gc.repo.runGitCommand("config", gc.localityFlag, "--unset", key, pattern)
+	return err
+}
+
 func (gc *gitConfig) ReadBool(key string) (bool, error) {
 	val, err := gc.ReadString(key)
 	if err != nil {
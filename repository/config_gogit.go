@@ -0,0 +1,336 @@
+package repository
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	"gopkg.in/src-d/go-git.v4/plumbing/format/config"
+)
+
+var _ Config = &goGitConfig{}
+
+// goGitConfig read and write a git config file directly, the way git does
+type goGitConfig struct {
+	path string
+}
+
+func newGoGitConfig(path string) *goGitConfig {
+	return &goGitConfig{path: path}
+}
+
+// NewFileConfig return a config stored in a file in the git config format,
+// read and written without the git binary
+func NewFileConfig(path string) Config {
+	return newGoGitConfig(path)
+}
+
+// globalConfigPath return the path of the global git config of the user
+func globalConfigPath() string {
+	home, err := homedir.Dir()
+	if err != nil {
+		home = os.Getenv("HOME")
+	}
+	return filepath.Join(home, ".gitconfig")
+}
+
+func (gc *goGitConfig) load() (*config.Config, error) {
+	cfg := config.New()
+
+	data, err := ioutil.ReadFile(gc.path)
+	if os.IsNotExist(err) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = config.NewDecoder(bytes.NewReader(data)).Decode(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func (gc *goGitConfig) save(cfg *config.Config) error {
+	var buf bytes.Buffer
+
+	for _, section := range cfg.Sections {
+		if len(section.Options) > 0 {
+			_, _ = fmt.Fprintf(&buf, "[%s]\n", section.Name)
+			writeConfigOptions(&buf, section.Options)
+		}
+		for _, subsection := range section.Subsections {
+			if len(subsection.Options) == 0 {
+				continue
+			}
+			_, _ = fmt.Fprintf(&buf, "[%s \"%s\"]\n", section.Name, escapeConfigString(subsection.Name))
+			writeConfigOptions(&buf, subsection.Options)
+		}
+	}
+
+	return ioutil.WriteFile(gc.path, buf.Bytes(), 0644)
+}
+
+func writeConfigOptions(buf *bytes.Buffer, options config.Options) {
+	for _, option := range options {
+		value := escapeConfigString(option.Value)
+		// quote the values that git would otherwise trim or cut at a comment
+		if value != strings.TrimSpace(value) || strings.ContainsAny(value, "#;") {
+			value = `"` + value + `"`
+		}
+		_, _ = fmt.Fprintf(buf, "\t%s = %s\n", option.Key, value)
+	}
+}
+
+func escapeConfigString(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`).Replace(s)
+}
+
+// splitKey split a key into its section, subsection and name, the subsection
+// being everything between the first and the last dot
+func splitKey(key string) (section, subsection, name string, err error) {
+	first := strings.Index(key, ".")
+	last := strings.LastIndex(key, ".")
+	if first < 0 {
+		return "", "", "", fmt.Errorf("key does not contain a section: %s", key)
+	}
+
+	section = key[:first]
+	name = key[last+1:]
+	if first != last {
+		subsection = key[first+1 : last]
+	}
+
+	return section, subsection, name, nil
+}
+
+// sectionOptions return the options of a key section, and a function to
+// replace them
+func sectionOptions(cfg *config.Config, section string, subsection string) (config.Options, func(config.Options)) {
+	for _, s := range cfg.Sections {
+		if !s.IsName(section) {
+			continue
+		}
+		if subsection == "" {
+			return s.Options, func(o config.Options) { s.Options = o }
+		}
+		for _, ss := range s.Subsections {
+			if ss.IsName(subsection) {
+				return ss.Options, func(o config.Options) { ss.Options = o }
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// StoreString store a single key/value pair in the config of the repo
+func (gc *goGitConfig) StoreString(key string, value string) error {
+	section, subsection, name, err := splitKey(key)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := gc.load()
+	if err != nil {
+		return err
+	}
+
+	if subsection == "" {
+		cfg.Section(section).RemoveOption(name).AddOption(name, value)
+	} else {
+		cfg.Section(section).Subsection(subsection).RemoveOption(name).AddOption(name, value)
+	}
+
+	return gc.save(cfg)
+}
+
+func (gc *goGitConfig) StoreBool(key string, value bool) error {
+	return gc.StoreString(key, strconv.FormatBool(value))
+}
+
+func (gc *goGitConfig) StoreTimestamp(key string, value time.Time) error {
+	return gc.StoreString(key, strconv.Itoa(int(value.Unix())))
+}
+
+// ReadAll read all key/value pair matching the key prefix
+func (gc *goGitConfig) ReadAll(keyPrefix string) (map[string]string, error) {
+	r, err := regexp.Compile(keyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := gc.load()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+
+	// like git, the section and the names are given in lower case
+	add := func(prefix string, options config.Options) {
+		for _, option := range options {
+			key := prefix + "." + strings.ToLower(option.Key)
+			if r.MatchString(key) {
+				result[key] = option.Value
+			}
+		}
+	}
+
+	for _, section := range cfg.Sections {
+		add(strings.ToLower(section.Name), section.Options)
+		for _, subsection := range section.Subsections {
+			add(strings.ToLower(section.Name)+"."+subsection.Name, subsection.Options)
+		}
+	}
+
+	return result, nil
+}
+
+func (gc *goGitConfig) ReadString(key string) (string, error) {
+	values, err := gc.ReadAllValues(key)
+	if err != nil {
+		return "", err
+	}
+
+	if len(values) == 0 {
+		return "", ErrNoConfigEntry
+	}
+	if len(values) > 1 {
+		return "", ErrMultipleConfigEntry
+	}
+
+	return values[0], nil
+}
+
+func (gc *goGitConfig) ReadAllValues(key string) ([]string, error) {
+	section, subsection, name, err := splitKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := gc.load()
+	if err != nil {
+		return nil, err
+	}
+
+	opts, _ := sectionOptions(cfg, section, subsection)
+
+	return opts.GetAll(name), nil
+}
+
+func (gc *goGitConfig) AddString(key string, value string) error {
+	section, subsection, name, err := splitKey(key)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := gc.load()
+	if err != nil {
+		return err
+	}
+
+	cfg.AddOption(section, subsection, name, value)
+
+	return gc.save(cfg)
+}
+
+func (gc *goGitConfig) RemoveValue(key string, value string) error {
+	section, subsection, name, err := splitKey(key)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := gc.load()
+	if err != nil {
+		return err
+	}
+
+	opts, set := sectionOptions(cfg, section, subsection)
+
+	var kept config.Options
+	for _, option := range opts {
+		if !option.IsKey(name) || option.Value != value {
+			kept = append(kept, option)
+		}
+	}
+
+	if len(kept) == len(opts) {
+		return fmt.Errorf("no value %s for the key %s", value, key)
+	}
+
+	set(kept)
+
+	return gc.save(cfg)
+}
+
+func (gc *goGitConfig) ReadBool(key string) (bool, error) {
+	val, err := gc.ReadString(key)
+	if err != nil {
+		return false, err
+	}
+
+	return strconv.ParseBool(val)
+}
+
+func (gc *goGitConfig) ReadTimestamp(key string) (time.Time, error) {
+	value, err := gc.ReadString(key)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return ParseTimestamp(value)
+}
+
+// RemoveAll remove all key/value pair matching the key prefix, which is
+// either a key or a section
+func (gc *goGitConfig) RemoveAll(keyPrefix string) error {
+	cfg, err := gc.load()
+	if err != nil {
+		return err
+	}
+
+	// a key
+	section, subsection, name, err := splitKey(keyPrefix)
+	if err == nil {
+		opts, set := sectionOptions(cfg, section, subsection)
+		if len(opts.GetAll(name)) > 0 {
+			var kept config.Options
+			for _, option := range opts {
+				if !option.IsKey(name) {
+					kept = append(kept, option)
+				}
+			}
+			set(kept)
+			return gc.save(cfg)
+		}
+	}
+
+	// a section
+	split := strings.SplitN(keyPrefix, ".", 2)
+	if len(split) == 1 {
+		for _, s := range cfg.Sections {
+			if s.IsName(split[0]) && len(s.Options) > 0 {
+				// the subsections are separate sections for git
+				s.Options = nil
+				return gc.save(cfg)
+			}
+		}
+	} else {
+		opts, _ := sectionOptions(cfg, split[0], split[1])
+		if len(opts) > 0 {
+			cfg.RemoveSubsection(split[0], split[1])
+			return gc.save(cfg)
+		}
+	}
+
+	return fmt.Errorf("no config entry or section for %s", keyPrefix)
+}
@@ -8,10 +8,18 @@ import (
 
 var _ Config = &memConfig{}
 
+// memConfig is a config kept in memory, the multiple values of a key being
+// separated by a new line
 type memConfig struct {
 	config map[string]string
 }
 
+// NewMemConfig return an empty config kept in memory, for example to isolate
+// the tests from the config of the user
+func NewMemConfig() Config {
+	return newMemConfig(make(map[string]string))
+}
+
 func newMemConfig(config map[string]string) *memConfig {
 	return &memConfig{config: config}
 }
@@ -40,20 +48,21 @@ func (mc *memConfig) ReadAll(keyPrefix string) (map[string]string, error) {
 }
 
 func (mc *memConfig) ReadString(key string) (string, error) {
-	// unlike git, the mock can only store one value for the same key
 	val, ok := mc.config[key]
 	if !ok {
 		return "", ErrNoConfigEntry
 	}
+	if strings.Contains(val, "\n") {
+		return "", ErrMultipleConfigEntry
+	}
 
 	return val, nil
 }
 
 func (mc *memConfig) ReadBool(key string) (bool, error) {
-	// unlike git, the mock can only store one value for the same key
-	val, ok := mc.config[key]
-	if !ok {
-		return false, ErrNoConfigEntry
+	val, err := mc.ReadString(key)
+	if err != nil {
+		return false, err
 	}
 
 	return strconv.ParseBool(val)
@@ -73,6 +82,40 @@ func (mc *memConfig) ReadTimestamp(key string) (time.Time, error) {
 	return time.Unix(int64(timestamp), 0), nil
 }
 
+// the multiple values of a key are separated by a new line
+
+func (mc *memConfig) ReadAllValues(key string) ([]string, error) {
+	val, ok := mc.config[key]
+	if !ok {
+		return nil, nil
+	}
+	return strings.Split(val, "\n"), nil
+}
+
+func (mc *memConfig) AddString(key, value string) error {
+	if existing, ok := mc.config[key]; ok {
+		mc.config[key] = existing + "\n" + value
+		return nil
+	}
+	return mc.StoreString(key, value)
+}
+
+func (mc *memConfig) RemoveValue(key, value string) error {
+	values, _ := mc.ReadAllValues(key)
+	kept := values[:0]
+	for _, v := range values {
+		if v != value {
+			kept = append(kept, v)
+		}
+	}
+	if len(kept) == 0 {
+		delete(mc.config, key)
+	} else {
+		mc.config[key] = strings.Join(kept, "\n")
+	}
+	return nil
+}
+
 // RmConfigs remove all key/value pair matching the key prefix
 func (mc *memConfig) RemoveAll(keyPrefix string) error {
 	for key := range mc.config {
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadConfigAnyScope(t *testing.T) {
+	repo := NewMockRepoForTest()
+
+	val, err := ReadConfigAnyScope(repo, "section.key")
+	require.NoError(t, err)
+	assert.Equal(t, "", val)
+
+	require.NoError(t, repo.GlobalConfig().StoreString("section.key", "global"))
+	val, err = ReadConfigAnyScope(repo, "section.key")
+	require.NoError(t, err)
+	assert.Equal(t, "global", val)
+
+	require.NoError(t, repo.LocalConfig().StoreString("section.key", "local"))
+	val, err = ReadConfigAnyScope(repo, "section.key")
+	require.NoError(t, err)
+	assert.Equal(t, "local", val)
+}
@@ -7,7 +7,11 @@ import (
 	"io"
 	"os/exec"
 	"path"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -26,24 +30,55 @@ var (
 )
 
 var _ ClockedRepo = &GitRepo{}
+var _ GitConfigRepo = &GitRepo{}
 
 // GitRepo represents an instance of a (local) git repository.
 type GitRepo struct {
 	Path        string
 	createClock *lamport.Persisted
 	editClock   *lamport.Persisted
+
+	// the ref updates delayed until the end of a batch
+	batchMutex sync.Mutex
+	batchDepth int
+	batchRefs  map[string]git.Hash
+
+	// the config storages replacing the ones of git, if any
+	localConfig  Config
+	globalConfig Config
 }
 
 // LocalConfig give access to the repository scoped configuration
 func (repo *GitRepo) LocalConfig() Config {
+	if repo.localConfig != nil {
+		return repo.localConfig
+	}
 	return newGitConfig(repo, false)
 }
 
 // GlobalConfig give access to the git global configuration
 func (repo *GitRepo) GlobalConfig() Config {
+	if repo.globalConfig != nil {
+		return repo.globalConfig
+	}
 	return newGitConfig(repo, true)
 }
 
+// GitConfig give access to the repository scoped git config, even when the
+// configuration is replaced with SetConfig
+func (repo *GitRepo) GitConfig() Config {
+	return newGitConfig(repo, false)
+}
+
+// SetConfig replace the storage of the repository scoped configuration and
+// of the global one, a nil Config keeping the one of git. Only the settings
+// of git-bug are affected, git still reads its own ones like user.name or the
+// remotes, which are written with GitConfig.
+func (repo *GitRepo) SetConfig(local Config, global Config) {
+	repo.localConfig = local
+	repo.globalConfig = global
+}
+
 // Run the given git command with the given I/O reader/writers, returning an error if it fails.
 func (repo *GitRepo) runGitCommandWithIO(stdin io.Reader, stdout, stderr io.Writer, args ...string) error {
 	repopath := repo.Path
@@ -106,7 +141,11 @@ func NewGitRepo(path string, witnesser Witnesser) (*GitRepo, error) {
 		return nil, ErrNotARepo
 	}
 
-	// Fix the path to be sure we are at the root
+	// Fix the path to be sure we are at the root. The git dir is relative
+	// to the given path when it's the root of the work tree.
+	if !filepath.IsAbs(stdout) {
+		stdout = filepath.Join(path, stdout)
+	}
 	repo.Path = stdout
 
 	err = repo.LoadClocks()
@@ -197,6 +236,10 @@ func (repo *GitRepo) GetRemotes() (map[string]string, error) {
 	remotes := make(map[string]string, len(lines))
 
 	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
 		elements := strings.Fields(line)
 		if len(elements) != 3 {
 			return nil, fmt.Errorf("unexpected output format: %s", line)
@@ -210,6 +253,10 @@ func (repo *GitRepo) GetRemotes() (map[string]string, error) {
 
 // FetchRefs fetch git refs from a remote
 func (repo *GitRepo) FetchRefs(remote, refSpec string) (string, error) {
+	if err := repo.flushRefs(); err != nil {
+		return "", err
+	}
+
 	stdout, err := repo.runGitCommand("fetch", remote, refSpec)
 
 	if err != nil {
@@ -220,8 +267,13 @@ func (repo *GitRepo) FetchRefs(remote, refSpec string) (string, error) {
 }
 
 // PushRefs push git refs to a remote
-func (repo *GitRepo) PushRefs(remote string, refSpec string) (string, error) {
-	stdout, stderr, err := repo.runGitCommandRaw(nil, "push", remote, refSpec)
+func (repo *GitRepo) PushRefs(remote string, refSpecs ...string) (string, error) {
+	if err := repo.flushRefs(); err != nil {
+		return "", err
+	}
+
+	args := append([]string{"push", remote}, refSpecs...)
+	stdout, stderr, err := repo.runGitCommandRaw(nil, args...)
 
 	if err != nil {
 		return stdout + stderr, fmt.Errorf("failed to push to the remote '%s': %v", remote, stderr)
@@ -229,6 +281,25 @@ func (repo *GitRepo) PushRefs(remote string, refSpec string) (string, error) {
 	return stdout + stderr, nil
 }
 
+// ListRemoteRefs return the refs of a remote starting with the given prefix,
+// as they are on the remote right now
+func (repo *GitRepo) ListRemoteRefs(remote string, refPrefix string) ([]string, error) {
+	stdout, err := repo.runGitCommand("ls-remote", "--refs", remote, refPrefix+"*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the refs of the remote '%s': %v", remote, err)
+	}
+
+	var refs []string
+	for _, line := range strings.Split(stdout, "\n") {
+		split := strings.Split(line, "\t")
+		if len(split) == 2 && strings.HasPrefix(split[1], refPrefix) {
+			refs = append(refs, split[1])
+		}
+	}
+
+	return refs, nil
+}
+
 // StoreData will store arbitrary data and return the corresponding hash
 func (repo *GitRepo) StoreData(data []byte) (git.Hash, error) {
 	var stdin = bytes.NewReader(data)
@@ -290,13 +361,97 @@ func (repo *GitRepo) StoreCommitWithParent(treeHash git.Hash, parent git.Hash) (
 
 // UpdateRef will create or update a Git reference
 func (repo *GitRepo) UpdateRef(ref string, hash git.Hash) error {
+	repo.batchMutex.Lock()
+	if repo.batchDepth > 0 {
+		repo.batchRefs[ref] = hash
+		repo.batchMutex.Unlock()
+		return nil
+	}
+	repo.batchMutex.Unlock()
+
 	_, err := repo.runGitCommand("update-ref", ref, string(hash))
 
 	return err
 }
 
+// BeginBatch start delaying the ref updates until the matching EndBatch.
+// The refs are still read as if they were updated.
+func (repo *GitRepo) BeginBatch() {
+	repo.batchMutex.Lock()
+	defer repo.batchMutex.Unlock()
+
+	if repo.batchDepth == 0 {
+		repo.batchRefs = make(map[string]git.Hash)
+	}
+	repo.batchDepth++
+}
+
+// EndBatch write the ref updates delayed since BeginBatch in a single
+// transaction, then pack the objects and the refs written meanwhile, instead
+// of leaving them as many loose files
+func (repo *GitRepo) EndBatch() error {
+	repo.batchMutex.Lock()
+	defer repo.batchMutex.Unlock()
+
+	if repo.batchDepth == 0 {
+		return fmt.Errorf("no batch to end")
+	}
+
+	repo.batchDepth--
+	if repo.batchDepth > 0 {
+		return nil
+	}
+
+	err := repo.flushRefsLocked()
+	repo.batchRefs = nil
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.runGitCommand("repack", "-d", "-q")
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.runGitCommand("pack-refs", "--all")
+
+	return err
+}
+
+// flushRefs write the ref updates delayed by a batch, before git read the refs
+func (repo *GitRepo) flushRefs() error {
+	repo.batchMutex.Lock()
+	defer repo.batchMutex.Unlock()
+
+	return repo.flushRefsLocked()
+}
+
+func (repo *GitRepo) flushRefsLocked() error {
+	if len(repo.batchRefs) == 0 {
+		return nil
+	}
+
+	var stdin bytes.Buffer
+	for ref, hash := range repo.batchRefs {
+		_, _ = fmt.Fprintf(&stdin, "update %s %s\n", ref, hash)
+	}
+
+	_, err := repo.runGitCommandWithStdin(&stdin, "update-ref", "--stdin")
+	if err != nil {
+		return err
+	}
+
+	repo.batchRefs = make(map[string]git.Hash)
+
+	return nil
+}
+
 // ListRefs will return a list of Git ref matching the given refspec
 func (repo *GitRepo) ListRefs(refspec string) ([]string, error) {
+	if err := repo.flushRefs(); err != nil {
+		return nil, err
+	}
+
 	stdout, err := repo.runGitCommand("for-each-ref", "--format=%(refname)", refspec)
 
 	if err != nil {
@@ -312,8 +467,43 @@ func (repo *GitRepo) ListRefs(refspec string) ([]string, error) {
 	return split, nil
 }
 
+// ResolveRefs will return the Git refs matching the given refspec, with
+// the hash of the commit they point to
+func (repo *GitRepo) ResolveRefs(refspec string) (map[string]git.Hash, error) {
+	if err := repo.flushRefs(); err != nil {
+		return nil, err
+	}
+
+	stdout, err := repo.runGitCommand("for-each-ref", "--format=%(objectname) %(refname)", refspec)
+
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make(map[string]git.Hash)
+
+	for _, line := range strings.Split(stdout, "\n") {
+		if line == "" {
+			continue
+		}
+
+		split := strings.SplitN(line, " ", 2)
+		if len(split) != 2 {
+			return nil, fmt.Errorf("unexpected output of git for-each-ref: %s", line)
+		}
+
+		refs[split[1]] = git.Hash(split[0])
+	}
+
+	return refs, nil
+}
+
 // RefExist will check if a reference exist in Git
 func (repo *GitRepo) RefExist(ref string) (bool, error) {
+	if err := repo.flushRefs(); err != nil {
+		return false, err
+	}
+
 	stdout, err := repo.runGitCommand("for-each-ref", ref)
 
 	if err != nil {
@@ -325,13 +515,32 @@ func (repo *GitRepo) RefExist(ref string) (bool, error) {
 
 // CopyRef will create a new reference with the same value as another one
 func (repo *GitRepo) CopyRef(source string, dest string) error {
+	if err := repo.flushRefs(); err != nil {
+		return err
+	}
+
 	_, err := repo.runGitCommand("update-ref", dest, source)
 
 	return err
 }
 
+// RemoveRef will delete a Git reference
+func (repo *GitRepo) RemoveRef(ref string) error {
+	if err := repo.flushRefs(); err != nil {
+		return err
+	}
+
+	_, err := repo.runGitCommand("update-ref", "-d", ref)
+
+	return err
+}
+
 // ListCommits will return the list of commit hashes of a ref, in chronological order
 func (repo *GitRepo) ListCommits(ref string) ([]git.Hash, error) {
+	if err := repo.flushRefs(); err != nil {
+		return nil, err
+	}
+
 	stdout, err := repo.runGitCommand("rev-list", "--first-parent", "--reverse", ref)
 
 	if err != nil {
@@ -382,6 +591,127 @@ func (repo *GitRepo) GetTreeHash(commit git.Hash) (git.Hash, error) {
 	return git.Hash(stdout), nil
 }
 
+// Repack will pack the git objects and prune the unreachable ones
+func (repo *GitRepo) Repack() error {
+	_, err := repo.runGitCommand("gc", "--quiet")
+
+	return err
+}
+
+// ReadCommitSigner return the fingerprint of the key used to sign a commit,
+// or an empty string if the commit is not signed. A signature that gpg
+// doesn't find good give ErrInvalidSignature. The signatures of keys with an
+// unknown trust are accepted, the trust being given by the keys declared in
+// the identities.
+func (repo *GitRepo) ReadCommitSigner(commit git.Hash) (string, error) {
+	stdout, err := repo.runGitCommand("show", "-s", "--format=%G?%n%GF", string(commit))
+
+	if err != nil {
+		return "", err
+	}
+
+	lines := strings.SplitN(stdout, "\n", 2)
+	switch lines[0] {
+	case "N":
+		return "", nil
+	case "G", "U":
+		if len(lines) < 2 || lines[1] == "" {
+			return "", ErrInvalidSignature
+		}
+		return lines[1], nil
+	default:
+		return "", ErrInvalidSignature
+	}
+}
+
+// ReadCommits return the commits of the source code given by a revision,
+// like HEAD, or by a range, like ORIG_HEAD..HEAD, the newest first
+func (repo *GitRepo) ReadCommits(revisions string) ([]Commit, error) {
+	// --no-walk keep a single revision from being walked, not a range
+	stdout, err := repo.runGitCommand(append(logCommitsArgs, "--no-walk", revisions, "--")...)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseLogCommits(stdout), nil
+}
+
+// ReadReachableCommits return the commits reachable from the given heads but
+// not from the excluded ones, the newest first
+func (repo *GitRepo) ReadReachableCommits(heads []git.Hash, excluded []git.Hash) ([]Commit, error) {
+	if len(heads) == 0 {
+		return nil, nil
+	}
+
+	// the revisions are given on stdin, as there can be many
+	var revisions bytes.Buffer
+	for _, head := range heads {
+		fmt.Fprintln(&revisions, head)
+	}
+	for _, hash := range excluded {
+		fmt.Fprintf(&revisions, "^%s\n", hash)
+	}
+
+	stdout, err := repo.runGitCommandWithStdin(&revisions, append(logCommitsArgs, "--stdin")...)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseLogCommits(stdout), nil
+}
+
+// the git log printing the commits as parsed by parseLogCommits: each commit
+// start with \x01, followed by its fields separated by \x00 and the changed
+// files, one per line
+var logCommitsArgs = []string{
+	"-c", "core.quotePath=false", "log", "--name-only",
+	"--format=%x01%H%x00%an <%ae>%x00%at%x00%B%x00",
+}
+
+func parseLogCommits(stdout string) []Commit {
+	var result []Commit
+
+	for _, entry := range strings.Split(stdout, "\x01") {
+		fields := strings.SplitN(entry, "\x00", 5)
+		if len(fields) < 5 {
+			continue
+		}
+
+		unixTime, _ := strconv.ParseInt(fields[2], 10, 64)
+
+		commit := Commit{
+			Hash:    git.Hash(strings.TrimSpace(fields[0])),
+			Author:  fields[1],
+			Time:    time.Unix(unixTime, 0),
+			Message: strings.TrimSpace(fields[3]),
+		}
+		for _, file := range strings.Split(fields[4], "\n") {
+			if file = strings.TrimSpace(file); file != "" {
+				commit.Files = append(commit.Files, file)
+			}
+		}
+
+		result = append(result, commit)
+	}
+
+	return result
+}
+
+// GetHooksPath return the directory of the git hooks
+func (repo *GitRepo) GetHooksPath() (string, error) {
+	stdout, err := repo.runGitCommand("rev-parse", "--git-path", "hooks")
+	if err != nil {
+		return "", err
+	}
+
+	// relative to the directory git was run in
+	if !filepath.IsAbs(stdout) && repo.Path != ".git" {
+		stdout = filepath.Join(repo.Path, stdout)
+	}
+
+	return stdout, nil
+}
+
 // AddRemote add a new remote to the repository
 // Not in the interface because it's only used for testing
 func (repo *GitRepo) AddRemote(name string, url string) error {
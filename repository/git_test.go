@@ -2,15 +2,28 @@
 package repository
 
 import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/util/git"
 )
 
 func TestConfig(t *testing.T) {
 	repo := CreateTestRepo(false)
 	defer CleanupTestRepos(t, repo)
 
+	testConfig(t, repo)
+}
+
+func testConfig(t *testing.T, repo Repo) {
 	err := repo.LocalConfig().StoreString("section.key", "value")
 	assert.NoError(t, err)
 
@@ -65,3 +78,276 @@ func TestConfig(t *testing.T) {
 	err = repo.LocalConfig().RemoveAll("section.key")
 	assert.Error(t, err)
 }
+
+func TestConfigMultipleValues(t *testing.T) {
+	repo := CreateTestRepo(false)
+	defer CleanupTestRepos(t, repo)
+
+	configs := []Config{
+		repo.LocalConfig(),
+		NewMemConfig(),
+		NewFileConfig(path.Join(repo.GetPath(), "other-config")),
+	}
+
+	for _, config := range configs {
+		values, err := config.ReadAllValues("remote.origin.push")
+		assert.NoError(t, err)
+		assert.Empty(t, values)
+
+		assert.NoError(t, config.AddString("remote.origin.push", "refs/bugs/*"))
+		assert.NoError(t, config.AddString("remote.origin.push", "HEAD"))
+
+		values, err = config.ReadAllValues("remote.origin.push")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"refs/bugs/*", "HEAD"}, values)
+
+		_, err = config.ReadString("remote.origin.push")
+		assert.Equal(t, ErrMultipleConfigEntry, err)
+
+		assert.NoError(t, config.RemoveValue("remote.origin.push", "refs/bugs/*"))
+
+		values, err = config.ReadAllValues("remote.origin.push")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"HEAD"}, values)
+	}
+}
+
+func TestSetConfig(t *testing.T) {
+	repo := CreateTestRepo(false)
+	defer CleanupTestRepos(t, repo)
+
+	globalPath := path.Join(repo.GetPath(), "global-config")
+	repo.SetConfig(NewMemConfig(), NewFileConfig(globalPath))
+
+	assert.NoError(t, repo.LocalConfig().StoreString("git-bug.key", "local"))
+	assert.NoError(t, repo.GlobalConfig().StoreString("git-bug.key", "global"))
+
+	val, err := repo.LocalConfig().ReadString("git-bug.key")
+	assert.NoError(t, err)
+	assert.Equal(t, "local", val)
+
+	val, err = NewFileConfig(globalPath).ReadString("git-bug.key")
+	assert.NoError(t, err)
+	assert.Equal(t, "global", val)
+
+	// git itself didn't see anything
+	_, err = newGitConfig(repo, false).ReadString("git-bug.key")
+	assert.Equal(t, ErrNoConfigEntry, err)
+
+	repo.SetConfig(nil, nil)
+	_, err = repo.LocalConfig().ReadString("git-bug.key")
+	assert.Equal(t, ErrNoConfigEntry, err)
+}
+
+func TestBatch(t *testing.T) {
+	repo := CreateTestRepo(false)
+	defer CleanupTestRepos(t, repo)
+
+	blob, err := repo.StoreData([]byte("data"))
+	assert.NoError(t, err)
+	tree, err := repo.StoreTree([]TreeEntry{{ObjectType: Blob, Hash: blob, Name: "data"}})
+	assert.NoError(t, err)
+	commit1, err := repo.StoreCommit(tree)
+	assert.NoError(t, err)
+	commit2, err := repo.StoreCommitWithParent(tree, commit1)
+	assert.NoError(t, err)
+
+	repo.BeginBatch()
+	repo.BeginBatch()
+
+	assert.NoError(t, repo.UpdateRef("refs/bugs/a", commit1))
+	assert.NoError(t, repo.UpdateRef("refs/bugs/b", commit1))
+	assert.NoError(t, repo.UpdateRef("refs/bugs/b", commit2))
+
+	// delayed until read
+	_, err = os.Stat(path.Join(repo.GetPath(), "refs/bugs/a"))
+	assert.True(t, os.IsNotExist(err))
+
+	commits, err := repo.ListCommits("refs/bugs/b")
+	assert.NoError(t, err)
+	assert.Equal(t, []git.Hash{commit1, commit2}, commits)
+
+	assert.NoError(t, repo.UpdateRef("refs/bugs/c", commit2))
+
+	// the outer batch is still running
+	assert.NoError(t, repo.EndBatch())
+	_, err = os.Stat(path.Join(repo.GetPath(), "refs/bugs/c"))
+	assert.True(t, os.IsNotExist(err))
+
+	assert.NoError(t, repo.EndBatch())
+
+	refs, err := repo.ResolveRefs("refs/bugs/")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]git.Hash{
+		"refs/bugs/a": commit1,
+		"refs/bugs/b": commit2,
+		"refs/bugs/c": commit2,
+	}, refs)
+
+	// packed refs and objects
+	_, err = os.Stat(path.Join(repo.GetPath(), "refs/bugs/c"))
+	assert.True(t, os.IsNotExist(err))
+	count, err := repo.runGitCommand("count-objects")
+	assert.NoError(t, err)
+	assert.Equal(t, "0 objects, 0 kilobytes", count)
+
+	// back to the direct updates
+	assert.NoError(t, repo.UpdateRef("refs/bugs/d", commit1))
+	_, err = os.Stat(path.Join(repo.GetPath(), "refs/bugs/d"))
+	assert.NoError(t, err)
+
+	assert.Error(t, repo.EndBatch())
+}
+
+func TestReadCommits(t *testing.T) {
+	repo := CreateTestRepo(false)
+	defer CleanupTestRepos(t, repo)
+
+	for _, msg := range []string{"first", "second\n\nCloses-bug: 1234567", "third"} {
+		_, err := repo.runGitCommand("--work-tree", path.Dir(repo.GetPath()), "commit", "--allow-empty", "-m", msg)
+		require.NoError(t, err)
+	}
+
+	// the same repository, accessed with go-git
+	goGitRepo, err := NewGoGitRepo(path.Dir(repo.GetPath()), func(ClockedRepo) error { return nil })
+	require.NoError(t, err)
+
+	for _, r := range []Repo{repo, goGitRepo} {
+		commits, err := r.ReadCommits("HEAD")
+		require.NoError(t, err)
+		require.Len(t, commits, 1)
+		assert.Equal(t, "third", commits[0].Message)
+		assert.Len(t, commits[0].Hash, 40)
+
+		commits, err = r.ReadCommits("HEAD~2..HEAD")
+		require.NoError(t, err)
+		require.Len(t, commits, 2)
+		assert.Equal(t, "third", commits[0].Message)
+		assert.Equal(t, "second\n\nCloses-bug: 1234567", commits[1].Message)
+
+		commits, err = r.ReadCommits("HEAD~1..")
+		require.NoError(t, err)
+		assert.Len(t, commits, 1)
+
+		hooks, err := r.GetHooksPath()
+		require.NoError(t, err)
+		assert.Equal(t, path.Join(repo.GetPath(), "hooks"), hooks)
+	}
+}
+
+func TestReadReachableCommits(t *testing.T) {
+	repo := CreateTestRepo(false)
+	defer CleanupTestRepos(t, repo)
+
+	workTree := path.Dir(repo.GetPath())
+
+	commit := func(file string, msg string) git.Hash {
+		err := os.MkdirAll(path.Dir(path.Join(workTree, file)), 0755)
+		require.NoError(t, err)
+		err = ioutil.WriteFile(path.Join(workTree, file), []byte(msg), 0644)
+		require.NoError(t, err)
+		_, err = repo.runGitCommand("--work-tree", workTree, "add", path.Join(workTree, file))
+		require.NoError(t, err)
+		_, err = repo.runGitCommand("--work-tree", workTree, "commit", "-m", msg)
+		require.NoError(t, err)
+		hash, err := repo.runGitCommand("rev-parse", "HEAD")
+		require.NoError(t, err)
+		return git.Hash(hash)
+	}
+
+	first := commit("README", "first")
+	second := commit("parser/parse.go", "second")
+	third := commit("parser/lex.go", "third")
+
+	goGitRepo, err := NewGoGitRepo(workTree, func(ClockedRepo) error { return nil })
+	require.NoError(t, err)
+
+	for _, r := range []Repo{repo, goGitRepo} {
+		commits, err := r.ReadReachableCommits([]git.Hash{third}, nil)
+		require.NoError(t, err)
+		require.Len(t, commits, 3)
+		assert.Equal(t, third, commits[0].Hash)
+		assert.Equal(t, []string{"parser/lex.go"}, commits[0].Files)
+		assert.Equal(t, "second", commits[1].Message)
+		assert.Equal(t, []string{"README"}, commits[2].Files)
+		assert.Contains(t, commits[2].Author, "<")
+		assert.False(t, commits[2].Time.IsZero())
+
+		commits, err = r.ReadReachableCommits([]git.Hash{third}, []git.Hash{first})
+		require.NoError(t, err)
+		require.Len(t, commits, 2)
+		assert.Equal(t, second, commits[1].Hash)
+
+		commits, err = r.ReadReachableCommits([]git.Hash{second}, []git.Hash{third})
+		require.NoError(t, err)
+		assert.Len(t, commits, 0)
+	}
+}
+
+func TestReadCommitSigner(t *testing.T) {
+	gnupgHome, err := ioutil.TempDir("", "gnupg")
+	require.NoError(t, err)
+	defer os.RemoveAll(gnupgHome)
+
+	oldHome, hadHome := os.LookupEnv("GNUPGHOME")
+	require.NoError(t, os.Setenv("GNUPGHOME", gnupgHome))
+	defer func() {
+		if hadHome {
+			_ = os.Setenv("GNUPGHOME", oldHome)
+		} else {
+			_ = os.Unsetenv("GNUPGHOME")
+		}
+	}()
+
+	err = exec.Command("gpg", "--batch", "--passphrase", "", "--quick-gen-key",
+		"Test <test@example.com>", "ed25519", "sign", "never").Run()
+	if err != nil {
+		t.Skip("gpg is not available:", err)
+	}
+
+	out, err := exec.Command("gpg", "--batch", "--with-colons", "--list-secret-keys").Output()
+	require.NoError(t, err)
+	var fingerprint string
+	for _, line := range strings.Split(string(out), "\n") {
+		if strings.HasPrefix(line, "fpr:") {
+			fingerprint = strings.Split(line, ":")[9]
+			break
+		}
+	}
+	require.NotEmpty(t, fingerprint)
+
+	repo := CreateTestRepo(false)
+	defer CleanupTestRepos(t, repo)
+
+	blob, err := repo.StoreData([]byte("data"))
+	require.NoError(t, err)
+	tree, err := repo.StoreTree([]TreeEntry{{ObjectType: Blob, Hash: blob, Name: "ops"}})
+	require.NoError(t, err)
+
+	unsigned, err := repo.StoreCommit(tree)
+	require.NoError(t, err)
+	signer, err := repo.ReadCommitSigner(unsigned)
+	require.NoError(t, err)
+	assert.Equal(t, "", signer)
+
+	stdout, err := repo.runGitCommand("commit-tree", "-S"+fingerprint, string(tree))
+	require.NoError(t, err)
+	signed := git.Hash(stdout)
+	signer, err = repo.ReadCommitSigner(signed)
+	require.NoError(t, err)
+	assert.Equal(t, fingerprint, signer)
+
+	// a tampered commit keep the signature, which doesn't match anymore
+	var raw bytes.Buffer
+	require.NoError(t, repo.runGitCommandWithIO(nil, &raw, ioutil.Discard, "cat-file", "commit", string(signed)))
+	tampered := strings.Replace(raw.String(), "testuser", "forger", 1)
+	require.NotEqual(t, raw.String(), tampered)
+
+	var hash bytes.Buffer
+	require.NoError(t, repo.runGitCommandWithIO(strings.NewReader(tampered), &hash, ioutil.Discard,
+		"hash-object", "-t", "commit", "-w", "--stdin"))
+	forged := git.Hash(strings.TrimSpace(hash.String()))
+
+	_, err = repo.ReadCommitSigner(forged)
+	assert.Equal(t, ErrInvalidSignature, err)
+}
@@ -39,6 +39,41 @@ func CreateTestRepo(bare bool) *GitRepo {
 		log.Fatal("failed to set user.email for test repository: ", err)
 	}
 
+	// don't touch the global config of the user
+	repo.SetConfig(nil, NewMemConfig())
+
+	return repo
+}
+
+// CreateGoGitTestRepo is like CreateTestRepo, for a repository accessed
+// with go-git
+func CreateGoGitTestRepo(bare bool) *GoGitRepo {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var repo *GoGitRepo
+	if bare {
+		repo, err = InitBareGoGitRepo(dir)
+	} else {
+		repo, err = InitGoGitRepo(dir)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	config := repo.LocalConfig()
+	if err := config.StoreString("user.name", "testuser"); err != nil {
+		log.Fatal("failed to set user.name for test repository: ", err)
+	}
+	if err := config.StoreString("user.email", "testuser@example.com"); err != nil {
+		log.Fatal("failed to set user.email for test repository: ", err)
+	}
+
+	// don't touch the global config of the user
+	repo.SetConfig(nil, NewMemConfig())
+
 	return repo
 }
 
@@ -0,0 +1,1147 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	gogit "gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/config"
+	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/filemode"
+	"gopkg.in/src-d/go-git.v4/plumbing/format/packfile"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+	"gopkg.in/src-d/go-git.v4/plumbing/protocol/packp"
+	"gopkg.in/src-d/go-git.v4/plumbing/storer"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport/client"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport/server"
+	"gopkg.in/src-d/go-git.v4/storage/filesystem"
+
+	"github.com/MichaelMure/git-bug/util/git"
+	"github.com/MichaelMure/git-bug/util/lamport"
+)
+
+// ErrSignatureUnverifiable is returned when reading the signer of a signed
+// commit without the git binary, as go-git can't check the signature with the
+// keys of the user.
+var ErrSignatureUnverifiable = fmt.Errorf("verifying a commit signature require the git backend")
+
+func init() {
+	// serve the local remotes with go-git as well, instead of running
+	// git-upload-pack and git-receive-pack
+	client.InstallProtocol("file", fileTransport{server.DefaultServer})
+}
+
+// fileTransport work around the go-git server failing on a push that only
+// delete references, by giving it an empty packfile to read.
+type fileTransport struct {
+	transport.Transport
+}
+
+func (t fileTransport) NewReceivePackSession(ep *transport.Endpoint, auth transport.AuthMethod) (transport.ReceivePackSession, error) {
+	session, err := t.Transport.NewReceivePackSession(ep, auth)
+	if err != nil {
+		return nil, err
+	}
+	return receivePackSession{session}, nil
+}
+
+type receivePackSession struct {
+	transport.ReceivePackSession
+}
+
+func (s receivePackSession) ReceivePack(ctx context.Context, req *packp.ReferenceUpdateRequest) (*packp.ReportStatus, error) {
+	if req.Packfile == nil {
+		// signature, version 2, no object, and the checksum of all that
+		header := []byte{'P', 'A', 'C', 'K', 0, 0, 0, 2, 0, 0, 0, 0}
+		checksum := sha1.Sum(header)
+		req.Packfile = ioutil.NopCloser(bytes.NewReader(append(header, checksum[:]...)))
+	}
+	return s.ReceivePackSession.ReceivePack(ctx, req)
+}
+
+var _ ClockedRepo = &GoGitRepo{}
+var _ GitConfigRepo = &GoGitRepo{}
+
+// GoGitRepo is a git repository accessed with go-git, a pure Go
+// implementation of git, instead of running the git binary.
+type GoGitRepo struct {
+	r    *gogit.Repository
+	path string
+
+	// go-git is not safe for concurrent use
+	mu sync.Mutex
+
+	createClock *lamport.Persisted
+	editClock   *lamport.Persisted
+
+	// the config storages replacing the git config files, if any
+	localConfig  Config
+	globalConfig Config
+}
+
+// NewGoGitRepo determines if the given working directory is inside of a git
+// repository, and returns the corresponding GoGitRepo instance if it is.
+func NewGoGitRepo(path string, witnesser Witnesser) (*GoGitRepo, error) {
+	// a bare repository is found without looking in the parent directories
+	r, err := gogit.PlainOpen(path)
+	if err == gogit.ErrRepositoryNotExists {
+		r, err = gogit.PlainOpenWithOptions(path, &gogit.PlainOpenOptions{DetectDotGit: true})
+	}
+	if err == gogit.ErrRepositoryNotExists {
+		return nil, ErrNotARepo
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := newGoGitRepo(r)
+	if err != nil {
+		return nil, err
+	}
+
+	err = repo.LoadClocks()
+
+	if err != nil {
+		// No clock yet, trying to initialize them
+		err = repo.createClocks()
+		if err != nil {
+			return nil, err
+		}
+
+		err = witnesser(repo)
+		if err != nil {
+			return nil, err
+		}
+
+		err = repo.WriteClocks()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return repo, nil
+}
+
+// InitGoGitRepo create a new empty git repo at the given path
+func InitGoGitRepo(path string) (*GoGitRepo, error) {
+	return initGoGitRepo(path, false)
+}
+
+// InitBareGoGitRepo create a new --bare empty git repo at the given path
+func InitBareGoGitRepo(path string) (*GoGitRepo, error) {
+	return initGoGitRepo(path, true)
+}
+
+func initGoGitRepo(path string, bare bool) (*GoGitRepo, error) {
+	r, err := gogit.PlainInit(path, bare)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := newGoGitRepo(r)
+	if err != nil {
+		return nil, err
+	}
+
+	err = repo.createClocks()
+	if err != nil {
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+func newGoGitRepo(r *gogit.Repository) (*GoGitRepo, error) {
+	storage, ok := r.Storer.(*filesystem.Storage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected go-git storage %T", r.Storer)
+	}
+
+	return &GoGitRepo{
+		r:    r,
+		path: storage.Filesystem().Root(),
+	}, nil
+}
+
+// LocalConfig give access to the repository scoped configuration
+func (repo *GoGitRepo) LocalConfig() Config {
+	if repo.localConfig != nil {
+		return repo.localConfig
+	}
+	return newGoGitConfig(path.Join(repo.path, "config"))
+}
+
+// GlobalConfig give access to the git global configuration
+func (repo *GoGitRepo) GlobalConfig() Config {
+	if repo.globalConfig != nil {
+		return repo.globalConfig
+	}
+	return newGoGitConfig(globalConfigPath())
+}
+
+// GitConfig give access to the repository scoped git config file, even when
+// the configuration is replaced with SetConfig
+func (repo *GoGitRepo) GitConfig() Config {
+	return newGoGitConfig(path.Join(repo.path, "config"))
+}
+
+// SetConfig replace the storage of the repository scoped configuration and
+// of the global one, a nil Config keeping the git config file. Git still
+// reads its own settings like the remotes, which are written with GitConfig.
+func (repo *GoGitRepo) SetConfig(local Config, global Config) {
+	repo.localConfig = local
+	repo.globalConfig = global
+}
+
+// readConfig read a value of the local configuration, or of the global one
+// if not set in the repository, like git does
+func (repo *GoGitRepo) readConfig(key string) (string, error) {
+	val, err := repo.LocalConfig().ReadString(key)
+	if err == ErrNoConfigEntry {
+		return repo.GlobalConfig().ReadString(key)
+	}
+	return val, err
+}
+
+// GetPath returns the path to the repo.
+func (repo *GoGitRepo) GetPath() string {
+	return repo.path
+}
+
+// GetUserName returns the name the the user has used to configure git
+func (repo *GoGitRepo) GetUserName() (string, error) {
+	return repo.readConfig("user.name")
+}
+
+// GetUserEmail returns the email address that the user has used to configure git.
+func (repo *GoGitRepo) GetUserEmail() (string, error) {
+	return repo.readConfig("user.email")
+}
+
+// GetCoreEditor returns the name of the editor that the user has used to configure git.
+func (repo *GoGitRepo) GetCoreEditor() (string, error) {
+	// same order as git var GIT_EDITOR
+	if editor := os.Getenv("GIT_EDITOR"); editor != "" {
+		return editor, nil
+	}
+
+	editor, err := repo.readConfig("core.editor")
+	if err == nil && editor != "" {
+		return editor, nil
+	}
+	if err != nil && err != ErrNoConfigEntry {
+		return "", err
+	}
+
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if editor := os.Getenv(env); editor != "" {
+			return editor, nil
+		}
+	}
+
+	return "vi", nil
+}
+
+// GetRemotes returns the configured remotes repositories.
+func (repo *GoGitRepo) GetRemotes() (map[string]string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	remotes, err := repo.r.Remotes()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(remotes))
+	for _, remote := range remotes {
+		urls := remote.Config().URLs
+		if len(urls) > 0 {
+			result[remote.Config().Name] = urls[0]
+		}
+	}
+
+	return result, nil
+}
+
+// FetchRefs fetch git refs from a remote
+func (repo *GoGitRepo) FetchRefs(remote string, refSpec string) (string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	var progress bytes.Buffer
+
+	err := repo.r.Fetch(&gogit.FetchOptions{
+		RemoteName: remote,
+		RefSpecs:   []config.RefSpec{goGitRefSpec(refSpec)},
+		Progress:   &progress,
+	})
+	if err != nil && err != gogit.NoErrAlreadyUpToDate {
+		return progress.String(), fmt.Errorf("failed to fetch from the remote '%s': %v", remote, err)
+	}
+
+	return progress.String(), nil
+}
+
+// PushRefs push git refs to a remote
+func (repo *GoGitRepo) PushRefs(remote string, refSpecs ...string) (string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	specs := make([]config.RefSpec, len(refSpecs))
+	for i, refSpec := range refSpecs {
+		specs[i] = goGitRefSpec(refSpec)
+	}
+
+	var progress bytes.Buffer
+
+	err := repo.r.Push(&gogit.PushOptions{
+		RemoteName: remote,
+		RefSpecs:   specs,
+		Progress:   &progress,
+	})
+	if err == gogit.NoErrAlreadyUpToDate {
+		return "Everything up-to-date", nil
+	}
+	if err != nil {
+		return progress.String(), fmt.Errorf("failed to push to the remote '%s': %v", remote, err)
+	}
+
+	return progress.String(), nil
+}
+
+// ListRemoteRefs return the refs of a remote starting with the given prefix,
+// as they are on the remote right now
+func (repo *GoGitRepo) ListRemoteRefs(remote string, refPrefix string) ([]string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	r, err := repo.r.Remote(remote)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := r.List(&gogit.ListOptions{})
+	if err == transport.ErrEmptyRemoteRepository {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the refs of the remote '%s': %v", remote, err)
+	}
+
+	var refs []string
+	for _, ref := range list {
+		name := ref.Name().String()
+		if strings.HasPrefix(name, refPrefix) {
+			refs = append(refs, name)
+		}
+	}
+	sort.Strings(refs)
+
+	return refs, nil
+}
+
+// goGitRefSpec complete a refspec without destination like git does, as
+// go-git requires both sides
+func goGitRefSpec(refSpec string) config.RefSpec {
+	if !strings.Contains(refSpec, ":") {
+		refSpec = refSpec + ":" + strings.TrimPrefix(refSpec, "+")
+	}
+	return config.RefSpec(refSpec)
+}
+
+// StoreData will store arbitrary data and return the corresponding hash
+func (repo *GoGitRepo) StoreData(data []byte) (git.Hash, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	obj := repo.r.Storer.NewEncodedObject()
+	obj.SetType(plumbing.BlobObject)
+
+	w, err := obj.Writer()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = w.Write(data)
+	if err != nil {
+		return "", err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return "", err
+	}
+
+	h, err := repo.r.Storer.SetEncodedObject(obj)
+	if err != nil {
+		return "", err
+	}
+
+	return git.Hash(h.String()), nil
+}
+
+// ReadData will attempt to read arbitrary data from the given hash
+func (repo *GoGitRepo) ReadData(hash git.Hash) ([]byte, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	obj, err := repo.r.BlobObject(plumbing.NewHash(hash.String()))
+	if err != nil {
+		return []byte{}, err
+	}
+
+	r, err := obj.Reader()
+	if err != nil {
+		return []byte{}, err
+	}
+	defer r.Close()
+
+	return ioutil.ReadAll(r)
+}
+
+// StoreTree will store a mapping key-->Hash as a Git tree
+func (repo *GoGitRepo) StoreTree(mapping []TreeEntry) (git.Hash, error) {
+	var tree object.Tree
+
+	for _, entry := range mapping {
+		mode := filemode.Regular
+		if entry.ObjectType == Tree {
+			mode = filemode.Dir
+		}
+
+		tree.Entries = append(tree.Entries, object.TreeEntry{
+			Name: entry.Name,
+			Mode: mode,
+			Hash: plumbing.NewHash(entry.Hash.String()),
+		})
+	}
+
+	// git expect the entries sorted by name, the trees being compared as
+	// if their name ended with a slash
+	sortName := func(entry object.TreeEntry) string {
+		if entry.Mode == filemode.Dir {
+			return entry.Name + "/"
+		}
+		return entry.Name
+	}
+	sort.Slice(tree.Entries, func(i, j int) bool {
+		return sortName(tree.Entries[i]) < sortName(tree.Entries[j])
+	})
+
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	obj := repo.r.Storer.NewEncodedObject()
+	err := tree.Encode(obj)
+	if err != nil {
+		return "", err
+	}
+
+	h, err := repo.r.Storer.SetEncodedObject(obj)
+	if err != nil {
+		return "", err
+	}
+
+	return git.Hash(h.String()), nil
+}
+
+// StoreCommit will store a Git commit with the given Git tree
+func (repo *GoGitRepo) StoreCommit(treeHash git.Hash) (git.Hash, error) {
+	return repo.storeCommit(treeHash)
+}
+
+// StoreCommitWithParent will store a Git commit with the given Git tree
+func (repo *GoGitRepo) StoreCommitWithParent(treeHash git.Hash, parent git.Hash) (git.Hash, error) {
+	return repo.storeCommit(treeHash, parent)
+}
+
+func (repo *GoGitRepo) storeCommit(treeHash git.Hash, parents ...git.Hash) (git.Hash, error) {
+	name, err := repo.GetUserName()
+	if err != nil {
+		return "", fmt.Errorf("user.name is not configured")
+	}
+	email, err := repo.GetUserEmail()
+	if err != nil {
+		return "", fmt.Errorf("user.email is not configured")
+	}
+
+	signature := object.Signature{
+		Name:  name,
+		Email: email,
+		When:  time.Now(),
+	}
+
+	commit := object.Commit{
+		Author:    signature,
+		Committer: signature,
+		TreeHash:  plumbing.NewHash(treeHash.String()),
+	}
+
+	for _, parent := range parents {
+		commit.ParentHashes = append(commit.ParentHashes, plumbing.NewHash(parent.String()))
+	}
+
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	obj := repo.r.Storer.NewEncodedObject()
+	err = commit.Encode(obj)
+	if err != nil {
+		return "", err
+	}
+
+	h, err := repo.r.Storer.SetEncodedObject(obj)
+	if err != nil {
+		return "", err
+	}
+
+	return git.Hash(h.String()), nil
+}
+
+// UpdateRef will create or update a Git reference
+func (repo *GoGitRepo) UpdateRef(ref string, hash git.Hash) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	return repo.r.Storer.SetReference(
+		plumbing.NewHashReference(plumbing.ReferenceName(ref), plumbing.NewHash(hash.String())))
+}
+
+// BeginBatch start a batch of writes, packed together by the matching
+// EndBatch. go-git writes the refs directly, they are never delayed.
+func (repo *GoGitRepo) BeginBatch() {}
+
+// EndBatch pack the loose objects and refs written since BeginBatch
+func (repo *GoGitRepo) EndBatch() error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	err := repo.packLooseObjects()
+	if err != nil {
+		return err
+	}
+
+	return repo.r.Storer.PackRefs()
+}
+
+// packLooseObjects move the loose objects into a new pack, like git repack -d
+func (repo *GoGitRepo) packLooseObjects() error {
+	los, ok := repo.r.Storer.(storer.LooseObjectStorer)
+	if !ok {
+		return nil
+	}
+	pfw, ok := repo.r.Storer.(storer.PackfileWriter)
+	if !ok {
+		return nil
+	}
+
+	var loose []plumbing.Hash
+	err := los.ForEachObjectHash(func(hash plumbing.Hash) error {
+		loose = append(loose, hash)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(loose) == 0 {
+		return nil
+	}
+
+	w, err := pfw.PackfileWriter()
+	if err != nil {
+		return err
+	}
+
+	// 10 is the default window of git
+	_, err = packfile.NewEncoder(w, repo.r.Storer, false).Encode(loose, 10)
+	if err != nil {
+		_ = w.Close()
+		return err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, hash := range loose {
+		err = los.DeleteLooseObject(hash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refMatch tell if a ref match a pattern the way git for-each-ref does:
+// literally, up to a slash, or with a glob
+func refMatch(pattern string, ref string) bool {
+	if ref == pattern || strings.HasPrefix(ref, strings.TrimSuffix(pattern, "/")+"/") {
+		return true
+	}
+
+	if strings.ContainsAny(pattern, "*?[") {
+		match, _ := path.Match(pattern, ref)
+		return match
+	}
+
+	return false
+}
+
+// matchingRefs return the hash refs matching a refspec, sorted by name
+func (repo *GoGitRepo) matchingRefs(refspec string) ([]*plumbing.Reference, error) {
+	refs, err := repo.r.References()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*plumbing.Reference
+
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() == plumbing.HashReference && refMatch(refspec, ref.Name().String()) {
+			result = append(result, ref)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+
+	return result, nil
+}
+
+// ListRefs will return a list of Git ref matching the given refspec
+func (repo *GoGitRepo) ListRefs(refspec string) ([]string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	refs, err := repo.matchingRefs(refspec)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, len(refs))
+	for i, ref := range refs {
+		result[i] = ref.Name().String()
+	}
+
+	return result, nil
+}
+
+// ResolveRefs will return the Git refs matching the given refspec, with
+// the hash of the commit they point to
+func (repo *GoGitRepo) ResolveRefs(refspec string) (map[string]git.Hash, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	refs, err := repo.matchingRefs(refspec)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]git.Hash, len(refs))
+	for _, ref := range refs {
+		result[ref.Name().String()] = git.Hash(ref.Hash().String())
+	}
+
+	return result, nil
+}
+
+// RefExist will check if a reference exist in Git
+func (repo *GoGitRepo) RefExist(ref string) (bool, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	refs, err := repo.matchingRefs(ref)
+	if err != nil {
+		return false, err
+	}
+
+	return len(refs) > 0, nil
+}
+
+// CopyRef will create a new reference with the same value as another one
+func (repo *GoGitRepo) CopyRef(source string, dest string) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	hash, err := repo.r.ResolveRevision(plumbing.Revision(source))
+	if err != nil {
+		return err
+	}
+
+	return repo.r.Storer.SetReference(plumbing.NewHashReference(plumbing.ReferenceName(dest), *hash))
+}
+
+// RemoveRef will delete a Git reference
+func (repo *GoGitRepo) RemoveRef(ref string) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	return repo.r.Storer.RemoveReference(plumbing.ReferenceName(ref))
+}
+
+// ListCommits will return the list of commit hashes of a ref, in chronological order
+func (repo *GoGitRepo) ListCommits(ref string) ([]git.Hash, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	hash, err := repo.r.ResolveRevision(plumbing.Revision(ref))
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := repo.r.CommitObject(*hash)
+	if err != nil {
+		return nil, err
+	}
+
+	// follow the first parents, like git rev-list --first-parent
+	var result []git.Hash
+	for {
+		result = append(result, git.Hash(commit.Hash.String()))
+
+		if len(commit.ParentHashes) == 0 {
+			break
+		}
+
+		commit, err = repo.r.CommitObject(commit.ParentHashes[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+
+	return result, nil
+}
+
+// ListEntries will return the list of entries in a Git tree
+func (repo *GoGitRepo) ListEntries(hash git.Hash) ([]TreeEntry, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	h := plumbing.NewHash(hash.String())
+
+	tree, err := repo.r.TreeObject(h)
+	if err == plumbing.ErrObjectNotFound {
+		// like git ls-tree, accept the hash of a commit as well
+		commit, errCommit := repo.r.CommitObject(h)
+		if errCommit == nil {
+			tree, err = commit.Tree()
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]TreeEntry, len(tree.Entries))
+	for i, entry := range tree.Entries {
+		var objType ObjectType
+		switch entry.Mode {
+		case filemode.Regular:
+			objType = Blob
+		case filemode.Dir:
+			objType = Tree
+		default:
+			return nil, fmt.Errorf("Unknown git object type %s", entry.Mode)
+		}
+
+		result[i] = TreeEntry{
+			ObjectType: objType,
+			Hash:       git.Hash(entry.Hash.String()),
+			Name:       entry.Name,
+		}
+	}
+
+	return result, nil
+}
+
+// FindCommonAncestor will return the last common ancestor of two chain of commit
+func (repo *GoGitRepo) FindCommonAncestor(hash1 git.Hash, hash2 git.Hash) (git.Hash, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	commit1, err := repo.r.CommitObject(plumbing.NewHash(hash1.String()))
+	if err != nil {
+		return "", err
+	}
+	commit2, err := repo.r.CommitObject(plumbing.NewHash(hash2.String()))
+	if err != nil {
+		return "", err
+	}
+
+	bases, err := commit1.MergeBase(commit2)
+	if err != nil {
+		return "", err
+	}
+	if len(bases) == 0 {
+		return "", fmt.Errorf("no common ancestor between %s and %s", hash1, hash2)
+	}
+
+	return git.Hash(bases[0].Hash.String()), nil
+}
+
+// GetTreeHash return the git tree hash referenced in a commit
+func (repo *GoGitRepo) GetTreeHash(commit git.Hash) (git.Hash, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	obj, err := repo.r.CommitObject(plumbing.NewHash(commit.String()))
+	if err != nil {
+		return "", err
+	}
+
+	return git.Hash(obj.TreeHash.String()), nil
+}
+
+// ReadCommitSigner return the id of the key used to sign a commit, or an
+// empty string if the commit is not signed. The signatures can't be checked
+// without git, ErrSignatureUnverifiable is returned for a signed commit.
+func (repo *GoGitRepo) ReadCommitSigner(commit git.Hash) (string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	obj, err := repo.r.CommitObject(plumbing.NewHash(commit.String()))
+	if err != nil {
+		return "", err
+	}
+
+	if obj.PGPSignature == "" {
+		return "", nil
+	}
+
+	return "", ErrSignatureUnverifiable
+}
+
+// Repack will pack the git objects and prune the unreachable ones
+func (repo *GoGitRepo) Repack() error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	err := repo.r.RepackObjects(&gogit.RepackConfig{})
+	if err != nil {
+		return err
+	}
+
+	return repo.r.Storer.PackRefs()
+}
+
+// ReadCommits return the commits of the source code given by a revision,
+// like HEAD, or by a range, like ORIG_HEAD..HEAD, the newest first
+func (repo *GoGitRepo) ReadCommits(revisions string) ([]Commit, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if strings.Contains(revisions, "...") {
+		return nil, fmt.Errorf("unsupported revision range %s", revisions)
+	}
+
+	from, to := "", revisions
+	isRange := false
+	if i := strings.Index(revisions, ".."); i >= 0 {
+		from, to = revisions[:i], revisions[i+2:]
+		isRange = true
+	}
+
+	tip, err := repo.resolveCommit(to)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isRange {
+		commit, err := goGitCommit(tip)
+		if err != nil {
+			return nil, err
+		}
+		return []Commit{commit}, nil
+	}
+
+	// the commits reachable from the start of the range are excluded
+	start, err := repo.resolveCommit(from)
+	if err != nil {
+		return nil, err
+	}
+	excluded := make(map[plumbing.Hash]bool)
+	err = object.NewCommitPreorderIter(start, nil, nil).ForEach(func(c *object.Commit) error {
+		excluded[c.Hash] = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Commit
+	err = object.NewCommitPreorderIter(tip, excluded, nil).ForEach(func(c *object.Commit) error {
+		commit, err := goGitCommit(c)
+		if err != nil {
+			return err
+		}
+		result = append(result, commit)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// resolveCommit return the commit of a revision, HEAD if empty like in a
+// range
+func (repo *GoGitRepo) resolveCommit(revision string) (*object.Commit, error) {
+	if revision == "" {
+		revision = "HEAD"
+	}
+
+	hash, err := repo.r.ResolveRevision(plumbing.Revision(revision))
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.r.CommitObject(*hash)
+}
+
+func goGitCommit(c *object.Commit) (Commit, error) {
+	files, err := goGitCommitFiles(c)
+	if err != nil {
+		return Commit{}, err
+	}
+
+	return Commit{
+		Hash:    git.Hash(c.Hash.String()),
+		Author:  fmt.Sprintf("%s <%s>", c.Author.Name, c.Author.Email),
+		Time:    c.Author.When,
+		Message: strings.TrimSpace(c.Message),
+		Files:   files,
+	}, nil
+}
+
+// goGitCommitFiles return the files changed by a commit since its first
+// parent, none for a merge like git log
+func goGitCommitFiles(c *object.Commit) ([]string, error) {
+	if c.NumParents() > 1 {
+		return nil, nil
+	}
+
+	tree, err := c.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	var parentTree *object.Tree
+	if c.NumParents() == 1 {
+		parent, err := c.Parent(0)
+		if err != nil {
+			return nil, err
+		}
+		parentTree, err = parent.Tree()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changes, err := object.DiffTree(parentTree, tree)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0, len(changes))
+	for _, change := range changes {
+		name := change.To.Name
+		if name == "" {
+			name = change.From.Name
+		}
+		files = append(files, name)
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// ReadReachableCommits return the commits reachable from the given heads but
+// not from the excluded ones, the newest first
+func (repo *GoGitRepo) ReadReachableCommits(heads []git.Hash, excluded []git.Hash) ([]Commit, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	seen := make(map[plumbing.Hash]bool)
+	for _, hash := range excluded {
+		c, err := repo.r.CommitObject(plumbing.NewHash(hash.String()))
+		if err != nil {
+			return nil, err
+		}
+		err = object.NewCommitPreorderIter(c, seen, nil).ForEach(func(c *object.Commit) error {
+			seen[c.Hash] = true
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var commits []*object.Commit
+	for _, hash := range heads {
+		c, err := repo.r.CommitObject(plumbing.NewHash(hash.String()))
+		if err != nil {
+			return nil, err
+		}
+		err = object.NewCommitPreorderIter(c, seen, nil).ForEach(func(c *object.Commit) error {
+			seen[c.Hash] = true
+			commits = append(commits, c)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the newest first, like git log
+	sort.SliceStable(commits, func(i, j int) bool {
+		return commits[i].Committer.When.After(commits[j].Committer.When)
+	})
+
+	result := make([]Commit, len(commits))
+	for i, c := range commits {
+		commit, err := goGitCommit(c)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = commit
+	}
+
+	return result, nil
+}
+
+// GetHooksPath return the directory of the git hooks
+func (repo *GoGitRepo) GetHooksPath() (string, error) {
+	hooksPath, err := repo.readConfig("core.hooksPath")
+	if err == ErrNoConfigEntry {
+		return path.Join(repo.path, "hooks"), nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// like git, relative to the root of the work tree
+	if !path.IsAbs(hooksPath) {
+		hooksPath = path.Join(path.Dir(repo.path), hooksPath)
+	}
+
+	return hooksPath, nil
+}
+
+// AddRemote add a new remote to the repository
+// Not in the interface because it's only used for testing
+func (repo *GoGitRepo) AddRemote(name string, url string) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	_, err := repo.r.CreateRemote(&config.RemoteConfig{
+		Name: name,
+		URLs: []string{url},
+	})
+
+	return err
+}
+
+func (repo *GoGitRepo) createClocks() error {
+	createPath := path.Join(repo.path, createClockFile)
+	createClock, err := lamport.NewPersisted(createPath)
+	if err != nil {
+		return err
+	}
+
+	editPath := path.Join(repo.path, editClockFile)
+	editClock, err := lamport.NewPersisted(editPath)
+	if err != nil {
+		return err
+	}
+
+	repo.createClock = createClock
+	repo.editClock = editClock
+
+	return nil
+}
+
+// LoadClocks read the clocks values from the on-disk repo
+func (repo *GoGitRepo) LoadClocks() error {
+	createClock, err := lamport.LoadPersisted(repo.GetPath() + createClockFile)
+	if err != nil {
+		return err
+	}
+
+	editClock, err := lamport.LoadPersisted(repo.GetPath() + editClockFile)
+	if err != nil {
+		return err
+	}
+
+	repo.createClock = createClock
+	repo.editClock = editClock
+	return nil
+}
+
+// WriteClocks write the clocks values into the repo
+func (repo *GoGitRepo) WriteClocks() error {
+	err := repo.createClock.Write()
+	if err != nil {
+		return err
+	}
+
+	err = repo.editClock.Write()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateTime return the current value of the creation clock
+func (repo *GoGitRepo) CreateTime() lamport.Time {
+	return repo.createClock.Time()
+}
+
+// CreateTimeIncrement increment the creation clock and return the new value.
+func (repo *GoGitRepo) CreateTimeIncrement() (lamport.Time, error) {
+	return repo.createClock.Increment()
+}
+
+// EditTime return the current value of the edit clock
+func (repo *GoGitRepo) EditTime() lamport.Time {
+	return repo.editClock.Time()
+}
+
+// EditTimeIncrement increment the edit clock and return the new value.
+func (repo *GoGitRepo) EditTimeIncrement() (lamport.Time, error) {
+	return repo.editClock.Increment()
+}
+
+// WitnessCreate witness another create time and increment the corresponding clock
+// if needed.
+func (repo *GoGitRepo) WitnessCreate(time lamport.Time) error {
+	return repo.createClock.Witness(time)
+}
+
+// WitnessEdit witness another edition time and increment the corresponding clock
+// if needed.
+func (repo *GoGitRepo) WitnessEdit(time lamport.Time) error {
+	return repo.editClock.Witness(time)
+}
@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+func TestGoGitConfig(t *testing.T) {
+	repo := CreateGoGitTestRepo(false)
+	defer CleanupTestRepos(t, repo)
+
+	testConfig(t, repo)
+
+	// read back by git
+	gitRepo := &GitRepo{Path: repo.GetPath()}
+
+	values := []string{
+		"with spaces",
+		`with "quotes" and \\ backslashes`,
+		"with # a comment",
+		"with ; a semicolon",
+	}
+	for _, value := range values {
+		require.NoError(t, repo.LocalConfig().StoreString("git-bug.sub.section.key", value))
+		read, err := gitRepo.LocalConfig().ReadString("git-bug.sub.section.key")
+		require.NoError(t, err)
+		assert.Equal(t, value, read)
+	}
+
+	require.NoError(t, repo.LocalConfig().AddString("git-bug.sub.section.key", "other"))
+	read, err := gitRepo.LocalConfig().ReadAllValues("git-bug.sub.section.key")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"with ; a semicolon", "other"}, read)
+
+	all, err := repo.LocalConfig().ReadAll("git-bug.sub")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"git-bug.sub.section.key": "other"}, all)
+
+	name, err := repo.GetUserName()
+	require.NoError(t, err)
+	assert.Equal(t, "testuser", name)
+}
+
+func TestGoGitRepo(t *testing.T) {
+	repo := CreateGoGitTestRepo(false)
+	defer CleanupTestRepos(t, repo)
+
+	// the same repository, accessed with git
+	gitRepo, err := NewGitRepo(filepath.Dir(repo.GetPath()), func(ClockedRepo) error { return nil })
+	require.NoError(t, err)
+
+	blob, err := repo.StoreData([]byte("data"))
+	require.NoError(t, err)
+	gitBlob, err := gitRepo.StoreData([]byte("data"))
+	require.NoError(t, err)
+	assert.Equal(t, gitBlob, blob)
+
+	data, err := gitRepo.ReadData(blob)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+
+	entries := []TreeEntry{
+		{ObjectType: Blob, Hash: blob, Name: "b"},
+		{ObjectType: Blob, Hash: blob, Name: "a"},
+	}
+	tree, err := repo.StoreTree(entries)
+	require.NoError(t, err)
+	gitTree, err := gitRepo.StoreTree(entries)
+	require.NoError(t, err)
+	assert.Equal(t, gitTree, tree)
+
+	subtree, err := repo.StoreTree([]TreeEntry{{ObjectType: Tree, Hash: tree, Name: "a"}, {ObjectType: Blob, Hash: blob, Name: "a.txt"}})
+	require.NoError(t, err)
+	listed, err := repo.ListEntries(subtree)
+	require.NoError(t, err)
+	gitListed, err := gitRepo.ListEntries(subtree)
+	require.NoError(t, err)
+	assert.Equal(t, gitListed, listed)
+
+	commit1, err := repo.StoreCommit(tree)
+	require.NoError(t, err)
+	commit2, err := repo.StoreCommitWithParent(subtree, commit1)
+	require.NoError(t, err)
+	commit3, err := repo.StoreCommitWithParent(tree, commit1)
+	require.NoError(t, err)
+
+	// a commit is listed as its tree
+	listed, err = repo.ListEntries(commit2)
+	require.NoError(t, err)
+	assert.Equal(t, gitListed, listed)
+
+	treeHash, err := gitRepo.GetTreeHash(commit2)
+	require.NoError(t, err)
+	assert.Equal(t, subtree, treeHash)
+	treeHash, err = repo.GetTreeHash(commit2)
+	require.NoError(t, err)
+	assert.Equal(t, subtree, treeHash)
+
+	ancestor, err := repo.FindCommonAncestor(commit2, commit3)
+	require.NoError(t, err)
+	assert.Equal(t, commit1, ancestor)
+
+	require.NoError(t, repo.UpdateRef("refs/bugs/a", commit2))
+	require.NoError(t, repo.UpdateRef("refs/bugs/b", commit3))
+	require.NoError(t, repo.CopyRef("refs/bugs/b", "refs/other/b"))
+
+	commits, err := repo.ListCommits("refs/bugs/a")
+	require.NoError(t, err)
+	assert.Equal(t, []git.Hash{commit1, commit2}, commits)
+	gitCommits, err := gitRepo.ListCommits("refs/bugs/a")
+	require.NoError(t, err)
+	assert.Equal(t, gitCommits, commits)
+
+	refs, err := repo.ListRefs("refs/bugs/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"refs/bugs/a", "refs/bugs/b"}, refs)
+
+	resolved, err := repo.ResolveRefs("refs/")
+	require.NoError(t, err)
+	gitResolved, err := gitRepo.ResolveRefs("refs/")
+	require.NoError(t, err)
+	assert.Equal(t, gitResolved, resolved)
+
+	exist, err := repo.RefExist("refs/other/b")
+	require.NoError(t, err)
+	assert.True(t, exist)
+
+	require.NoError(t, repo.RemoveRef("refs/other/b"))
+	exist, err = repo.RefExist("refs/other/b")
+	require.NoError(t, err)
+	assert.False(t, exist)
+
+	signer, err := repo.ReadCommitSigner(commit1)
+	require.NoError(t, err)
+	assert.Equal(t, "", signer)
+
+	// packing keep everything readable by git
+	repo.BeginBatch()
+	require.NoError(t, repo.EndBatch())
+	count, err := gitRepo.runGitCommand("count-objects")
+	require.NoError(t, err)
+	assert.Equal(t, "0 objects, 0 kilobytes", count)
+	_, err = gitRepo.runGitCommand("fsck", "--no-dangling")
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Repack())
+	gitCommits, err = gitRepo.ListCommits("refs/bugs/b")
+	require.NoError(t, err)
+	assert.Equal(t, []git.Hash{commit1, commit3}, gitCommits)
+}
+
+func TestGoGitPushFetch(t *testing.T) {
+	repoA := CreateGoGitTestRepo(false)
+	repoB := CreateGoGitTestRepo(false)
+	remote := CreateGoGitTestRepo(true)
+	defer CleanupTestRepos(t, repoA, repoB, remote)
+
+	require.NoError(t, repoA.AddRemote("origin", "file://"+remote.GetPath()))
+	require.NoError(t, repoB.AddRemote("origin", "file://"+remote.GetPath()))
+
+	remotes, err := repoA.GetRemotes()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"origin": "file://" + remote.GetPath()}, remotes)
+
+	blob, err := repoA.StoreData([]byte("data"))
+	require.NoError(t, err)
+	tree, err := repoA.StoreTree([]TreeEntry{{ObjectType: Blob, Hash: blob, Name: "data"}})
+	require.NoError(t, err)
+	commit, err := repoA.StoreCommit(tree)
+	require.NoError(t, err)
+	require.NoError(t, repoA.UpdateRef("refs/bugs/a", commit))
+
+	_, err = repoA.PushRefs("origin", "refs/bugs/*")
+	require.NoError(t, err)
+
+	out, err := repoA.PushRefs("origin", "refs/bugs/*")
+	require.NoError(t, err)
+	assert.Equal(t, "Everything up-to-date", out)
+
+	remoteRefs, err := repoB.ListRemoteRefs("origin", "refs/bugs/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"refs/bugs/a"}, remoteRefs)
+
+	_, err = repoB.FetchRefs("origin", "refs/bugs/*:refs/remotes/origin/bugs/*")
+	require.NoError(t, err)
+
+	refs, err := repoB.ResolveRefs("refs/remotes/origin/bugs/")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]git.Hash{"refs/remotes/origin/bugs/a": commit}, refs)
+
+	data, err := repoB.ReadData(blob)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+
+	// delete a remote ref
+	_, err = repoA.PushRefs("origin", ":refs/bugs/a")
+	require.NoError(t, err)
+	exist, err := remote.RefExist("refs/bugs/a")
+	require.NoError(t, err)
+	assert.False(t, exist)
+}
+
+func TestRefMatch(t *testing.T) {
+	assert.True(t, refMatch("refs/bugs/", "refs/bugs/abc"))
+	assert.True(t, refMatch("refs/bugs", "refs/bugs/abc"))
+	assert.True(t, refMatch("refs/bugs/abc", "refs/bugs/abc"))
+	assert.True(t, refMatch("refs/bugs/a*", "refs/bugs/abc"))
+	assert.False(t, refMatch("refs/bugs/a", "refs/bugs/abc"))
+	assert.False(t, refMatch("refs/bug", "refs/bugs/abc"))
+	assert.False(t, refMatch("refs/*", "refs/bugs/abc"))
+}
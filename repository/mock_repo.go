@@ -3,6 +3,7 @@ package repository
 import (
 	"crypto/sha1"
 	"fmt"
+	"strings"
 
 	"github.com/MichaelMure/git-bug/util/git"
 	"github.com/MichaelMure/git-bug/util/lamport"
@@ -29,13 +30,14 @@ type commit struct {
 
 func NewMockRepoForTest() *mockRepoForTest {
 	return &mockRepoForTest{
-		config:      make(map[string]string),
-		blobs:       make(map[git.Hash][]byte),
-		trees:       make(map[git.Hash]string),
-		commits:     make(map[git.Hash]commit),
-		refs:        make(map[string]git.Hash),
-		createClock: lamport.NewClock(),
-		editClock:   lamport.NewClock(),
+		config:       make(map[string]string),
+		globalConfig: make(map[string]string),
+		blobs:        make(map[git.Hash][]byte),
+		trees:        make(map[git.Hash]string),
+		commits:      make(map[git.Hash]commit),
+		refs:         make(map[string]git.Hash),
+		createClock:  lamport.NewClock(),
+		editClock:    lamport.NewClock(),
 	}
 }
 
@@ -76,10 +78,15 @@ func (r *mockRepoForTest) GetRemotes() (map[string]string, error) {
 }
 
 // PushRefs push git refs to a remote
-func (r *mockRepoForTest) PushRefs(remote string, refSpec string) (string, error) {
+func (r *mockRepoForTest) PushRefs(remote string, refSpecs ...string) (string, error) {
 	return "", nil
 }
 
+func (r *mockRepoForTest) ListRemoteRefs(remote string, refPrefix string) ([]string, error) {
+	// nothing is pushed to the remotes of the mock
+	return nil, nil
+}
+
 func (r *mockRepoForTest) FetchRefs(remote string, refSpec string) (string, error) {
 	return "", nil
 }
@@ -150,6 +157,11 @@ func (r *mockRepoForTest) CopyRef(source string, dest string) error {
 	return nil
 }
 
+func (r *mockRepoForTest) RemoveRef(ref string) error {
+	delete(r.refs, ref)
+	return nil
+}
+
 func (r *mockRepoForTest) ListRefs(refspec string) ([]string, error) {
 	keys := make([]string, len(r.refs))
 
@@ -162,6 +174,18 @@ func (r *mockRepoForTest) ListRefs(refspec string) ([]string, error) {
 	return keys, nil
 }
 
+func (r *mockRepoForTest) ResolveRefs(refspec string) (map[string]git.Hash, error) {
+	refs := make(map[string]git.Hash)
+
+	for k, hash := range r.refs {
+		if strings.HasPrefix(k, refspec) {
+			refs[k] = hash
+		}
+	}
+
+	return refs, nil
+}
+
 func (r *mockRepoForTest) ListCommits(ref string) ([]git.Hash, error) {
 	var hashes []git.Hash
 
@@ -212,6 +236,39 @@ func (r *mockRepoForTest) GetTreeHash(commit git.Hash) (git.Hash, error) {
 	panic("implement me")
 }
 
+func (r *mockRepoForTest) ReadCommitSigner(commit git.Hash) (string, error) {
+	if _, ok := r.commits[commit]; !ok {
+		return "", fmt.Errorf("unknown hash")
+	}
+
+	// the mock never sign commits
+	return "", nil
+}
+
+func (r *mockRepoForTest) ReadCommits(revisions string) ([]Commit, error) {
+	// the mock has no source code
+	return nil, nil
+}
+
+func (r *mockRepoForTest) ReadReachableCommits(heads []git.Hash, excluded []git.Hash) ([]Commit, error) {
+	// the mock has no source code
+	return nil, nil
+}
+
+func (r *mockRepoForTest) GetHooksPath() (string, error) {
+	return r.GetPath() + "hooks", nil
+}
+
+func (r *mockRepoForTest) BeginBatch() {}
+
+func (r *mockRepoForTest) EndBatch() error {
+	return nil
+}
+
+func (r *mockRepoForTest) Repack() error {
+	return nil
+}
+
 func (r *mockRepoForTest) LoadClocks() error {
 	return nil
 }
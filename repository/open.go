@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// BackendEnv is the environment variable selecting how the git repositories
+// are accessed: "git" to run the git binary, or "go-git" to use a pure Go
+// implementation that doesn't need git to be installed.
+const BackendEnv = "GIT_BUG_BACKEND"
+
+const (
+	BackendGit   = "git"
+	BackendGoGit = "go-git"
+)
+
+// Backend return the backend selected by the GIT_BUG_BACKEND environment
+// variable. It default to the git binary, or to go-git when git is not
+// installed.
+func Backend() (string, error) {
+	switch backend := strings.ToLower(strings.TrimSpace(os.Getenv(BackendEnv))); backend {
+	case BackendGit, BackendGoGit:
+		return backend, nil
+	case "":
+		if _, err := exec.LookPath("git"); err != nil {
+			return BackendGoGit, nil
+		}
+		return BackendGit, nil
+	default:
+		return "", fmt.Errorf("unknown repository backend %s, expected %s or %s", backend, BackendGit, BackendGoGit)
+	}
+}
+
+// OpenRepo determines if the given working directory is inside of a git
+// repository, and returns the corresponding repository accessed with the
+// selected backend if it is.
+func OpenRepo(path string, witnesser Witnesser) (ClockedRepo, error) {
+	backend, err := Backend()
+	if err != nil {
+		return nil, err
+	}
+
+	// don't return a typed nil on error
+	if backend == BackendGoGit {
+		repo, err := NewGoGitRepo(path, witnesser)
+		if err != nil {
+			return nil, err
+		}
+		return repo, nil
+	}
+
+	repo, err := NewGitRepo(path, witnesser)
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/MichaelMure/git-bug/util/git"
 	"github.com/MichaelMure/git-bug/util/lamport"
@@ -13,6 +14,9 @@ import (
 var (
 	ErrNoConfigEntry       = errors.New("no config entry for the given key")
 	ErrMultipleConfigEntry = errors.New("multiple config entry for the given key")
+	// ErrInvalidSignature is returned when reading the signer of a commit
+	// whose signature is bad, or made by a missing, expired or revoked key
+	ErrInvalidSignature = errors.New("invalid commit signature")
 )
 
 // RepoCommon represent the common function the we want all the repo to implement
@@ -39,6 +43,15 @@ type RepoCommon interface {
 	GlobalConfig() Config
 }
 
+// GitConfigRepo is a repository whose git config file stays reachable when
+// the configuration of git-bug is stored elsewhere with SetConfig. The
+// settings read by git itself, like the refspecs of the remotes, must be
+// written there.
+type GitConfigRepo interface {
+	// GitConfig give access to the repository scoped git config
+	GitConfig() Config
+}
+
 // Repo represents a source code repository.
 type Repo interface {
 	RepoCommon
@@ -46,8 +59,12 @@ type Repo interface {
 	// FetchRefs fetch git refs from a remote
 	FetchRefs(remote string, refSpec string) (string, error)
 
-	// PushRefs push git refs to a remote
-	PushRefs(remote string, refSpec string) (string, error)
+	// PushRefs push git refs to a remote, with one or more refspecs
+	PushRefs(remote string, refSpecs ...string) (string, error)
+
+	// ListRemoteRefs return the refs of a remote starting with the given
+	// prefix, as they are on the remote right now
+	ListRemoteRefs(remote string, refPrefix string) ([]string, error)
 
 	// StoreData will store arbitrary data and return the corresponding hash
 	StoreData(data []byte) (git.Hash, error)
@@ -67,15 +84,29 @@ type Repo interface {
 	// UpdateRef will create or update a Git reference
 	UpdateRef(ref string, hash git.Hash) error
 
+	// BeginBatch start delaying the ref updates until the matching EndBatch
+	BeginBatch()
+
+	// EndBatch write the ref updates delayed since BeginBatch at once, and
+	// pack what was written meanwhile
+	EndBatch() error
+
 	// ListRefs will return a list of Git ref matching the given refspec
 	ListRefs(refspec string) ([]string, error)
 
+	// ResolveRefs will return the Git refs matching the given refspec, with
+	// the hash of the commit they point to
+	ResolveRefs(refspec string) (map[string]git.Hash, error)
+
 	// RefExist will check if a reference exist in Git
 	RefExist(ref string) (bool, error)
 
 	// CopyRef will create a new reference with the same value as another one
 	CopyRef(source string, dest string) error
 
+	// RemoveRef will delete a Git reference
+	RemoveRef(ref string) error
+
 	// ListCommits will return the list of tree hashes of a ref, in chronological order
 	ListCommits(ref string) ([]git.Hash, error)
 
@@ -87,6 +118,36 @@ type Repo interface {
 
 	// GetTreeHash return the git tree hash referenced in a commit
 	GetTreeHash(commit git.Hash) (git.Hash, error)
+
+	// ReadCommitSigner return the fingerprint of the key used to sign a
+	// commit, or an empty string if the commit is not signed. A signature
+	// that can't be verified give ErrInvalidSignature.
+	ReadCommitSigner(commit git.Hash) (string, error)
+
+	// Repack will pack the git objects and prune the unreachable ones
+	Repack() error
+
+	// ReadCommits return the commits of the source code given by a revision,
+	// like HEAD, or by a range, like ORIG_HEAD..HEAD, the newest first
+	ReadCommits(revisions string) ([]Commit, error)
+
+	// ReadReachableCommits return the commits reachable from the given heads
+	// but not from the excluded ones, the newest first
+	ReadReachableCommits(heads []git.Hash, excluded []git.Hash) ([]Commit, error)
+
+	// GetHooksPath return the directory of the git hooks
+	GetHooksPath() (string, error)
+}
+
+// Commit is a commit of the source code, as read by ReadCommits
+type Commit struct {
+	Hash git.Hash
+	// the name and email of the author, as "Name <email>"
+	Author  string
+	Time    time.Time
+	Message string
+	// the files changed since the first parent, none for a merge
+	Files []string
 }
 
 // ClockedRepo is a Repo that also has Lamport clocks
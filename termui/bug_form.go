@@ -0,0 +1,514 @@
+package termui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/awesome-gocui/gocui"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/input"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+const bugFormTitleView = "bugFormTitleView"
+const bugFormBodyView = "bugFormBodyView"
+const bugFormLabelsView = "bugFormLabelsView"
+const bugFormAssigneesView = "bugFormAssigneesView"
+const bugFormPriorityView = "bugFormPriorityView"
+const bugFormErrorView = "bugFormErrorView"
+const bugFormInstructionView = "bugFormInstructionView"
+
+// bugFormFields is the views of the fields of the form, in the order they are
+// focused
+var bugFormFields = []string{
+	bugFormTitleView,
+	bugFormBodyView,
+	bugFormLabelsView,
+	bugFormAssigneesView,
+	bugFormPriorityView,
+}
+
+// formChoice is an item of a list of the form, a label or an assignee
+type formChoice struct {
+	name     string
+	display  string
+	id       entity.Id
+	selected bool
+}
+
+// bugForm is a window to create a bug, with its title, body, labels,
+// assignees and priority
+type bugForm struct {
+	cache *cache.RepoCache
+
+	title     string
+	body      string
+	labels    []formChoice
+	assignees []formChoice
+	priority  int
+
+	// the field having the focus, and the cursor of each list
+	focus          int
+	labelCursor    int
+	assigneeCursor int
+
+	// the last validation error
+	err string
+
+	childViews []string
+}
+
+func newBugForm(cache *cache.RepoCache) *bugForm {
+	return &bugForm{
+		cache: cache,
+	}
+}
+
+// Reset empty the form, with the body pre-filled with the template
+// configured with git-bug.add.template, if any
+func (bf *bugForm) Reset() error {
+	body, err := input.BugCreateTemplate(bf.cache)
+	if err != nil {
+		return err
+	}
+
+	// the comments of the template are for the editor
+	var lines []string
+	for _, line := range strings.Split(body, "\n") {
+		if !strings.HasPrefix(line, "#") {
+			lines = append(lines, line)
+		}
+	}
+
+	bf.title = ""
+	bf.body = strings.Join(lines, "\n")
+	bf.priority = 0
+	bf.focus = 0
+	bf.labelCursor = 0
+	bf.assigneeCursor = 0
+	bf.err = ""
+
+	bf.labels = nil
+	for _, label := range bf.cache.ValidLabels() {
+		if strings.HasPrefix(label.String(), bug.PriorityLabelPrefix) {
+			// chosen with the priority
+			continue
+		}
+
+		lc256 := bf.cache.LabelStore().Color(label).Term256()
+		bf.labels = append(bf.labels, formChoice{
+			name:    label.String(),
+			display: colors.Term256(int(lc256), "◼ ") + label.String(),
+		})
+	}
+
+	bf.assignees = nil
+	for _, id := range bf.cache.AllIdentityIds() {
+		excerpt, err := bf.cache.ResolveIdentityExcerpt(id)
+		if err != nil {
+			return err
+		}
+
+		bf.assignees = append(bf.assignees, formChoice{
+			name:    excerpt.DisplayName(),
+			display: colors.Author(excerpt.DisplayName()),
+			id:      id,
+		})
+	}
+	sort.SliceStable(bf.assignees, func(i, j int) bool {
+		return strings.ToLower(bf.assignees[i].name) < strings.ToLower(bf.assignees[j].name)
+	})
+
+	return nil
+}
+
+func (bf *bugForm) keybindings(g *gocui.Gui) error {
+	for _, view := range bugFormFields {
+		// Next and previous field
+		if err := setKeybindings(g, view, actionFormNext, bf.nextField); err != nil {
+			return err
+		}
+		if err := setKeybindings(g, view, actionFormPrevious, bf.previousField); err != nil {
+			return err
+		}
+
+		// Create
+		if err := setKeybindings(g, view, actionFormSubmit, bf.submit); err != nil {
+			return err
+		}
+
+		// Cancel
+		if err := setKeybindings(g, view, actionFormCancel, bf.cancel); err != nil {
+			return err
+		}
+
+		// Editor
+		if err := setKeybindings(g, view, actionFormEditor, bf.editor); err != nil {
+			return err
+		}
+	}
+
+	for _, view := range []string{bugFormLabelsView, bugFormAssigneesView} {
+		// Up
+		if err := setKeybindings(g, view, actionFormUp, bf.cursorUp); err != nil {
+			return err
+		}
+		// Down
+		if err := setKeybindings(g, view, actionFormDown, bf.cursorDown); err != nil {
+			return err
+		}
+		// Toggle
+		if err := setKeybindings(g, view, actionFormToggle, bf.toggle); err != nil {
+			return err
+		}
+	}
+
+	// Priority
+	if err := setKeybindings(g, bugFormPriorityView, actionFormLower, bf.lowerPriority); err != nil {
+		return err
+	}
+	if err := setKeybindings(g, bugFormPriorityView, actionFormHigher, bf.higherPriority); err != nil {
+		return err
+	}
+	if err := setKeybindings(g, bugFormPriorityView, actionFormToggle, bf.cyclePriority); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (bf *bugForm) layout(g *gocui.Gui) error {
+	maxX, maxY := g.Size()
+	bf.childViews = nil
+
+	// from the bottom: the error, the priority, then the lists
+	errorY := maxY - 5
+	priorityY := errorY - 3
+	listRows := minInt(6, maxInt(1, (priorityY-10)/2))
+	listY := priorityY - listRows - 2
+
+	// Title
+	v, created, err := bf.setView(g, bugFormTitleView, 0, 0, maxX-1, 2)
+	if err != nil {
+		return err
+	}
+	if created {
+		v.Title = "Title"
+		v.Editable = true
+		v.Editor = gocui.EditorFunc(singleLineEditor)
+		_, _ = fmt.Fprint(v, bf.title)
+		_ = v.SetCursor(len(bf.title), 0)
+	}
+	bf.title = strings.TrimSpace(v.Buffer())
+
+	// Body
+	v, created, err = bf.setView(g, bugFormBodyView, 0, 3, maxX-1, listY-1)
+	if err != nil {
+		return err
+	}
+	if created {
+		v.Title = "Body"
+		v.Editable = true
+		v.Wrap = true
+		_, _ = fmt.Fprint(v, bf.body)
+	}
+	bf.body = strings.TrimRight(v.Buffer(), "\n ")
+
+	// Labels and assignees
+	v, _, err = bf.setView(g, bugFormLabelsView, 0, listY, maxX/2-1, priorityY-1)
+	if err != nil {
+		return err
+	}
+	v.Title = "Labels"
+	err = bf.renderList(v, bf.labels, bf.labelCursor, "No label defined")
+	if err != nil {
+		return err
+	}
+
+	v, _, err = bf.setView(g, bugFormAssigneesView, maxX/2, listY, maxX-1, priorityY-1)
+	if err != nil {
+		return err
+	}
+	v.Title = "Assignees"
+	err = bf.renderList(v, bf.assignees, bf.assigneeCursor, "No identity")
+	if err != nil {
+		return err
+	}
+
+	// Priority
+	v, _, err = bf.setView(g, bugFormPriorityView, 0, priorityY, maxX-1, priorityY+2)
+	if err != nil {
+		return err
+	}
+	v.Title = "Priority"
+	v.Clear()
+	for i, priority := range bug.Priorities {
+		if i == bf.priority {
+			_, _ = fmt.Fprintf(v, " %s", colors.Selection("["+priority+"]"))
+		} else {
+			_, _ = fmt.Fprintf(v, "  %s ", priority)
+		}
+	}
+
+	// Error
+	v, _, err = bf.setView(g, bugFormErrorView, -1, errorY, maxX, errorY+2)
+	if err != nil {
+		return err
+	}
+	v.Frame = false
+	v.Clear()
+	if bf.err != "" {
+		_, _ = fmt.Fprint(v, colors.Error(bf.err))
+	}
+
+	// Instructions
+	v, _, err = bf.setView(g, bugFormInstructionView, -1, maxY-2, maxX, maxY)
+	if err != nil {
+		return err
+	}
+	v.Frame = false
+	v.FgColor, v.BgColor = roleColors(colors.RoleBar)
+	v.Clear()
+	_, _ = fmt.Fprint(v, instructions(
+		instruction("Create", actionFormSubmit),
+		instruction("Cancel", actionFormCancel),
+		instruction("Next field", actionFormNext),
+		instruction("Toggle", actionFormToggle),
+		instruction("Editor", actionFormEditor),
+		instruction("Help", actionHelp),
+	))
+	if _, err := g.SetViewOnTop(bugFormInstructionView); err != nil {
+		return err
+	}
+
+	// the focused field is highlighted
+	g.Highlight = true
+	g.SelFgColor, _ = roleColors(colors.RoleSeparator)
+	g.SelFrameColor = g.SelFgColor
+
+	focused := bugFormFields[bf.focus]
+	if focused == bugFormTitleView || focused == bugFormBodyView {
+		g.Cursor = true
+	}
+
+	_, err = g.SetCurrentView(focused)
+	return err
+}
+
+// setView create or update a framed view of the form, created is true if
+// the view didn't exist
+func (bf *bugForm) setView(g *gocui.Gui, name string, x0, y0, x1, y1 int) (v *gocui.View, created bool, err error) {
+	v, err = g.SetView(name, x0, y0, x1, y1, 0)
+	if err != nil {
+		if !gocui.IsUnknownView(err) {
+			return nil, false, err
+		}
+		v.Frame = true
+		created = true
+	}
+
+	bf.childViews = append(bf.childViews, name)
+
+	return v, created, nil
+}
+
+// renderList render the labels or the assignees, scrolled to keep the
+// cursor visible
+func (bf *bugForm) renderList(v *gocui.View, choices []formChoice, cursor int, empty string) error {
+	v.Clear()
+
+	if len(choices) == 0 {
+		_, _ = fmt.Fprint(v, colors.Placeholder(empty))
+		return nil
+	}
+
+	focused := bugFormFields[bf.focus] == v.Name()
+
+	for i, choice := range choices {
+		box := "[ ]"
+		if choice.selected {
+			box = "[x]"
+		}
+
+		line := fmt.Sprintf(" %s %s", box, choice.display)
+		if focused && i == cursor {
+			line = fmt.Sprintf(" %s %s", colors.Selection(box), choice.display)
+		}
+
+		_, _ = fmt.Fprintln(v, line)
+	}
+
+	_, rows := v.Size()
+	_, oy := v.Origin()
+
+	switch {
+	case cursor < oy:
+		oy = cursor
+	case cursor >= oy+rows:
+		oy = cursor - rows + 1
+	}
+
+	return v.SetOrigin(0, oy)
+}
+
+func (bf *bugForm) disable(g *gocui.Gui) error {
+	for _, view := range bf.childViews {
+		if err := g.DeleteView(view); err != nil && !gocui.IsUnknownView(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// singleLineEditor is the default editor, without new lines
+func singleLineEditor(v *gocui.View, key gocui.Key, ch rune, mod gocui.Modifier) {
+	if key == gocui.KeyEnter || key == gocui.KeyArrowDown || key == gocui.KeyArrowUp {
+		return
+	}
+	gocui.DefaultEditor.Edit(v, key, ch, mod)
+}
+
+func (bf *bugForm) nextField(g *gocui.Gui, v *gocui.View) error {
+	return bf.setFocus(g, (bf.focus+1)%len(bugFormFields))
+}
+
+func (bf *bugForm) previousField(g *gocui.Gui, v *gocui.View) error {
+	return bf.setFocus(g, (bf.focus+len(bugFormFields)-1)%len(bugFormFields))
+}
+
+// setFocus focus a field right away, as the keys already typed are handled
+// before the next layout
+func (bf *bugForm) setFocus(g *gocui.Gui, focus int) error {
+	bf.focus = focus
+	_, err := g.SetCurrentView(bugFormFields[focus])
+	return err
+}
+
+// list return the focused list and its cursor
+func (bf *bugForm) list() ([]formChoice, *int) {
+	if bugFormFields[bf.focus] == bugFormLabelsView {
+		return bf.labels, &bf.labelCursor
+	}
+	return bf.assignees, &bf.assigneeCursor
+}
+
+func (bf *bugForm) cursorUp(g *gocui.Gui, v *gocui.View) error {
+	_, cursor := bf.list()
+	*cursor = maxInt(0, *cursor-1)
+	return nil
+}
+
+func (bf *bugForm) cursorDown(g *gocui.Gui, v *gocui.View) error {
+	choices, cursor := bf.list()
+	*cursor = maxInt(0, minInt(len(choices)-1, *cursor+1))
+	return nil
+}
+
+func (bf *bugForm) toggle(g *gocui.Gui, v *gocui.View) error {
+	choices, cursor := bf.list()
+	if *cursor < len(choices) {
+		choices[*cursor].selected = !choices[*cursor].selected
+	}
+	return nil
+}
+
+func (bf *bugForm) lowerPriority(g *gocui.Gui, v *gocui.View) error {
+	bf.priority = maxInt(0, bf.priority-1)
+	return nil
+}
+
+func (bf *bugForm) higherPriority(g *gocui.Gui, v *gocui.View) error {
+	bf.priority = minInt(len(bug.Priorities)-1, bf.priority+1)
+	return nil
+}
+
+func (bf *bugForm) cyclePriority(g *gocui.Gui, v *gocui.View) error {
+	bf.priority = (bf.priority + 1) % len(bug.Priorities)
+	return nil
+}
+
+func (bf *bugForm) cancel(g *gocui.Gui, v *gocui.View) error {
+	return ui.activateWindow(ui.bugTable)
+}
+
+func (bf *bugForm) editor(g *gocui.Gui, v *gocui.View) error {
+	return editBugFormWithEditor(bf)
+}
+
+// validate check the title and the body, and return the problems found
+func (bf *bugForm) validate() (string, error) {
+	if bf.title == "" {
+		return "The title can't be empty.", nil
+	}
+
+	err := input.ValidateBug(bf.cache, bf.title, bf.body)
+	if invalid, ok := err.(input.ErrInvalidBug); ok {
+		return strings.Join(invalid.Problems, ", ") + ".", nil
+	}
+
+	return "", err
+}
+
+func (bf *bugForm) submit(g *gocui.Gui, v *gocui.View) error {
+	problem, err := bf.validate()
+	if err != nil {
+		return err
+	}
+	if problem != "" {
+		bf.err = problem
+		return nil
+	}
+
+	b, _, err := bf.cache.NewBug(bf.title, bf.body)
+	if err != nil {
+		bf.err = err.Error()
+		return nil
+	}
+
+	var labels []string
+	for _, label := range bf.labels {
+		if label.selected {
+			labels = append(labels, label.name)
+		}
+	}
+	if bf.priority > 0 {
+		labels = append(labels, bug.PriorityLabelPrefix+bug.Priorities[bf.priority])
+	}
+
+	if len(labels) > 0 {
+		if _, _, err := b.ChangeLabels(labels, nil); err != nil {
+			return err
+		}
+	}
+
+	var assignees []*cache.IdentityCache
+	for _, assignee := range bf.assignees {
+		if !assignee.selected {
+			continue
+		}
+		i, err := bf.cache.ResolveIdentity(assignee.id)
+		if err != nil {
+			return err
+		}
+		assignees = append(assignees, i)
+	}
+
+	if len(assignees) > 0 {
+		if _, err := b.ChangeAssignees(assignees, nil); err != nil {
+			return err
+		}
+	}
+
+	if err := b.CommitAsNeeded(); err != nil {
+		return err
+	}
+
+	ui.statusBar.showMessage("Bug %s created", b.Id().Human())
+
+	ui.showBug.SetBug(b)
+	return ui.activateWindow(ui.showBug)
+}
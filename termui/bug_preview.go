@@ -0,0 +1,96 @@
+package termui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MichaelMure/go-term-text"
+	"github.com/awesome-gocui/gocui"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+const bugPreviewView = "bugPreviewView"
+
+// layoutPreview show the selected bug on the right of the table, in the split
+// layout
+func (bt *bugTable) layoutPreview(g *gocui.Gui, x0 int, maxX int, maxY int) error {
+	v, err := g.SetView(bugPreviewView, x0, 2, maxX-1, maxY-4, 0)
+
+	if err != nil {
+		if !gocui.IsUnknownView(err) {
+			return err
+		}
+
+		v.Frame = true
+		v.Wrap = false
+	}
+
+	v.Clear()
+
+	width, _ := v.Size()
+
+	id, ok := bt.selectedBug()
+	if !ok {
+		_, _ = fmt.Fprintf(v, " %s", colors.Placeholder("No bug selected"))
+		return nil
+	}
+
+	b, err := bt.repo.ResolveBug(id)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprint(v, renderPreview(b.Snapshot(), width))
+
+	return nil
+}
+
+// renderPreview render the header of a bug and its messages, the events of
+// the timeline being left to the bug view
+func renderPreview(snap *bug.Snapshot, width int) string {
+	var labels []string
+	for _, label := range snap.Labels {
+		labels = append(labels, colors.Emphasis(label.String()))
+	}
+
+	header := fmt.Sprintf("[%s] %s\n\n[%s] %s opened this bug on %s",
+		colors.Id(snap.Id().Human()),
+		colors.Emphasis(snap.Title),
+		colors.Status(snap.Status),
+		colors.Author(snap.Author.DisplayName()),
+		snap.CreatedAt.Format(timeLayout),
+	)
+	if len(labels) > 0 {
+		header += "\nLabels: " + strings.Join(labels, ", ")
+	}
+
+	header, _ = text.Wrap(header, width)
+
+	blocks := []string{header}
+
+	for i, comment := range snap.Comments {
+		var message string
+		if comment.Message == "" {
+			message, _ = text.WrapLeftPadded(emptyMessagePlaceholder(), width, 2)
+		} else {
+			message, _ = ui.showBug.renderMessage(comment.Message, width, 2)
+		}
+
+		if i == 0 {
+			// the description of the bug, already introduced by the header
+			blocks = append(blocks, message)
+			continue
+		}
+
+		intro, _ := text.Wrap(fmt.Sprintf("%s commented on %s",
+			colors.Author(comment.Author.DisplayName()),
+			comment.UnixTime.Time().Format(timeLayout),
+		), width)
+
+		blocks = append(blocks, intro+"\n\n"+message)
+	}
+
+	return strings.Join(blocks, "\n\n")
+}
@@ -3,6 +3,8 @@ package termui
 import (
 	"bytes"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/awesome-gocui/gocui"
 	"github.com/dustin/go-humanize"
 
+	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/util/colors"
@@ -19,18 +22,94 @@ const bugTableView = "bugTableView"
 const bugTableHeaderView = "bugTableHeaderView"
 const bugTableFooterView = "bugTableFooterView"
 const bugTableInstructionView = "bugTableInstructionView"
+const bugTableFilterView = "bugTableFilterView"
+
+// the prompt of the filter, in the header of the table
+const bugTableFilterPrompt = " Filter: "
+
+// below this width, the table only has the id, the status and the title of
+// the bugs
+const compactTableWidth = 100
 
 const defaultRemote = "origin"
 const defaultQuery = "status:open"
 
+// tableSortings are the sort orders the table cycle through
+var tableSortings = []string{"creation", "edit", "id", "title"}
+
+var sortQualifierRegexp = regexp.MustCompile(`(^|\s)sort:\S*`)
+
+// tableGrouping is how the bugs of the table are grouped
+type tableGrouping int
+
+const (
+	groupNone tableGrouping = iota
+	groupByStatus
+	groupByLabel
+	groupByMilestone
+)
+
+func (g tableGrouping) String() string {
+	switch g {
+	case groupByStatus:
+		return "status"
+	case groupByLabel:
+		return "label"
+	case groupByMilestone:
+		return "milestone"
+	default:
+		return "none"
+	}
+}
+
+// bugTableRow is a row of the table: a bug, or the header of a group of bugs
+type bugTableRow struct {
+	// the bug, empty for a group header
+	id entity.Id
+	// the group of the bug or of the header, empty when not grouping
+	group string
+	// the number of bugs in the group, for a header
+	count int
+}
+
+func (r bugTableRow) isHeader() bool {
+	return r.id == ""
+}
+
 type bugTable struct {
-	repo         *cache.RepoCache
-	queryStr     string
-	query        *cache.Query
-	allIds       []entity.Id
+	repo     *cache.RepoCache
+	queryStr string
+	query    *cache.Query
+	// the bugs of the query, only queried again when it changes or when the
+	// bugs may have changed. The excerpts are resolved page by page.
+	allIds []entity.Id
+	stale  bool
+	// the bugs of each group, in the order of the query, computed with it
+	groups map[string][]entity.Id
+	// the rows of the table, and the ones of the page with their excerpt,
+	// nil for a group header
+	rows         []bugTableRow
+	pageRows     []bugTableRow
 	excerpts     []*cache.BugExcerpt
+	// the excerpts resolved for the page and the next one, kept while
+	// scrolling
+	prefetched map[entity.Id]*cache.BugExcerpt
 	pageCursor   int
 	selectCursor int
+
+	grouping tableGrouping
+	// the selected bug is shown on the right of the table
+	split bool
+	// the groups collapsed, by key
+	collapsed map[string]bool
+
+	// the filter bar is open and the query updated as it's typed
+	filterActive bool
+	// the query to restore if the filter is cancelled
+	filterBackupStr string
+	filterBackup    *cache.Query
+	// the problem with the query typed, if any
+	filterErr error
 }
 
 func newBugTable(c *cache.RepoCache) *bugTable {
@@ -45,18 +124,26 @@ func newBugTable(c *cache.RepoCache) *bugTable {
 		queryStr:     defaultQuery,
 		pageCursor:   0,
 		selectCursor: 0,
+		stale:        true,
+		collapsed:    make(map[string]bool),
 	}
 }
 
 func (bt *bugTable) layout(g *gocui.Gui) error {
 	maxX, maxY := g.Size()
 
-	if maxY < 4 {
+	if maxY < 5 {
 		// window too small !
 		return nil
 	}
 
-	v, err := g.SetView(bugTableHeaderView, -1, -1, maxX, 3, 0)
+	// the width of the table, the preview taking the rest of the screen
+	tableWidth := maxX
+	if bt.split {
+		tableWidth = maxX * 2 / 5
+	}
+
+	v, err := g.SetView(bugTableHeaderView, -1, -1, maxX, 4, 0)
 
 	if err != nil {
 		if !gocui.IsUnknownView(err) {
@@ -67,9 +154,16 @@ func (bt *bugTable) layout(g *gocui.Gui) error {
 	}
 
 	v.Clear()
-	bt.renderHeader(v, maxX)
+	bt.renderHeader(v, tableWidth)
+
+	if bt.filterActive {
+		err = bt.layoutFilter(g, maxX)
+		if err != nil {
+			return err
+		}
+	}
 
-	v, err = g.SetView(bugTableView, -1, 1, maxX, maxY-3, 0)
+	v, err = g.SetView(bugTableView, -1, 2, tableWidth, maxY-3, 0)
 
 	if err != nil {
 		if !gocui.IsUnknownView(err) {
@@ -77,8 +171,7 @@ func (bt *bugTable) layout(g *gocui.Gui) error {
 		}
 
 		v.Frame = false
-		v.SelBgColor = gocui.ColorWhite
-		v.SelFgColor = gocui.ColorBlack
+		v.SelFgColor, v.SelBgColor = roleColors(colors.RoleSelection)
 	}
 
 	_, viewHeight := v.Size()
@@ -93,9 +186,21 @@ func (bt *bugTable) layout(g *gocui.Gui) error {
 	}
 
 	v.Clear()
-	bt.render(v, maxX)
+	bt.render(v, tableWidth)
+
+	if bt.split {
+		err = bt.layoutPreview(g, tableWidth, maxX, maxY)
+	} else {
+		err = g.DeleteView(bugPreviewView)
+		if gocui.IsUnknownView(err) {
+			err = nil
+		}
+	}
+	if err != nil {
+		return err
+	}
 
-	v, err = g.SetView(bugTableFooterView, -1, maxY-4, maxX, maxY, 0)
+	v, err = g.SetView(bugTableFooterView, -1, maxY-4, maxX, maxY-2, 0)
 
 	if err != nil {
 		if !gocui.IsUnknownView(err) {
@@ -116,94 +221,169 @@ func (bt *bugTable) layout(g *gocui.Gui) error {
 		}
 
 		v.Frame = false
-		v.BgColor = gocui.ColorBlue
+		v.FgColor, v.BgColor = roleColors(colors.RoleBar)
+
+		_, _ = fmt.Fprint(v, instructions(
+			instruction("Quit", actionTableQuit),
+			instruction("Filter", actionTableFilter),
+			instruction("Navigation", actionTablePrevPage, actionTableDown, actionTableUp, actionTableNextPage),
+			instruction("Open bug", actionTableOpen),
+			instruction("New bug", actionTableNew),
+			instruction("Help", actionHelp),
+		))
+	}
 
-		_, _ = fmt.Fprintf(v, "[q] Quit [s] Search [←↓↑→,hjkl] Navigation [↵] Open bug [n] New bug [i] Pull [o] Push")
+	if bt.filterActive {
+		g.Cursor = true
+		_, err = g.SetCurrentView(bugTableFilterView)
+		return err
 	}
 
 	_, err = g.SetCurrentView(bugTableView)
 	return err
 }
 
+// layoutFilter display the filter bar over the header, and update the query
+// with what was typed
+func (bt *bugTable) layoutFilter(g *gocui.Gui, maxX int) error {
+	v, err := g.SetView(bugTableFilterView, len(bugTableFilterPrompt)-1, 0, maxX, 2, 0)
+
+	if err != nil {
+		if !gocui.IsUnknownView(err) {
+			return err
+		}
+
+		v.Frame = false
+		v.Editable = true
+		_, _ = fmt.Fprint(v, bt.queryStr)
+		_ = v.SetCursor(len(bt.queryStr), 0)
+	}
+
+	queryStr := strings.TrimSpace(v.Buffer())
+	if queryStr == strings.TrimSpace(bt.queryStr) {
+		return nil
+	}
+
+	bt.queryStr = queryStr
+
+	query, err := cache.ParseQuery(queryStr)
+	if err != nil {
+		// keep the previous results until the query is valid again
+		bt.filterErr = err
+		return nil
+	}
+
+	bt.filterErr = nil
+	bt.setQuery(queryStr, query)
+
+	return nil
+}
+
 func (bt *bugTable) keybindings(g *gocui.Gui) error {
 	// Quit
-	if err := g.SetKeybinding(bugTableView, 'q', gocui.ModNone, quit); err != nil {
+	if err := setKeybindings(g, bugTableView, actionTableQuit, quit); err != nil {
 		return err
 	}
 
 	// Down
-	if err := g.SetKeybinding(bugTableView, 'j', gocui.ModNone,
-		bt.cursorDown); err != nil {
+	if err := setKeybindings(g, bugTableView, actionTableDown, bt.cursorDown); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding(bugTableView, gocui.KeyArrowDown, gocui.ModNone,
-		bt.cursorDown); err != nil {
+
+	// Up
+	if err := setKeybindings(g, bugTableView, actionTableUp, bt.cursorUp); err != nil {
 		return err
 	}
-	// Up
-	if err := g.SetKeybinding(bugTableView, 'k', gocui.ModNone,
-		bt.cursorUp); err != nil {
+
+	// Previous page
+	if err := setKeybindings(g, bugTableView, actionTablePrevPage, bt.previousPage); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding(bugTableView, gocui.KeyArrowUp, gocui.ModNone,
-		bt.cursorUp); err != nil {
+
+	// Next page
+	if err := setKeybindings(g, bugTableView, actionTableNextPage, bt.nextPage); err != nil {
 		return err
 	}
 
-	// Previous page
-	if err := g.SetKeybinding(bugTableView, 'h', gocui.ModNone,
-		bt.previousPage); err != nil {
+	// New bug
+	if err := setKeybindings(g, bugTableView, actionTableNew, bt.newBug); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding(bugTableView, gocui.KeyArrowLeft, gocui.ModNone,
-		bt.previousPage); err != nil {
+
+	// Open bug
+	if err := setKeybindings(g, bugTableView, actionTableOpen, bt.openBug); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding(bugTableView, gocui.KeyPgup, gocui.ModNone,
-		bt.previousPage); err != nil {
+
+	// Pull
+	if err := setKeybindings(g, bugTableView, actionTablePull, bt.pull); err != nil {
 		return err
 	}
-	// Next page
-	if err := g.SetKeybinding(bugTableView, 'l', gocui.ModNone,
-		bt.nextPage); err != nil {
+
+	// Push
+	if err := setKeybindings(g, bugTableView, actionTablePush, bt.push); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding(bugTableView, gocui.KeyArrowRight, gocui.ModNone,
-		bt.nextPage); err != nil {
+
+	// Query
+	if err := setKeybindings(g, bugTableView, actionTableSearch, bt.changeQuery); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding(bugTableView, gocui.KeyPgdn, gocui.ModNone,
-		bt.nextPage); err != nil {
+
+	// Filter
+	if err := setKeybindings(g, bugTableView, actionTableFilter, bt.openFilter); err != nil {
 		return err
 	}
 
-	// New bug
-	if err := g.SetKeybinding(bugTableView, 'n', gocui.ModNone,
-		bt.newBug); err != nil {
+	// Sort
+	if err := setKeybindings(g, bugTableView, actionTableSort, bt.cycleSort); err != nil {
 		return err
 	}
 
-	// Open bug
-	if err := g.SetKeybinding(bugTableView, gocui.KeyEnter, gocui.ModNone,
-		bt.openBug); err != nil {
+	// Group
+	if err := setKeybindings(g, bugTableView, actionTableGroup, bt.cycleGrouping); err != nil {
+		return err
+	}
+	if err := setKeybindings(g, bugTableView, actionTableFold, bt.toggleGroup); err != nil {
 		return err
 	}
 
-	// Pull
-	if err := g.SetKeybinding(bugTableView, 'i', gocui.ModNone,
-		bt.pull); err != nil {
+	// Clipboard
+	if err := setKeybindings(g, bugTableView, actionYankId, yankBug(yankId, bt.selectedBug)); err != nil {
+		return err
+	}
+	if err := setKeybindings(g, bugTableView, actionYankHumanId, yankBug(yankHumanId, bt.selectedBug)); err != nil {
+		return err
+	}
+	if err := setKeybindings(g, bugTableView, actionYankURL, yankBug(yankURL, bt.selectedBug)); err != nil {
 		return err
 	}
 
-	// Push
-	if err := g.SetKeybinding(bugTableView, 'o', gocui.ModNone,
-		bt.push); err != nil {
+	// Tabs
+	for i, action := range tabActions {
+		if err := setKeybindings(g, bugTableView, action, bt.selectTab(i)); err != nil {
+			return err
+		}
+	}
+	if err := setKeybindings(g, bugTableView, actionTableNextTab, bt.nextTab); err != nil {
 		return err
 	}
 
-	// Query
-	if err := g.SetKeybinding(bugTableView, 's', gocui.ModNone,
-		bt.changeQuery); err != nil {
+	// Inbox
+	if err := setKeybindings(g, bugTableView, actionTableInbox, bt.openInbox); err != nil {
+		return err
+	}
+
+	// Split layout
+	if err := setKeybindings(g, bugTableView, actionTableSplit, bt.toggleSplit); err != nil {
+		return err
+	}
+
+	// Filter bar
+	if err := setKeybindings(g, bugTableFilterView, actionFilterValidate, bt.validateFilter); err != nil {
+		return err
+	}
+	if err := setKeybindings(g, bugTableFilterView, actionFilterCancel, bt.cancelFilter); err != nil {
 		return err
 	}
 
@@ -211,6 +391,9 @@ func (bt *bugTable) keybindings(g *gocui.Gui) error {
 }
 
 func (bt *bugTable) disable(g *gocui.Gui) error {
+	// the bugs can be changed while the table is hidden
+	bt.stale = true
+
 	if err := g.DeleteView(bugTableView); err != nil && !gocui.IsUnknownView(err) {
 		return err
 	}
@@ -223,46 +406,202 @@ func (bt *bugTable) disable(g *gocui.Gui) error {
 	if err := g.DeleteView(bugTableInstructionView); err != nil && !gocui.IsUnknownView(err) {
 		return err
 	}
+	if err := g.DeleteView(bugTableFilterView); err != nil && !gocui.IsUnknownView(err) {
+		return err
+	}
+	if err := g.DeleteView(bugPreviewView); err != nil && !gocui.IsUnknownView(err) {
+		return err
+	}
 	return nil
 }
 
+// setQuery show the bugs of a new query, from the top of the table
+func (bt *bugTable) setQuery(queryStr string, query *cache.Query) {
+	bt.queryStr = queryStr
+	bt.query = query
+	bt.pageCursor = 0
+	bt.selectCursor = 0
+	bt.stale = true
+}
+
+// paginate run the query if needed, and resolve the excerpts of the page.
+// Running the query at each layout would filter and sort every bug of the
+// repository at each key press.
 func (bt *bugTable) paginate(max int) error {
-	bt.allIds = bt.repo.QueryBugs(bt.query)
+	if bt.stale {
+		if bt.grouping == groupNone {
+			bt.allIds = bt.repo.QueryBugs(bt.query)
+			bt.groups = nil
+		} else {
+			excerpts := bt.repo.QueryBugExcerpts(bt.query)
+			bt.allIds = make([]entity.Id, len(excerpts))
+			for i, excerpt := range excerpts {
+				bt.allIds[i] = excerpt.Id
+			}
+			bt.groups = bt.groupBugs(excerpts)
+		}
+		bt.rows = bt.groupRows()
+		bt.prefetched = nil
+		bt.stale = false
+	}
 
 	return bt.doPaginate(max)
 }
 
+// groupBugs sort the bugs of the query in their groups. Only the grouping
+// needs the excerpts of all the bugs, given by the query.
+func (bt *bugTable) groupBugs(excerpts []*cache.BugExcerpt) map[string][]entity.Id {
+	groups := make(map[string][]entity.Id)
+
+	for _, excerpt := range excerpts {
+		for _, group := range bt.groupsOf(excerpt) {
+			groups[group] = append(groups[group], excerpt.Id)
+		}
+	}
+
+	return groups
+}
+
+// groupRows build the rows of the table from the bugs of the query and their
+// groups, with a header before the bugs of each group. The bugs of a
+// collapsed group are omitted.
+func (bt *bugTable) groupRows() []bugTableRow {
+	if bt.grouping == groupNone {
+		rows := make([]bugTableRow, len(bt.allIds))
+		for i, id := range bt.allIds {
+			rows[i] = bugTableRow{id: id}
+		}
+		return rows
+	}
+
+	var rows []bugTableRow
+
+	for _, group := range bt.sortedGroups(bt.groups) {
+		ids := bt.groups[group]
+
+		rows = append(rows, bugTableRow{group: group, count: len(ids)})
+
+		if bt.collapsed[bt.groupKey(group)] {
+			continue
+		}
+
+		for _, id := range ids {
+			rows = append(rows, bugTableRow{id: id, group: group})
+		}
+	}
+
+	return rows
+}
+
+// groupsOf return the groups of a bug. A bug with several labels is in the
+// group of each label, and a bug without label or milestone in the "" group.
+func (bt *bugTable) groupsOf(excerpt *cache.BugExcerpt) []string {
+	switch bt.grouping {
+	case groupByStatus:
+		return []string{excerpt.Status.String()}
+	case groupByMilestone:
+		return []string{excerpt.Milestone}
+	}
+
+	labels := bt.repo.LabelStore().ResolveAll(excerpt.Labels)
+	if len(labels) == 0 {
+		return []string{""}
+	}
+
+	result := make([]string, len(labels))
+	for i, label := range labels {
+		result[i] = label.String()
+	}
+	return result
+}
+
+// sortedGroups return the groups in the order they are displayed: the open
+// bugs before the closed ones, the labels or the milestones alphabetically
+// then the bugs without one.
+func (bt *bugTable) sortedGroups(groups map[string][]entity.Id) []string {
+	if bt.grouping == groupByStatus {
+		var result []string
+		for _, status := range []bug.Status{bug.OpenStatus, bug.ClosedStatus} {
+			if _, ok := groups[status.String()]; ok {
+				result = append(result, status.String())
+			}
+		}
+		return result
+	}
+
+	result := make([]string, 0, len(groups))
+	for group := range groups {
+		result = append(result, group)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i] == "" || result[j] == "" {
+			return result[j] == ""
+		}
+		return result[i] < result[j]
+	})
+
+	return result
+}
+
+// groupKey identify a group across the groupings, to remember if it's
+// collapsed
+func (bt *bugTable) groupKey(group string) string {
+	return bt.grouping.String() + ":" + group
+}
+
 func (bt *bugTable) doPaginate(max int) error {
 	// clamp the cursor
 	bt.pageCursor = maxInt(bt.pageCursor, 0)
-	bt.pageCursor = minInt(bt.pageCursor, len(bt.allIds))
+	bt.pageCursor = minInt(bt.pageCursor, len(bt.rows))
 
-	nb := minInt(len(bt.allIds)-bt.pageCursor, max)
+	nb := minInt(len(bt.rows)-bt.pageCursor, max)
 
 	if nb < 0 {
+		bt.pageRows = nil
 		bt.excerpts = []*cache.BugExcerpt{}
 		return nil
 	}
 
 	// slice the data
-	ids := bt.allIds[bt.pageCursor : bt.pageCursor+nb]
+	bt.pageRows = bt.rows[bt.pageCursor : bt.pageCursor+nb]
 
-	bt.excerpts = make([]*cache.BugExcerpt, len(ids))
+	// only the excerpts of the page are resolved, and the ones of the next
+	// page to scroll to it right away
+	end := minInt(len(bt.rows), bt.pageCursor+2*max)
+	prefetched := make(map[entity.Id]*cache.BugExcerpt, end-bt.pageCursor)
 
-	for i, id := range ids {
-		excerpt, err := bt.repo.ResolveBugExcerpt(id)
-		if err != nil {
-			return err
+	for _, row := range bt.rows[bt.pageCursor:end] {
+		if row.isHeader() {
+			continue
+		}
+
+		excerpt, ok := bt.prefetched[row.id]
+		if !ok {
+			var err error
+			excerpt, err = bt.repo.ResolveBugExcerpt(row.id)
+			if err != nil {
+				return err
+			}
 		}
 
-		bt.excerpts[i] = excerpt
+		prefetched[row.id] = excerpt
+	}
+
+	bt.prefetched = prefetched
+
+	bt.excerpts = make([]*cache.BugExcerpt, len(bt.pageRows))
+	for i, row := range bt.pageRows {
+		if !row.isHeader() {
+			bt.excerpts[i] = prefetched[row.id]
+		}
 	}
 
 	return nil
 }
 
 func (bt *bugTable) getTableLength() int {
-	return len(bt.excerpts)
+	return len(bt.pageRows)
 }
 
 func (bt *bugTable) getColumnWidths(maxX int) map[string]int {
@@ -270,6 +609,12 @@ func (bt *bugTable) getColumnWidths(maxX int) map[string]int {
 	m["id"] = 9
 	m["status"] = 7
 
+	if maxX < compactTableWidth {
+		// only the title next to the id and the status
+		m["title"] = maxInt(maxX-3-m["id"]-m["status"], 10)
+		return m
+	}
+
 	left := maxX - 5 - m["id"] - m["status"]
 
 	m["comments"] = 10
@@ -286,7 +631,12 @@ func (bt *bugTable) getColumnWidths(maxX int) map[string]int {
 func (bt *bugTable) render(v *gocui.View, maxX int) {
 	columnWidths := bt.getColumnWidths(maxX)
 
-	for _, excerpt := range bt.excerpts {
+	for i, excerpt := range bt.excerpts {
+		if bt.pageRows[i].isHeader() {
+			_, _ = fmt.Fprintln(v, bt.renderGroupHeader(bt.pageRows[i]))
+			continue
+		}
+
 		summaryTxt := fmt.Sprintf("%4d 💬", excerpt.LenComments)
 		if excerpt.LenComments <= 0 {
 			summaryTxt = ""
@@ -296,11 +646,9 @@ func (bt *bugTable) render(v *gocui.View, maxX int) {
 		}
 
 		var labelsTxt strings.Builder
-		for _, l := range excerpt.Labels {
-			lc256 := l.Color().Term256()
-			labelsTxt.WriteString(lc256.Escape())
-			labelsTxt.WriteString(" ◼")
-			labelsTxt.WriteString(lc256.Unescape())
+		for _, l := range bt.repo.LabelStore().ResolveAll(excerpt.Labels) {
+			lc256 := bt.repo.LabelStore().Color(l).Term256()
+			labelsTxt.WriteString(colors.Term256(int(lc256), " ◼"))
 		}
 
 		var authorDisplayName string
@@ -324,12 +672,17 @@ func (bt *bugTable) render(v *gocui.View, maxX int) {
 		comments := text.LeftPadMaxLine(summaryTxt, columnWidths["comments"], 1)
 		lastEdit := text.LeftPadMaxLine(humanize.Time(lastEditTime), columnWidths["lastEdit"], 1)
 
+		if columnWidths["author"] == 0 {
+			_, _ = fmt.Fprintf(v, "%s %s %s%s\n", colors.Id(id), colors.Status(status), title, labels)
+			continue
+		}
+
 		_, _ = fmt.Fprintf(v, "%s %s %s%s %s %s %s\n",
-			colors.Cyan(id),
-			colors.Yellow(status),
+			colors.Id(id),
+			colors.Status(status),
 			title,
 			labels,
-			colors.Magenta(author),
+			colors.Author(author),
 			comments,
 			lastEdit,
 		)
@@ -338,6 +691,31 @@ func (bt *bugTable) render(v *gocui.View, maxX int) {
 	_ = v.SetHighlight(bt.selectCursor, true)
 }
 
+// renderGroupHeader render the header of a group, with its number of bugs
+func (bt *bugTable) renderGroupHeader(row bugTableRow) string {
+	fold := "▾"
+	if bt.collapsed[bt.groupKey(row.group)] {
+		fold = "▸"
+	}
+
+	var name string
+	switch {
+	case bt.grouping == groupByLabel && row.group == "":
+		name = colors.Placeholder("no label")
+	case bt.grouping == groupByLabel:
+		lc256 := bt.repo.LabelStore().Color(bug.Label(row.group)).Term256()
+		name = colors.Term256(int(lc256), "◼ ") + colors.Emphasis(row.group)
+	case bt.grouping == groupByMilestone && row.group == "":
+		name = colors.Placeholder("no milestone")
+	case bt.grouping == groupByMilestone:
+		name = colors.Emphasis(row.group)
+	default:
+		name = colors.Status(row.group)
+	}
+
+	return fmt.Sprintf(" %s %s (%d)", fold, name, row.count)
+}
+
 func (bt *bugTable) renderHeader(v *gocui.View, maxX int) {
 	columnWidths := bt.getColumnWidths(maxX)
 
@@ -348,12 +726,46 @@ func (bt *bugTable) renderHeader(v *gocui.View, maxX int) {
 	comments := text.LeftPadMaxLine("COMMENTS", columnWidths["comments"], 1)
 	lastEdit := text.LeftPadMaxLine("LAST EDIT", columnWidths["lastEdit"], 1)
 
-	_, _ = fmt.Fprintf(v, "\n")
+	// the filter bar is displayed over the query when open
+	queryStr := bt.queryStr
+	if bt.filterActive {
+		queryStr = ""
+	}
+
+	_, _ = fmt.Fprintln(v, renderTabs(bt.queryStr))
+	_, _ = fmt.Fprintf(v, "%s%s\n", bugTableFilterPrompt, queryStr)
+	if columnWidths["author"] == 0 {
+		_, _ = fmt.Fprintf(v, "%s %s %s\n", id, status, title)
+		return
+	}
 	_, _ = fmt.Fprintf(v, "%s %s %s %s %s %s\n", id, status, title, author, comments, lastEdit)
 }
 
+// renderFooter render the grouping and the problem with the query typed in
+// the filter bar, the number of bugs being in the status bar
 func (bt *bugTable) renderFooter(v *gocui.View, maxX int) {
-	_, _ = fmt.Fprintf(v, " \nShowing %d of %d bugs", len(bt.excerpts), len(bt.allIds))
+	var parts []string
+
+	if bt.grouping != groupNone {
+		parts = append(parts, fmt.Sprintf("Grouped by %s", bt.grouping))
+	}
+
+	if bt.filterErr != nil {
+		parts = append(parts, colors.Error("invalid query: ", bt.filterErr))
+	}
+
+	_, _ = fmt.Fprintf(v, " %s", strings.Join(parts, " "))
+}
+
+// shownBugs return the number of bugs on the page
+func (bt *bugTable) shownBugs() int {
+	shown := 0
+	for _, row := range bt.pageRows {
+		if !row.isHeader() {
+			shown++
+		}
+	}
+	return shown
 }
 
 func (bt *bugTable) cursorDown(g *gocui.Gui, v *gocui.View) error {
@@ -361,7 +773,7 @@ func (bt *bugTable) cursorDown(g *gocui.Gui, v *gocui.View) error {
 	if bt.selectCursor+1 > bt.getTableLength()-1 {
 		_, max := v.Size()
 
-		if bt.pageCursor+max >= len(bt.allIds) {
+		if bt.pageCursor+max >= len(bt.rows) {
 			return nil
 		}
 
@@ -410,7 +822,7 @@ func (bt *bugTable) cursorClamp(v *gocui.View) error {
 func (bt *bugTable) nextPage(g *gocui.Gui, v *gocui.View) error {
 	_, max := v.Size()
 
-	if bt.pageCursor+max >= len(bt.allIds) {
+	if bt.pageCursor+max >= len(bt.rows) {
 		return nil
 	}
 
@@ -432,10 +844,33 @@ func (bt *bugTable) previousPage(g *gocui.Gui, v *gocui.View) error {
 }
 
 func (bt *bugTable) newBug(g *gocui.Gui, v *gocui.View) error {
-	return newBugWithEditor(bt.repo)
+	if err := ui.bugForm.Reset(); err != nil {
+		return err
+	}
+	return ui.activateWindow(ui.bugForm)
+}
+
+// toggleSplit show or hide the selected bug on the right of the table
+func (bt *bugTable) toggleSplit(g *gocui.Gui, v *gocui.View) error {
+	bt.split = !bt.split
+	return nil
+}
+
+func (bt *bugTable) openInbox(g *gocui.Gui, v *gocui.View) error {
+	if err := ui.inbox.load(); err != nil {
+		return err
+	}
+	return ui.activateWindow(ui.inbox)
 }
 
 func (bt *bugTable) openBug(g *gocui.Gui, v *gocui.View) error {
+	if bt.selectCursor >= len(bt.pageRows) {
+		return nil
+	}
+	if bt.pageRows[bt.selectCursor].isHeader() {
+		return bt.toggleGroup(g, v)
+	}
+
 	id := bt.excerpts[bt.selectCursor].Id
 	b, err := bt.repo.ResolveBug(id)
 	if err != nil {
@@ -445,6 +880,15 @@ func (bt *bugTable) openBug(g *gocui.Gui, v *gocui.View) error {
 	return ui.activateWindow(ui.showBug)
 }
 
+// selectedBug return the id of the selected bug, if a bug and not a group
+// header is selected
+func (bt *bugTable) selectedBug() (entity.Id, bool) {
+	if bt.selectCursor >= len(bt.pageRows) || bt.pageRows[bt.selectCursor].isHeader() {
+		return "", false
+	}
+	return bt.pageRows[bt.selectCursor].id, true
+}
+
 func (bt *bugTable) pull(g *gocui.Gui, v *gocui.View) error {
 	ui.msgPopup.Activate("Pull from remote "+defaultRemote, "...")
 
@@ -478,7 +922,7 @@ func (bt *bugTable) pull(g *gocui.Gui, v *gocui.View) error {
 				})
 			} else {
 				_, _ = fmt.Fprintf(&buffer, "%s%s: %s",
-					beginLine, colors.Cyan(result.Entity.Id().Human()), result,
+					beginLine, colors.Id(result.Entity.Id().Human()), result,
 				)
 
 				beginLine = "\n"
@@ -494,6 +938,8 @@ func (bt *bugTable) pull(g *gocui.Gui, v *gocui.View) error {
 
 		g.Update(func(gui *gocui.Gui) error {
 			ui.msgPopup.UpdateMessage(buffer.String())
+			ui.statusBar.showMessage("Pulled from %s", defaultRemote)
+			bt.stale = true
 			return nil
 		})
 
@@ -517,6 +963,7 @@ func (bt *bugTable) push(g *gocui.Gui, v *gocui.View) error {
 		} else {
 			g.Update(func(gui *gocui.Gui) error {
 				ui.msgPopup.UpdateMessage(stdout)
+				ui.statusBar.showMessage("Pushed to %s", defaultRemote)
 				return nil
 			})
 		}
@@ -528,3 +975,128 @@ func (bt *bugTable) push(g *gocui.Gui, v *gocui.View) error {
 func (bt *bugTable) changeQuery(g *gocui.Gui, v *gocui.View) error {
 	return editQueryWithEditor(bt)
 }
+
+func (bt *bugTable) openFilter(g *gocui.Gui, v *gocui.View) error {
+	bt.filterActive = true
+	bt.filterBackupStr = bt.queryStr
+	bt.filterBackup = bt.query
+	bt.filterErr = nil
+	return nil
+}
+
+func (bt *bugTable) closeFilter(g *gocui.Gui) error {
+	bt.filterActive = false
+	bt.filterErr = nil
+
+	err := g.DeleteView(bugTableFilterView)
+	if err != nil && !gocui.IsUnknownView(err) {
+		return err
+	}
+	return nil
+}
+
+// validateFilter close the filter bar, keeping the query if valid
+func (bt *bugTable) validateFilter(g *gocui.Gui, v *gocui.View) error {
+	if bt.filterErr != nil {
+		return nil
+	}
+	return bt.closeFilter(g)
+}
+
+// cancelFilter close the filter bar and restore the previous query
+func (bt *bugTable) cancelFilter(g *gocui.Gui, v *gocui.View) error {
+	bt.setQuery(bt.filterBackupStr, bt.filterBackup)
+	return bt.closeFilter(g)
+}
+
+// cycleSort sort the bugs with the next sort order of tableSortings, by
+// changing the sort qualifier of the query
+func (bt *bugTable) cycleSort(g *gocui.Gui, v *gocui.View) error {
+	next := tableSortings[0]
+	for i, name := range tableSortings {
+		key, err := cache.ParseSortKey(name)
+		if err != nil {
+			return err
+		}
+		if len(bt.query.Sorting) > 0 && bt.query.Sorting[0].OrderBy == key.OrderBy {
+			next = tableSortings[(i+1)%len(tableSortings)]
+		}
+	}
+
+	queryStr := strings.TrimSpace(sortQualifierRegexp.ReplaceAllString(bt.queryStr, ""))
+	queryStr = strings.TrimSpace(queryStr + " sort:" + next)
+
+	query, err := cache.ParseQuery(queryStr)
+	if err != nil {
+		ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+		return nil
+	}
+
+	bt.setQuery(queryStr, query)
+
+	return nil
+}
+
+// cycleGrouping group the bugs by status, then by label, then by milestone,
+// then not at all
+func (bt *bugTable) cycleGrouping(g *gocui.Gui, v *gocui.View) error {
+	bt.grouping = (bt.grouping + 1) % (groupByMilestone + 1)
+	bt.pageCursor = 0
+	bt.selectCursor = 0
+	bt.stale = true
+	return nil
+}
+
+// toggleGroup collapse or expand the group of the selected row, and select
+// its header
+func (bt *bugTable) toggleGroup(g *gocui.Gui, v *gocui.View) error {
+	if bt.grouping == groupNone || bt.selectCursor >= len(bt.pageRows) {
+		return nil
+	}
+
+	group := bt.pageRows[bt.selectCursor].group
+	key := bt.groupKey(group)
+	bt.collapsed[key] = !bt.collapsed[key]
+	bt.rows = bt.groupRows()
+
+	for i, row := range bt.rows {
+		if !row.isHeader() || row.group != group {
+			continue
+		}
+
+		_, max := v.Size()
+		if i < bt.pageCursor || i >= bt.pageCursor+max {
+			bt.pageCursor = i
+		}
+		bt.selectCursor = i - bt.pageCursor
+		break
+	}
+
+	return nil
+}
+
+// selectTab return a handler showing the bugs of a tab
+func (bt *bugTable) selectTab(index int) func(*gocui.Gui, *gocui.View) error {
+	return func(g *gocui.Gui, v *gocui.View) error {
+		if index >= len(tabs) {
+			return nil
+		}
+
+		query, err := cache.ParseQuery(tabs[index].Query)
+		if err != nil {
+			ui.msgPopup.Activate(msgPopupErrorTitle, fmt.Sprintf("tab %s: %v", tabs[index].Name, err))
+			return nil
+		}
+
+		bt.setQuery(tabs[index].Query, query)
+
+		return nil
+	}
+}
+
+// nextTab show the bugs of the tab after the current one, or the first tab
+// if the query is not the one of a tab
+func (bt *bugTable) nextTab(g *gocui.Gui, v *gocui.View) error {
+	next := (currentTab(bt.queryStr) + 1) % len(tabs)
+	return bt.selectTab(next)(g, v)
+}
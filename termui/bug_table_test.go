@@ -0,0 +1,74 @@
+package termui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestBugTableGroupByMilestone(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	c, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer c.Close()
+
+	rene, err := c.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, c.SetUserIdentity(rene))
+
+	newBug := func(title string, labels ...string) entity.Id {
+		b, _, err := c.NewBug(title, "message")
+		require.NoError(t, err)
+		if len(labels) > 0 {
+			_, _, err = b.ChangeLabels(labels, nil)
+			require.NoError(t, err)
+		}
+		return b.Id()
+	}
+
+	v2 := newBug("later", "milestone:v2")
+	none := newBug("whenever")
+	v1 := newBug("first", "milestone:v1", "bug")
+	v1bis := newBug("first too", "milestone:v1")
+
+	bt := newBugTable(c)
+
+	// the cycle goes through the milestones before going back to no grouping
+	for bt.grouping != groupByMilestone {
+		require.NoError(t, bt.cycleGrouping(nil, nil))
+	}
+
+	// only the excerpts of the page and of the next one are resolved
+	require.NoError(t, bt.paginate(2))
+	assert.Len(t, bt.excerpts, 2)
+	assert.Len(t, bt.prefetched, 2)
+
+	// in the order of the query, the newest first
+	assert.Equal(t, []bugTableRow{
+		{group: "v1", count: 2},
+		{id: v1bis, group: "v1"},
+		{id: v1, group: "v1"},
+		{group: "v2", count: 1},
+		{id: v2, group: "v2"},
+		{group: "", count: 1},
+		{id: none, group: ""},
+	}, bt.groupRows())
+
+	// a collapsed group only keep its header, without resolving the bugs
+	// again
+	bt.collapsed[bt.groupKey("v1")] = true
+	require.NoError(t, c.RemoveBug(v1bis))
+	rows := bt.groupRows()
+	assert.Equal(t, bugTableRow{group: "v1", count: 2}, rows[0])
+	assert.Equal(t, bugTableRow{group: "v2", count: 1}, rows[1])
+
+	require.NoError(t, bt.cycleGrouping(nil, nil))
+	assert.Equal(t, groupNone, bt.grouping)
+}
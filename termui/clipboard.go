@@ -0,0 +1,120 @@
+package termui
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/awesome-gocui/gocui"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// webUIURL is the address of the web UI, to copy the url of the bugs, empty
+// if unknown
+var webUIURL string
+
+// SetWebUIURL set the address of the web UI, like "http://127.0.0.1:8080"
+func SetWebUIURL(url string) {
+	webUIURL = strings.TrimRight(url, "/")
+}
+
+// yankTarget is what is copied about a bug
+type yankTarget int
+
+const (
+	yankId yankTarget = iota
+	yankHumanId
+	yankURL
+)
+
+// clipboardCommands are the native tools copying their input to the
+// clipboard, with the environment variable needed to reach it, if any
+var clipboardCommands = []struct {
+	env  string
+	args []string
+}{
+	{"", []string{"pbcopy"}},
+	{"WAYLAND_DISPLAY", []string{"wl-copy"}},
+	{"DISPLAY", []string{"xclip", "-selection", "clipboard"}},
+	{"DISPLAY", []string{"xsel", "--clipboard", "--input"}},
+	{"", []string{"clip.exe"}},
+}
+
+// yankBug return a handler copying the id, the human id or the url of a bug
+// to the clipboard. The handler does nothing if no bug is selected.
+func yankBug(target yankTarget, selected func() (entity.Id, bool)) func(*gocui.Gui, *gocui.View) error {
+	return func(g *gocui.Gui, v *gocui.View) error {
+		id, ok := selected()
+		if !ok {
+			return nil
+		}
+
+		var text string
+		switch target {
+		case yankId:
+			text = id.String()
+		case yankHumanId:
+			text = id.Human()
+		case yankURL:
+			if webUIURL == "" {
+				ui.msgPopup.Activate(msgPopupErrorTitle,
+					"The url of the web UI is unknown, set git-bug.webui.url or git-bug.webui.port")
+				return nil
+			}
+			text = bugURL(webUIURL, id)
+		}
+
+		if err := copyToClipboard(text); err != nil {
+			ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+			return nil
+		}
+
+		ui.statusBar.showMessage("Copied %s", text)
+		return nil
+	}
+}
+
+// bugURL return the url of a bug in the web UI
+func bugURL(base string, id entity.Id) string {
+	return fmt.Sprintf("%s/bug/%s", base, id.Human())
+}
+
+// copyToClipboard copy a text to the clipboard with the OSC 52 escape
+// sequence, understood by most terminals even over ssh, and with a native
+// tool if one is available. The terminal doesn't tell if the sequence is
+// supported, so an error is only returned if nothing could be tried.
+func copyToClipboard(text string) error {
+	_, seqErr := os.Stdout.WriteString(osc52(text, os.Getenv("TMUX") != ""))
+
+	for _, cmd := range clipboardCommands {
+		if cmd.env != "" && os.Getenv(cmd.env) == "" {
+			continue
+		}
+		if _, err := exec.LookPath(cmd.args[0]); err != nil {
+			continue
+		}
+
+		c := exec.Command(cmd.args[0], cmd.args[1:]...)
+		c.Stdin = strings.NewReader(text)
+		if err := c.Run(); err == nil {
+			return nil
+		}
+	}
+
+	return seqErr
+}
+
+// osc52 return the escape sequence setting the clipboard of the terminal.
+// Inside tmux, it's wrapped to be passed through to the terminal.
+func osc52(text string, tmux bool) string {
+	seq := fmt.Sprintf("\x1b]52;c;%s\a", base64.StdEncoding.EncodeToString([]byte(text)))
+
+	if tmux {
+		seq = "\x1bPtmux;" + strings.Replace(seq, "\x1b", "\x1b\x1b", -1) + "\x1b\\"
+	}
+
+	return seq
+}
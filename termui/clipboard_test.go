@@ -0,0 +1,19 @@
+package termui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+func TestOSC52(t *testing.T) {
+	assert.Equal(t, "\x1b]52;c;NWUyOTM5Nw==\a", osc52("5e29397", false))
+	assert.Equal(t, "\x1bPtmux;\x1b\x1b]52;c;NWUyOTM5Nw==\a\x1b\\", osc52("5e29397", true))
+}
+
+func TestBugURL(t *testing.T) {
+	id := entity.Id("5e29397a8c2f4d1b9e6f0a3c7d8b2e1f4a6c9d0b3e5f7a1c2d4e6f8a0b2c4d6e")
+	assert.Equal(t, "http://127.0.0.1:8080/bug/5e29397", bugURL("http://127.0.0.1:8080", id))
+}
@@ -0,0 +1,57 @@
+package termui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/awesome-gocui/gocui"
+)
+
+// openCommandLine ask for a command, like ":q" in vim or "M-x quit" in emacs
+func openCommandLine(g *gocui.Gui, v *gocui.View) error {
+	c := ui.inputPopup.Activate("Command")
+
+	go func() {
+		input := <-c
+
+		g.Update(func(g *gocui.Gui) error {
+			return runCommand(g, strings.TrimSpace(input))
+		})
+	}()
+
+	return nil
+}
+
+// runCommand run a command of the command line: "q" return to the bug list
+// or quit from there, "qa" quit from anywhere and "help" list the key
+// bindings
+func runCommand(g *gocui.Gui, command string) error {
+	switch strings.TrimSuffix(command, "!") {
+	case "":
+		return nil
+
+	case "q", "quit", "wq", "x":
+		switch window := ui.activeWindow.(type) {
+		case *showBug:
+			return window.saveAndBack(g, nil)
+		case *inbox:
+			return window.back(g, nil)
+		default:
+			return gocui.ErrQuit
+		}
+
+	case "qa", "qall", "quitall", "wqa", "xa":
+		if window, ok := ui.activeWindow.(*showBug); ok {
+			if err := window.bug.CommitAsNeeded(); err != nil {
+				return err
+			}
+		}
+		return gocui.ErrQuit
+
+	case "h", "help":
+		return ui.helpPopup.open(g, nil)
+	}
+
+	ui.msgPopup.Activate(msgPopupErrorTitle, fmt.Sprintf("unknown command %s", command))
+	return nil
+}
@@ -0,0 +1,131 @@
+package termui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MichaelMure/go-term-text"
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+// number of unchanged lines displayed around the changes of a diff
+const diffContext = 2
+
+// unifiedDiff return the colored line diff between two versions of a
+// message, with the removed lines followed by the added ones
+func unifiedDiff(before string, after string, width int) []string {
+	a, b := diffSplit(before), diffSplit(after)
+
+	var result []string
+
+	for i, group := range difflib.NewMatcher(a, b).GetGroupedOpCodes(diffContext) {
+		if i > 0 {
+			result = append(result, colors.Separator("…"))
+		}
+
+		for _, op := range group {
+			if op.Tag == 'e' {
+				for _, line := range a[op.I1:op.I2] {
+					result = append(result, diffLines("  ", line, width, fmt.Sprint)...)
+				}
+				continue
+			}
+
+			for _, line := range a[op.I1:op.I2] {
+				result = append(result, diffLines("- ", line, width, colors.Removed)...)
+			}
+			for _, line := range b[op.J1:op.J2] {
+				result = append(result, diffLines("+ ", line, width, colors.Added)...)
+			}
+		}
+	}
+
+	return result
+}
+
+// sideBySideDiff return the colored line diff between two versions of a
+// message, with the removed lines on the left and the added ones on the right
+func sideBySideDiff(before string, after string, width int) []string {
+	a, b := diffSplit(before), diffSplit(after)
+
+	separator := colors.Separator(" │ ")
+	colWidth := maxInt(4, (width-text.Len(separator))/2)
+
+	var result []string
+
+	for i, group := range difflib.NewMatcher(a, b).GetGroupedOpCodes(diffContext) {
+		if i > 0 {
+			result = append(result, colors.Separator("…"))
+		}
+
+		for _, op := range group {
+			if op.Tag == 'e' {
+				for k := 0; k < op.I2-op.I1; k++ {
+					left := diffLines("  ", a[op.I1+k], colWidth, fmt.Sprint)
+					right := diffLines("  ", b[op.J1+k], colWidth, fmt.Sprint)
+					result = append(result, sideBySideRows(left, right, colWidth, separator)...)
+				}
+				continue
+			}
+
+			removed := a[op.I1:op.I2]
+			added := b[op.J1:op.J2]
+
+			for k := 0; k < maxInt(len(removed), len(added)); k++ {
+				var left, right []string
+				if k < len(removed) {
+					left = diffLines("- ", removed[k], colWidth, colors.Removed)
+				}
+				if k < len(added) {
+					right = diffLines("+ ", added[k], colWidth, colors.Added)
+				}
+				result = append(result, sideBySideRows(left, right, colWidth, separator)...)
+			}
+		}
+	}
+
+	return result
+}
+
+func diffSplit(message string) []string {
+	return strings.Split(strings.TrimRight(message, "\n"), "\n")
+}
+
+// diffLines wrap a line of a diff, with a marker before it, and format each
+// of the wrapped lines
+func diffLines(marker string, line string, width int, format func(a ...interface{}) string) []string {
+	wrapped, _ := text.Wrap(line, maxInt(1, width-len(marker)))
+
+	split := strings.Split(wrapped, "\n")
+	for i, l := range split {
+		if i == 0 {
+			split[i] = format(marker + l)
+		} else {
+			split[i] = format(strings.Repeat(" ", len(marker)) + l)
+		}
+	}
+
+	return split
+}
+
+// sideBySideRows put two sets of lines side by side
+func sideBySideRows(left []string, right []string, colWidth int, separator string) []string {
+	var result []string
+
+	for i := 0; i < maxInt(len(left), len(right)); i++ {
+		var l, r string
+		if i < len(left) {
+			l = left[i]
+		}
+		if i < len(right) {
+			r = right[i]
+		}
+
+		padding := strings.Repeat(" ", maxInt(0, colWidth-text.Len(l)))
+		result = append(result, strings.TrimRight(l+padding+separator+r, " "))
+	}
+
+	return result
+}
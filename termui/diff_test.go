@@ -0,0 +1,43 @@
+package termui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	before := "one\ntwo\nthree\nfour\nfive\nsix\nseven\n"
+	after := "one\n2\nthree\nfour\nfive\nsix\nseven\neight"
+
+	assert.Equal(t, []string{
+		"  one",
+		"- two",
+		"+ 2",
+		"  three",
+		"  four",
+		"…",
+		"  six",
+		"  seven",
+		"+ eight",
+	}, unifiedDiff(before, after, 40))
+
+	// the long lines are wrapped
+	assert.Equal(t, []string{
+		"- a b",
+		"+ a b c",
+		"  d",
+	}, unifiedDiff("a b", "a b c d", 7))
+}
+
+func TestSideBySideDiff(t *testing.T) {
+	before := "one\ntwo\nthree"
+	after := "one\n2\n2.5\nthree"
+
+	assert.Equal(t, []string{
+		"  one        │   one",
+		"- two        │ + 2",
+		"             │ + 2.5",
+		"  three      │   three",
+	}, sideBySideDiff(before, after, 27))
+}
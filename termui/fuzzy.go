@@ -0,0 +1,52 @@
+package termui
+
+import (
+	"strings"
+	"unicode"
+)
+
+// fuzzyScore return how well a query match a text. Each word of the query
+// must be found in the text as a subsequence of its characters, ignoring the
+// case. Consecutive characters and characters at the start of a word score
+// higher. ok is false if the query doesn't match.
+func fuzzyScore(query string, text string) (score int, ok bool) {
+	runes := []rune(strings.ToLower(text))
+
+	for _, term := range strings.Fields(strings.ToLower(query)) {
+		s, ok := fuzzyTermScore([]rune(term), runes)
+		if !ok {
+			return 0, false
+		}
+		score += s
+	}
+
+	return score, true
+}
+
+func fuzzyTermScore(term []rune, text []rune) (int, bool) {
+	score := 0
+	t := 0
+	last := -2
+
+	for i, r := range text {
+		if t == len(term) {
+			break
+		}
+		if r != term[t] {
+			continue
+		}
+
+		score++
+		if i == last+1 {
+			score += 4
+		}
+		if i == 0 || !unicode.IsLetter(text[i-1]) && !unicode.IsDigit(text[i-1]) {
+			score += 2
+		}
+
+		last = i
+		t++
+	}
+
+	return score, t == len(term)
+}
@@ -0,0 +1,250 @@
+package termui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/MichaelMure/go-term-text"
+	"github.com/awesome-gocui/gocui"
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+const fuzzySelectInputView = "fuzzySelectInputView"
+const fuzzySelectListView = "fuzzySelectListView"
+
+// ErrNoBugSelected is returned when the fuzzy selector is closed without
+// choosing a bug
+var ErrNoBugSelected = errors.New("no bug selected")
+
+type fuzzyEntry struct {
+	id       entity.Id
+	search   string
+	display  string
+	editTime int64
+	score    int
+}
+
+type fuzzySelect struct {
+	entries  []fuzzyEntry
+	matches  []fuzzyEntry
+	query    string
+	selected int
+	scroll   int
+	result   entity.Id
+}
+
+// SelectBug open an interactive fuzzy finder over the ids, titles and labels
+// of the bugs, and return the id of the chosen one. ErrNoBugSelected is
+// returned if the user quit without choosing.
+func SelectBug(repo *cache.RepoCache) (entity.Id, error) {
+	fs, err := newFuzzySelect(repo)
+	if err != nil {
+		return "", err
+	}
+
+	g, err := gocui.NewGui(gocui.Output256, false)
+	if err != nil {
+		return "", err
+	}
+
+	g.InputEsc = true
+	g.Cursor = true
+	g.SetManagerFunc(fs.layout)
+
+	err = fs.keybindings(g)
+	if err != nil {
+		g.Close()
+		return "", err
+	}
+
+	err = g.MainLoop()
+	g.Close()
+
+	if err != nil && err != gocui.ErrQuit {
+		return "", err
+	}
+
+	if fs.result == "" {
+		return "", ErrNoBugSelected
+	}
+
+	return fs.result, nil
+}
+
+func newFuzzySelect(repo *cache.RepoCache) (*fuzzySelect, error) {
+	fs := &fuzzySelect{}
+
+	for _, id := range repo.AllBugsIds() {
+		excerpt, err := repo.ResolveBugExcerpt(id)
+		if err != nil {
+			return nil, err
+		}
+
+		var labels []string
+		var labelsTxt strings.Builder
+		for _, l := range repo.LabelStore().ResolveAll(excerpt.Labels) {
+			labels = append(labels, l.String())
+			lc256 := repo.LabelStore().Color(l).Term256()
+			labelsTxt.WriteString(" ")
+			labelsTxt.WriteString(colors.Term256(int(lc256), l.String()))
+		}
+
+		fs.entries = append(fs.entries, fuzzyEntry{
+			id:     id,
+			search: fmt.Sprintf("%s %s %s", id.Human(), excerpt.Title, strings.Join(labels, " ")),
+			display: fmt.Sprintf("%s %s %s%s",
+				colors.Id(id.Human()),
+				colors.Status(text.LeftPadMaxLine(excerpt.Status.String(), 6, 0)),
+				excerpt.Title,
+				labelsTxt.String(),
+			),
+			editTime: excerpt.EditUnixTime,
+		})
+	}
+
+	// without a query, the most recently edited bugs come first
+	sort.SliceStable(fs.entries, func(i, j int) bool {
+		return fs.entries[i].editTime > fs.entries[j].editTime
+	})
+
+	fs.filter("")
+
+	return fs, nil
+}
+
+// filter update the matching entries for a new query, the best matches first
+func (fs *fuzzySelect) filter(query string) {
+	fs.query = query
+	fs.matches = fs.matches[:0]
+
+	for _, entry := range fs.entries {
+		score, ok := fuzzyScore(query, entry.search)
+		if !ok {
+			continue
+		}
+		entry.score = score
+		fs.matches = append(fs.matches, entry)
+	}
+
+	sort.SliceStable(fs.matches, func(i, j int) bool {
+		return fs.matches[i].score > fs.matches[j].score
+	})
+
+	fs.selected = 0
+	fs.scroll = 0
+}
+
+func (fs *fuzzySelect) keybindings(g *gocui.Gui) error {
+	// Abort
+	if err := g.SetKeybinding(fuzzySelectInputView, gocui.KeyEsc, gocui.ModNone, fs.abort); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(fuzzySelectInputView, gocui.KeyCtrlC, gocui.ModNone, fs.abort); err != nil {
+		return err
+	}
+	// Up
+	if err := g.SetKeybinding(fuzzySelectInputView, gocui.KeyArrowUp, gocui.ModNone, fs.selectPrevious); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(fuzzySelectInputView, gocui.KeyCtrlP, gocui.ModNone, fs.selectPrevious); err != nil {
+		return err
+	}
+	// Down
+	if err := g.SetKeybinding(fuzzySelectInputView, gocui.KeyArrowDown, gocui.ModNone, fs.selectNext); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(fuzzySelectInputView, gocui.KeyCtrlN, gocui.ModNone, fs.selectNext); err != nil {
+		return err
+	}
+	// Choose
+	if err := g.SetKeybinding(fuzzySelectInputView, gocui.KeyEnter, gocui.ModNone, fs.choose); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (fs *fuzzySelect) layout(g *gocui.Gui) error {
+	maxX, maxY := g.Size()
+
+	v, err := g.SetView(fuzzySelectInputView, 0, 0, maxX-1, 2, 0)
+	if err != nil {
+		if !gocui.IsUnknownView(err) {
+			return err
+		}
+
+		v.Frame = true
+		v.Title = "Search a bug"
+		v.Editable = true
+	}
+
+	query := strings.TrimSpace(v.Buffer())
+	if query != fs.query {
+		fs.filter(query)
+	}
+
+	if _, err := g.SetCurrentView(fuzzySelectInputView); err != nil {
+		return err
+	}
+
+	v, err = g.SetView(fuzzySelectListView, 0, 2, maxX-1, maxY-1, 0)
+	if err != nil {
+		if !gocui.IsUnknownView(err) {
+			return err
+		}
+
+		v.Frame = true
+		v.SelFgColor, v.SelBgColor = roleColors(colors.RoleSelection)
+	}
+
+	v.Title = fmt.Sprintf("%d/%d", len(fs.matches), len(fs.entries))
+
+	_, height := v.Size()
+	if fs.selected < fs.scroll {
+		fs.scroll = fs.selected
+	}
+	if fs.selected >= fs.scroll+height {
+		fs.scroll = fs.selected - height + 1
+	}
+
+	v.Clear()
+	for i := fs.scroll; i < len(fs.matches) && i < fs.scroll+height; i++ {
+		_, _ = fmt.Fprintln(v, fs.matches[i].display)
+	}
+
+	if len(fs.matches) > 0 {
+		_ = v.SetHighlight(fs.selected-fs.scroll, true)
+	}
+
+	return nil
+}
+
+func (fs *fuzzySelect) selectPrevious(g *gocui.Gui, v *gocui.View) error {
+	if fs.selected > 0 {
+		fs.selected--
+	}
+	return nil
+}
+
+func (fs *fuzzySelect) selectNext(g *gocui.Gui, v *gocui.View) error {
+	if fs.selected < len(fs.matches)-1 {
+		fs.selected++
+	}
+	return nil
+}
+
+func (fs *fuzzySelect) choose(g *gocui.Gui, v *gocui.View) error {
+	if len(fs.matches) == 0 {
+		return nil
+	}
+	fs.result = fs.matches[fs.selected].id
+	return gocui.ErrQuit
+}
+
+func (fs *fuzzySelect) abort(g *gocui.Gui, v *gocui.View) error {
+	return gocui.ErrQuit
+}
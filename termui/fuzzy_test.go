@@ -0,0 +1,37 @@
+package termui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuzzyScore(t *testing.T) {
+	_, ok := fuzzyScore("", "anything")
+	assert.True(t, ok)
+
+	_, ok = fuzzyScore("crash", "Crash on startup")
+	assert.True(t, ok)
+
+	_, ok = fuzzyScore("csu", "Crash on startup")
+	assert.True(t, ok)
+
+	_, ok = fuzzyScore("startup crash", "Crash on startup")
+	assert.True(t, ok)
+
+	_, ok = fuzzyScore("crashes", "Crash on startup")
+	assert.False(t, ok)
+
+	_, ok = fuzzyScore("crash foo", "Crash on startup")
+	assert.False(t, ok)
+
+	// consecutive characters score higher
+	contiguous, _ := fuzzyScore("start", "Crash on startup")
+	scattered, _ := fuzzyScore("start", "Some text about a rat")
+	assert.True(t, contiguous > scattered)
+
+	// start of words score higher
+	wordStart, _ := fuzzyScore("ui", "the ui is broken")
+	inWord, _ := fuzzyScore("ui", "build is broken")
+	assert.True(t, wordStart > inWord)
+}
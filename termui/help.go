@@ -0,0 +1,256 @@
+package termui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MichaelMure/go-term-text"
+	"github.com/awesome-gocui/gocui"
+
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+const helpView = "helpView"
+const helpFilterView = "helpFilterView"
+
+// width of the keys column of the help
+const helpKeysWidth = 18
+
+// keyContexts is the parts of the UI listed in the help, and the prefixes of
+// the name of their actions. The actions without prefix are available
+// everywhere.
+var keyContexts = []struct {
+	name     string
+	prefixes []string
+}{
+	{"Everywhere", nil},
+	{"Bug list", []string{"table."}},
+	{"Filter bar", []string{"filter."}},
+	{"Inbox", []string{"inbox."}},
+	{"Bug", []string{"bug."}},
+	{"Labels", []string{"labels."}},
+	{"New bug", []string{"form."}},
+	{"Popups", []string{"popup.", "input."}},
+	{"Help", []string{"help."}},
+}
+
+// helpPopup list the key bindings of every part of the UI, filtered with what
+// the user type
+type helpPopup struct {
+	active   bool
+	filter   string
+	scroll   int
+	pageSize int
+}
+
+func newHelpPopup() *helpPopup {
+	return &helpPopup{}
+}
+
+func (hp *helpPopup) keybindings(g *gocui.Gui) error {
+	// Open
+	views := []string{bugTableView, inboxView, showBugView, labelSelectView,
+		bugFormLabelsView, bugFormAssigneesView, bugFormPriorityView}
+	for _, view := range views {
+		if err := setKeybindings(g, view, actionHelp, hp.open); err != nil {
+			return err
+		}
+	}
+
+	// Close
+	if err := setKeybindings(g, helpFilterView, actionHelpClose, hp.close); err != nil {
+		return err
+	}
+
+	// Scrolling
+	if err := setKeybindings(g, helpFilterView, actionHelpUp, hp.scrollUp); err != nil {
+		return err
+	}
+	if err := setKeybindings(g, helpFilterView, actionHelpDown, hp.scrollDown); err != nil {
+		return err
+	}
+	if err := setKeybindings(g, helpFilterView, actionHelpPrevPage, hp.previousPage); err != nil {
+		return err
+	}
+	if err := setKeybindings(g, helpFilterView, actionHelpNextPage, hp.nextPage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (hp *helpPopup) layout(g *gocui.Gui) error {
+	if !hp.active {
+		return nil
+	}
+
+	maxX, maxY := g.Size()
+
+	width := minInt(70, maxX)
+	x0 := (maxX - width) / 2
+	y0 := 0
+	y1 := maxY - 3
+
+	fv, err := g.SetView(helpFilterView, x0, y0, x0+width, y0+2, 0)
+	if err != nil {
+		if !gocui.IsUnknownView(err) {
+			return err
+		}
+
+		fv.Frame = true
+		fv.Title = "Key bindings, type to filter"
+		fv.Editable = true
+	}
+
+	filter := strings.TrimSpace(fv.Buffer())
+	if filter != hp.filter {
+		hp.filter = filter
+		hp.scroll = 0
+	}
+
+	v, err := g.SetView(helpView, x0, y0+3, x0+width, y1, 0)
+	if err != nil {
+		if !gocui.IsUnknownView(err) {
+			return err
+		}
+
+		v.Frame = true
+	}
+
+	lines := helpLines(hp.filter, width-2)
+	if len(lines) == 0 {
+		lines = []string{colors.Placeholder("No key binding matching")}
+	}
+
+	hp.pageSize = maxInt(1, y1-y0-4)
+	hp.scroll = minInt(hp.scroll, maxInt(0, len(lines)-hp.pageSize))
+
+	v.Clear()
+	_, _ = fmt.Fprint(v, strings.Join(lines, "\n"))
+	if err := v.SetOrigin(0, hp.scroll); err != nil {
+		return err
+	}
+
+	g.Cursor = true
+	_, err = g.SetCurrentView(helpFilterView)
+	return err
+}
+
+func (hp *helpPopup) open(g *gocui.Gui, v *gocui.View) error {
+	hp.active = true
+	return nil
+}
+
+func (hp *helpPopup) close(g *gocui.Gui, v *gocui.View) error {
+	hp.active = false
+	hp.filter = ""
+	hp.scroll = 0
+
+	if err := g.DeleteView(helpFilterView); err != nil {
+		return err
+	}
+	return g.DeleteView(helpView)
+}
+
+func (hp *helpPopup) scrollUp(g *gocui.Gui, v *gocui.View) error {
+	hp.scroll = maxInt(0, hp.scroll-1)
+	return nil
+}
+
+func (hp *helpPopup) scrollDown(g *gocui.Gui, v *gocui.View) error {
+	// the layout prevent to scroll past the end
+	hp.scroll++
+	return nil
+}
+
+func (hp *helpPopup) previousPage(g *gocui.Gui, v *gocui.View) error {
+	hp.scroll = maxInt(0, hp.scroll-hp.pageSize)
+	return nil
+}
+
+func (hp *helpPopup) nextPage(g *gocui.Gui, v *gocui.View) error {
+	hp.scroll += hp.pageSize
+	return nil
+}
+
+// helpLines format the key bindings matching a filter, grouped by the part
+// of the UI where they are available
+func helpLines(filter string, width int) []string {
+	var result []string
+
+	for _, context := range keyContexts {
+		var lines []string
+
+		for _, binding := range defaultKeys {
+			if keyContext(binding.action) != context.name {
+				continue
+			}
+
+			keys := formatKeys(keymap[binding.action])
+
+			searched := fmt.Sprintf("%s %s %s", binding.description, binding.action, keys)
+			if !matchAllWords(filter, searched) {
+				continue
+			}
+
+			if keys == "" {
+				keys = colors.Placeholder("unbound")
+			}
+
+			line := fmt.Sprintf("  %s %s",
+				text.LeftPadMaxLine(keys, helpKeysWidth, 0),
+				binding.description)
+			lines = append(lines, text.TruncateMax(line, width))
+		}
+
+		if len(lines) == 0 {
+			continue
+		}
+
+		if len(result) > 0 {
+			result = append(result, "")
+		}
+		result = append(result, colors.Emphasis(context.name))
+		result = append(result, lines...)
+	}
+
+	return result
+}
+
+// matchAllWords return true if each word of the filter is found in the text,
+// ignoring the case
+func matchAllWords(filter string, str string) bool {
+	str = strings.ToLower(str)
+	for _, word := range strings.Fields(strings.ToLower(filter)) {
+		if !strings.Contains(str, word) {
+			return false
+		}
+	}
+	return true
+}
+
+// keyContext return the name of the part of the UI where an action is
+// available
+func keyContext(action keyAction) string {
+	for _, context := range keyContexts {
+		for _, prefix := range context.prefixes {
+			if strings.HasPrefix(string(action), prefix) {
+				return context.name
+			}
+		}
+	}
+	return keyContexts[0].name
+}
+
+// formatKeys format the keys of an action for the help, like "j, ↓"
+func formatKeys(keys []string) string {
+	formatted := make([]string, len(keys))
+	for i, key := range keys {
+		if symbol, ok := keySymbols[key]; ok {
+			formatted[i] = symbol
+		} else {
+			formatted[i] = key
+		}
+	}
+	return strings.Join(formatted, ", ")
+}
@@ -0,0 +1,242 @@
+package termui
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+// syntax is what the highlighting of code needs to know about a language
+type syntax struct {
+	// the start of a comment running to the end of the line
+	lineComments []string
+	// the start and end of a comment that can span several lines
+	blockComment [2]string
+	keywords     map[string]bool
+}
+
+func words(list string) map[string]bool {
+	result := make(map[string]bool)
+	for _, word := range strings.Fields(list) {
+		result[word] = true
+	}
+	return result
+}
+
+var cLikeSyntax = syntax{
+	lineComments: []string{"//"},
+	blockComment: [2]string{"/*", "*/"},
+}
+
+var syntaxes = map[string]syntax{
+	"go": {
+		lineComments: cLikeSyntax.lineComments,
+		blockComment: cLikeSyntax.blockComment,
+		keywords: words(`break case chan const continue default defer else fallthrough
+			for func go goto if import interface map package range return select struct
+			switch type var nil true false iota`),
+	},
+	"c": {
+		lineComments: cLikeSyntax.lineComments,
+		blockComment: cLikeSyntax.blockComment,
+		keywords: words(`auto break case char const continue default do double else
+			enum extern float for goto if int long register return short signed sizeof
+			static struct switch typedef union unsigned void volatile while NULL
+			class namespace public private protected template typename new delete
+			true false this virtual`),
+	},
+	"java": {
+		lineComments: cLikeSyntax.lineComments,
+		blockComment: cLikeSyntax.blockComment,
+		keywords: words(`abstract boolean break byte case catch char class const
+			continue default do double else enum extends final finally float for if
+			implements import instanceof int interface long new null package private
+			protected public return short static super switch this throw throws try void
+			while true false val var fun`),
+	},
+	"javascript": {
+		lineComments: cLikeSyntax.lineComments,
+		blockComment: cLikeSyntax.blockComment,
+		keywords: words(`async await break case catch class const continue default
+			delete do else export extends finally for function if import in instanceof
+			let new null return switch this throw try typeof undefined var void while
+			yield true false interface type`),
+	},
+	"rust": {
+		lineComments: cLikeSyntax.lineComments,
+		blockComment: cLikeSyntax.blockComment,
+		keywords: words(`as break const continue crate else enum extern false fn for
+			if impl in let loop match mod move mut pub ref return self Self static struct
+			super trait true type unsafe use where while`),
+	},
+	"python": {
+		lineComments: []string{"#"},
+		keywords: words(`and as assert async await break class continue def del elif
+			else except finally for from global if import in is lambda nonlocal not or
+			pass raise return try while with yield None True False`),
+	},
+	"ruby": {
+		lineComments: []string{"#"},
+		keywords: words(`begin break case class def do else elsif end ensure false for
+			if in module next nil not or rescue return self super then true unless until
+			when while yield require`),
+	},
+	"sh": {
+		lineComments: []string{"#"},
+		keywords: words(`if then else elif fi case esac for while until do done in
+			function return export local echo exit`),
+	},
+	"yaml": {
+		lineComments: []string{"#"},
+		keywords:     words(`true false null yes no`),
+	},
+	"sql": {
+		lineComments: []string{"--"},
+		blockComment: cLikeSyntax.blockComment,
+		keywords: words(`select from where insert into values update set delete create
+			table drop alter index join left right inner outer on and or not null as
+			order by group having limit SELECT FROM WHERE INSERT INTO VALUES UPDATE SET
+			DELETE CREATE TABLE DROP ALTER INDEX JOIN LEFT RIGHT INNER OUTER ON AND OR
+			NOT NULL AS ORDER BY GROUP HAVING LIMIT`),
+	},
+	"lua": {
+		lineComments: []string{"--"},
+		keywords: words(`and break do else elseif end false for function if in local
+			nil not or repeat return then true until while`),
+	},
+}
+
+// languageAliases are the other names of the languages of a code block
+var languageAliases = map[string]string{
+	"golang":     "go",
+	"h":          "c",
+	"cpp":        "c",
+	"c++":        "c",
+	"cs":         "java",
+	"csharp":     "java",
+	"kotlin":     "java",
+	"js":         "javascript",
+	"ts":         "javascript",
+	"typescript": "javascript",
+	"json":       "javascript",
+	"rs":         "rust",
+	"py":         "python",
+	"rb":         "ruby",
+	"bash":       "sh",
+	"shell":      "sh",
+	"zsh":        "sh",
+	"console":    "sh",
+	"yml":        "yaml",
+	"toml":       "yaml",
+}
+
+// highlight color the lines of some code with the syntax of its language. The
+// code of an unknown language is only colored as code.
+func highlight(code string, lang string) []string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if alias, ok := languageAliases[lang]; ok {
+		lang = alias
+	}
+
+	lines := strings.Split(code, "\n")
+
+	s, ok := syntaxes[lang]
+	if !ok {
+		for i, line := range lines {
+			lines[i] = colors.Code(line)
+		}
+		return lines
+	}
+
+	// whether a block comment is still open at the start of a line
+	inComment := false
+	for i, line := range lines {
+		lines[i], inComment = highlightLine(line, s, inComment)
+	}
+	return lines
+}
+
+func highlightLine(line string, s syntax, inComment bool) (string, bool) {
+	var result strings.Builder
+	runes := []rune(line)
+
+	for i := 0; i < len(runes); {
+		rest := string(runes[i:])
+
+		if inComment {
+			end := strings.Index(rest, s.blockComment[1])
+			if end < 0 {
+				result.WriteString(colors.Comment(rest))
+				return result.String(), true
+			}
+			end += len(s.blockComment[1])
+			result.WriteString(colors.Comment(rest[:end]))
+			i += len([]rune(rest[:end]))
+			inComment = false
+			continue
+		}
+
+		if s.blockComment[0] != "" && strings.HasPrefix(rest, s.blockComment[0]) {
+			inComment = true
+			result.WriteString(colors.Comment(s.blockComment[0]))
+			i += len([]rune(s.blockComment[0]))
+			continue
+		}
+
+		lineComment := false
+		for _, prefix := range s.lineComments {
+			if strings.HasPrefix(rest, prefix) {
+				lineComment = true
+			}
+		}
+		if lineComment {
+			result.WriteString(colors.Comment(rest))
+			return result.String(), false
+		}
+
+		r := runes[i]
+		switch {
+		case r == '"' || r == '\'' || r == '`':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				if runes[end] == '\\' && r != '`' {
+					end++
+				}
+				end++
+			}
+			if end >= len(runes) {
+				end = len(runes) - 1
+			}
+			result.WriteString(colors.Literal(string(runes[i : end+1])))
+			i = end + 1
+
+		case unicode.IsDigit(r):
+			end := i
+			for end < len(runes) && (unicode.IsDigit(runes[end]) || unicode.IsLetter(runes[end]) || runes[end] == '.' || runes[end] == '_') {
+				end++
+			}
+			result.WriteString(colors.Literal(string(runes[i:end])))
+			i = end
+
+		case unicode.IsLetter(r) || r == '_':
+			end := i
+			for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end]) || runes[end] == '_') {
+				end++
+			}
+			word := string(runes[i:end])
+			if s.keywords[word] {
+				result.WriteString(colors.Keyword(word))
+			} else {
+				result.WriteString(word)
+			}
+			i = end
+
+		default:
+			result.WriteRune(r)
+			i++
+		}
+	}
+
+	return result.String(), inComment
+}
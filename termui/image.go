@@ -0,0 +1,350 @@
+package termui
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/awesome-gocui/termbox-go"
+
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+// graphicsProtocol is how the terminal can display an image
+type graphicsProtocol int
+
+const (
+	graphicsNone graphicsProtocol = iota
+	graphicsKitty
+	graphicsITerm2
+	graphicsSixel
+)
+
+// graphics is the protocol used to display the images inline, detected when
+// the termui is launched
+var graphics = graphicsNone
+
+// height in rows of an image in the timeline
+const imageRows = 10
+
+// size in pixels assumed for a cell, to scale the images
+const cellPixelWidth = 10
+const cellPixelHeight = 20
+
+// detectGraphics guess the graphics protocol of the terminal from the
+// environment. Inside tmux or screen, the escape sequences would need to be
+// wrapped, so no images are displayed.
+func detectGraphics() graphicsProtocol {
+	term := os.Getenv("TERM")
+
+	switch {
+	case os.Getenv("TMUX") != "" || strings.HasPrefix(term, "screen"):
+		return graphicsNone
+	case os.Getenv("KITTY_WINDOW_ID") != "" || term == "xterm-kitty":
+		return graphicsKitty
+	case os.Getenv("TERM_PROGRAM") == "iTerm.app" || os.Getenv("TERM_PROGRAM") == "WezTerm":
+		return graphicsITerm2
+	case term == "mlterm" || strings.HasPrefix(term, "foot") || strings.Contains(term, "sixel"):
+		return graphicsSixel
+	}
+
+	return graphicsNone
+}
+
+// imageRef is an image referenced in a message, or a file attached to it
+type imageRef struct {
+	name string
+	// the file in the repo, empty for an image outside of it
+	hash git.Hash
+}
+
+var markdownImageRegexp = regexp.MustCompile(`!\[([^\]]*)\]\(\s*<?([^)\s>]+)`)
+
+// the url of a file of the repo, as uploaded by the webui
+var gitFileRegexp = regexp.MustCompile(`gitfile/([0-9a-f]{40}|[0-9a-f]{64})$`)
+
+// messageImages return the images of a message: the ones in its markdown,
+// then the attached files not already referenced
+func messageImages(message string, files []git.Hash) []imageRef {
+	var result []imageRef
+	seen := make(map[git.Hash]bool)
+
+	for _, match := range markdownImageRegexp.FindAllStringSubmatch(message, -1) {
+		alt, link := match[1], match[2]
+
+		ref := imageRef{name: path.Base(link)}
+
+		if m := gitFileRegexp.FindStringSubmatch(link); m != nil {
+			ref.hash = git.Hash(m[1])
+			ref.name = alt
+			if ref.name == "" {
+				ref.name = attachmentName(ref.hash)
+			}
+			if seen[ref.hash] {
+				continue
+			}
+			seen[ref.hash] = true
+		}
+
+		result = append(result, ref)
+	}
+
+	for _, hash := range files {
+		if seen[hash] {
+			continue
+		}
+		seen[hash] = true
+		result = append(result, imageRef{name: attachmentName(hash), hash: hash})
+	}
+
+	return result
+}
+
+func attachmentName(hash git.Hash) string {
+	return fmt.Sprintf("attachment %.7s", hash)
+}
+
+// inlineImage is a file of the repo decoded as an image
+type inlineImage struct {
+	data []byte
+	img  image.Image
+	// the escape sequences already encoded, by size
+	encoded map[[2]int]string
+}
+
+// imagePlacement is where an image is drawn on the screen
+type imagePlacement struct {
+	image      *inlineImage
+	x, y       int
+	cols, rows int
+}
+
+// imageSize return the size in cells of an image, at most imageRows high and
+// maxCols wide, keeping its aspect ratio
+func imageSize(img image.Image, maxCols int) (cols int, rows int) {
+	b := img.Bounds()
+	if b.Dx() <= 0 || b.Dy() <= 0 {
+		return 1, 1
+	}
+
+	rows = imageRows
+	cols = rows * cellPixelHeight * b.Dx() / (b.Dy() * cellPixelWidth)
+
+	if cols > maxCols {
+		cols = maxCols
+		rows = cols * cellPixelWidth * b.Dy() / (b.Dx() * cellPixelHeight)
+	}
+
+	return maxInt(1, cols), maxInt(1, rows)
+}
+
+// encodeImage return the escape sequence drawing an image at the cursor
+func encodeImage(im *inlineImage, cols int, rows int) (string, error) {
+	size := [2]int{cols, rows}
+	if seq, ok := im.encoded[size]; ok {
+		return seq, nil
+	}
+
+	scaled := scaleImage(im.img, cols*cellPixelWidth, rows*cellPixelHeight)
+
+	var seq string
+	var err error
+
+	switch graphics {
+	case graphicsKitty:
+		seq, err = encodeKitty(scaled, cols, rows)
+	case graphicsITerm2:
+		seq = encodeITerm2(im.data, cols, rows)
+	case graphicsSixel:
+		seq = encodeSixel(scaled)
+	default:
+		return "", fmt.Errorf("no graphics protocol")
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if im.encoded == nil {
+		im.encoded = make(map[[2]int]string)
+	}
+	im.encoded[size] = seq
+
+	return seq, nil
+}
+
+// encodeKitty encode an image with the kitty graphics protocol, as chunks of
+// PNG data. The terminal doesn't answer, and the cursor doesn't move.
+func encodeKitty(img image.Image, cols int, rows int) (string, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return "", err
+	}
+
+	data := base64.StdEncoding.EncodeToString(buf.Bytes())
+
+	var out strings.Builder
+	for first := true; first || data != ""; first = false {
+		chunk := data
+		if len(chunk) > 4096 {
+			chunk = chunk[:4096]
+		}
+		data = data[len(chunk):]
+
+		more := 0
+		if data != "" {
+			more = 1
+		}
+
+		if first {
+			_, _ = fmt.Fprintf(&out, "\x1b_Ga=T,f=100,q=2,C=1,c=%d,r=%d,m=%d;%s\x1b\\", cols, rows, more, chunk)
+		} else {
+			_, _ = fmt.Fprintf(&out, "\x1b_Gm=%d;%s\x1b\\", more, chunk)
+		}
+	}
+
+	return out.String(), nil
+}
+
+// encodeITerm2 encode the file of an image with the iTerm2 inline images
+// protocol, the terminal doing the decoding and the scaling
+func encodeITerm2(data []byte, cols int, rows int) string {
+	return fmt.Sprintf("\x1b]1337;File=inline=1;size=%d;width=%d;height=%d;preserveAspectRatio=1:%s\a",
+		len(data), cols, rows, base64.StdEncoding.EncodeToString(data))
+}
+
+// encodeSixel encode an image as sixels, with a palette of 216 colors. The
+// mostly transparent pixels are not drawn.
+func encodeSixel(img image.Image) string {
+	b := img.Bounds()
+	width, height := b.Dx(), b.Dy()
+
+	var out strings.Builder
+	_, _ = fmt.Fprintf(&out, "\x1bPq\"1;1;%d;%d", width, height)
+
+	for i := 0; i < 216; i++ {
+		_, _ = fmt.Fprintf(&out, "#%d;2;%d;%d;%d", i, i/36*20, i/6%6*20, i%6*20)
+	}
+
+	for y0 := 0; y0 < height; y0 += 6 {
+		// the sixels of each color of the band of 6 rows
+		sixels := make(map[int][]byte)
+		var order []int
+
+		for x := 0; x < width; x++ {
+			for dy := 0; dy < 6 && y0+dy < height; dy++ {
+				c := color.NRGBAModel.Convert(img.At(b.Min.X+x, b.Min.Y+y0+dy)).(color.NRGBA)
+				if c.A < 128 {
+					continue
+				}
+
+				index := paletteLevel(c.R)*36 + paletteLevel(c.G)*6 + paletteLevel(c.B)
+
+				row, ok := sixels[index]
+				if !ok {
+					row = make([]byte, width)
+					sixels[index] = row
+					order = append(order, index)
+				}
+				row[x] |= 1 << uint(dy)
+			}
+		}
+
+		for i, index := range order {
+			if i > 0 {
+				// back to the start of the band
+				out.WriteByte('$')
+			}
+			_, _ = fmt.Fprintf(&out, "#%d", index)
+			writeSixelRow(&out, sixels[index])
+		}
+
+		out.WriteByte('-')
+	}
+
+	out.WriteString("\x1b\\")
+
+	return out.String()
+}
+
+func paletteLevel(v uint8) int {
+	return (int(v)*5 + 127) / 255
+}
+
+// writeSixelRow write a row of sixels, the repeated ones being compressed
+func writeSixelRow(out *strings.Builder, row []byte) {
+	for i := 0; i < len(row); {
+		j := i
+		for j < len(row) && row[j] == row[i] {
+			j++
+		}
+
+		char := '?' + row[i]
+		if j-i > 3 {
+			_, _ = fmt.Fprintf(out, "!%d%c", j-i, char)
+		} else {
+			for k := i; k < j; k++ {
+				out.WriteByte(char)
+			}
+		}
+
+		i = j
+	}
+}
+
+// scaleImage resize an image to the given size, with the nearest pixel
+func scaleImage(img image.Image, width int, height int) image.Image {
+	b := img.Bounds()
+	result := image.NewNRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			result.Set(x, y, img.At(b.Min.X+x*b.Dx()/width, b.Min.Y+y*b.Dy()/height))
+		}
+	}
+
+	return result
+}
+
+// drawImages draw the images over the cells reserved for them, and remove the
+// previous ones. It must be called once gocui has flushed the screen, as an
+// update of the main loop.
+func drawImages(previous []imagePlacement, placements []imagePlacement) error {
+	var out strings.Builder
+
+	// save the cursor of termbox
+	out.WriteString("\x1b7")
+
+	if graphics == graphicsKitty {
+		// the images are above the text, they are removed
+		out.WriteString("\x1b_Ga=d,q=2\x1b\\")
+	} else if len(previous) > 0 {
+		// the images replaced the cells, termbox redraw them all
+		if err := termbox.Sync(); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range placements {
+		seq, err := encodeImage(p.image, p.cols, p.rows)
+		if err != nil {
+			// the placeholder stay visible
+			continue
+		}
+		_, _ = fmt.Fprintf(&out, "\x1b[%d;%dH%s", p.y+1, p.x+1, seq)
+	}
+
+	out.WriteString("\x1b8")
+
+	_, err := os.Stdout.WriteString(out.String())
+	return err
+}
@@ -0,0 +1,58 @@
+package termui
+
+import (
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+func TestMessageImages(t *testing.T) {
+	hash1 := git.Hash("f9e7f3d4c2a1b0e8f7d6c5b4a39281706f5e4d3c")
+	hash2 := git.Hash("0123456789abcdef0123456789abcdef01234567")
+
+	message := `Crash on start:
+
+![screenshot](/gitfile/f9e7f3d4c2a1b0e8f7d6c5b4a39281706f5e4d3c)
+![](https://example.com/img/logo.png "logo")
+![again](/gitfile/f9e7f3d4c2a1b0e8f7d6c5b4a39281706f5e4d3c)`
+
+	images := messageImages(message, []git.Hash{hash1, hash2})
+
+	assert.Equal(t, []imageRef{
+		{name: "screenshot", hash: hash1},
+		{name: "logo.png"},
+		{name: "attachment 0123456", hash: hash2},
+	}, images)
+
+	assert.Empty(t, messageImages("no image, only a [link](https://example.com)", nil))
+}
+
+func TestImageSize(t *testing.T) {
+	square := image.NewNRGBA(image.Rect(0, 0, 100, 100))
+	cols, rows := imageSize(square, 80)
+	assert.Equal(t, 20, cols)
+	assert.Equal(t, 10, rows)
+
+	wide := image.NewNRGBA(image.Rect(0, 0, 1000, 100))
+	cols, rows = imageSize(wide, 40)
+	assert.Equal(t, 40, cols)
+	assert.Equal(t, 2, rows)
+}
+
+func TestEncodeSixel(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 8, 2))
+	for x := 0; x < 8; x++ {
+		img.Set(x, 0, color.NRGBA{R: 255, A: 255})
+	}
+
+	seq := encodeSixel(img)
+
+	assert.True(t, strings.HasPrefix(seq, "\x1bPq\"1;1;8;2#0;2;0;0;0"))
+	// a single band, with the first row in red (180) and the second transparent
+	assert.True(t, strings.HasSuffix(seq, "#180!8@-\x1b\\"))
+}
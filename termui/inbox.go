@@ -0,0 +1,425 @@
+package termui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/go-term-text"
+	"github.com/awesome-gocui/gocui"
+	"github.com/dustin/go-humanize"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+const inboxView = "inboxView"
+const inboxHeaderView = "inboxHeaderView"
+const inboxInstructionView = "inboxInstructionView"
+
+const lastSessionConfigKey = "git-bug.termui.last-session"
+
+// how far back the inbox goes for the first session
+const firstSessionInbox = 7 * 24 * time.Hour
+
+// inboxEntry is a bug changed by someone else since the last session
+type inboxEntry struct {
+	id      entity.Id
+	title   string
+	changes []string
+	last    time.Time
+}
+
+// inbox list the new bugs and the changes of the bugs the user authored,
+// commented, or is assigned to since the last session, to triage them
+type inbox struct {
+	repo *cache.RepoCache
+	// when the previous session started
+	since   time.Time
+	entries []inboxEntry
+	// the entries opened or dismissed during this session
+	dismissed map[entity.Id]bool
+	selected  int
+}
+
+func newInbox(repo *cache.RepoCache, since time.Time) *inbox {
+	return &inbox{
+		repo:      repo,
+		since:     since,
+		dismissed: make(map[entity.Id]bool),
+	}
+}
+
+// readLastSession return when the previous session of the termui started, or
+// a week ago for the first one
+func readLastSession(repo *cache.RepoCache) (time.Time, error) {
+	t, err := repo.LocalConfig().ReadTimestamp(lastSessionConfigKey)
+	if err == repository.ErrNoConfigEntry {
+		return time.Now().Add(-firstSessionInbox), nil
+	}
+	return t, err
+}
+
+// storeLastSession store when the session started
+func storeLastSession(repo *cache.RepoCache, start time.Time) error {
+	return repo.LocalConfig().StoreTimestamp(lastSessionConfigKey, start)
+}
+
+func (ib *inbox) keybindings(g *gocui.Gui) error {
+	// Back
+	if err := setKeybindings(g, inboxView, actionInboxBack, ib.back); err != nil {
+		return err
+	}
+
+	// Navigation
+	if err := setKeybindings(g, inboxView, actionInboxDown, ib.selectNext); err != nil {
+		return err
+	}
+	if err := setKeybindings(g, inboxView, actionInboxUp, ib.selectPrevious); err != nil {
+		return err
+	}
+
+	// Open
+	if err := setKeybindings(g, inboxView, actionInboxOpen, ib.open); err != nil {
+		return err
+	}
+
+	// Dismiss
+	if err := setKeybindings(g, inboxView, actionInboxDismiss, ib.dismiss); err != nil {
+		return err
+	}
+
+	// Clipboard
+	if err := setKeybindings(g, inboxView, actionYankId, yankBug(yankId, ib.selectedBug)); err != nil {
+		return err
+	}
+	if err := setKeybindings(g, inboxView, actionYankHumanId, yankBug(yankHumanId, ib.selectedBug)); err != nil {
+		return err
+	}
+	if err := setKeybindings(g, inboxView, actionYankURL, yankBug(yankURL, ib.selectedBug)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ib *inbox) layout(g *gocui.Gui) error {
+	maxX, maxY := g.Size()
+
+	if maxY < 5 {
+		// window too small !
+		return nil
+	}
+
+	v, err := g.SetView(inboxHeaderView, -1, -1, maxX, 1, 0)
+	if err != nil {
+		if !gocui.IsUnknownView(err) {
+			return err
+		}
+
+		v.Frame = false
+	}
+
+	changed := fmt.Sprintf("%d bugs changed", len(ib.entries))
+	if len(ib.entries) == 1 {
+		changed = "1 bug changed"
+	}
+
+	v.Clear()
+	_, _ = fmt.Fprintf(v, " %s %s since %s\n", colors.Emphasis("Inbox:"), changed, humanize.Time(ib.since))
+
+	v, err = g.SetView(inboxView, -1, 0, maxX, maxY-3, 0)
+	if err != nil {
+		if !gocui.IsUnknownView(err) {
+			return err
+		}
+
+		v.Frame = false
+		v.SelFgColor, v.SelBgColor = roleColors(colors.RoleSelection)
+	}
+
+	ib.selected = minInt(ib.selected, len(ib.entries)-1)
+	ib.selected = maxInt(ib.selected, 0)
+
+	_, height := v.Size()
+	_, oy := v.Origin()
+	switch {
+	case ib.selected < oy:
+		oy = ib.selected
+	case ib.selected >= oy+height:
+		oy = ib.selected - height + 1
+	}
+
+	v.Clear()
+	ib.render(v, maxX)
+	if err := v.SetOrigin(0, oy); err != nil {
+		return err
+	}
+	if err := v.SetCursor(0, ib.selected-oy); err != nil {
+		return err
+	}
+	v.Highlight = len(ib.entries) > 0
+
+	v, err = g.SetView(inboxInstructionView, -1, maxY-2, maxX, maxY, 0)
+	if err != nil {
+		if !gocui.IsUnknownView(err) {
+			return err
+		}
+
+		v.Frame = false
+		v.FgColor, v.BgColor = roleColors(colors.RoleBar)
+
+		_, _ = fmt.Fprint(v, instructions(
+			instruction("Return", actionInboxBack),
+			instruction("Navigation", actionInboxDown, actionInboxUp),
+			instruction("Open bug", actionInboxOpen),
+			instruction("Dismiss", actionInboxDismiss),
+			instruction("Help", actionHelp),
+		))
+	}
+
+	_, err = g.SetCurrentView(inboxView)
+	return err
+}
+
+func (ib *inbox) render(v *gocui.View, maxX int) {
+	if len(ib.entries) == 0 {
+		_, _ = fmt.Fprintf(v, " %s\n", colors.Placeholder("Nothing new"))
+		return
+	}
+
+	lastWidth := 15
+	changesWidth := maxInt(20, (maxX-10-lastWidth)/3)
+	titleWidth := maxInt(10, maxX-10-lastWidth-changesWidth)
+
+	for _, entry := range ib.entries {
+		id := text.LeftPadMaxLine(entry.id.Human(), 8, 1)
+		title := text.LeftPadMaxLine(entry.title, titleWidth, 1)
+		changes := text.LeftPadMaxLine(strings.Join(entry.changes, ", "), changesWidth, 1)
+		last := text.LeftPadMaxLine(humanize.Time(entry.last), lastWidth, 1)
+
+		_, _ = fmt.Fprintf(v, "%s %s%s%s\n", colors.Id(id), title, colors.Emphasis(changes), last)
+	}
+}
+
+func (ib *inbox) disable(g *gocui.Gui) error {
+	for _, view := range []string{inboxView, inboxHeaderView, inboxInstructionView} {
+		if err := g.DeleteView(view); err != nil && !gocui.IsUnknownView(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// load build the entries of the inbox. Only the bugs changed since the last
+// session are read.
+func (ib *inbox) load() error {
+	var userId entity.Id
+	user, err := ib.repo.GetUserIdentity()
+	if err == nil {
+		userId = user.Id()
+	}
+
+	ib.entries = nil
+
+	for _, id := range ib.repo.AllBugsIds() {
+		if ib.dismissed[id] {
+			continue
+		}
+
+		excerpt, err := ib.repo.ResolveBugExcerpt(id)
+		if err != nil {
+			return err
+		}
+
+		if excerpt.EditUnixTime <= ib.since.Unix() {
+			continue
+		}
+		if excerpt.CreateUnixTime <= ib.since.Unix() && !watchedBy(excerpt, userId) {
+			continue
+		}
+
+		b, err := ib.repo.ResolveBug(id)
+		if err != nil {
+			return err
+		}
+
+		snap := b.Snapshot()
+		changes, last := bugActivity(snap, ib.since, userId)
+		if len(changes) == 0 {
+			continue
+		}
+
+		ib.entries = append(ib.entries, inboxEntry{
+			id:      id,
+			title:   snap.Title,
+			changes: changes,
+			last:    last,
+		})
+	}
+
+	sort.Slice(ib.entries, func(i, j int) bool {
+		return ib.entries[i].last.After(ib.entries[j].last)
+	})
+
+	ib.selected = 0
+
+	return nil
+}
+
+// watchedBy tell if the user authored, took part in or is assigned to a bug
+func watchedBy(excerpt *cache.BugExcerpt, user entity.Id) bool {
+	if user == "" {
+		return false
+	}
+	if excerpt.AuthorId == user {
+		return true
+	}
+	for _, ids := range [][]entity.Id{excerpt.Actors, excerpt.Participants, excerpt.Assignees} {
+		for _, id := range ids {
+			if id == user {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// bugActivity summarize the changes of a bug made by the others since a
+// time, like "2 new comments, closed", and return when the last one was made
+func bugActivity(snap *bug.Snapshot, since time.Time, user entity.Id) ([]string, time.Time) {
+	var created, renamed, labels, assignees, due, edited bool
+	var comments int
+	var status bug.Status
+	var last time.Time
+
+	for _, op := range snap.Operations {
+		if op.GetUnixTime() <= since.Unix() {
+			continue
+		}
+		if user != "" && op.GetAuthor().Id() == user {
+			continue
+		}
+
+		switch op := op.(type) {
+		case *bug.CreateOperation:
+			created = true
+		case *bug.AddCommentOperation:
+			comments++
+		case *bug.EditCommentOperation:
+			edited = true
+		case *bug.SetStatusOperation:
+			status = op.Status
+		case *bug.SetTitleOperation:
+			renamed = true
+		case *bug.LabelChangeOperation:
+			labels = true
+		case *bug.AssigneeChangeOperation:
+			assignees = true
+		case *bug.SetDueDateOperation:
+			due = true
+		default:
+			// the metadata and the noop are not visible
+			continue
+		}
+
+		last = op.Time()
+	}
+
+	var changes []string
+
+	if created {
+		changes = append(changes, "new bug")
+	}
+	switch comments {
+	case 0:
+	case 1:
+		changes = append(changes, "1 new comment")
+	default:
+		changes = append(changes, fmt.Sprintf("%d new comments", comments))
+	}
+	switch status {
+	case bug.ClosedStatus:
+		changes = append(changes, "closed")
+	case bug.OpenStatus:
+		changes = append(changes, "reopened")
+	}
+	if renamed {
+		changes = append(changes, "renamed")
+	}
+	if labels {
+		changes = append(changes, "labels changed")
+	}
+	if assignees {
+		changes = append(changes, "assignees changed")
+	}
+	if due {
+		changes = append(changes, "due date changed")
+	}
+	if edited {
+		changes = append(changes, "comment edited")
+	}
+
+	return changes, last
+}
+
+// selectedBug return the id of the selected bug, if any
+func (ib *inbox) selectedBug() (entity.Id, bool) {
+	if ib.selected >= len(ib.entries) {
+		return "", false
+	}
+	return ib.entries[ib.selected].id, true
+}
+
+func (ib *inbox) back(g *gocui.Gui, v *gocui.View) error {
+	return ui.activateWindow(ui.bugTable)
+}
+
+func (ib *inbox) selectNext(g *gocui.Gui, v *gocui.View) error {
+	ib.selected = minInt(ib.selected+1, len(ib.entries)-1)
+	return nil
+}
+
+func (ib *inbox) selectPrevious(g *gocui.Gui, v *gocui.View) error {
+	ib.selected = maxInt(ib.selected-1, 0)
+	return nil
+}
+
+// open show the selected bug, and remove it from the inbox as it's been read
+func (ib *inbox) open(g *gocui.Gui, v *gocui.View) error {
+	id, ok := ib.selectedBug()
+	if !ok {
+		return nil
+	}
+
+	b, err := ib.repo.ResolveBug(id)
+	if err != nil {
+		return err
+	}
+
+	ib.removeSelected()
+
+	ui.showBug.SetBug(b)
+	ui.showBug.back = ui.inbox
+	return ui.activateWindow(ui.showBug)
+}
+
+// dismiss remove the selected bug from the inbox until the next session
+func (ib *inbox) dismiss(g *gocui.Gui, v *gocui.View) error {
+	ib.removeSelected()
+	return nil
+}
+
+func (ib *inbox) removeSelected() {
+	id, ok := ib.selectedBug()
+	if !ok {
+		return
+	}
+
+	ib.dismissed[id] = true
+	ib.entries = append(ib.entries[:ib.selected], ib.entries[ib.selected+1:]...)
+}
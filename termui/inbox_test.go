@@ -0,0 +1,42 @@
+package termui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+func TestBugActivity(t *testing.T) {
+	me := identity.NewBare("René Descartes", "rene@descartes.fr")
+	other := identity.NewBare("Ada Lovelace", "ada@example.com")
+
+	since := time.Unix(1000, 0)
+
+	snap := &bug.Snapshot{
+		Operations: []bug.Operation{
+			bug.NewCreateOp(me, 500, "title", "message", nil),
+			bug.NewAddCommentOp(other, 900, "before the session", nil),
+			bug.NewAddCommentOp(other, 1100, "first", nil),
+			bug.NewAddCommentOp(me, 1200, "mine", nil),
+			bug.NewAddCommentOp(other, 1300, "second", nil),
+			bug.NewSetStatusOp(other, 1400, bug.ClosedStatus),
+			bug.NewSetTitleOp(me, 1500, "new title", "title"),
+		},
+	}
+
+	changes, last := bugActivity(snap, since, me.Id())
+	assert.Equal(t, []string{"2 new comments", "closed"}, changes)
+	assert.Equal(t, int64(1400), last.Unix())
+
+	// without identity, every change is listed
+	changes, last = bugActivity(snap, since, "")
+	assert.Equal(t, []string{"3 new comments", "closed", "renamed"}, changes)
+	assert.Equal(t, int64(1500), last.Unix())
+
+	changes, _ = bugActivity(snap, time.Unix(2000, 0), me.Id())
+	assert.Empty(t, changes)
+}
@@ -22,12 +22,12 @@ func newInputPopup() *inputPopup {
 
 func (ip *inputPopup) keybindings(g *gocui.Gui) error {
 	// Close
-	if err := g.SetKeybinding(inputPopupView, gocui.KeyEsc, gocui.ModNone, ip.close); err != nil {
+	if err := setKeybindings(g, inputPopupView, actionInputCancel, ip.close); err != nil {
 		return err
 	}
 
 	// Validate
-	if err := g.SetKeybinding(inputPopupView, gocui.KeyEnter, gocui.ModNone, ip.validate); err != nil {
+	if err := setKeybindings(g, inputPopupView, actionInputValidate, ip.validate); err != nil {
 		return err
 	}
 
@@ -0,0 +1,414 @@
+package termui
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/awesome-gocui/gocui"
+)
+
+// keyAction is something the user can do with a key, named after the part of
+// the UI where it's available, like "table.open"
+type keyAction string
+
+const (
+	actionQuit        keyAction = "quit"
+	actionHelp        keyAction = "help"
+	actionYankId      keyAction = "yank-id"
+	actionYankHumanId keyAction = "yank-human-id"
+	actionYankURL     keyAction = "yank-url"
+	actionCommand     keyAction = "command"
+
+	actionTableQuit     keyAction = "table.quit"
+	actionTableDown     keyAction = "table.down"
+	actionTableUp       keyAction = "table.up"
+	actionTablePrevPage keyAction = "table.previous-page"
+	actionTableNextPage keyAction = "table.next-page"
+	actionTableNew      keyAction = "table.new"
+	actionTableOpen     keyAction = "table.open"
+	actionTablePull     keyAction = "table.pull"
+	actionTablePush     keyAction = "table.push"
+	actionTableSearch   keyAction = "table.search"
+	actionTableFilter   keyAction = "table.filter"
+	actionTableSort     keyAction = "table.sort"
+	actionTableGroup    keyAction = "table.group"
+	actionTableFold     keyAction = "table.fold"
+	actionTableNextTab  keyAction = "table.next-tab"
+	actionTableInbox    keyAction = "table.inbox"
+	actionTableSplit    keyAction = "table.split"
+	actionTableTab1     keyAction = "table.tab-1"
+	actionTableTab2     keyAction = "table.tab-2"
+	actionTableTab3     keyAction = "table.tab-3"
+	actionTableTab4     keyAction = "table.tab-4"
+	actionTableTab5     keyAction = "table.tab-5"
+	actionTableTab6     keyAction = "table.tab-6"
+	actionTableTab7     keyAction = "table.tab-7"
+	actionTableTab8     keyAction = "table.tab-8"
+	actionTableTab9     keyAction = "table.tab-9"
+
+	actionFilterValidate keyAction = "filter.validate"
+	actionFilterCancel   keyAction = "filter.cancel"
+
+	actionBugBack         keyAction = "bug.back"
+	actionBugScrollUp     keyAction = "bug.scroll-up"
+	actionBugScrollDown   keyAction = "bug.scroll-down"
+	actionBugDown         keyAction = "bug.down"
+	actionBugUp           keyAction = "bug.up"
+	actionBugLeft         keyAction = "bug.left"
+	actionBugRight        keyAction = "bug.right"
+	actionBugComment      keyAction = "bug.comment"
+	actionBugToggleStatus keyAction = "bug.toggle-status"
+	actionBugTitle        keyAction = "bug.title"
+	actionBugEdit         keyAction = "bug.edit"
+	actionBugLabels       keyAction = "bug.labels"
+	actionBugToggleSource keyAction = "bug.toggle-source"
+	actionBugHistory      keyAction = "bug.history"
+	actionBugDiffMode     keyAction = "bug.diff-mode"
+
+	actionInboxBack    keyAction = "inbox.back"
+	actionInboxDown    keyAction = "inbox.down"
+	actionInboxUp      keyAction = "inbox.up"
+	actionInboxOpen    keyAction = "inbox.open"
+	actionInboxDismiss keyAction = "inbox.dismiss"
+
+	actionLabelsCancel keyAction = "labels.cancel"
+	actionLabelsSave   keyAction = "labels.save"
+	actionLabelsUp     keyAction = "labels.up"
+	actionLabelsDown   keyAction = "labels.down"
+	actionLabelsToggle keyAction = "labels.toggle"
+	actionLabelsAdd    keyAction = "labels.add"
+
+	actionFormNext     keyAction = "form.next"
+	actionFormPrevious keyAction = "form.previous"
+	actionFormSubmit   keyAction = "form.submit"
+	actionFormCancel   keyAction = "form.cancel"
+	actionFormEditor   keyAction = "form.editor"
+	actionFormUp       keyAction = "form.up"
+	actionFormDown     keyAction = "form.down"
+	actionFormToggle   keyAction = "form.toggle"
+	actionFormLower    keyAction = "form.lower"
+	actionFormHigher   keyAction = "form.higher"
+
+	actionPopupClose    keyAction = "popup.close"
+	actionInputValidate keyAction = "input.validate"
+	actionInputCancel   keyAction = "input.cancel"
+
+	actionHelpClose    keyAction = "help.close"
+	actionHelpUp       keyAction = "help.up"
+	actionHelpDown     keyAction = "help.down"
+	actionHelpPrevPage keyAction = "help.previous-page"
+	actionHelpNextPage keyAction = "help.next-page"
+)
+
+// keyBinding is the keys of an action, each key being a character like "q",
+// or a special key possibly with a modifier like "enter" or "ctrl+c"
+type keyBinding struct {
+	action      keyAction
+	description string
+	keys        []string
+}
+
+// defaultKeys is the key bindings of the termui, before any customization
+var defaultKeys = []keyBinding{
+	{actionQuit, "Quit from anywhere", []string{"ctrl+c"}},
+	{actionHelp, "Show the key bindings", []string{"?"}},
+	{actionYankId, "Copy the full id of the bug to the clipboard", []string{"y"}},
+	{actionYankHumanId, "Copy the short id of the bug to the clipboard", []string{"Y"}},
+	{actionYankURL, "Copy the web UI url of the bug to the clipboard", []string{"u"}},
+	{actionCommand, "Run a command: q to return or quit, qa to quit, help", nil},
+
+	{actionTableQuit, "Quit", []string{"q"}},
+	{actionTableDown, "Select the next bug", []string{"j", "down"}},
+	{actionTableUp, "Select the previous bug", []string{"k", "up"}},
+	{actionTablePrevPage, "Show the previous page", []string{"h", "left", "pgup"}},
+	{actionTableNextPage, "Show the next page", []string{"l", "right", "pgdn"}},
+	{actionTableNew, "Create a new bug", []string{"n"}},
+	{actionTableOpen, "Open the selected bug", []string{"enter"}},
+	{actionTablePull, "Pull the bugs from the remote", []string{"i"}},
+	{actionTablePush, "Push the bugs to the remote", []string{"o"}},
+	{actionTableSearch, "Edit the query in the editor", []string{"s"}},
+	{actionTableFilter, "Filter the bugs as the query is typed", []string{"/"}},
+	{actionTableSort, "Change the sort order", []string{"S"}},
+	{actionTableGroup, "Group the bugs by status, by label, by milestone or not at all", []string{"G"}},
+	{actionTableFold, "Collapse or expand the selected group", []string{"space"}},
+	{actionTableNextTab, "Show the next tab", []string{"tab"}},
+	{actionTableInbox, "Show the bugs changed since the last session", []string{"a"}},
+	{actionTableSplit, "Show or hide the selected bug next to the list", []string{"v"}},
+	{actionTableTab1, "Show the first tab", []string{"1"}},
+	{actionTableTab2, "Show the second tab", []string{"2"}},
+	{actionTableTab3, "Show the third tab", []string{"3"}},
+	{actionTableTab4, "Show the fourth tab", []string{"4"}},
+	{actionTableTab5, "Show the fifth tab", []string{"5"}},
+	{actionTableTab6, "Show the sixth tab", []string{"6"}},
+	{actionTableTab7, "Show the seventh tab", []string{"7"}},
+	{actionTableTab8, "Show the eighth tab", []string{"8"}},
+	{actionTableTab9, "Show the ninth tab", []string{"9"}},
+
+	{actionFilterValidate, "Keep the filter", []string{"enter"}},
+	{actionFilterCancel, "Restore the previous filter", []string{"esc"}},
+
+	{actionBugBack, "Return to the bug list", []string{"q"}},
+	{actionBugScrollUp, "Scroll up", []string{"pgup"}},
+	{actionBugScrollDown, "Scroll down", []string{"pgdn"}},
+	{actionBugDown, "Select the next item", []string{"j", "down"}},
+	{actionBugUp, "Select the previous item", []string{"k", "up"}},
+	{actionBugLeft, "Select the timeline", []string{"h", "left"}},
+	{actionBugRight, "Select the side panel", []string{"l", "right"}},
+	{actionBugComment, "Add a comment", []string{"c"}},
+	{actionBugToggleStatus, "Open or close the bug", []string{"o"}},
+	{actionBugTitle, "Change the title", []string{"t"}},
+	{actionBugEdit, "Edit the selected item", []string{"e"}},
+	{actionBugLabels, "Add or remove labels", []string{"L"}},
+	{actionBugToggleSource, "Show the markdown source or its rendering", []string{"m"}},
+	{actionBugHistory, "Show or hide the edits of the selected message", []string{"d"}},
+	{actionBugDiffMode, "Show the edits as unified or side by side diffs", []string{"D"}},
+
+	{actionInboxBack, "Return to the bug list", []string{"q", "esc"}},
+	{actionInboxDown, "Select the next bug", []string{"j", "down"}},
+	{actionInboxUp, "Select the previous bug", []string{"k", "up"}},
+	{actionInboxOpen, "Open the selected bug", []string{"enter"}},
+	{actionInboxDismiss, "Remove the selected bug from the inbox", []string{"x"}},
+
+	{actionLabelsCancel, "Return without saving", []string{"esc"}},
+	{actionLabelsSave, "Save and return", []string{"q"}},
+	{actionLabelsUp, "Select the previous label", []string{"k", "up"}},
+	{actionLabelsDown, "Select the next label", []string{"j", "down"}},
+	{actionLabelsToggle, "Toggle the selected label", []string{"space", "x", "enter"}},
+	{actionLabelsAdd, "Add a new label", []string{"a"}},
+
+	{actionFormNext, "Go to the next field", []string{"tab", "ctrl+n"}},
+	{actionFormPrevious, "Go to the previous field", []string{"ctrl+p"}},
+	{actionFormSubmit, "Create the bug", []string{"ctrl+s"}},
+	{actionFormCancel, "Return without creating the bug", []string{"esc"}},
+	{actionFormEditor, "Write the title and the body in the editor", []string{"ctrl+e"}},
+	{actionFormUp, "Select the previous label or assignee", []string{"k", "up"}},
+	{actionFormDown, "Select the next label or assignee", []string{"j", "down"}},
+	{actionFormToggle, "Toggle the selected label or assignee, or change the priority", []string{"space", "x"}},
+	{actionFormLower, "Lower the priority", []string{"h", "left"}},
+	{actionFormHigher, "Raise the priority", []string{"l", "right"}},
+
+	{actionPopupClose, "Close a message", []string{"q", "space", "enter"}},
+	{actionInputValidate, "Validate an input", []string{"enter"}},
+	{actionInputCancel, "Cancel an input", []string{"esc"}},
+
+	{actionHelpClose, "Close the help", []string{"esc", "enter"}},
+	{actionHelpUp, "Scroll up", []string{"up"}},
+	{actionHelpDown, "Scroll down", []string{"down"}},
+	{actionHelpPrevPage, "Scroll up a page", []string{"pgup"}},
+	{actionHelpNextPage, "Scroll down a page", []string{"pgdn"}},
+}
+
+// keymap is the keys of each action, customized with LoadKeys
+var keymap = defaultKeymap()
+
+func defaultKeymap() map[keyAction][]string {
+	result := make(map[keyAction][]string, len(defaultKeys))
+	for _, binding := range defaultKeys {
+		result[binding.action] = binding.keys
+	}
+	return result
+}
+
+// specialKeys is the names of the keys that are not a character
+var specialKeys = map[string]gocui.Key{
+	"enter":     gocui.KeyEnter,
+	"esc":       gocui.KeyEsc,
+	"space":     gocui.KeySpace,
+	"tab":       gocui.KeyTab,
+	"backspace": gocui.KeyBackspace2,
+	"delete":    gocui.KeyDelete,
+	"insert":    gocui.KeyInsert,
+	"home":      gocui.KeyHome,
+	"end":       gocui.KeyEnd,
+	"pgup":      gocui.KeyPgup,
+	"pgdn":      gocui.KeyPgdn,
+	"up":        gocui.KeyArrowUp,
+	"down":      gocui.KeyArrowDown,
+	"left":      gocui.KeyArrowLeft,
+	"right":     gocui.KeyArrowRight,
+	"f1":        gocui.KeyF1,
+	"f2":        gocui.KeyF2,
+	"f3":        gocui.KeyF3,
+	"f4":        gocui.KeyF4,
+	"f5":        gocui.KeyF5,
+	"f6":        gocui.KeyF6,
+	"f7":        gocui.KeyF7,
+	"f8":        gocui.KeyF8,
+	"f9":        gocui.KeyF9,
+	"f10":       gocui.KeyF10,
+	"f11":       gocui.KeyF11,
+	"f12":       gocui.KeyF12,
+}
+
+// keySymbols is how some keys are displayed in the instructions
+var keySymbols = map[string]string{
+	"up":    "↑",
+	"down":  "↓",
+	"left":  "←",
+	"right": "→",
+	"enter": "↵",
+}
+
+// parseKey parse a key as written in the key bindings: a character, the name
+// of a special key, or ctrl+<letter>. Any of them can be prefixed by alt+.
+func parseKey(str string) (interface{}, gocui.Modifier, error) {
+	mod := gocui.ModNone
+	name := str
+
+	if strings.HasPrefix(strings.ToLower(name), "alt+") && len(name) > len("alt+") {
+		mod = gocui.ModAlt
+		name = name[len("alt+"):]
+	}
+
+	if utf8.RuneCountInString(name) == 1 {
+		r, _ := utf8.DecodeRuneInString(name)
+		return r, mod, nil
+	}
+
+	lower := strings.ToLower(name)
+
+	if key, ok := specialKeys[lower]; ok {
+		return key, mod, nil
+	}
+
+	if strings.HasPrefix(lower, "ctrl+") && len(lower) == len("ctrl+")+1 {
+		letter := lower[len(lower)-1]
+		if letter >= 'a' && letter <= 'z' {
+			return gocui.KeyCtrlA + gocui.Key(letter-'a'), mod, nil
+		}
+	}
+
+	return nil, mod, fmt.Errorf("unknown key %s", str)
+}
+
+// LoadKeys read key bindings overriding the current ones, with one action per
+// line followed by its comma separated keys, like:
+//
+//	# comment
+//	table.down = j, down, ctrl+n
+//	bug.back = q, esc
+//
+// The actions not in the file keep their current keys.
+func LoadKeys(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		split := strings.SplitN(text, "=", 2)
+		if len(split) != 2 {
+			return fmt.Errorf("keys line %d: expected \"action = keys\"", line)
+		}
+
+		action := keyAction(strings.TrimSpace(split[0]))
+		if _, ok := keymap[action]; !ok {
+			return fmt.Errorf("keys line %d: unknown action %s", line, action)
+		}
+
+		var keys []string
+		for _, key := range strings.Split(split[1], ",") {
+			key = strings.TrimSpace(key)
+			if key == "" {
+				continue
+			}
+			if _, _, err := parseKey(key); err != nil {
+				return fmt.Errorf("keys line %d: %v", line, err)
+			}
+			keys = append(keys, key)
+		}
+
+		keymap[action] = keys
+	}
+
+	return scanner.Err()
+}
+
+// WriteKeys write the current key bindings, in the format read by LoadKeys
+func WriteKeys(w io.Writer) error {
+	for _, binding := range defaultKeys {
+		_, err := fmt.Fprintf(w, "# %s\n%s = %s\n",
+			binding.description, binding.action, strings.Join(keymap[binding.action], ", "))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setKeybindings bind the keys of an action in a view
+func setKeybindings(g *gocui.Gui, viewName string, action keyAction, handler func(*gocui.Gui, *gocui.View) error) error {
+	for _, str := range keymap[action] {
+		key, mod, err := parseKey(str)
+		if err != nil {
+			return err
+		}
+
+		if err := g.SetKeybinding(viewName, key, mod, handler); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// instruction format the keys of some actions for the instruction bar, like
+// "[←↓↑→,hjkl] Navigation": the arrows and the characters are grouped.
+func instruction(label string, actions ...keyAction) string {
+	var symbols, chars strings.Builder
+	var others []string
+
+	seen := make(map[string]bool)
+
+	for _, action := range actions {
+		for _, key := range keymap[action] {
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			switch {
+			case keySymbols[key] != "":
+				symbols.WriteString(keySymbols[key])
+			case utf8.RuneCountInString(key) == 1:
+				chars.WriteString(key)
+			default:
+				others = append(others, key)
+			}
+		}
+	}
+
+	var parts []string
+	for _, part := range []string{symbols.String(), chars.String()} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	parts = append(parts, others...)
+
+	if len(parts) == 0 {
+		// nothing is bound, the action is not available
+		return ""
+	}
+
+	return fmt.Sprintf("[%s] %s", strings.Join(parts, ","), label)
+}
+
+// instructions format a set of instructions, skipping the empty ones
+func instructions(list ...string) string {
+	var result []string
+	for _, s := range list {
+		if s != "" {
+			result = append(result, s)
+		}
+	}
+	return strings.Join(result, " ")
+}
@@ -0,0 +1,125 @@
+package termui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/awesome-gocui/gocui"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKey(t *testing.T) {
+	var tests = []struct {
+		input string
+		key   interface{}
+		mod   gocui.Modifier
+	}{
+		{"q", 'q', gocui.ModNone},
+		{"L", 'L', gocui.ModNone},
+		{"/", '/', gocui.ModNone},
+		{"enter", gocui.KeyEnter, gocui.ModNone},
+		{"PgDn", gocui.KeyPgdn, gocui.ModNone},
+		{"ctrl+n", gocui.KeyCtrlN, gocui.ModNone},
+		{"alt+x", 'x', gocui.ModAlt},
+		{"alt+up", gocui.KeyArrowUp, gocui.ModAlt},
+	}
+
+	for _, test := range tests {
+		key, mod, err := parseKey(test.input)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.key, key, test.input)
+		assert.Equal(t, test.mod, mod, test.input)
+	}
+
+	for _, input := range []string{"", "ctrl+1", "hyper+q", "nope"} {
+		_, _, err := parseKey(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestLoadKeys(t *testing.T) {
+	defer func() { keymap = defaultKeymap() }()
+
+	err := LoadKeys(strings.NewReader(`
+# dvorak
+table.down = h, down
+table.up = t, up
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"h", "down"}, keymap[actionTableDown])
+	assert.Equal(t, []string{"t", "up"}, keymap[actionTableUp])
+	assert.Equal(t, []string{"q"}, keymap[actionTableQuit])
+
+	assert.Equal(t, "[↓↑,ht] Navigation", instruction("Navigation", actionTableDown, actionTableUp))
+
+	// the output can be read back
+	var buf bytes.Buffer
+	require.NoError(t, WriteKeys(&buf))
+	keymap = defaultKeymap()
+	require.NoError(t, LoadKeys(&buf))
+	assert.Equal(t, []string{"h", "down"}, keymap[actionTableDown])
+
+	assert.Error(t, LoadKeys(strings.NewReader("table.nope = x")))
+	assert.Error(t, LoadKeys(strings.NewReader("table.down = hyper+x")))
+	assert.Error(t, LoadKeys(strings.NewReader("table.down")))
+}
+
+func TestInstruction(t *testing.T) {
+	assert.Equal(t, "[←↓↑→,hjkl] Navigation",
+		instruction("Navigation", actionBugLeft, actionBugDown, actionBugUp, actionBugRight))
+	assert.Equal(t, "[↵] Open bug", instruction("Open bug", actionTableOpen))
+	assert.Equal(t, "[esc] Cancel", instruction("Cancel", actionLabelsCancel))
+}
+
+func TestHelpLines(t *testing.T) {
+	defer func() { keymap = defaultKeymap() }()
+
+	keymap[actionLabelsAdd] = nil
+
+	lines := helpLines("label", 80)
+	require.NotEmpty(t, lines)
+	assert.Equal(t, "Bug list", lines[0])
+	assert.Contains(t, lines, "  L                  Add or remove labels")
+	assert.Contains(t, lines, "Labels")
+	assert.Contains(t, lines, "  unbound            Add a new label")
+
+	lines = helpLines("ctrl+c", 80)
+	assert.Equal(t, []string{"Everywhere", "  ctrl+c             Quit from anywhere"}, lines)
+
+	assert.Empty(t, helpLines("nothing like this", 80))
+	assert.Len(t, helpLines("", 80), len(defaultKeys)+2*len(keyContexts)-1)
+}
+
+func TestKeyPresets(t *testing.T) {
+	defer func() { keymap = defaultKeymap() }()
+
+	for _, name := range KeyPresets() {
+		keymap = defaultKeymap()
+		require.NoError(t, LoadKeyPreset(name), name)
+
+		// a key can't be bound twice in the same part of the UI, the actions
+		// without prefix being available in the bug list, the inbox and the bug
+		for _, prefix := range []string{"table.", "bug.", "inbox.", "labels.", "form.", "help.", "filter."} {
+			seen := make(map[string]keyAction)
+			for _, binding := range defaultKeys {
+				action := binding.action
+				shared := !strings.Contains(string(action), ".")
+				if !strings.HasPrefix(string(action), prefix) &&
+					!(shared && (prefix == "table." || prefix == "bug." || prefix == "inbox.")) {
+					continue
+				}
+				for _, key := range keymap[action] {
+					other, ok := seen[key]
+					assert.False(t, ok, "%s: %s bound to %s and %s", name, key, other, action)
+					seen[key] = action
+				}
+			}
+		}
+	}
+
+	assert.Equal(t, []string{":"}, keymap[actionCommand])
+	assert.Error(t, LoadKeyPreset("nano"))
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/colors"
 )
 
 const labelSelectView = "labelSelectView"
@@ -37,7 +38,7 @@ func (ls *labelSelect) SetBug(cache *cache.RepoCache, bug *cache.BugCache) {
 	labelSelect := make([]bool, len(ls.labels))
 	for i, label := range ls.labels {
 		for _, bugLabel := range bugLabels {
-			if label == bugLabel {
+			if label == cache.LabelStore().Resolve(bugLabel) {
 				labelSelect[i] = true
 				break
 			}
@@ -56,39 +57,27 @@ func (ls *labelSelect) SetBug(cache *cache.RepoCache, bug *cache.BugCache) {
 
 func (ls *labelSelect) keybindings(g *gocui.Gui) error {
 	// Abort
-	if err := g.SetKeybinding(labelSelectView, gocui.KeyEsc, gocui.ModNone, ls.abort); err != nil {
+	if err := setKeybindings(g, labelSelectView, actionLabelsCancel, ls.abort); err != nil {
 		return err
 	}
 	// Save and return
-	if err := g.SetKeybinding(labelSelectView, 'q', gocui.ModNone, ls.saveAndReturn); err != nil {
+	if err := setKeybindings(g, labelSelectView, actionLabelsSave, ls.saveAndReturn); err != nil {
 		return err
 	}
 	// Up
-	if err := g.SetKeybinding(labelSelectView, gocui.KeyArrowUp, gocui.ModNone, ls.selectPrevious); err != nil {
-		return err
-	}
-	if err := g.SetKeybinding(labelSelectView, 'k', gocui.ModNone, ls.selectPrevious); err != nil {
+	if err := setKeybindings(g, labelSelectView, actionLabelsUp, ls.selectPrevious); err != nil {
 		return err
 	}
 	// Down
-	if err := g.SetKeybinding(labelSelectView, gocui.KeyArrowDown, gocui.ModNone, ls.selectNext); err != nil {
-		return err
-	}
-	if err := g.SetKeybinding(labelSelectView, 'j', gocui.ModNone, ls.selectNext); err != nil {
+	if err := setKeybindings(g, labelSelectView, actionLabelsDown, ls.selectNext); err != nil {
 		return err
 	}
 	// Select
-	if err := g.SetKeybinding(labelSelectView, gocui.KeySpace, gocui.ModNone, ls.selectItem); err != nil {
-		return err
-	}
-	if err := g.SetKeybinding(labelSelectView, 'x', gocui.ModNone, ls.selectItem); err != nil {
-		return err
-	}
-	if err := g.SetKeybinding(labelSelectView, gocui.KeyEnter, gocui.ModNone, ls.selectItem); err != nil {
+	if err := setKeybindings(g, labelSelectView, actionLabelsToggle, ls.selectItem); err != nil {
 		return err
 	}
 	// Add
-	if err := g.SetKeybinding(labelSelectView, 'a', gocui.ModNone, ls.addItem); err != nil {
+	if err := setKeybindings(g, labelSelectView, actionLabelsAdd, ls.addItem); err != nil {
 		return err
 	}
 	return nil
@@ -102,11 +91,12 @@ func (ls *labelSelect) layout(g *gocui.Gui) error {
 	for _, label := range ls.labels {
 		width = maxInt(width, len(label))
 	}
-	width += 10
+	// the checkbox, the color and the frame
+	width += 12
 	x0 := 1
 	y0 := 0 - ls.scroll
 
-	v, err := g.SetView(labelSelectView, x0, 0, x0+width, maxY-2, 0)
+	v, err := g.SetView(labelSelectView, x0, 0, x0+width, maxY-3, 0)
 	if err != nil {
 		if !gocui.IsUnknownView(err) {
 			return err
@@ -129,9 +119,9 @@ func (ls *labelSelect) layout(g *gocui.Gui) error {
 			selectBox = " [x] "
 		}
 
-		lc := label.Color()
+		lc := ls.cache.LabelStore().Color(label)
 		lc256 := lc.Term256()
-		labelStr := lc256.Escape() + "◼ " + lc256.Unescape() + label.String()
+		labelStr := colors.Term256(int(lc256), "◼ ") + label.String()
 		fmt.Fprint(v, selectBox, labelStr)
 
 		y0 += 2
@@ -144,10 +134,17 @@ func (ls *labelSelect) layout(g *gocui.Gui) error {
 			return err
 		}
 		v.Frame = false
-		v.BgColor = gocui.ColorBlue
+		v.FgColor, v.BgColor = roleColors(colors.RoleBar)
 	}
 	v.Clear()
-	fmt.Fprint(v, "[q] Save and close [↓↑,jk] Nav [a] Add item")
+	fmt.Fprint(v, instructions(
+		instruction("Save and close", actionLabelsSave),
+		instruction("Cancel", actionLabelsCancel),
+		instruction("Nav", actionLabelsDown, actionLabelsUp),
+		instruction("Toggle", actionLabelsToggle),
+		instruction("Add label", actionLabelsAdd),
+		instruction("Help", actionHelp),
+	))
 	if _, err = g.SetViewOnTop(labelSelectInstructionsView); err != nil {
 		return err
 	}
@@ -229,9 +226,21 @@ func (ls *labelSelect) addItem(g *gocui.Gui, v *gocui.View) error {
 		input := <-c
 
 		// Standardize label format
-		input = strings.TrimSuffix(input, "\n")
+		input = strings.TrimSpace(input)
 		input = strings.Replace(input, " ", "-", -1)
 
+		if input == "" {
+			return
+		}
+
+		if err := bug.Label(input).Validate(); err != nil {
+			g.Update(func(gui *gocui.Gui) error {
+				ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+				return nil
+			})
+			return
+		}
+
 		// Check if label already exists
 		for i, label := range ls.labels {
 			if input == label.String() {
@@ -252,7 +261,11 @@ func (ls *labelSelect) addItem(g *gocui.Gui, v *gocui.View) error {
 		ls.selected = len(ls.labels) - 1
 
 		g.Update(func(g *gocui.Gui) error {
-			return nil
+			// the view of the new label is created by the layout first
+			if err := ls.layout(g); err != nil {
+				return err
+			}
+			return ls.focusView(g)
 		})
 	}()
 
@@ -279,7 +292,7 @@ func (ls *labelSelect) saveAndReturn(g *gocui.Gui, v *gocui.View) error {
 	for _, selectedLabel := range selectedLabels {
 		found := false
 		for _, bugLabel := range bugLabels {
-			if selectedLabel == bugLabel {
+			if selectedLabel == ls.cache.LabelStore().Resolve(bugLabel) {
 				found = true
 			}
 		}
@@ -292,7 +305,7 @@ func (ls *labelSelect) saveAndReturn(g *gocui.Gui, v *gocui.View) error {
 	for _, bugLabel := range bugLabels {
 		found := false
 		for _, selectedLabel := range selectedLabels {
-			if bugLabel == selectedLabel {
+			if ls.cache.LabelStore().Resolve(bugLabel) == selectedLabel {
 				found = true
 			}
 		}
@@ -302,8 +315,12 @@ func (ls *labelSelect) saveAndReturn(g *gocui.Gui, v *gocui.View) error {
 		}
 	}
 
-	if _, _, err := ls.bug.ChangeLabels(newLabels, rmLabels); err != nil {
-		ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+	if len(newLabels) > 0 || len(rmLabels) > 0 {
+		if _, _, err := ls.bug.ChangeLabels(newLabels, rmLabels); err != nil {
+			ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+		} else {
+			ui.statusBar.showMessage("Labels of bug %s changed", ls.bug.Id().Human())
+		}
 	}
 
 	return ui.activateWindow(ui.showBug)
@@ -0,0 +1,399 @@
+package termui
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/MichaelMure/go-term-text"
+	"github.com/russross/blackfriday"
+
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+// The markdown is first rendered into logical lines, each starting with the
+// marks of the blocks it's in (quotes, list items), followed by one of these
+// separators, and its content. The lines are then wrapped to the width of the
+// view, keeping the marks of the blocks on each wrapped line.
+const (
+	// the content of the line can be wrapped
+	mdWrap = '\x00'
+	// the content of the line is kept as is, like some code
+	mdNoWrap = '\x01'
+	// a hard line break in a paragraph
+	mdLineBreak = '\x02'
+	// the end of a cell of a table
+	mdCellEnd = '\x03'
+	// a horizontal rule, as wide as the view
+	mdRule = '\x04'
+)
+
+const markdownExtensions = blackfriday.EXTENSION_NO_INTRA_EMPHASIS |
+	blackfriday.EXTENSION_TABLES |
+	blackfriday.EXTENSION_FENCED_CODE |
+	blackfriday.EXTENSION_AUTOLINK |
+	blackfriday.EXTENSION_STRIKETHROUGH |
+	blackfriday.EXTENSION_SPACE_HEADERS
+
+// renderMarkdown render a markdown text for the terminal, wrapped to the given
+// width
+func renderMarkdown(markdown string, width int) (string, int) {
+	out := blackfriday.Markdown([]byte(markdown), &termRenderer{}, markdownExtensions)
+
+	var lines []string
+	blank := true
+
+	for _, line := range strings.Split(string(out), "\n") {
+		idx := strings.IndexAny(line, string([]rune{mdWrap, mdNoWrap, mdRule}))
+		if idx < 0 {
+			// raw text outside of any block
+			idx = 0
+			line = string(mdWrap) + line
+		}
+
+		marks := line[:idx]
+		kind := line[idx]
+		content := line[idx+1:]
+
+		// collapse the consecutive empty lines
+		if marks == "" && content == "" && kind != mdRule {
+			if !blank {
+				lines = append(lines, "")
+			}
+			blank = true
+			continue
+		}
+		blank = false
+
+		switch kind {
+		case mdRule:
+			ruleWidth := maxInt(width-text.Len(marks), 0)
+			lines = append(lines, colorMarks(marks)+strings.Repeat("─", ruleWidth))
+		case mdNoWrap:
+			lines = append(lines, colorMarks(marks)+content)
+		default:
+			wrapped, _ := text.WrapWithPadIndent(content, width,
+				colorMarks(marks), colorMarks(continuationMarks(marks)))
+			lines = append(lines, wrapped)
+		}
+	}
+
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	result := strings.Join(lines, "\n")
+	return result, strings.Count(result, "\n") + 1
+}
+
+// continuationMarks return the marks of the lines after the first one of a
+// block: the quotes are kept, the bullets and numbers of a list are not.
+func continuationMarks(marks string) string {
+	var result strings.Builder
+	for _, r := range marks {
+		if r == '│' {
+			result.WriteRune(r)
+		} else {
+			result.WriteString(strings.Repeat(" ", text.Len(string(r))))
+		}
+	}
+	return result.String()
+}
+
+func colorMarks(marks string) string {
+	return strings.Replace(marks, "│", colors.Quote("│"), -1)
+}
+
+// logicalLines split the rendering of some blocks into logical lines. Raw text,
+// like the content of the items of a tight list, is joined into one line.
+func logicalLines(content string) []string {
+	var result []string
+	var raw []string
+
+	flush := func() {
+		if len(raw) > 0 {
+			result = append(result, string(mdWrap)+strings.Join(raw, " "))
+			raw = nil
+		}
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		if strings.ContainsAny(line, string([]rune{mdWrap, mdNoWrap, mdRule})) {
+			flush()
+			result = append(result, line)
+			continue
+		}
+		if line == "" {
+			flush()
+			continue
+		}
+		raw = append(raw, line)
+	}
+	flush()
+
+	// the blank lines at the end belong to the parent
+	for len(result) > 0 && result[len(result)-1] == string(mdWrap) {
+		result = result[:len(result)-1]
+	}
+
+	return result
+}
+
+// writeLines write logical lines and an empty one to separate the next block
+func writeLines(out *bytes.Buffer, lines []string) {
+	for _, line := range lines {
+		out.WriteString(line)
+		out.WriteByte('\n')
+	}
+	out.WriteByte(mdWrap)
+	out.WriteByte('\n')
+}
+
+// capture return what a callback of blackfriday write in the output, removing
+// it from the output
+func capture(out *bytes.Buffer, render func() bool) string {
+	mark := out.Len()
+	render()
+	content := string(out.Bytes()[mark:])
+	out.Truncate(mark)
+	return content
+}
+
+// termRenderer is a blackfriday renderer producing the logical lines of a
+// markdown text, with the colors of the theme
+type termRenderer struct {
+	// the number of the current item of the ordered lists being rendered
+	listCounters []int
+}
+
+var _ blackfriday.Renderer = &termRenderer{}
+
+func (r *termRenderer) BlockCode(out *bytes.Buffer, code []byte, lang string) {
+	var lines []string
+	for _, line := range highlight(strings.TrimRight(strings.Replace(string(code), "\t", "    ", -1), "\n"), lang) {
+		lines = append(lines, "    "+string(mdNoWrap)+line)
+	}
+	writeLines(out, lines)
+}
+
+func (r *termRenderer) BlockQuote(out *bytes.Buffer, content []byte) {
+	var lines []string
+	for _, line := range logicalLines(string(content)) {
+		lines = append(lines, "│ "+line)
+	}
+	writeLines(out, lines)
+}
+
+func (r *termRenderer) BlockHtml(out *bytes.Buffer, content []byte) {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimRight(string(content), "\n"), "\n") {
+		lines = append(lines, string(mdNoWrap)+line)
+	}
+	writeLines(out, lines)
+}
+
+func (r *termRenderer) Header(out *bytes.Buffer, content func() bool, level int, id string) {
+	heading := strings.Replace(capture(out, content), "\n", " ", -1)
+	if level <= 2 {
+		heading = strings.ToUpper(heading)
+	}
+	writeLines(out, []string{string(mdWrap) + colors.Heading(heading)})
+}
+
+func (r *termRenderer) HRule(out *bytes.Buffer) {
+	writeLines(out, []string{string(mdRule)})
+}
+
+func (r *termRenderer) List(out *bytes.Buffer, content func() bool, flags int) {
+	r.listCounters = append(r.listCounters, 0)
+	items := capture(out, content)
+	r.listCounters = r.listCounters[:len(r.listCounters)-1]
+
+	writeLines(out, logicalLines(items))
+}
+
+func (r *termRenderer) ListItem(out *bytes.Buffer, content []byte, flags int) {
+	bullet := "• "
+	if flags&blackfriday.LIST_TYPE_ORDERED != 0 && len(r.listCounters) > 0 {
+		r.listCounters[len(r.listCounters)-1]++
+		bullet = fmt.Sprintf("%d. ", r.listCounters[len(r.listCounters)-1])
+	}
+	pad := strings.Repeat(" ", text.Len(bullet))
+
+	for i, line := range logicalLines(string(content)) {
+		if i == 0 {
+			out.WriteString(bullet)
+		} else {
+			out.WriteString(pad)
+		}
+		out.WriteString(line)
+		out.WriteByte('\n')
+	}
+}
+
+func (r *termRenderer) Paragraph(out *bytes.Buffer, content func() bool) {
+	paragraph := strings.Replace(capture(out, content), "\n", " ", -1)
+
+	var lines []string
+	for _, line := range strings.Split(paragraph, string(mdLineBreak)) {
+		lines = append(lines, string(mdWrap)+strings.TrimSpace(line))
+	}
+	writeLines(out, lines)
+}
+
+func (r *termRenderer) Table(out *bytes.Buffer, header []byte, body []byte, columnData []int) {
+	var rows [][]string
+	for _, row := range strings.Split(strings.TrimRight(string(header)+string(body), "\n"), "\n") {
+		cells := strings.Split(strings.TrimSuffix(row, string(mdCellEnd)), string(mdCellEnd))
+		rows = append(rows, cells)
+	}
+
+	widths := make([]int, len(columnData))
+	for _, row := range rows {
+		for i, cell := range row {
+			if i < len(widths) {
+				widths[i] = maxInt(widths[i], text.Len(cell))
+			}
+		}
+	}
+
+	headerRows := strings.Count(string(header), "\n")
+
+	var lines []string
+	for i, row := range rows {
+		cells := make([]string, len(widths))
+		for j := range widths {
+			var cell string
+			if j < len(row) {
+				cell = row[j]
+			}
+			cells[j] = alignCell(cell, widths[j], columnData[j])
+		}
+		lines = append(lines, string(mdNoWrap)+strings.TrimRight(strings.Join(cells, " │ "), " "))
+
+		if i == headerRows-1 {
+			separators := make([]string, len(widths))
+			for j, width := range widths {
+				separators[j] = strings.Repeat("─", width)
+			}
+			lines = append(lines, string(mdNoWrap)+strings.Join(separators, "─┼─"))
+		}
+	}
+
+	writeLines(out, lines)
+}
+
+func alignCell(cell string, width int, flags int) string {
+	missing := width - text.Len(cell)
+	switch flags & blackfriday.TABLE_ALIGNMENT_CENTER {
+	case blackfriday.TABLE_ALIGNMENT_RIGHT:
+		return strings.Repeat(" ", missing) + cell
+	case blackfriday.TABLE_ALIGNMENT_CENTER:
+		return strings.Repeat(" ", missing/2) + cell + strings.Repeat(" ", missing-missing/2)
+	default:
+		return cell + strings.Repeat(" ", missing)
+	}
+}
+
+func (r *termRenderer) TableRow(out *bytes.Buffer, content []byte) {
+	out.Write(content)
+	out.WriteByte('\n')
+}
+
+func (r *termRenderer) TableHeaderCell(out *bytes.Buffer, content []byte, flags int) {
+	out.WriteString(colors.Heading(string(content)))
+	out.WriteByte(mdCellEnd)
+}
+
+func (r *termRenderer) TableCell(out *bytes.Buffer, content []byte, flags int) {
+	out.Write(content)
+	out.WriteByte(mdCellEnd)
+}
+
+func (r *termRenderer) Footnotes(out *bytes.Buffer, content func() bool) {
+	content()
+}
+
+func (r *termRenderer) FootnoteItem(out *bytes.Buffer, name, content []byte, flags int) {
+	out.WriteString(fmt.Sprintf("[%s] ", name))
+	out.Write(content)
+}
+
+func (r *termRenderer) TitleBlock(out *bytes.Buffer, content []byte) {
+	writeLines(out, []string{string(mdWrap) + colors.Heading(string(content))})
+}
+
+func (r *termRenderer) AutoLink(out *bytes.Buffer, link []byte, kind int) {
+	out.WriteString(colors.Link(string(link)))
+}
+
+func (r *termRenderer) CodeSpan(out *bytes.Buffer, code []byte) {
+	out.WriteString(colors.Code(string(code)))
+}
+
+func (r *termRenderer) DoubleEmphasis(out *bytes.Buffer, content []byte) {
+	out.WriteString(colors.Strong(string(content)))
+}
+
+func (r *termRenderer) Emphasis(out *bytes.Buffer, content []byte) {
+	out.WriteString(colors.Emph(string(content)))
+}
+
+func (r *termRenderer) Image(out *bytes.Buffer, link []byte, title []byte, alt []byte) {
+	name := string(alt)
+	if name == "" {
+		name = "image"
+	}
+	out.WriteString(colors.Link(fmt.Sprintf("[%s]", name)))
+	out.WriteString(fmt.Sprintf(" (%s)", link))
+}
+
+func (r *termRenderer) LineBreak(out *bytes.Buffer) {
+	out.WriteByte(mdLineBreak)
+}
+
+func (r *termRenderer) Link(out *bytes.Buffer, link []byte, title []byte, content []byte) {
+	out.WriteString(colors.Link(string(content)))
+	if string(content) != string(link) {
+		out.WriteString(fmt.Sprintf(" (%s)", link))
+	}
+}
+
+func (r *termRenderer) RawHtmlTag(out *bytes.Buffer, tag []byte) {
+	out.Write(tag)
+}
+
+func (r *termRenderer) TripleEmphasis(out *bytes.Buffer, content []byte) {
+	out.WriteString(colors.Strong(colors.Emph(string(content))))
+}
+
+func (r *termRenderer) StrikeThrough(out *bytes.Buffer, content []byte) {
+	out.WriteString(colors.Removed(string(content)))
+}
+
+func (r *termRenderer) FootnoteRef(out *bytes.Buffer, ref []byte, id int) {
+	out.WriteString(fmt.Sprintf("[%d]", id))
+}
+
+func (r *termRenderer) Entity(out *bytes.Buffer, entity []byte) {
+	out.WriteString(html.UnescapeString(string(entity)))
+}
+
+func (r *termRenderer) NormalText(out *bytes.Buffer, content []byte) {
+	// the control characters would be confused with the separators
+	out.WriteString(strings.Map(func(r rune) rune {
+		if r < ' ' && r != '\n' && r != '\t' {
+			return -1
+		}
+		return r
+	}, string(content)))
+}
+
+func (r *termRenderer) DocumentHeader(out *bytes.Buffer) {}
+
+func (r *termRenderer) DocumentFooter(out *bytes.Buffer) {}
+
+func (r *termRenderer) GetFlags() int {
+	return 0
+}
@@ -0,0 +1,101 @@
+package termui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	colors.SetMode(colors.ModeNever)
+	defer colors.SetMode(colors.ModeAuto)
+
+	var tests = []struct {
+		name     string
+		markdown string
+		expected string
+	}{
+		{
+			name:     "paragraphs",
+			markdown: "Some *thing*\nand **more**.\n\n\n\nAnother one.",
+			expected: "Some thing\nand more.\n\nAnother one.",
+		},
+		{
+			name:     "wrapping",
+			markdown: "one two three four five six",
+			expected: "one two\nthree four\nfive six",
+		},
+		{
+			name:     "heading",
+			markdown: "# Title\n\n### Section\ntext",
+			expected: "TITLE\n\nSection\n\ntext",
+		},
+		{
+			name:     "code block",
+			markdown: "```go\nfunc f() {\n\treturn nil // a very long line that is not wrapped\n}\n```",
+			expected: "    func f() {\n        return nil // a very long line that is not wrapped\n    }",
+		},
+		{
+			name:     "quote",
+			markdown: "> one two three four five",
+			expected: "│ one two\n│ three four\n│ five",
+		},
+		{
+			name:     "lists",
+			markdown: "- one\n- two three four five\n\n1. first\n2. second",
+			expected: "• one\n• two three\n  four five\n\n1. first\n2. second",
+		},
+		{
+			name:     "link",
+			markdown: "see [the doc](http://a.b)",
+			expected: "see the doc\n(http://a.b)",
+		},
+		{
+			name:     "table",
+			markdown: "| a | long |\n|--:|------|\n| 1 | 2 |",
+			expected: "a │ long\n──┼─────\n1 │ 2",
+		},
+		{
+			name:     "rule",
+			markdown: "above\n\n---\n\nbelow",
+			expected: "above\n\n────────────\n\nbelow",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, lines := renderMarkdown(test.markdown, 12)
+			assert.Equal(t, test.expected, result)
+			assert.Equal(t, len(splitLines(test.expected)), lines)
+		})
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	colors.SetMode(colors.ModeAlways)
+	defer colors.SetMode(colors.ModeAuto)
+
+	lines := highlight("if x == \"a\" { // check\n/* multi\nline */ return 1", "golang")
+
+	assert.Equal(t, colors.Keyword("if")+" x == "+colors.Literal(`"a"`)+" { "+colors.Comment("// check"), lines[0])
+	assert.Equal(t, colors.Comment("/*")+colors.Comment(" multi"), lines[1])
+	assert.Equal(t, colors.Comment("line */")+" "+colors.Keyword("return")+" "+colors.Literal("1"), lines[2])
+
+	// an unknown language is only colored as code
+	lines = highlight("if x", "unknown")
+	assert.Equal(t, []string{colors.Code("if x")}, lines)
+}
+
+func splitLines(s string) []string {
+	var lines []string
+	start := 0
+	for i, r := range s {
+		if r == '\n' {
+			lines = append(lines, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(lines, s[start:])
+}
@@ -24,13 +24,7 @@ func newMsgPopup() *msgPopup {
 }
 
 func (ep *msgPopup) keybindings(g *gocui.Gui) error {
-	if err := g.SetKeybinding(msgPopupView, gocui.KeySpace, gocui.ModNone, ep.close); err != nil {
-		return err
-	}
-	if err := g.SetKeybinding(msgPopupView, gocui.KeyEnter, gocui.ModNone, ep.close); err != nil {
-		return err
-	}
-	if err := g.SetKeybinding(msgPopupView, 'q', gocui.ModNone, ep.close); err != nil {
+	if err := setKeybindings(g, msgPopupView, actionPopupClose, ep.close); err != nil {
 		return err
 	}
 
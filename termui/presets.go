@@ -0,0 +1,112 @@
+package termui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// keyPresets are key bindings in the format read by LoadKeys, moving around
+// like in an editor. A key bindings file can still change them.
+var keyPresets = map[string]string{
+	"vim": `
+command = :
+
+table.down = j, down
+table.up = k, up
+table.previous-page = ctrl+b, ctrl+u, pgup
+table.next-page = ctrl+f, ctrl+d, pgdn
+table.open = enter, l, right
+table.filter = /
+
+bug.back = q, esc
+bug.down = j, down
+bug.up = k, up
+bug.left = h, left
+bug.right = l, right
+bug.scroll-up = ctrl+b, ctrl+u, pgup
+bug.scroll-down = ctrl+f, ctrl+d, pgdn
+
+inbox.back = q, esc
+inbox.down = j, down
+inbox.up = k, up
+inbox.open = enter, l, right
+
+labels.up = k, up
+labels.down = j, down
+
+form.up = k, up
+form.down = j, down
+form.lower = h, left
+form.higher = l, right
+
+help.up = ctrl+y, up
+help.down = ctrl+e, down
+help.previous-page = ctrl+b, ctrl+u, pgup
+help.next-page = ctrl+f, ctrl+d, pgdn
+`,
+	"emacs": `
+command = alt+x
+
+table.down = ctrl+n, down
+table.up = ctrl+p, up
+table.previous-page = alt+v, pgup
+table.next-page = ctrl+v, pgdn
+table.open = enter, ctrl+o
+table.filter = ctrl+s
+
+filter.cancel = ctrl+g, esc
+
+bug.back = q, ctrl+g
+bug.down = ctrl+n, down
+bug.up = ctrl+p, up
+bug.left = ctrl+b, left
+bug.right = ctrl+f, right
+bug.scroll-up = alt+v, pgup
+bug.scroll-down = ctrl+v, pgdn
+
+inbox.back = q, ctrl+g
+inbox.down = ctrl+n, down
+inbox.up = ctrl+p, up
+inbox.open = enter, ctrl+o
+
+labels.cancel = ctrl+g, esc
+labels.up = ctrl+p, up
+labels.down = ctrl+n, down
+
+form.cancel = ctrl+g, esc
+form.up = alt+p, up
+form.down = alt+n, down
+form.lower = ctrl+b, left
+form.higher = ctrl+f, right
+
+popup.close = q, ctrl+g, enter
+input.cancel = ctrl+g, esc
+
+help.close = ctrl+g, esc, enter
+help.up = ctrl+p, up
+help.down = ctrl+n, down
+help.previous-page = alt+v, pgup
+help.next-page = ctrl+v, pgdn
+`,
+}
+
+// KeyPresets return the names of the presets of key bindings
+func KeyPresets() []string {
+	result := make([]string, 0, len(keyPresets))
+	for name := range keyPresets {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// LoadKeyPreset override the current key bindings with the ones of a preset
+func LoadKeyPreset(name string) error {
+	preset, ok := keyPresets[name]
+	if !ok {
+		return fmt.Errorf("unknown key bindings preset %s, expected one of %s",
+			name, strings.Join(KeyPresets(), ", "))
+	}
+	return LoadKeys(strings.NewReader(preset))
+}
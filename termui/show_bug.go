@@ -3,6 +3,7 @@ package termui
 import (
 	"bytes"
 	"fmt"
+	"image"
 	"strings"
 
 	"github.com/MichaelMure/go-term-text"
@@ -11,7 +12,9 @@ import (
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
 	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/git"
 )
 
 const showBugView = "showBugView"
@@ -20,26 +23,43 @@ const showBugInstructionView = "showBugInstructionView"
 const showBugHeaderView = "showBugHeaderView"
 
 const timeLayout = "Jan 2 2006"
+const editTimeLayout = "Jan 2 2006 15:04"
 
 type showBug struct {
-	cache              *cache.RepoCache
-	bug                *cache.BugCache
+	cache *cache.RepoCache
+	bug   *cache.BugCache
+	// the window to return to, the bug table if nil
+	back               window
 	childViews         []string
 	mainSelectableView []string
 	sideSelectableView []string
 	selected           string
 	isOnSide           bool
 	scroll             int
+	// show the markdown source of the messages instead of their rendering
+	markdownSource bool
+	// the messages with their edits displayed, by view
+	expandedHistory map[string]bool
+	// display the edits as side by side diffs instead of unified ones
+	sideBySide bool
+	// the files of the repo decoded as images, nil if they are not
+	images map[git.Hash]*inlineImage
+	// the images to draw over the timeline, and the ones drawn
+	imagePlacements []imagePlacement
+	drawnImages     []imagePlacement
 }
 
 func newShowBug(cache *cache.RepoCache) *showBug {
 	return &showBug{
-		cache: cache,
+		cache:           cache,
+		images:          make(map[git.Hash]*inlineImage),
+		expandedHistory: make(map[string]bool),
 	}
 }
 
 func (sb *showBug) SetBug(bug *cache.BugCache) {
 	sb.bug = bug
+	sb.back = nil
 	sb.scroll = 0
 	sb.selected = ""
 	sb.isOnSide = false
@@ -49,7 +69,7 @@ func (sb *showBug) layout(g *gocui.Gui) error {
 	maxX, maxY := g.Size()
 	sb.childViews = nil
 
-	v, err := g.SetView(showBugView, 0, 0, maxX*2/3, maxY-2, 0)
+	v, err := g.SetView(showBugView, 0, 0, maxX*2/3, maxY-3, 0)
 
 	if err != nil {
 		if !gocui.IsUnknownView(err) {
@@ -66,7 +86,7 @@ func (sb *showBug) layout(g *gocui.Gui) error {
 		return err
 	}
 
-	v, err = g.SetView(showBugSidebarView, maxX*2/3+1, 0, maxX-1, maxY-2, 0)
+	v, err = g.SetView(showBugSidebarView, maxX*2/3+1, 0, maxX-1, maxY-3, 0)
 
 	if err != nil {
 		if !gocui.IsUnknownView(err) {
@@ -92,103 +112,126 @@ func (sb *showBug) layout(g *gocui.Gui) error {
 
 		sb.childViews = append(sb.childViews, showBugInstructionView)
 		v.Frame = false
-		v.BgColor = gocui.ColorBlue
+		v.FgColor, v.BgColor = roleColors(colors.RoleBar)
 	}
 
 	v.Clear()
-	_, _ = fmt.Fprintf(v, "[q] Save and return [←↓↑→,hjkl] Navigation [o] Toggle open/close [e] Edit [c] Comment [t] Change title")
+	_, _ = fmt.Fprint(v, instructions(
+		instruction("Save and return", actionBugBack),
+		instruction("Navigation", actionBugLeft, actionBugDown, actionBugUp, actionBugRight),
+		instruction("Edit", actionBugEdit),
+		instruction("Comment", actionBugComment),
+		instruction("Help", actionHelp),
+	))
 
 	_, err = g.SetViewOnTop(showBugInstructionView)
 	if err != nil {
 		return err
 	}
 
+	sb.updateImages(g, sb.visibleImages(maxY))
+
 	_, err = g.SetCurrentView(showBugView)
 	return err
 }
 
 func (sb *showBug) keybindings(g *gocui.Gui) error {
 	// Return
-	if err := g.SetKeybinding(showBugView, 'q', gocui.ModNone, sb.saveAndBack); err != nil {
+	if err := setKeybindings(g, showBugView, actionBugBack, sb.saveAndBack); err != nil {
 		return err
 	}
 
 	// Scrolling
-	if err := g.SetKeybinding(showBugView, gocui.KeyPgup, gocui.ModNone,
-		sb.scrollUp); err != nil {
+	if err := setKeybindings(g, showBugView, actionBugScrollUp, sb.scrollUp); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding(showBugView, gocui.KeyPgdn, gocui.ModNone,
-		sb.scrollDown); err != nil {
+	if err := setKeybindings(g, showBugView, actionBugScrollDown, sb.scrollDown); err != nil {
 		return err
 	}
 
 	// Down
-	if err := g.SetKeybinding(showBugView, 'j', gocui.ModNone,
-		sb.selectNext); err != nil {
+	if err := setKeybindings(g, showBugView, actionBugDown, sb.selectNext); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding(showBugView, gocui.KeyArrowDown, gocui.ModNone,
-		sb.selectNext); err != nil {
+
+	// Up
+	if err := setKeybindings(g, showBugView, actionBugUp, sb.selectPrevious); err != nil {
 		return err
 	}
-	// Up
-	if err := g.SetKeybinding(showBugView, 'k', gocui.ModNone,
-		sb.selectPrevious); err != nil {
+
+	// Left
+	if err := setKeybindings(g, showBugView, actionBugLeft, sb.left); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding(showBugView, gocui.KeyArrowUp, gocui.ModNone,
-		sb.selectPrevious); err != nil {
+
+	// Right
+	if err := setKeybindings(g, showBugView, actionBugRight, sb.right); err != nil {
 		return err
 	}
 
-	// Left
-	if err := g.SetKeybinding(showBugView, 'h', gocui.ModNone,
-		sb.left); err != nil {
+	// Comment
+	if err := setKeybindings(g, showBugView, actionBugComment, sb.comment); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding(showBugView, gocui.KeyArrowLeft, gocui.ModNone,
-		sb.left); err != nil {
+
+	// Open/close
+	if err := setKeybindings(g, showBugView, actionBugToggleStatus, sb.toggleOpenClose); err != nil {
 		return err
 	}
-	// Right
-	if err := g.SetKeybinding(showBugView, 'l', gocui.ModNone,
-		sb.right); err != nil {
+
+	// Title
+	if err := setKeybindings(g, showBugView, actionBugTitle, sb.setTitle); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding(showBugView, gocui.KeyArrowRight, gocui.ModNone,
-		sb.right); err != nil {
+
+	// Edit
+	if err := setKeybindings(g, showBugView, actionBugEdit, sb.edit); err != nil {
 		return err
 	}
 
-	// Comment
-	if err := g.SetKeybinding(showBugView, 'c', gocui.ModNone,
-		sb.comment); err != nil {
+	// Labels
+	if err := setKeybindings(g, showBugView, actionBugLabels, sb.labels); err != nil {
 		return err
 	}
 
-	// Open/close
-	if err := g.SetKeybinding(showBugView, 'o', gocui.ModNone,
-		sb.toggleOpenClose); err != nil {
+	// Markdown
+	if err := setKeybindings(g, showBugView, actionBugToggleSource, sb.toggleSource); err != nil {
 		return err
 	}
 
-	// Title
-	if err := g.SetKeybinding(showBugView, 't', gocui.ModNone,
-		sb.setTitle); err != nil {
+	// Edits
+	if err := setKeybindings(g, showBugView, actionBugHistory, sb.toggleHistory); err != nil {
+		return err
+	}
+	if err := setKeybindings(g, showBugView, actionBugDiffMode, sb.toggleDiffMode); err != nil {
 		return err
 	}
 
-	// Edit
-	if err := g.SetKeybinding(showBugView, 'e', gocui.ModNone,
-		sb.edit); err != nil {
+	// Clipboard
+	if err := setKeybindings(g, showBugView, actionYankId, yankBug(yankId, sb.shownBug)); err != nil {
+		return err
+	}
+	if err := setKeybindings(g, showBugView, actionYankHumanId, yankBug(yankHumanId, sb.shownBug)); err != nil {
+		return err
+	}
+	if err := setKeybindings(g, showBugView, actionYankURL, yankBug(yankURL, sb.shownBug)); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// shownBug return the id of the bug displayed
+func (sb *showBug) shownBug() (entity.Id, bool) {
+	if sb.bug == nil {
+		return "", false
+	}
+	return sb.bug.Id(), true
+}
+
 func (sb *showBug) disable(g *gocui.Gui) error {
+	sb.updateImages(g, nil)
+
 	for _, view := range sb.childViews {
 		if err := g.DeleteView(view); err != nil && !gocui.IsUnknownView(err) {
 			return err
@@ -206,6 +249,7 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 	snap := sb.bug.Snapshot()
 
 	sb.mainSelectableView = nil
+	sb.imagePlacements = nil
 
 	createTimelineItem := snap.Timeline[0].(*bug.CreateTimelineItem)
 
@@ -214,17 +258,19 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 		edited = " (edited)"
 	}
 
-	bugHeader := fmt.Sprintf("[%s] %s\n\n[%s] %s opened this bug on %s%s",
-		colors.Cyan(snap.Id().Human()),
-		colors.Bold(snap.Title),
-		colors.Yellow(snap.Status),
-		colors.Magenta(snap.Author.DisplayName()),
+	bugHeader := fmt.Sprintf("[%s] %s\n\n[%s] %s %s opened this bug on %s%s",
+		colors.Id(snap.Id().Human()),
+		colors.Emphasis(snap.Title),
+		colors.Status(snap.Status),
+		authorAvatar(snap.Author),
+		colors.Author(snap.Author.DisplayName()),
 		snap.CreatedAt.Format(timeLayout),
 		edited,
 	)
 	bugHeader, lines := text.Wrap(bugHeader, maxX)
 
-	v, err := sb.createOpView(g, showBugHeaderView, x0, y0, maxX+1, lines, false)
+	// the header is selectable to edit the title
+	v, err := sb.createOpView(g, showBugHeaderView, x0, y0, maxX+1, lines, true)
 	if err != nil {
 		return err
 	}
@@ -249,9 +295,13 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 			if create.MessageIsEmpty() {
 				content, lines = text.WrapLeftPadded(emptyMessagePlaceholder(), maxX-1, 4)
 			} else {
-				content, lines = text.WrapLeftPadded(create.Message, maxX-1, 4)
+				content, lines = sb.renderMessage(create.Message, maxX-1, 4)
 			}
 
+			images := messageImages(create.Message, create.Files)
+			content, lines = sb.renderImages(content, lines, images, x0, y0, maxX-1)
+			content, lines = sb.renderHistory(content, lines, viewName, create.History, maxX-1)
+
 			v, err := sb.createOpView(g, viewName, x0, y0, maxX+1, lines, true)
 			if err != nil {
 				return err
@@ -271,17 +321,22 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 			if comment.MessageIsEmpty() {
 				message, _ = text.WrapLeftPadded(emptyMessagePlaceholder(), maxX-1, 4)
 			} else {
-				message, _ = text.WrapLeftPadded(comment.Message, maxX-1, 4)
+				message, _ = sb.renderMessage(comment.Message, maxX-1, 4)
 			}
 
-			content := fmt.Sprintf("%s commented on %s%s\n\n%s",
-				colors.Magenta(comment.Author.DisplayName()),
+			content := fmt.Sprintf("%s %s commented on %s%s\n\n%s",
+				authorAvatar(comment.Author),
+				colors.Author(comment.Author.DisplayName()),
 				comment.CreatedAt.Time().Format(timeLayout),
 				edited,
 				message,
 			)
 			content, lines = text.Wrap(content, maxX)
 
+			images := messageImages(comment.Message, comment.Files)
+			content, lines = sb.renderImages(content, lines, images, x0, y0, maxX-1)
+			content, lines = sb.renderHistory(content, lines, viewName, comment.History, maxX-1)
+
 			v, err := sb.createOpView(g, viewName, x0, y0, maxX+1, lines, true)
 			if err != nil {
 				return err
@@ -293,8 +348,8 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 			setTitle := op.(*bug.SetTitleTimelineItem)
 
 			content := fmt.Sprintf("%s changed the title to %s on %s",
-				colors.Magenta(setTitle.Author.DisplayName()),
-				colors.Bold(setTitle.Title),
+				colors.Author(setTitle.Author.DisplayName()),
+				colors.Emphasis(setTitle.Title),
 				setTitle.UnixTime.Time().Format(timeLayout),
 			)
 			content, lines := text.Wrap(content, maxX)
@@ -310,8 +365,8 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 			setStatus := op.(*bug.SetStatusTimelineItem)
 
 			content := fmt.Sprintf("%s %s the bug on %s",
-				colors.Magenta(setStatus.Author.DisplayName()),
-				colors.Bold(setStatus.Status.Action()),
+				colors.Author(setStatus.Author.DisplayName()),
+				colors.Emphasis(setStatus.Status.Action()),
 				setStatus.UnixTime.Time().Format(timeLayout),
 			)
 			content, lines := text.Wrap(content, maxX)
@@ -323,17 +378,40 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 			_, _ = fmt.Fprint(v, content)
 			y0 += lines + 2
 
+		case *bug.SetDueDateTimelineItem:
+			setDueDate := op.(*bug.SetDueDateTimelineItem)
+
+			action := "removed the due date"
+			if setDueDate.Due != 0 {
+				action = fmt.Sprintf("set the due date to %s",
+					colors.Emphasis(setDueDate.Due.Time().Format(timeLayout)))
+			}
+
+			content := fmt.Sprintf("%s %s on %s",
+				colors.Author(setDueDate.Author.DisplayName()),
+				action,
+				setDueDate.UnixTime.Time().Format(timeLayout),
+			)
+			content, lines := text.Wrap(content, maxX)
+
+			v, err := sb.createOpView(g, viewName, x0, y0, maxX+1, lines, true)
+			if err != nil {
+				return err
+			}
+			_, _ = fmt.Fprint(v, content)
+			y0 += lines + 2
+
 		case *bug.LabelChangeTimelineItem:
 			labelChange := op.(*bug.LabelChangeTimelineItem)
 
 			var added []string
 			for _, label := range labelChange.Added {
-				added = append(added, colors.Bold("\""+label+"\""))
+				added = append(added, colors.Emphasis("\""+label+"\""))
 			}
 
 			var removed []string
 			for _, label := range labelChange.Removed {
-				removed = append(removed, colors.Bold("\""+label+"\""))
+				removed = append(removed, colors.Emphasis("\""+label+"\""))
 			}
 
 			var action bytes.Buffer
@@ -359,12 +437,45 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 			}
 
 			content := fmt.Sprintf("%s %s on %s",
-				colors.Magenta(labelChange.Author.DisplayName()),
+				colors.Author(labelChange.Author.DisplayName()),
 				action.String(),
 				labelChange.UnixTime.Time().Format(timeLayout),
 			)
 			content, lines := text.Wrap(content, maxX)
 
+			v, err := sb.createOpView(g, viewName, x0, y0, maxX+1, lines, true)
+			if err != nil {
+				return err
+			}
+			_, _ = fmt.Fprint(v, content)
+			y0 += lines + 2
+
+		case *bug.AssigneeChangeTimelineItem:
+			assigneeChange := op.(*bug.AssigneeChangeTimelineItem)
+
+			var action bytes.Buffer
+
+			if len(assigneeChange.Added) > 0 {
+				action.WriteString("assigned ")
+				action.WriteString(identityNames(assigneeChange.Added))
+
+				if len(assigneeChange.Removed) > 0 {
+					action.WriteString(" and ")
+				}
+			}
+
+			if len(assigneeChange.Removed) > 0 {
+				action.WriteString("unassigned ")
+				action.WriteString(identityNames(assigneeChange.Removed))
+			}
+
+			content := fmt.Sprintf("%s %s on %s",
+				colors.Author(assigneeChange.Author.DisplayName()),
+				action.String(),
+				assigneeChange.UnixTime.Time().Format(timeLayout),
+			)
+			content, lines := text.Wrap(content, maxX)
+
 			v, err := sb.createOpView(g, viewName, x0, y0, maxX+1, lines, true)
 			if err != nil {
 				return err
@@ -377,9 +488,178 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 	return nil
 }
 
+// authorAvatar render the initials of an identity with a color derived from
+// its id, as a stand-in for its avatar in the terminal
+func authorAvatar(i identity.Interface) string {
+	// reuse the deterministic palette of the labels
+	color := bug.Label(i.Id().String()).Color().Term256()
+	return colors.Term256(int(color), colors.Emphasis(identity.Initials(i)))
+}
+
 // emptyMessagePlaceholder return a formatted placeholder for an empty message
 func emptyMessagePlaceholder() string {
-	return colors.GreyBold("No description provided.")
+	return colors.Placeholder("No description provided.")
+}
+
+// renderMessage render the markdown of a message, or show its source if
+// toggled, wrapped and padded on the left
+func (sb *showBug) renderMessage(message string, width int, pad int) (string, int) {
+	if sb.markdownSource {
+		return text.WrapLeftPadded(message, width, pad)
+	}
+
+	rendered, lines := renderMarkdown(message, width-pad)
+
+	padding := strings.Repeat(" ", pad)
+	split := strings.Split(rendered, "\n")
+	for i, line := range split {
+		if line != "" {
+			split[i] = padding + line
+		}
+	}
+
+	return strings.Join(split, "\n"), lines
+}
+
+// renderImages add the images of a message after its content, as a
+// placeholder with their name. When the terminal can display an image, rows
+// are reserved to draw it over the placeholder.
+func (sb *showBug) renderImages(content string, lines int, images []imageRef, x0 int, y0 int, width int) (string, int) {
+	for _, ref := range images {
+		placeholder := colors.Placeholder(fmt.Sprintf("[image: %s]", ref.name))
+		content += "\n\n" + text.LeftPadMaxLine(placeholder, width, 4)
+		lines += 2
+
+		im := sb.loadImage(ref.hash)
+		if im == nil || graphics == graphicsNone {
+			continue
+		}
+
+		cols, rows := imageSize(im.img, width-4)
+		sb.imagePlacements = append(sb.imagePlacements, imagePlacement{
+			image: im,
+			// the content of a view start after its frame
+			x:    x0 + 1 + 4,
+			y:    y0 + lines,
+			cols: cols,
+			rows: rows,
+		})
+
+		content += strings.Repeat("\n", rows-1)
+		lines += rows - 1
+	}
+
+	return content, lines
+}
+
+// renderHistory add the edits of a message after its content, as a diff
+// between each version, if they are expanded
+func (sb *showBug) renderHistory(content string, lines int, viewName string, history []bug.CommentHistoryStep, width int) (string, int) {
+	if !sb.expandedHistory[viewName] || len(history) < 2 {
+		return content, lines
+	}
+
+	padding := strings.Repeat(" ", 4)
+
+	for i := 1; i < len(history); i++ {
+		step := history[i]
+
+		header := fmt.Sprintf("Edited on %s", step.UnixTime.Time().Format(editTimeLayout))
+		if step.Author != nil {
+			header = fmt.Sprintf("%s edited on %s",
+				colors.Author(step.Author.DisplayName()),
+				step.UnixTime.Time().Format(editTimeLayout))
+		}
+
+		content += "\n\n" + padding + colors.Emphasis(header)
+		lines += 2
+
+		var diff []string
+		if sb.sideBySide {
+			diff = sideBySideDiff(history[i-1].Message, step.Message, width-4)
+		} else {
+			diff = unifiedDiff(history[i-1].Message, step.Message, width-4)
+		}
+
+		if history[i-1].Message == step.Message {
+			diff = []string{colors.Placeholder("No change of the message")}
+		}
+
+		for _, line := range diff {
+			content += "\n" + padding + line
+			lines++
+		}
+	}
+
+	return content, lines
+}
+
+// loadImage read and decode a file of the repo, nil if it's not an image
+func (sb *showBug) loadImage(hash git.Hash) *inlineImage {
+	if hash == "" {
+		return nil
+	}
+
+	if im, ok := sb.images[hash]; ok {
+		return im
+	}
+
+	var im *inlineImage
+
+	data, err := sb.cache.ReadData(hash)
+	if err == nil {
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err == nil {
+			im = &inlineImage{data: data, img: img}
+		}
+	}
+
+	sb.images[hash] = im
+	return im
+}
+
+// visibleImages return the images to draw, the ones entirely visible in the
+// timeline when nothing is displayed over it
+func (sb *showBug) visibleImages(maxY int) []imagePlacement {
+	if ui.msgPopup.active || ui.inputPopup.active || ui.helpPopup.active {
+		return nil
+	}
+
+	var result []imagePlacement
+	for _, p := range sb.imagePlacements {
+		// the timeline is from the row 1 to maxY-4
+		if p.y >= 1 && p.y+p.rows-1 <= maxY-4 {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// updateImages draw the images once gocui has flushed the screen, if they
+// changed
+func (sb *showBug) updateImages(g *gocui.Gui, placements []imagePlacement) {
+	if samePlacements(placements, sb.drawnImages) {
+		return
+	}
+
+	previous := sb.drawnImages
+	sb.drawnImages = placements
+
+	g.Update(func(*gocui.Gui) error {
+		return drawImages(previous, placements)
+	})
+}
+
+func samePlacements(a []imagePlacement, b []imagePlacement) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 func (sb *showBug) createOpView(g *gocui.Gui, name string, x0 int, y0 int, maxX int, height int, selectable bool) (*gocui.View, error) {
@@ -428,17 +708,18 @@ func (sb *showBug) renderSidebar(g *gocui.Gui, sideView *gocui.View) error {
 
 	sb.sideSelectableView = nil
 
-	labelStr := make([]string, len(snap.Labels))
-	for i, l := range snap.Labels {
-		lc := l.Color()
+	labels := sb.cache.LabelStore().ResolveAll(snap.Labels)
+	labelStr := make([]string, len(labels))
+	for i, l := range labels {
+		lc := sb.cache.LabelStore().Color(l)
 		lc256 := lc.Term256()
-		labelStr[i] = lc256.Escape() + "◼ " + lc256.Unescape() + l.String()
+		labelStr[i] = colors.Term256(int(lc256), "◼ ") + l.String()
 	}
 
-	labels := strings.Join(labelStr, "\n")
-	labels, lines := text.WrapLeftPadded(labels, maxX, 2)
+	labelsTxt := strings.Join(labelStr, "\n")
+	labelsTxt, lines := text.WrapLeftPadded(labelsTxt, maxX, 2)
 
-	content := fmt.Sprintf("%s\n\n%s", colors.Bold("  Labels"), labels)
+	content := fmt.Sprintf("%s\n\n%s", colors.Emphasis("  Labels"), labelsTxt)
 
 	v, err := sb.createSideView(g, "sideLabels", x0, y0, maxX, lines+2)
 	if err != nil {
@@ -447,15 +728,54 @@ func (sb *showBug) renderSidebar(g *gocui.Gui, sideView *gocui.View) error {
 
 	_, _ = fmt.Fprint(v, content)
 
+	if len(snap.Assignees) == 0 {
+		return nil
+	}
+
+	y0 += lines + 4
+
+	assigneeStr := make([]string, len(snap.Assignees))
+	for i, a := range snap.Assignees {
+		assigneeStr[i] = colors.Author(a.DisplayName())
+	}
+
+	assigneesTxt := strings.Join(assigneeStr, "\n")
+	assigneesTxt, lines = text.WrapLeftPadded(assigneesTxt, maxX, 2)
+
+	content = fmt.Sprintf("%s\n\n%s", colors.Emphasis("  Assignees"), assigneesTxt)
+
+	// not selectable, as only the labels can be edited from the sidebar
+	v, err = g.SetView("sideAssignees", x0, y0, maxX, y0+lines+3, 0)
+	if err != nil && !gocui.IsUnknownView(err) {
+		return err
+	}
+	sb.childViews = append(sb.childViews, "sideAssignees")
+	v.Frame = false
+	v.Clear()
+
+	_, _ = fmt.Fprint(v, content)
+
 	return nil
 }
 
+func identityNames(identities []identity.Interface) string {
+	names := make([]string, len(identities))
+	for i, id := range identities {
+		names[i] = colors.Author(id.DisplayName())
+	}
+	return strings.Join(names, ", ")
+}
+
 func (sb *showBug) saveAndBack(g *gocui.Gui, v *gocui.View) error {
 	err := sb.bug.CommitAsNeeded()
 	if err != nil {
 		return err
 	}
-	err = ui.activateWindow(ui.bugTable)
+	back := sb.back
+	if back == nil {
+		back = ui.bugTable
+	}
+	err = ui.activateWindow(back)
 	if err != nil {
 		return err
 	}
@@ -626,9 +946,15 @@ func (sb *showBug) toggleOpenClose(g *gocui.Gui, v *gocui.View) error {
 	switch sb.bug.Snapshot().Status {
 	case bug.OpenStatus:
 		_, err := sb.bug.Close()
+		if err == nil {
+			ui.statusBar.showMessage("Bug %s closed", sb.bug.Id().Human())
+		}
 		return err
 	case bug.ClosedStatus:
 		_, err := sb.bug.Open()
+		if err == nil {
+			ui.statusBar.showMessage("Bug %s reopened", sb.bug.Id().Human())
+		}
 		return err
 	default:
 		return nil
@@ -646,6 +972,10 @@ func (sb *showBug) edit(g *gocui.Gui, v *gocui.View) error {
 		return nil
 	}
 
+	if sb.selected == showBugHeaderView {
+		return setTitleWithEditor(sb.bug)
+	}
+
 	op, err := snap.SearchTimelineItem(entity.Id(sb.selected))
 	if err != nil {
 		return err
@@ -658,6 +988,8 @@ func (sb *showBug) edit(g *gocui.Gui, v *gocui.View) error {
 	case *bug.CreateTimelineItem:
 		preMessage := op.(*bug.CreateTimelineItem).Message
 		return editCommentWithEditor(sb.bug, op.Id(), preMessage)
+	case *bug.SetTitleTimelineItem:
+		return setTitleWithEditor(sb.bug)
 	case *bug.LabelChangeTimelineItem:
 		return sb.editLabels(g, snap)
 	}
@@ -666,7 +998,48 @@ func (sb *showBug) edit(g *gocui.Gui, v *gocui.View) error {
 	return nil
 }
 
+func (sb *showBug) labels(g *gocui.Gui, v *gocui.View) error {
+	return sb.editLabels(g, sb.bug.Snapshot())
+}
+
 func (sb *showBug) editLabels(g *gocui.Gui, snap *bug.Snapshot) error {
 	ui.labelSelect.SetBug(sb.cache, sb.bug)
 	return ui.activateWindow(ui.labelSelect)
 }
+
+func (sb *showBug) toggleSource(g *gocui.Gui, v *gocui.View) error {
+	sb.markdownSource = !sb.markdownSource
+	return nil
+}
+
+func (sb *showBug) toggleHistory(g *gocui.Gui, v *gocui.View) error {
+	if sb.isOnSide || sb.selected == "" || sb.selected == showBugHeaderView {
+		return nil
+	}
+
+	op, err := sb.bug.Snapshot().SearchTimelineItem(entity.Id(sb.selected))
+	if err != nil {
+		return err
+	}
+
+	var edited bool
+	switch op := op.(type) {
+	case *bug.CreateTimelineItem:
+		edited = op.Edited()
+	case *bug.AddCommentTimelineItem:
+		edited = op.Edited()
+	}
+
+	if !edited {
+		ui.statusBar.showMessage("This message was not edited")
+		return nil
+	}
+
+	sb.expandedHistory[sb.selected] = !sb.expandedHistory[sb.selected]
+	return nil
+}
+
+func (sb *showBug) toggleDiffMode(g *gocui.Gui, v *gocui.View) error {
+	sb.sideBySide = !sb.sideBySide
+	return nil
+}
@@ -0,0 +1,128 @@
+package termui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/go-term-text"
+	"github.com/awesome-gocui/gocui"
+	"github.com/dustin/go-humanize"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+const statusBarView = "statusBarView"
+
+// how long a message stays in the status bar
+const statusMessageDuration = 5 * time.Second
+
+// statusBar is displayed above the instructions of every window, with the
+// user identity, the query of the bug table, and the last import of a bridge.
+// It also show a message for a few seconds when an action is completed.
+type statusBar struct {
+	cache *cache.RepoCache
+
+	// the bridge that imported the most recently, and when
+	lastImportBridge string
+	lastImport       time.Time
+
+	message     string
+	messageTime time.Time
+	// a redraw is scheduled to remove the message
+	clearScheduled bool
+}
+
+func newStatusBar(cache *cache.RepoCache) (*statusBar, error) {
+	name, last, err := core.LastImport(cache)
+	if err != nil {
+		return nil, err
+	}
+
+	return &statusBar{
+		cache:            cache,
+		lastImportBridge: name,
+		lastImport:       last,
+	}, nil
+}
+
+// showMessage show a message for a few seconds
+func (sb *statusBar) showMessage(format string, a ...interface{}) {
+	sb.message = fmt.Sprintf(format, a...)
+	sb.messageTime = time.Now()
+	sb.clearScheduled = false
+}
+
+func (sb *statusBar) layout(g *gocui.Gui) error {
+	maxX, maxY := g.Size()
+
+	v, err := g.SetView(statusBarView, -1, maxY-3, maxX, maxY-1, 0)
+
+	if err != nil {
+		if !gocui.IsUnknownView(err) {
+			return err
+		}
+
+		v.Frame = false
+	}
+
+	if sb.message != "" && time.Since(sb.messageTime) >= statusMessageDuration {
+		sb.message = ""
+	}
+
+	if sb.message != "" && !sb.clearScheduled {
+		// gocui only redraw on events
+		sb.clearScheduled = true
+		time.AfterFunc(statusMessageDuration-time.Since(sb.messageTime), func() {
+			g.Update(func(*gocui.Gui) error { return nil })
+		})
+	}
+
+	v.Clear()
+	_, _ = fmt.Fprint(v, sb.render(maxX))
+
+	return nil
+}
+
+func (sb *statusBar) render(maxX int) string {
+	var parts []string
+
+	user, err := sb.cache.GetUserIdentity()
+	if err == nil {
+		parts = append(parts, colors.Author(user.DisplayName()))
+	} else {
+		parts = append(parts, colors.Placeholder("no identity"))
+	}
+
+	bt := ui.bugTable
+	if bt.queryStr != "" {
+		parts = append(parts, bt.queryStr)
+	} else {
+		parts = append(parts, colors.Placeholder("all bugs"))
+	}
+
+	parts = append(parts, fmt.Sprintf("%d of %d bugs", bt.shownBugs(), len(bt.allIds)))
+
+	if sb.lastImportBridge != "" {
+		parts = append(parts, fmt.Sprintf("pulled from %s %s",
+			sb.lastImportBridge, humanize.Time(sb.lastImport)))
+	}
+
+	status := " " + strings.Join(parts, colors.Separator(" │ "))
+
+	if sb.message == "" {
+		return text.TruncateMax(status, maxX)
+	}
+
+	// the message is aligned on the right, over the status if needed
+	message := colors.Emphasis(sb.message) + " "
+	space := maxX - text.Len(message)
+	if space <= 0 {
+		return text.TruncateMax(message, maxX)
+	}
+
+	status = text.TruncateMax(status, space)
+	return status + strings.Repeat(" ", space-text.Len(status)) + message
+}
@@ -0,0 +1,68 @@
+package termui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+// Tab is a query of the bug table, shown above it and selectable with its
+// number
+type Tab struct {
+	Name  string
+	Query string
+}
+
+// tabs are the tabs of the bug table, the builtin ones and the ones added
+// with AddTab
+var tabs = []Tab{
+	{Name: "All", Query: ""},
+	{Name: "Open", Query: defaultQuery},
+}
+
+// tabActions are the actions selecting a tab, by position
+var tabActions = []keyAction{
+	actionTableTab1, actionTableTab2, actionTableTab3,
+	actionTableTab4, actionTableTab5, actionTableTab6,
+	actionTableTab7, actionTableTab8, actionTableTab9,
+}
+
+// AddTab add a tab after the existing ones
+func AddTab(name string, query string) {
+	tabs = append(tabs, Tab{Name: name, Query: query})
+}
+
+// currentTab return the position of the tab of a query, or -1 if the query
+// is not the one of a tab
+func currentTab(queryStr string) int {
+	for i, tab := range tabs {
+		if strings.TrimSpace(tab.Query) == strings.TrimSpace(queryStr) {
+			return i
+		}
+	}
+	return -1
+}
+
+// renderTabs render the tabs in a line, with the number to select them, the
+// current one being highlighted
+func renderTabs(queryStr string) string {
+	current := currentTab(queryStr)
+
+	var result strings.Builder
+	for i, tab := range tabs {
+		label := fmt.Sprintf(" %s ", tab.Name)
+		if i < len(tabActions) && len(keymap[tabActions[i]]) > 0 {
+			label = fmt.Sprintf(" %s %s ", keymap[tabActions[i]][0], tab.Name)
+		}
+
+		if i == current {
+			label = colors.Selection(label)
+		}
+
+		result.WriteString(" ")
+		result.WriteString(label)
+	}
+
+	return result.String()
+}
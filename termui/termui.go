@@ -3,6 +3,7 @@ package termui
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/awesome-gocui/gocui"
 	"github.com/pkg/errors"
@@ -12,6 +13,7 @@ import (
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/input"
+	"github.com/MichaelMure/git-bug/util/colors"
 )
 
 var errTerminateMainloop = errors.New("terminate gocui mainloop")
@@ -24,10 +26,14 @@ type termUI struct {
 	activeWindow window
 
 	bugTable    *bugTable
+	inbox       *inbox
 	showBug     *showBug
 	labelSelect *labelSelect
+	bugForm     *bugForm
 	msgPopup    *msgPopup
 	inputPopup  *inputPopup
+	helpPopup   *helpPopup
+	statusBar   *statusBar
 }
 
 func (tui *termUI) activateWindow(window window) error {
@@ -50,28 +56,52 @@ type window interface {
 
 // Run will launch the termUI in the terminal
 func Run(cache *cache.RepoCache) error {
+	statusBar, err := newStatusBar(cache)
+	if err != nil {
+		return err
+	}
+
+	sessionStart := time.Now()
+	lastSession, err := readLastSession(cache)
+	if err != nil {
+		return err
+	}
+
 	ui = &termUI{
 		gError:      make(chan error, 1),
 		cache:       cache,
 		bugTable:    newBugTable(cache),
+		inbox:       newInbox(cache, lastSession),
 		showBug:     newShowBug(cache),
 		labelSelect: newLabelSelect(),
+		bugForm:     newBugForm(cache),
 		msgPopup:    newMsgPopup(),
 		inputPopup:  newInputPopup(),
+		helpPopup:   newHelpPopup(),
+		statusBar:   statusBar,
 	}
 
 	ui.activeWindow = ui.bugTable
 
+	graphics = detectGraphics()
+
+	if watchBugs {
+		stop := watch(cache)
+		defer stop()
+	}
+
 	initGui(nil)
 
-	err := <-ui.gError
+	err = <-ui.gError
 
 	if err != nil && err != gocui.ErrQuit {
 		fmt.Println(err.(*errors2.Error).ErrorStack())
 		return err
 	}
 
-	return nil
+	// the changes made by the others during this session are in the inbox
+	// of the next one
+	return storeLastSession(cache, sessionStart)
 }
 
 func initGui(action func(ui *termUI) error) {
@@ -84,6 +114,9 @@ func initGui(action func(ui *termUI) error) {
 
 	ui.g = g
 
+	// the images were drawn on the previous screen
+	ui.showBug.drawnImages = nil
+
 	ui.g.SetManagerFunc(layout)
 
 	ui.g.InputEsc = true
@@ -121,11 +154,16 @@ func initGui(action func(ui *termUI) error) {
 
 func layout(g *gocui.Gui) error {
 	g.Cursor = false
+	g.Highlight = false
 
 	if err := ui.activeWindow.layout(g); err != nil {
 		return err
 	}
 
+	if err := ui.statusBar.layout(g); err != nil {
+		return err
+	}
+
 	if err := ui.msgPopup.layout(g); err != nil {
 		return err
 	}
@@ -134,19 +172,34 @@ func layout(g *gocui.Gui) error {
 		return err
 	}
 
+	if err := ui.helpPopup.layout(g); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func keybindings(g *gocui.Gui) error {
 	// Quit
-	if err := g.SetKeybinding("", gocui.KeyCtrlC, gocui.ModNone, quit); err != nil {
+	if err := setKeybindings(g, "", actionQuit, quit); err != nil {
 		return err
 	}
 
+	// Command line
+	for _, view := range []string{bugTableView, inboxView, showBugView} {
+		if err := setKeybindings(g, view, actionCommand, openCommandLine); err != nil {
+			return err
+		}
+	}
+
 	if err := ui.bugTable.keybindings(g); err != nil {
 		return err
 	}
 
+	if err := ui.inbox.keybindings(g); err != nil {
+		return err
+	}
+
 	if err := ui.showBug.keybindings(g); err != nil {
 		return err
 	}
@@ -155,6 +208,10 @@ func keybindings(g *gocui.Gui) error {
 		return err
 	}
 
+	if err := ui.bugForm.keybindings(g); err != nil {
+		return err
+	}
+
 	if err := ui.msgPopup.keybindings(g); err != nil {
 		return err
 	}
@@ -163,6 +220,10 @@ func keybindings(g *gocui.Gui) error {
 		return err
 	}
 
+	if err := ui.helpPopup.keybindings(g); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -170,7 +231,7 @@ func quit(g *gocui.Gui, v *gocui.View) error {
 	return gocui.ErrQuit
 }
 
-func newBugWithEditor(repo *cache.RepoCache) error {
+func editBugFormWithEditor(bf *bugForm) error {
 	// This is somewhat hacky.
 	// As there is no way to pause gocui, run the editor and restart gocui,
 	// we have to stop it entirely and start a new one later.
@@ -183,31 +244,24 @@ func newBugWithEditor(repo *cache.RepoCache) error {
 	ui.g.Close()
 	ui.g = nil
 
-	title, message, err := input.BugCreateEditorInput(ui.cache, "", "")
+	title, message, err := input.BugCreateEditorInput(ui.cache, bf.title, bf.body)
 
 	if err != nil && err != input.ErrEmptyTitle {
 		return err
 	}
 
-	var b *cache.BugCache
 	if err == input.ErrEmptyTitle {
-		ui.msgPopup.Activate(msgPopupErrorTitle, "Empty title, aborting.")
-		initGui(nil)
-
-		return errTerminateMainloop
+		// the form is kept as it was
+		ui.statusBar.showMessage("Empty title, the form is unchanged")
 	} else {
-		b, _, err = repo.NewBug(title, message)
-		if err != nil {
-			return err
-		}
+		bf.title = title
+		bf.body = message
+	}
 
-		initGui(func(ui *termUI) error {
-			ui.showBug.SetBug(b)
-			return ui.activateWindow(ui.showBug)
-		})
+	// the form views are created again with the new values
+	initGui(nil)
 
-		return errTerminateMainloop
-	}
+	return errTerminateMainloop
 }
 
 func addCommentWithEditor(bug *cache.BugCache) error {
@@ -236,6 +290,7 @@ func addCommentWithEditor(bug *cache.BugCache) error {
 		if err != nil {
 			return err
 		}
+		ui.statusBar.showMessage("Comment added")
 	}
 
 	initGui(nil)
@@ -271,6 +326,7 @@ func editCommentWithEditor(bug *cache.BugCache, target entity.Id, preMessage str
 		if err != nil {
 			return err
 		}
+		ui.statusBar.showMessage("Comment edited")
 	}
 
 	initGui(nil)
@@ -308,6 +364,7 @@ func setTitleWithEditor(bug *cache.BugCache) error {
 		if err != nil {
 			return err
 		}
+		ui.statusBar.showMessage("Title changed")
 	}
 
 	initGui(nil)
@@ -334,14 +391,13 @@ func editQueryWithEditor(bt *bugTable) error {
 		return err
 	}
 
-	bt.queryStr = queryStr
-
 	query, err := cache.ParseQuery(queryStr)
 
 	if err != nil {
+		bt.queryStr = queryStr
 		ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
 	} else {
-		bt.query = query
+		bt.setQuery(queryStr, query)
 	}
 
 	initGui(nil)
@@ -362,3 +418,33 @@ func minInt(a, b int) int {
 	}
 	return a
 }
+
+// roleColors return the foreground and background colors of a role of the
+// theme, as used by gocui
+func roleColors(role colors.Role) (gocui.Attribute, gocui.Attribute) {
+	style := colors.RoleStyle(role)
+
+	fg := gocuiColor(style.Fg)
+	for _, attr := range style.Attributes {
+		switch attr {
+		case "bold":
+			fg |= gocui.AttrBold
+		case "underline":
+			fg |= gocui.AttrUnderline
+		case "reverse":
+			fg |= gocui.AttrReverse
+		}
+	}
+
+	return fg, gocuiColor(style.Bg)
+}
+
+// gocuiColor convert one of the 256 colors of the terminal, or -1 for the
+// default color
+func gocuiColor(n int) gocui.Attribute {
+	if n < 0 {
+		return gocui.ColorDefault
+	}
+	// in the 256 colors output mode, the colors are shifted by one
+	return gocui.Attribute(n + 1)
+}
@@ -0,0 +1,64 @@
+package termui
+
+import (
+	"github.com/awesome-gocui/gocui"
+
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// watchBugs tell if the bugs are watched for changes made by another process
+var watchBugs bool
+
+// SetWatch enable the refresh of the bugs changed by another process, like a
+// git fetch in another terminal
+func SetWatch(watch bool) {
+	watchBugs = watch
+}
+
+// watch refresh the cache and the displayed bugs when they change, until the
+// returned function is called
+func watch(repo *cache.RepoCache) (stop func()) {
+	return repo.Watch(cache.DefaultWatchInterval, func() {
+		// the refresh is done in the main loop, not to change the cache while
+		// it's used
+		g := ui.g
+		if g == nil {
+			// an editor is open, the next change will tell
+			return
+		}
+		g.Update(func(gui *gocui.Gui) error {
+			return refreshBugs()
+		})
+	})
+}
+
+func refreshBugs() error {
+	changed, err := ui.cache.Refresh()
+	if err != nil {
+		ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+		return nil
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	ui.bugTable.stale = true
+
+	// the displayed bug was unloaded to be read again
+	if ui.showBug.bug == nil {
+		return nil
+	}
+	for _, id := range changed {
+		if id != ui.showBug.bug.Id() {
+			continue
+		}
+		b, err := ui.cache.ResolveBug(id)
+		if err != nil {
+			// removed
+			return ui.activateWindow(ui.bugTable)
+		}
+		ui.showBug.bug = b
+	}
+
+	return nil
+}
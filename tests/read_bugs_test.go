@@ -3,7 +3,10 @@ package tests
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/misc/random_bugs"
 	"github.com/MichaelMure/git-bug/repository"
 )
@@ -22,6 +25,38 @@ func TestReadBugs(t *testing.T) {
 	}
 }
 
+func TestReadLocalBugs(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	random_bugs.FillRepoWithSeed(repo, 15, 42)
+
+	ids, err := bug.ListLocalIds(repo)
+	require.NoError(t, err)
+
+	// read concurrently, but in order
+	var read []entity.Id
+	for b := range bug.ReadLocalBugs(repo, ids) {
+		require.NoError(t, b.Err)
+		read = append(read, b.Bug.Id())
+	}
+	require.Equal(t, ids, read)
+
+	// stopped at the first error
+	missing := append([]entity.Id{ids[0], "0123456789012345678901234567890123456789"}, ids[1:]...)
+	read = nil
+	var errs int
+	for b := range bug.ReadLocalBugs(repo, missing) {
+		if b.Err != nil {
+			errs++
+			continue
+		}
+		read = append(read, b.Bug.Id())
+	}
+	require.Equal(t, 1, errs)
+	require.Equal(t, ids[:1], read)
+}
+
 func benchmarkReadBugs(bugNumber int, t *testing.B) {
 	repo := repository.CreateTestRepo(false)
 	defer repository.CleanupTestRepos(t, repo)
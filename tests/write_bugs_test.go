@@ -0,0 +1,25 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/MichaelMure/git-bug/misc/random_bugs"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func benchmarkWriteBugs(bugNumber int, t *testing.B) {
+	for n := 0; n < t.N; n++ {
+		t.StopTimer()
+		repo := repository.CreateTestRepo(false)
+		t.StartTimer()
+
+		random_bugs.FillRepoWithSeed(repo, bugNumber, 42)
+
+		t.StopTimer()
+		repository.CleanupTestRepos(t, repo)
+		t.StartTimer()
+	}
+}
+
+func BenchmarkWriteBugs5(b *testing.B)  { benchmarkWriteBugs(5, b) }
+func BenchmarkWriteBugs25(b *testing.B) { benchmarkWriteBugs(25, b) }
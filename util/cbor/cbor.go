@@ -0,0 +1,410 @@
+// Package cbor transcode JSON documents to CBOR (RFC 7049) and back, as a
+// more compact storage of the same data.
+//
+// The transcoding keep the order of the object keys, and the strings of
+// lowercase hexadecimal digits like the ids and the hashes are stored as
+// bytes with the "expected conversion to base16" tag, which halves their size.
+// The JSON produced by Go's encoding/json is transcoded back to the exact
+// same bytes.
+package cbor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// major types
+const (
+	majorUint   = 0
+	majorNegInt = 1
+	majorBytes  = 2
+	majorText   = 3
+	majorArray  = 4
+	majorMap    = 5
+	majorTag    = 6
+	majorSimple = 7
+)
+
+const (
+	infoIndefinite = 31
+
+	simpleFalse   = 20
+	simpleTrue    = 21
+	simpleNull    = 22
+	simpleFloat64 = 27
+
+	tagBase16 = 23
+
+	breakByte = 0xff
+
+	// the shortest hexadecimal string stored as bytes
+	minHexLen = 16
+
+	// the deepest nesting of arrays and maps decoded, as the documents can
+	// come from a remote and the decoding is recursive
+	maxDepth = 1000
+)
+
+// IsCBOR tell if some data encoded with FromJSON is CBOR rather than JSON, a
+// JSON object or array starting with a different byte
+func IsCBOR(data []byte) bool {
+	return len(data) > 0 && (data[0] == majorMap<<5|infoIndefinite || data[0] == majorArray<<5|infoIndefinite)
+}
+
+// FromJSON transcode a JSON document to CBOR
+func FromJSON(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var out bytes.Buffer
+	depth := 0
+
+	for {
+		token, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case json.Delim:
+			switch token {
+			case '{':
+				out.WriteByte(majorMap<<5 | infoIndefinite)
+				depth++
+			case '[':
+				out.WriteByte(majorArray<<5 | infoIndefinite)
+				depth++
+			default:
+				out.WriteByte(breakByte)
+				depth--
+			}
+		case bool:
+			if token {
+				out.WriteByte(majorSimple<<5 | simpleTrue)
+			} else {
+				out.WriteByte(majorSimple<<5 | simpleFalse)
+			}
+		case nil:
+			out.WriteByte(majorSimple<<5 | simpleNull)
+		case json.Number:
+			if err := writeNumber(&out, token); err != nil {
+				return nil, err
+			}
+		case string:
+			writeString(&out, token)
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced JSON document")
+	}
+
+	return out.Bytes(), nil
+}
+
+func writeHead(out *bytes.Buffer, major byte, n uint64) {
+	switch {
+	case n < 24:
+		out.WriteByte(major<<5 | byte(n))
+	case n <= math.MaxUint8:
+		out.WriteByte(major<<5 | 24)
+		out.WriteByte(byte(n))
+	case n <= math.MaxUint16:
+		out.WriteByte(major<<5 | 25)
+		_ = binary.Write(out, binary.BigEndian, uint16(n))
+	case n <= math.MaxUint32:
+		out.WriteByte(major<<5 | 26)
+		_ = binary.Write(out, binary.BigEndian, uint32(n))
+	default:
+		out.WriteByte(major<<5 | 27)
+		_ = binary.Write(out, binary.BigEndian, n)
+	}
+}
+
+func writeNumber(out *bytes.Buffer, number json.Number) error {
+	str := number.String()
+
+	if i, err := strconv.ParseInt(str, 10, 64); err == nil && strconv.FormatInt(i, 10) == str {
+		if i >= 0 {
+			writeHead(out, majorUint, uint64(i))
+		} else {
+			writeHead(out, majorNegInt, uint64(-1-i))
+		}
+		return nil
+	}
+
+	if u, err := strconv.ParseUint(str, 10, 64); err == nil && strconv.FormatUint(u, 10) == str {
+		writeHead(out, majorUint, u)
+		return nil
+	}
+
+	f, err := number.Float64()
+	if err != nil {
+		return err
+	}
+	out.WriteByte(majorSimple<<5 | simpleFloat64)
+	return binary.Write(out, binary.BigEndian, math.Float64bits(f))
+}
+
+func writeString(out *bytes.Buffer, str string) {
+	if isLowerHex(str) {
+		raw, _ := hex.DecodeString(str)
+		writeHead(out, majorTag, tagBase16)
+		writeHead(out, majorBytes, uint64(len(raw)))
+		out.Write(raw)
+		return
+	}
+
+	writeHead(out, majorText, uint64(len(str)))
+	out.WriteString(str)
+}
+
+func isLowerHex(str string) bool {
+	if len(str) < minHexLen || len(str)%2 != 0 {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// ToJSON transcode a CBOR document produced by FromJSON back to JSON
+func ToJSON(data []byte) ([]byte, error) {
+	d := decoder{data: data}
+	var out bytes.Buffer
+
+	if err := d.value(&out); err != nil {
+		return nil, err
+	}
+	if d.pos != len(d.data) {
+		return nil, fmt.Errorf("unexpected data after the CBOR document")
+	}
+
+	return out.Bytes(), nil
+}
+
+type decoder struct {
+	data []byte
+	pos  int
+	// the number of arrays and maps being decoded
+	depth int
+}
+
+var errUnexpectedEnd = fmt.Errorf("unexpected end of the CBOR document")
+
+func (d *decoder) next() (byte, error) {
+	if d.pos >= len(d.data) {
+		return 0, errUnexpectedEnd
+	}
+	b := d.data[d.pos]
+	d.pos++
+	return b, nil
+}
+
+func (d *decoder) read(n uint64) ([]byte, error) {
+	if uint64(len(d.data)-d.pos) < n {
+		return nil, errUnexpectedEnd
+	}
+	result := d.data[d.pos : d.pos+int(n)]
+	d.pos += int(n)
+	return result, nil
+}
+
+// argument read the argument of an item from its additional information
+func (d *decoder) argument(info byte) (uint64, error) {
+	var size uint64
+	switch {
+	case info < 24:
+		return uint64(info), nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	default:
+		return 0, fmt.Errorf("invalid CBOR additional information %d", info)
+	}
+
+	raw, err := d.read(size)
+	if err != nil {
+		return 0, err
+	}
+
+	var result uint64
+	for _, b := range raw {
+		result = result<<8 | uint64(b)
+	}
+	return result, nil
+}
+
+// atBreak tell if the next byte end an indefinite length item, and consume it
+func (d *decoder) atBreak() (bool, error) {
+	if d.pos >= len(d.data) {
+		return false, errUnexpectedEnd
+	}
+	if d.data[d.pos] == breakByte {
+		d.pos++
+		return true, nil
+	}
+	return false, nil
+}
+
+// items write the items of an array or a map, of the given length or until
+// a break if indefinite
+func (d *decoder) items(out *bytes.Buffer, indefinite bool, length uint64, isMap bool) error {
+	for i := uint64(0); ; i++ {
+		if indefinite {
+			end, err := d.atBreak()
+			if err != nil {
+				return err
+			}
+			if end {
+				return nil
+			}
+		} else if i == length {
+			return nil
+		}
+
+		if i > 0 {
+			out.WriteByte(',')
+		}
+		if err := d.value(out); err != nil {
+			return err
+		}
+		if isMap {
+			out.WriteByte(':')
+			if err := d.value(out); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (d *decoder) value(out *bytes.Buffer) error {
+	b, err := d.next()
+	if err != nil {
+		return err
+	}
+	major, info := b>>5, b&0x1f
+
+	if (major == majorArray || major == majorMap) && info == infoIndefinite {
+		return d.container(out, major, true, 0)
+	}
+
+	if major == majorSimple {
+		switch info {
+		case simpleFalse:
+			out.WriteString("false")
+		case simpleTrue:
+			out.WriteString("true")
+		case simpleNull:
+			out.WriteString("null")
+		case simpleFloat64:
+			raw, err := d.read(8)
+			if err != nil {
+				return err
+			}
+			encoded, err := json.Marshal(math.Float64frombits(binary.BigEndian.Uint64(raw)))
+			if err != nil {
+				return err
+			}
+			out.Write(encoded)
+		default:
+			return fmt.Errorf("unsupported CBOR simple value %d", info)
+		}
+		return nil
+	}
+
+	arg, err := d.argument(info)
+	if err != nil {
+		return err
+	}
+
+	switch major {
+	case majorUint:
+		out.WriteString(strconv.FormatUint(arg, 10))
+	case majorNegInt:
+		if arg > math.MaxInt64 {
+			return fmt.Errorf("CBOR negative integer out of range")
+		}
+		out.WriteString(strconv.FormatInt(-1-int64(arg), 10))
+	case majorText:
+		raw, err := d.read(arg)
+		if err != nil {
+			return err
+		}
+		encoded, err := json.Marshal(string(raw))
+		if err != nil {
+			return err
+		}
+		out.Write(encoded)
+	case majorTag:
+		if arg != tagBase16 {
+			return fmt.Errorf("unsupported CBOR tag %d", arg)
+		}
+		b, err := d.next()
+		if err != nil {
+			return err
+		}
+		if b>>5 != majorBytes {
+			return fmt.Errorf("base16 tag not followed by bytes")
+		}
+		length, err := d.argument(b & 0x1f)
+		if err != nil {
+			return err
+		}
+		raw, err := d.read(length)
+		if err != nil {
+			return err
+		}
+		out.WriteByte('"')
+		out.WriteString(hex.EncodeToString(raw))
+		out.WriteByte('"')
+	case majorArray, majorMap:
+		return d.container(out, major, false, arg)
+	default:
+		return fmt.Errorf("unsupported CBOR major type %d", major)
+	}
+
+	return nil
+}
+
+func (d *decoder) container(out *bytes.Buffer, major byte, indefinite bool, length uint64) error {
+	d.depth++
+	defer func() { d.depth-- }()
+	if d.depth > maxDepth {
+		return fmt.Errorf("CBOR document nested deeper than %d", maxDepth)
+	}
+
+	if major == majorMap {
+		out.WriteByte('{')
+		if err := d.items(out, indefinite, length, true); err != nil {
+			return err
+		}
+		out.WriteByte('}')
+		return nil
+	}
+
+	out.WriteByte('[')
+	if err := d.items(out, indefinite, length, false); err != nil {
+		return err
+	}
+	out.WriteByte(']')
+	return nil
+}
@@ -0,0 +1,111 @@
+package cbor
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTrip(t *testing.T) {
+	type inner struct {
+		Id     string            `json:"id"`
+		Nonce  []byte            `json:"nonce"`
+		Labels []string          `json:"labels"`
+		Meta   map[string]string `json:"metadata,omitempty"`
+	}
+
+	values := []interface{}{
+		map[string]interface{}{},
+		[]interface{}{},
+		struct {
+			Zebra   int     `json:"zebra"`
+			Apple   int64   `json:"apple"`
+			Neg     int     `json:"neg"`
+			Float   float64 `json:"float"`
+			Big     uint64  `json:"big"`
+			Bool    bool    `json:"bool"`
+			Nil     *int    `json:"nil"`
+			Message string  `json:"message"`
+			Inner   []inner `json:"inner"`
+		}{
+			Zebra:   1585000000,
+			Apple:   -70000,
+			Neg:     -1,
+			Float:   3.25,
+			Big:     1 << 63,
+			Bool:    true,
+			Message: "<b>héhé</b> & \"quotes\"\n\t ",
+			Inner: []inner{
+				{
+					Id:     "a87084417c86dd370246cfd9cd065bfb028c7f05a87084417c86dd370246cfd9",
+					Nonce:  []byte{1, 2, 3},
+					Labels: []string{"bug", "deadbeef", "DEADBEEFDEADBEEF"},
+					Meta:   map[string]string{"0123456789abcdef": "value"},
+				},
+			},
+		},
+	}
+
+	for _, value := range values {
+		data, err := json.Marshal(value)
+		require.NoError(t, err)
+
+		encoded, err := FromJSON(data)
+		require.NoError(t, err)
+		assert.True(t, IsCBOR(encoded))
+
+		decoded, err := ToJSON(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, string(data), string(decoded))
+	}
+}
+
+func TestHexSize(t *testing.T) {
+	data := []byte(`{"id":"a87084417c86dd370246cfd9cd065bfb028c7f05a87084417c86dd370246cfd9"}`)
+
+	encoded, err := FromJSON(data)
+	require.NoError(t, err)
+
+	// map, key, tag, bytes header, 32 bytes, break
+	assert.Equal(t, 1+3+1+2+32+1, len(encoded))
+}
+
+func TestInvalid(t *testing.T) {
+	_, err := FromJSON([]byte(`{"a":`))
+	assert.Error(t, err)
+
+	for _, data := range [][]byte{
+		{},
+		{0xbf, 0x61},            // truncated key
+		{0xbf, 0x61, 'a', 0x01}, // no break
+		{0xd8, 0x20, 0x40},      // unknown tag
+		{0xd7, 0x61, 'a'},       // base16 of a text
+		{0x01, 0x02},            // trailing data
+		{0x9f, 0xf7, 0xff},      // undefined
+		{0x3b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, // too negative
+	} {
+		_, err := ToJSON(data)
+		assert.Error(t, err, "%x", data)
+	}
+}
+
+func TestDepth(t *testing.T) {
+	// [[[...null...]]]
+	nested := func(depth int) []byte {
+		return append(bytes.Repeat([]byte{0x81}, depth), 0xf6)
+	}
+
+	out, err := ToJSON(nested(maxDepth))
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("[", maxDepth)+"null"+strings.Repeat("]", maxDepth), string(out))
+
+	_, err = ToJSON(nested(maxDepth + 1))
+	assert.Error(t, err)
+
+	_, err = ToJSON(nested(10000000))
+	assert.Error(t, err)
+}
@@ -1,23 +1,335 @@
+// Package colors format the output of git-bug with colors. The colors are
+// chosen by role, like the id of a bug or an author, and can be changed with
+// a theme.
 package colors
 
-import "github.com/fatih/color"
-
-var (
-	Bold       = color.New(color.Bold).SprintFunc()
-	Black      = color.New(color.FgBlack).SprintFunc()
-	BlackBg    = color.New(color.BgBlack, color.FgWhite).SprintFunc()
-	White      = color.New(color.FgWhite).SprintFunc()
-	WhiteBold  = color.New(color.FgWhite, color.Bold).SprintFunc()
-	Yellow     = color.New(color.FgYellow).SprintFunc()
-	YellowBold = color.New(color.FgYellow, color.Bold).SprintFunc()
-	YellowBg   = color.New(color.BgYellow, color.FgBlack).SprintFunc()
-	Green      = color.New(color.FgGreen).SprintFunc()
-	GreenBg    = color.New(color.BgGreen, color.FgBlack).SprintFunc()
-	GreyBold   = color.New(color.BgBlack, color.Bold).SprintfFunc()
-	Red        = color.New(color.FgRed).SprintFunc()
-	Cyan       = color.New(color.FgCyan).SprintFunc()
-	CyanBg     = color.New(color.BgCyan, color.FgBlack).SprintFunc()
-	Blue       = color.New(color.FgBlue).SprintFunc()
-	BlueBg     = color.New(color.BgBlue).SprintFunc()
-	Magenta    = color.New(color.FgMagenta).SprintFunc()
+import (
+	"fmt"
+	"os"
+
+	"github.com/fatih/color"
+
+	"github.com/MichaelMure/git-bug/util/term"
+)
+
+// Role is the meaning of a colored text, mapped to an actual color by the theme
+type Role string
+
+const (
+	RoleId          Role = "id"
+	RoleStatus      Role = "status"
+	RoleAuthor      Role = "author"
+	RoleEmphasis    Role = "emphasis"
+	RolePlaceholder Role = "placeholder"
+	RoleDescription Role = "description"
+	RoleAction      Role = "action"
+	RoleKind        Role = "kind"
+	RoleAdded       Role = "added"
+	RoleRemoved     Role = "removed"
+	RoleError       Role = "error"
+	RoleFile        Role = "file"
+	RoleLocation    Role = "location"
+	RoleSeparator   Role = "separator"
+	RoleMatch       Role = "match"
+
+	// the roles of a markdown text rendered in the terminal
+	RoleHeading Role = "heading"
+	RoleStrong  Role = "strong"
+	RoleEmph    Role = "emph"
+	RoleCode    Role = "code"
+	RoleQuote   Role = "quote"
+	RoleLink    Role = "link"
+	RoleKeyword Role = "keyword"
+	RoleLiteral Role = "literal"
+	RoleComment Role = "comment"
+
+	// the roles of the terminal UI
+	RoleSelection Role = "selection"
+	RoleBar       Role = "bar"
+)
+
+// DefaultTheme is the color specification of each role, in the same format
+// as a theme file
+var DefaultTheme = map[Role]string{
+	RoleId:          "cyan",
+	RoleStatus:      "yellow",
+	RoleAuthor:      "magenta",
+	RoleEmphasis:    "bold",
+	RolePlaceholder: "bold normal black",
+	RoleDescription: "white",
+	RoleAction:      "yellow",
+	RoleKind:        "magenta",
+	RoleAdded:       "green",
+	RoleRemoved:     "red",
+	RoleError:       "red",
+	RoleFile:        "magenta",
+	RoleLocation:    "green",
+	RoleSeparator:   "cyan",
+	RoleMatch:       "bold red",
+
+	RoleHeading: "bold",
+	RoleStrong:  "bold",
+	RoleEmph:    "underline",
+	RoleCode:    "yellow",
+	RoleQuote:   "cyan",
+	RoleLink:    "blue underline",
+	RoleKeyword: "magenta",
+	RoleLiteral: "green",
+	RoleComment: "244",
+
+	RoleSelection: "black white",
+	RoleBar:       "normal blue",
+}
+
+// Themes are the themes available by name, as the roles changed from the
+// default theme
+var Themes = map[string]map[Role]string{
+	"dark": {},
+	"light": {
+		RoleId:          "blue",
+		RoleStatus:      "130",
+		RolePlaceholder: "dim",
+		RoleDescription: "normal",
+		RoleAction:      "130",
+		RoleAdded:       "28",
+		RoleLocation:    "28",
+		RoleSeparator:   "blue",
+		RoleCode:        "130",
+		RoleQuote:       "blue",
+		RoleLiteral:     "28",
+		RoleComment:     "245",
+		RoleSelection:   "black 252",
+		RoleBar:         "black 153",
+	},
+	// the 256 colors approximation of the solarized palette
+	"solarized": {
+		RoleId:          "33",
+		RoleStatus:      "136",
+		RoleAuthor:      "125",
+		RolePlaceholder: "240",
+		RoleDescription: "244",
+		RoleAction:      "136",
+		RoleKind:        "61",
+		RoleAdded:       "64",
+		RoleRemoved:     "160",
+		RoleError:       "160",
+		RoleFile:        "125",
+		RoleLocation:    "64",
+		RoleSeparator:   "37",
+		RoleMatch:       "bold 166",
+		RoleHeading:     "bold 166",
+		RoleCode:        "136",
+		RoleQuote:       "37",
+		RoleLink:        "33 underline",
+		RoleKeyword:     "64",
+		RoleLiteral:     "37",
+		RoleComment:     "240",
+		RoleSelection:   "230 33",
+		RoleBar:         "230 61",
+	},
+}
+
+var theme = make(map[Role]*color.Color)
+
+// specs is the color specification of the roles of the current theme
+var specs = make(map[Role]string)
+
+func init() {
+	if err := UseTheme("dark"); err != nil {
+		panic(err)
+	}
+
+	SetMode(ModeAuto)
+}
+
+// Mode select when the output is colored
+type Mode int
+
+const (
+	// color the output when writing to a terminal
+	ModeAuto Mode = iota
+	ModeAlways
+	ModeNever
 )
+
+// ParseMode parse a mode as found in the git config: auto, always or never.
+// Like git, true is the same as auto and false the same as never.
+func ParseMode(str string) (Mode, error) {
+	switch str {
+	case "auto", "true":
+		return ModeAuto, nil
+	case "always":
+		return ModeAlways, nil
+	case "never", "false":
+		return ModeNever, nil
+	default:
+		return ModeAuto, fmt.Errorf("unknown color mode %s", str)
+	}
+}
+
+// SetMode enable or disable the colors. The environment takes precedence over
+// the given mode:
+// - NO_COLOR set to any value disable the colors
+// - CLICOLOR_FORCE set to a non-zero value enable them
+// - CLICOLOR set to 0 disable them, unless the mode is ModeAlways
+func SetMode(mode Mode) {
+	switch {
+	case os.Getenv("NO_COLOR") != "":
+		mode = ModeNever
+	case os.Getenv("CLICOLOR_FORCE") != "" && os.Getenv("CLICOLOR_FORCE") != "0":
+		mode = ModeAlways
+	case os.Getenv("CLICOLOR") == "0" && mode == ModeAuto:
+		mode = ModeNever
+	}
+
+	switch mode {
+	case ModeAlways:
+		color.NoColor = false
+	case ModeNever:
+		color.NoColor = true
+	default:
+		color.NoColor = os.Getenv("TERM") == "dumb" || !term.IsTerminal(os.Stdout)
+	}
+}
+
+// Enabled tell if the output is colored
+func Enabled() bool {
+	return !color.NoColor
+}
+
+// SetRole change the color of a role, with a specification like "bold red"
+func SetRole(role Role, spec string) error {
+	if _, ok := DefaultTheme[role]; !ok {
+		return fmt.Errorf("unknown color role %s", role)
+	}
+
+	c, err := ParseSpec(spec)
+	if err != nil {
+		return err
+	}
+
+	theme[role] = c
+	specs[role] = spec
+	return nil
+}
+
+// UseTheme replace the colors of all the roles by the ones of a theme of
+// Themes
+func UseTheme(name string) error {
+	changes, ok := Themes[name]
+	if !ok {
+		return fmt.Errorf("unknown theme %s", name)
+	}
+
+	for role, spec := range DefaultTheme {
+		if change, ok := changes[role]; ok {
+			spec = change
+		}
+		if err := SetRole(role, spec); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RoleStyle return the parsed color specification of a role, for the
+// interfaces not using the escape sequences
+func RoleStyle(role Role) Style {
+	// the specs of the theme are already validated
+	style, _ := ParseStyle(specs[role])
+	return style
+}
+
+// Sprint format the operands with the color of the role
+func Sprint(role Role, a ...interface{}) string {
+	c, ok := theme[role]
+	if !ok {
+		return fmt.Sprint(a...)
+	}
+	return c.Sprint(a...)
+}
+
+// Term256 format the operands with one of the 256 colors of the terminal,
+// like the colors of the labels
+func Term256(code int, a ...interface{}) string {
+	if color.NoColor {
+		return fmt.Sprint(a...)
+	}
+	return fmt.Sprintf("\x1b[38;5;%dm%s\x1b[0m", code, fmt.Sprint(a...))
+}
+
+// Id format the id of an entity
+func Id(a ...interface{}) string { return Sprint(RoleId, a...) }
+
+// Status format the status of a bug
+func Status(a ...interface{}) string { return Sprint(RoleStatus, a...) }
+
+// Author format the name of an author
+func Author(a ...interface{}) string { return Sprint(RoleAuthor, a...) }
+
+// Emphasis format a title or a heading
+func Emphasis(a ...interface{}) string { return Sprint(RoleEmphasis, a...) }
+
+// Placeholder format a text displayed in place of a missing value
+func Placeholder(a ...interface{}) string { return Sprint(RolePlaceholder, a...) }
+
+// Description format a secondary text, like the description of a label
+func Description(a ...interface{}) string { return Sprint(RoleDescription, a...) }
+
+// Action format the name of an action or an operation
+func Action(a ...interface{}) string { return Sprint(RoleAction, a...) }
+
+// Kind format the kind of an entity
+func Kind(a ...interface{}) string { return Sprint(RoleKind, a...) }
+
+// Added format something added, like a label or a line of a diff
+func Added(a ...interface{}) string { return Sprint(RoleAdded, a...) }
+
+// Removed format something removed, like a label or a line of a diff
+func Removed(a ...interface{}) string { return Sprint(RoleRemoved, a...) }
+
+// Error format a problem
+func Error(a ...interface{}) string { return Sprint(RoleError, a...) }
+
+// File format the source of a search result
+func File(a ...interface{}) string { return Sprint(RoleFile, a...) }
+
+// Location format the position of a search result
+func Location(a ...interface{}) string { return Sprint(RoleLocation, a...) }
+
+// Separator format a separator, between fields or groups of lines
+func Separator(a ...interface{}) string { return Sprint(RoleSeparator, a...) }
+
+// Match format the part of a text matching a search
+func Match(a ...interface{}) string { return Sprint(RoleMatch, a...) }
+
+// Heading format the heading of a markdown text
+func Heading(a ...interface{}) string { return Sprint(RoleHeading, a...) }
+
+// Strong format a strongly emphasized part of a markdown text
+func Strong(a ...interface{}) string { return Sprint(RoleStrong, a...) }
+
+// Emph format an emphasized part of a markdown text
+func Emph(a ...interface{}) string { return Sprint(RoleEmph, a...) }
+
+// Code format some code, inline or in a block
+func Code(a ...interface{}) string { return Sprint(RoleCode, a...) }
+
+// Quote format the mark of a quote
+func Quote(a ...interface{}) string { return Sprint(RoleQuote, a...) }
+
+// Link format a link or an image
+func Link(a ...interface{}) string { return Sprint(RoleLink, a...) }
+
+// Keyword format a keyword of a highlighted code
+func Keyword(a ...interface{}) string { return Sprint(RoleKeyword, a...) }
+
+// Literal format a string or a number of a highlighted code
+func Literal(a ...interface{}) string { return Sprint(RoleLiteral, a...) }
+
+// Comment format a comment of a highlighted code
+func Comment(a ...interface{}) string { return Sprint(RoleComment, a...) }
+
+// Selection format the selected item of a list in the terminal UI
+func Selection(a ...interface{}) string { return Sprint(RoleSelection, a...) }
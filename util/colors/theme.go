@@ -0,0 +1,174 @@
+package colors
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+var attributes = map[string]color.Attribute{
+	"bold":      color.Bold,
+	"dim":       color.Faint,
+	"italic":    color.Italic,
+	"ul":        color.Underline,
+	"underline": color.Underline,
+	"blink":     color.BlinkSlow,
+	"reverse":   color.ReverseVideo,
+}
+
+var colorNames = map[string]int{
+	"black":   0,
+	"red":     1,
+	"green":   2,
+	"yellow":  3,
+	"blue":    4,
+	"magenta": 5,
+	"cyan":    6,
+	"white":   7,
+}
+
+// Style is a parsed color specification
+type Style struct {
+	// the foreground and background colors, between 0 and 255, the first 16
+	// being the named colors, or -1 to keep the default color
+	Fg, Bg int
+	// the attributes, like bold or underline
+	Attributes []string
+}
+
+// ParseStyle parse a color specification, in the format used by git: a list
+// of attributes (bold, dim, italic, ul, blink, reverse), a foreground color
+// and a background color, in any order. The first color is the foreground,
+// the second one the background.
+//
+// A color is either one of black, red, green, yellow, blue, magenta, cyan
+// and white, possibly prefixed by "bright", a number between 0 and 255, or
+// normal to keep the default color.
+func ParseStyle(spec string) (Style, error) {
+	style := Style{Fg: -1, Bg: -1}
+	colorsFound := 0
+
+	for _, word := range strings.Fields(strings.ToLower(spec)) {
+		if _, ok := attributes[word]; ok {
+			if word == "ul" {
+				word = "underline"
+			}
+			style.Attributes = append(style.Attributes, word)
+			continue
+		}
+
+		if colorsFound >= 2 {
+			return Style{}, fmt.Errorf("invalid color spec \"%s\": too many colors", spec)
+		}
+		target := &style.Fg
+		if colorsFound == 1 {
+			target = &style.Bg
+		}
+		colorsFound++
+
+		if word == "normal" {
+			continue
+		}
+
+		if n, err := strconv.Atoi(word); err == nil {
+			if n < 0 || n > 255 {
+				return Style{}, fmt.Errorf("invalid color spec \"%s\": %d is not a valid color", spec, n)
+			}
+			*target = n
+			continue
+		}
+
+		name := word
+		bright := 0
+		if strings.HasPrefix(word, "bright") {
+			name = strings.TrimPrefix(word, "bright")
+			bright = 8
+		}
+
+		offset, ok := colorNames[name]
+		if !ok {
+			return Style{}, fmt.Errorf("invalid color spec \"%s\": unknown color or attribute %s", spec, word)
+		}
+		*target = offset + bright
+	}
+
+	return style, nil
+}
+
+// ParseSpec parse a color specification, as described in ParseStyle
+func ParseSpec(spec string) (*color.Color, error) {
+	style, err := ParseStyle(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	c := color.New()
+	for _, attr := range style.Attributes {
+		c.Add(attributes[attr])
+	}
+	addColor(c, style.Fg, color.FgBlack, color.FgHiBlack, 38)
+	addColor(c, style.Bg, color.BgBlack, color.BgHiBlack, 48)
+
+	return c, nil
+}
+
+// addColor add a foreground or background color, as a named color if possible
+func addColor(c *color.Color, n int, base, brightBase, extended color.Attribute) {
+	switch {
+	case n < 0:
+	case n < 8:
+		c.Add(base + color.Attribute(n))
+	case n < 16:
+		c.Add(brightBase + color.Attribute(n-8))
+	default:
+		c.Add(extended, 5, color.Attribute(n))
+	}
+}
+
+// LoadTheme read a theme, with one role per line followed by its color
+// specification, like:
+//
+//	# comment
+//	theme = light
+//	id = bold blue
+//	author = 208
+//
+// A "theme" line start from one of the Themes, as if used with UseTheme. The
+// roles not in the theme keep their current color.
+func LoadTheme(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		split := strings.SplitN(text, "=", 2)
+		if len(split) != 2 {
+			return fmt.Errorf("theme line %d: expected \"role = color\"", line)
+		}
+
+		key := strings.TrimSpace(split[0])
+		value := strings.TrimSpace(split[1])
+
+		var err error
+		if key == "theme" {
+			err = UseTheme(value)
+		} else {
+			err = SetRole(Role(key), value)
+		}
+		if err != nil {
+			return fmt.Errorf("theme line %d: %v", line, err)
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,91 @@
+package colors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSpec(t *testing.T) {
+	cases := []struct {
+		spec     string
+		expected *color.Color
+	}{
+		{"", color.New()},
+		{"cyan", color.New(color.FgCyan)},
+		{"bold red", color.New(color.Bold, color.FgRed)},
+		{"brightgreen blue", color.New(color.FgHiGreen, color.BgBlue)},
+		{"normal black ul", color.New(color.BgBlack, color.Underline)},
+		{"208 17", color.New(38, 5, 208, 48, 5, 17)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.spec, func(t *testing.T) {
+			parsed, err := ParseSpec(c.spec)
+			require.NoError(t, err)
+			assert.True(t, c.expected.Equals(parsed))
+		})
+	}
+
+	for _, spec := range []string{"purple", "red green blue", "256"} {
+		_, err := ParseSpec(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestLoadTheme(t *testing.T) {
+	defer func() {
+		for role, spec := range DefaultTheme {
+			require.NoError(t, SetRole(role, spec))
+		}
+	}()
+
+	oldNoColor := color.NoColor
+	defer func() { color.NoColor = oldNoColor }()
+	color.NoColor = false
+
+	err := LoadTheme(strings.NewReader(`
+# my theme
+id = bold blue
+author=208
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "\x1b[1;34mabc\x1b[0m", Id("abc"))
+	assert.Equal(t, "\x1b[38;5;208mrene\x1b[0m", Author("rene"))
+	assert.Equal(t, "\x1b[33mopen\x1b[0m", Status("open"))
+
+	color.NoColor = true
+	assert.Equal(t, "abc", Id("abc"))
+	assert.Equal(t, "◼", Term256(42, "◼"))
+
+	assert.Error(t, LoadTheme(strings.NewReader("unknown = red")))
+	assert.Error(t, LoadTheme(strings.NewReader("id red")))
+}
+
+func TestUseTheme(t *testing.T) {
+	defer func() {
+		require.NoError(t, UseTheme("dark"))
+	}()
+
+	require.NoError(t, UseTheme("light"))
+	assert.Equal(t, Style{Fg: 0, Bg: 252}, RoleStyle(RoleSelection))
+	assert.Equal(t, Style{Fg: 5, Bg: -1}, RoleStyle(RoleAuthor))
+
+	err := LoadTheme(strings.NewReader(`
+theme = solarized
+author = bold brightmagenta
+`))
+	require.NoError(t, err)
+	assert.Equal(t, Style{Fg: 33, Bg: -1}, RoleStyle(RoleId))
+	assert.Equal(t, Style{Fg: 13, Bg: -1, Attributes: []string{"bold"}}, RoleStyle(RoleAuthor))
+
+	require.NoError(t, UseTheme("dark"))
+	assert.Equal(t, Style{Fg: 6, Bg: -1}, RoleStyle(RoleId))
+
+	assert.Error(t, UseTheme("unknown"))
+	assert.Error(t, LoadTheme(strings.NewReader("theme = unknown")))
+}
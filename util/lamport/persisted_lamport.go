@@ -5,11 +5,15 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 )
 
 type Persisted struct {
 	Clock
 	filePath string
+
+	// serialize the writes of the file
+	mu sync.Mutex
 }
 
 // NewPersisted create a new persisted Lamport clock
@@ -44,16 +48,22 @@ func LoadPersisted(filePath string) (*Persisted, error) {
 
 // Increment is used to return the value of the lamport clock and increment it afterwards
 func (c *Persisted) Increment() (Time, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	time := c.Clock.Increment()
-	return time, c.Write()
+	return time, c.write()
 }
 
 // Witness is called to update our local clock if necessary after
 // witnessing a clock value received from another process
 func (c *Persisted) Witness(time Time) error {
 	// TODO: rework so that we write only when the clock was actually updated
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.Clock.Witness(time)
-	return c.Write()
+	return c.write()
 }
 
 func (c *Persisted) read() error {
@@ -79,6 +89,13 @@ func (c *Persisted) read() error {
 }
 
 func (c *Persisted) Write() error {
-	data := []byte(fmt.Sprintf("%d", c.counter))
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.write()
+}
+
+func (c *Persisted) write() error {
+	data := []byte(fmt.Sprintf("%d", c.Time()))
 	return ioutil.WriteFile(c.filePath, data, 0644)
 }
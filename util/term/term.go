@@ -0,0 +1,91 @@
+// Package term give a portable access to the terminal, including the
+// Windows console and the Cygwin and MSYS terminals like mintty.
+package term
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+	"golang.org/x/crypto/ssh/terminal"
+
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+// IsTerminal tell if a file is a terminal. On Windows, the Cygwin and MSYS
+// terminals, seen as pipes, are terminals as well.
+func IsTerminal(f *os.File) bool {
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
+
+// ReadPassword read a line of the standard input without echoing it, and
+// without the new line. The echo is restored on interrupt. When the standard
+// input is not a terminal, the line is read as is.
+func ReadPassword() (string, error) {
+	fd := int(os.Stdin.Fd())
+
+	// a Windows console or a unix terminal
+	if terminal.IsTerminal(fd) {
+		state, err := terminal.GetState(fd)
+		if err != nil {
+			return "", err
+		}
+
+		cancel := interrupt.RegisterCleaner(func() error {
+			return terminal.Restore(fd, state)
+		})
+		defer cancel()
+
+		password, err := terminal.ReadPassword(fd)
+		return string(password), err
+	}
+
+	// the Cygwin and MSYS terminals are pipes for Windows, their echo is
+	// disabled the way their own programs do
+	if isatty.IsCygwinTerminal(os.Stdin.Fd()) {
+		err := stty("-echo")
+		if err != nil {
+			return "", err
+		}
+
+		cancel := interrupt.RegisterCleaner(func() error {
+			return stty("echo")
+		})
+		defer cancel()
+		defer func() { _ = stty("echo") }()
+	}
+
+	return readLine(os.Stdin)
+}
+
+func stty(arg string) error {
+	cmd := exec.Command("stty", arg)
+	cmd.Stdin = os.Stdin
+	return cmd.Run()
+}
+
+// readLine read a line byte by byte, to not consume what follow it
+func readLine(r io.Reader) (string, error) {
+	var line []byte
+	buf := make([]byte, 1)
+
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if buf[0] == '\n' {
+				break
+			}
+			line = append(line, buf[0])
+		}
+		if err == io.EOF && len(line) > 0 {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return strings.TrimSuffix(string(line), "\r"), nil
+}
@@ -0,0 +1,39 @@
+package term
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadLine(t *testing.T) {
+	r := strings.NewReader("secret\r\nnext\nlast")
+
+	line, err := readLine(r)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", line)
+
+	line, err = readLine(r)
+	require.NoError(t, err)
+	assert.Equal(t, "next", line)
+
+	line, err = readLine(r)
+	require.NoError(t, err)
+	assert.Equal(t, "last", line)
+
+	_, err = readLine(r)
+	assert.Error(t, err)
+}
+
+func TestIsTerminal(t *testing.T) {
+	f, err := ioutil.TempFile("", "git-bug-term")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	assert.False(t, IsTerminal(f))
+}
@@ -21,14 +21,14 @@ Author.fragment = gql`
       name
       email
       displayName
-      avatarUrl
+      avatar
     }
   }
 `;
 
 export const Avatar = ({ author, ...props }) => {
-  if (author.avatarUrl) {
-    return <MAvatar src={author.avatarUrl} {...props} />;
+  if (author.avatar) {
+    return <MAvatar src={author.avatar} {...props} />;
   }
 
   return <MAvatar {...props}>{author.displayName[0]}</MAvatar>;